@@ -2,6 +2,7 @@ package server
 
 import (
 	"central_reserve/cmd/internal/routes"
+	"central_reserve/services/audit"
 	"central_reserve/services/auth"
 	"central_reserve/services/auth/middleware"
 	"central_reserve/services/business"
@@ -36,6 +37,7 @@ func Init(ctx context.Context) error {
 
 	v1Group := r.Group("/api/v1")
 
+	audit.New(database, environment, logger, v1Group)
 	auth.New(database, environment, logger, s3, v1Group, jwtService)
 	customer.New(database, environment, logger, v1Group)
 	business.New(database, environment, logger, s3, v1Group)
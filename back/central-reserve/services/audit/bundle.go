@@ -0,0 +1,24 @@
+package audit
+
+import (
+	"central_reserve/services/audit/internal/app/usecaseaudit"
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler"
+	"central_reserve/services/audit/internal/infra/secondary/repository"
+	sharedaudit "central_reserve/shared/audit"
+	"central_reserve/shared/db"
+	"central_reserve/shared/env"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// New registra el repositorio como destino de la auditoría de todos los servicios y expone las
+// rutas de consulta y exportación
+func New(db db.IDatabase, env env.IConfig, logger log.ILogger, v1Group *gin.RouterGroup) {
+	repository := repository.New(db, logger)
+	sharedaudit.Configure(repository, logger)
+
+	usecaseaudit := usecaseaudit.New(repository, logger)
+	handler := audithandler.New(usecaseaudit, logger)
+	audithandler.RegisterRoutes(v1Group, handler, logger)
+}
@@ -0,0 +1,24 @@
+package usecaseaudit
+
+import (
+	"central_reserve/services/audit/internal/domain"
+	"central_reserve/shared/log"
+	"context"
+)
+
+type IUseCaseAudit interface {
+	ListAuditLogs(ctx context.Context, filters domain.AuditLogFilters) (*domain.PaginatedAuditLogs, error)
+	ExportAuditLogs(ctx context.Context, filters domain.AuditLogFilters) ([]domain.AuditLog, error)
+}
+
+type AuditUseCase struct {
+	repository domain.IAuditRepository
+	log        log.ILogger
+}
+
+func New(repository domain.IAuditRepository, logger log.ILogger) IUseCaseAudit {
+	return &AuditUseCase{
+		repository: repository,
+		log:        logger,
+	}
+}
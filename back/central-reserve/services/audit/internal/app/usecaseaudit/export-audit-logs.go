@@ -0,0 +1,31 @@
+package usecaseaudit
+
+import (
+	"central_reserve/services/audit/internal/domain"
+	"context"
+	"fmt"
+)
+
+// ExportAuditLogs obtiene todos los registros que cumplen los filtros para exportarlos. Rechaza
+// exportaciones de más de MaxExportRows en lugar de truncarlas en silencio.
+func (u *AuditUseCase) ExportAuditLogs(ctx context.Context, filters domain.AuditLogFilters) ([]domain.AuditLog, error) {
+	if err := validateDateRange(filters); err != nil {
+		return nil, err
+	}
+
+	total, err := u.repository.CountAuditLogs(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error al contar auditoría: %w", err)
+	}
+	if total > domain.MaxExportRows {
+		return nil, domain.ErrExportTooLarge
+	}
+
+	logs, err := u.repository.GetAuditLogsForExport(ctx, filters, domain.MaxExportRows)
+	if err != nil {
+		return nil, fmt.Errorf("error al exportar auditoría: %w", err)
+	}
+
+	u.log.Info(ctx).Uint("business_id", filters.BusinessID).Int("rows", len(logs)).Msg("Auditoría exportada")
+	return logs, nil
+}
@@ -0,0 +1,37 @@
+package usecaseaudit
+
+import (
+	"central_reserve/services/audit/internal/domain"
+	"context"
+	"fmt"
+)
+
+// ListAuditLogs obtiene una página de la auditoría
+func (u *AuditUseCase) ListAuditLogs(ctx context.Context, filters domain.AuditLogFilters) (*domain.PaginatedAuditLogs, error) {
+	if err := validateDateRange(filters); err != nil {
+		return nil, err
+	}
+
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 {
+		filters.PageSize = domain.DefaultPageSize
+	}
+	if filters.PageSize > domain.MaxPageSize {
+		filters.PageSize = domain.MaxPageSize
+	}
+
+	result, err := u.repository.ListAuditLogs(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error al listar auditoría: %w", err)
+	}
+	return result, nil
+}
+
+func validateDateRange(filters domain.AuditLogFilters) error {
+	if filters.From != nil && filters.To != nil && filters.From.After(*filters.To) {
+		return domain.ErrInvalidDateRange
+	}
+	return nil
+}
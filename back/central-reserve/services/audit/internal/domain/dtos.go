@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"central_reserve/shared/audit"
+	"time"
+)
+
+const (
+	// DefaultPageSize es el tamaño de página si no se indica
+	DefaultPageSize = 20
+	// MaxPageSize es el tamaño de página máximo del listado
+	MaxPageSize = 100
+	// MaxExportRows es el máximo de registros de una exportación CSV
+	MaxExportRows = 10000
+)
+
+// AuditLog es un registro de auditoría
+type AuditLog struct {
+	ID         uint
+	CreatedAt  time.Time
+	BusinessID *uint
+	UserID     *uint
+	UserEmail  string
+	APIKeyID   *uint
+	IPAddress  string
+	EntityType string
+	EntityID   string
+	Action     string
+	Changes    audit.Changes
+}
+
+// AuditLogFilters son los filtros del listado. BusinessID 0 solo lo usa el super admin para ver
+// todos los negocios.
+type AuditLogFilters struct {
+	BusinessID uint
+	UserID     *uint
+	APIKeyID   *uint
+	EntityType string
+	EntityID   string
+	Action     string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	PageSize   int
+}
+
+// PaginatedAuditLogs es una página del listado
+type PaginatedAuditLogs struct {
+	Logs       []AuditLog
+	Total      int64
+	Page       int
+	PageSize   int
+	TotalPages int
+}
@@ -0,0 +1,8 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidDateRange = errors.New("la fecha inicial no puede ser posterior a la final")
+	ErrExportTooLarge   = errors.New("la exportación supera el máximo de registros, acota los filtros")
+)
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"central_reserve/shared/audit"
+	"context"
+)
+
+// IAuditRepository persiste y consulta la auditoría. Los registros solo se insertan.
+type IAuditRepository interface {
+	audit.Writer
+	ListAuditLogs(ctx context.Context, filters AuditLogFilters) (*PaginatedAuditLogs, error)
+	CountAuditLogs(ctx context.Context, filters AuditLogFilters) (int64, error)
+	GetAuditLogsForExport(ctx context.Context, filters AuditLogFilters, limit int) ([]AuditLog, error)
+}
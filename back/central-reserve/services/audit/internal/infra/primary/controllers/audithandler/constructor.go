@@ -0,0 +1,27 @@
+package audithandler
+
+import (
+	"central_reserve/services/audit/internal/app/usecaseaudit"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IAuditHandler define la interfaz para el handler de auditoría
+type IAuditHandler interface {
+	GetAuditLogsHandler(c *gin.Context)
+	ExportAuditLogsHandler(c *gin.Context)
+}
+
+type AuditHandler struct {
+	usecase usecaseaudit.IUseCaseAudit
+	logger  log.ILogger
+}
+
+// New crea una nueva instancia del handler de auditoría
+func New(usecase usecaseaudit.IUseCaseAudit, logger log.ILogger) IAuditHandler {
+	return &AuditHandler{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
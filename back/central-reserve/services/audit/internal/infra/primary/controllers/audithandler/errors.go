@@ -0,0 +1,13 @@
+package audithandler
+
+import (
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler/mapper"
+	"central_reserve/services/auth/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondBusinessScopeError responde el error al acotar la request al negocio del token
+func respondBusinessScopeError(c *gin.Context, err error) {
+	c.JSON(middleware.BusinessScopeStatus(err), mapper.BuildErrorResponse("invalid_business_scope", err.Error()))
+}
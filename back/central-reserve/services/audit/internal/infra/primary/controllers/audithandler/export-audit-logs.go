@@ -0,0 +1,86 @@
+package audithandler
+
+import (
+	"central_reserve/services/audit/internal/domain"
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler/mapper"
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler/request"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/db"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Exporta la auditoría a CSV
+// @Description	Exporta los registros de auditoría que cumplen los filtros (los mismos del listado, sin paginar). Rechaza exportaciones de más de 10000 registros.
+// @Tags			Auditoría
+// @Produce		text/csv
+// @Security		BearerAuth
+// @Param			business_id	query		string		false	"ID del negocio o all para todos (solo super admin, obligatorio para él)"
+// @Param			user_id		query		int		false	"Usuario que hizo el cambio"
+// @Param			api_key_id	query		int		false	"API Key usada"
+// @Param			entity_type	query		string	false	"Tipo de entidad"
+// @Param			entity_id	query		string	false	"ID de la entidad"
+// @Param			action		query		string	false	"Acción"
+// @Param			from		query		string	false	"Desde (RFC3339)"
+// @Param			to			query		string	false	"Hasta, exclusivo (RFC3339)"
+// @Success		200			{file}		binary
+// @Failure		400			{object}	response.ErrorResponse	"Filtros inválidos o exportación demasiado grande"
+// @Failure		401			{object}	response.ErrorResponse	"Token de acceso requerido"
+// @Failure		403			{object}	response.ErrorResponse	"Sin acceso a la auditoría"
+// @Failure		500			{object}	response.ErrorResponse	"Error interno del servidor"
+// @Router			/audit-logs/export [get]
+func (h *AuditHandler) ExportAuditLogsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Entrada ───────────────────────────────────────────
+	var req request.AuditLogFiltersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_request", "Filtros inválidos: "+err.Error()))
+		return
+	}
+
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+	// En los filtros de auditoría 0 significa todos los negocios
+	if businessID == db.AllBusinesses {
+		businessID = 0
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	logs, err := h.usecase.ExportAuditLogs(ctx, mapper.ToAuditLogFilters(req, businessID))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidDateRange) || errors.Is(err, domain.ErrExportTooLarge) {
+			c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_request", err.Error()))
+			return
+		}
+		h.logger.Error().Err(err).Msg("error interno al exportar auditoría")
+		c.JSON(http.StatusInternalServerError, mapper.BuildErrorResponse("internal_error", "No se pudo exportar la auditoría"))
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	filename := fmt.Sprintf("auditoria_%s.csv", time.Now().Format("20060102_150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	c.Status(http.StatusOK)
+
+	c.Writer.Write([]byte("\xEF\xBB\xBF")) // BOM UTF-8 para que Excel respete los acentos
+	writer := csv.NewWriter(c.Writer)
+	_ = writer.Write(mapper.CSVHeader)
+	for _, log := range logs {
+		_ = writer.Write(mapper.AuditLogToCSVRow(log))
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		h.logger.Error().Err(err).Msg("error al escribir exportación de auditoría")
+	}
+}
@@ -0,0 +1,72 @@
+package audithandler
+
+import (
+	"central_reserve/services/audit/internal/domain"
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler/mapper"
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler/request"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/db"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Lista la auditoría del negocio
+// @Description	Obtiene de forma paginada las mutaciones administrativas (quién, qué entidad, qué acción y los campos cambiados), de la más reciente a la más antigua. El super admin debe indicar el negocio con business_id, o business_id=all para ver todos.
+// @Tags			Auditoría
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			business_id	query		string						false	"ID del negocio o all para todos (solo super admin, obligatorio para él)"
+// @Param			user_id		query		int						false	"Usuario que hizo el cambio"
+// @Param			api_key_id	query		int						false	"API Key usada"
+// @Param			entity_type	query		string					false	"Tipo de entidad (role, table, resident, vote...)"
+// @Param			entity_id	query		string					false	"ID de la entidad"
+// @Param			action		query		string					false	"Acción (create, update, delete...)"
+// @Param			from		query		string					false	"Desde (RFC3339)"
+// @Param			to			query		string					false	"Hasta, exclusivo (RFC3339)"
+// @Param			page		query		int						false	"Página"			default(1)
+// @Param			page_size	query		int						false	"Tamaño de página"	default(20)
+// @Success		200			{object}	response.GetAuditLogsResponse	"Auditoría obtenida exitosamente"
+// @Failure		400			{object}	response.ErrorResponse			"Filtros inválidos"
+// @Failure		401			{object}	response.ErrorResponse			"Token de acceso requerido"
+// @Failure		403			{object}	response.ErrorResponse			"Sin acceso a la auditoría"
+// @Failure		500			{object}	response.ErrorResponse			"Error interno del servidor"
+// @Router			/audit-logs [get]
+func (h *AuditHandler) GetAuditLogsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Entrada ───────────────────────────────────────────
+	var req request.AuditLogFiltersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_request", "Filtros inválidos: "+err.Error()))
+		return
+	}
+
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+	// En los filtros de auditoría 0 significa todos los negocios
+	if businessID == db.AllBusinesses {
+		businessID = 0
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	result, err := h.usecase.ListAuditLogs(ctx, mapper.ToAuditLogFilters(req, businessID))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidDateRange) {
+			c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_request", err.Error()))
+			return
+		}
+		h.logger.Error().Err(err).Msg("error interno al listar auditoría")
+		c.JSON(http.StatusInternalServerError, mapper.BuildErrorResponse("internal_error", "No se pudo obtener la auditoría"))
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, mapper.BuildGetAuditLogsResponse(result, "Auditoría obtenida exitosamente"))
+}
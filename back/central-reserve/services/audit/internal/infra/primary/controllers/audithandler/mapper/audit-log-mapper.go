@@ -0,0 +1,108 @@
+package mapper
+
+import (
+	"central_reserve/services/audit/internal/domain"
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler/request"
+	"central_reserve/services/audit/internal/infra/primary/controllers/audithandler/response"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// CSVHeader son las columnas de la exportación CSV
+var CSVHeader = []string{"id", "fecha", "negocio", "usuario", "email", "api_key", "ip", "entidad", "entidad_id", "accion", "cambios"}
+
+// ToAuditLogFilters convierte la request en filtros del dominio acotados al negocio
+func ToAuditLogFilters(req request.AuditLogFiltersRequest, businessID uint) domain.AuditLogFilters {
+	return domain.AuditLogFilters{
+		BusinessID: businessID,
+		UserID:     req.UserID,
+		APIKeyID:   req.APIKeyID,
+		EntityType: req.EntityType,
+		EntityID:   req.EntityID,
+		Action:     req.Action,
+		From:       req.From,
+		To:         req.To,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}
+}
+
+// AuditLogToResponse convierte un registro del dominio a AuditLogResponse
+func AuditLogToResponse(log domain.AuditLog) response.AuditLogResponse {
+	changes := make(map[string]response.ChangeResponse, len(log.Changes))
+	for field, change := range log.Changes {
+		changes[field] = response.ChangeResponse{Before: change.Before, After: change.After}
+	}
+	return response.AuditLogResponse{
+		ID:         log.ID,
+		CreatedAt:  log.CreatedAt,
+		BusinessID: log.BusinessID,
+		UserID:     log.UserID,
+		UserEmail:  log.UserEmail,
+		APIKeyID:   log.APIKeyID,
+		IPAddress:  log.IPAddress,
+		EntityType: log.EntityType,
+		EntityID:   log.EntityID,
+		Action:     log.Action,
+		Changes:    changes,
+	}
+}
+
+// BuildGetAuditLogsResponse construye la respuesta del listado
+func BuildGetAuditLogsResponse(result *domain.PaginatedAuditLogs, message string) response.GetAuditLogsResponse {
+	logs := make([]response.AuditLogResponse, 0, len(result.Logs))
+	for _, log := range result.Logs {
+		logs = append(logs, AuditLogToResponse(log))
+	}
+	return response.GetAuditLogsResponse{
+		Success: true,
+		Message: message,
+		Data: response.PaginatedAuditLogsResponse{
+			Logs:       logs,
+			Total:      result.Total,
+			Page:       result.Page,
+			PageSize:   result.PageSize,
+			TotalPages: result.TotalPages,
+		},
+	}
+}
+
+// AuditLogToCSVRow convierte un registro en una fila CSV con las columnas de CSVHeader. Los
+// cambios se exportan como JSON con las claves ordenadas.
+func AuditLogToCSVRow(log domain.AuditLog) []string {
+	changes := "{}"
+	if raw, err := json.Marshal(log.Changes); err == nil && len(log.Changes) > 0 {
+		changes = string(raw) // encoding/json ordena las claves de los mapas
+	}
+
+	return []string{
+		strconv.FormatUint(uint64(log.ID), 10),
+		log.CreatedAt.Format(time.RFC3339),
+		optionalID(log.BusinessID),
+		optionalID(log.UserID),
+		log.UserEmail,
+		optionalID(log.APIKeyID),
+		log.IPAddress,
+		log.EntityType,
+		log.EntityID,
+		log.Action,
+		changes,
+	}
+}
+
+// BuildErrorResponse construye una respuesta de error
+func BuildErrorResponse(errorType, message string) response.ErrorResponse {
+	return response.ErrorResponse{
+		Success: false,
+		Error:   errorType,
+		Message: message,
+	}
+}
+
+func optionalID(id *uint) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*id), 10)
+}
@@ -0,0 +1,16 @@
+package request
+
+import "time"
+
+// AuditLogFiltersRequest son los filtros del listado y la exportación de auditoría
+type AuditLogFiltersRequest struct {
+	UserID     *uint      `form:"user_id"`
+	APIKeyID   *uint      `form:"api_key_id"`
+	EntityType string     `form:"entity_type"`
+	EntityID   string     `form:"entity_id"`
+	Action     string     `form:"action"`
+	From       *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To         *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page       int        `form:"page" binding:"omitempty,min=1"`
+	PageSize   int        `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
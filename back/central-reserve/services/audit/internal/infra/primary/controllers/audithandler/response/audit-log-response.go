@@ -0,0 +1,47 @@
+package response
+
+import "time"
+
+// ChangeResponse es el valor de un campo antes y después del cambio
+type ChangeResponse struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditLogResponse representa un registro de auditoría en la respuesta API
+type AuditLogResponse struct {
+	ID         uint                      `json:"id"`
+	CreatedAt  time.Time                 `json:"created_at"`
+	BusinessID *uint                     `json:"business_id"`
+	UserID     *uint                     `json:"user_id"`
+	UserEmail  string                    `json:"user_email,omitempty"`
+	APIKeyID   *uint                     `json:"api_key_id,omitempty"`
+	IPAddress  string                    `json:"ip_address"`
+	EntityType string                    `json:"entity_type"`
+	EntityID   string                    `json:"entity_id"`
+	Action     string                    `json:"action"`
+	Changes    map[string]ChangeResponse `json:"changes"`
+}
+
+// PaginatedAuditLogsResponse es una página de la auditoría
+type PaginatedAuditLogsResponse struct {
+	Logs       []AuditLogResponse `json:"logs"`
+	Total      int64              `json:"total"`
+	Page       int                `json:"page"`
+	PageSize   int                `json:"page_size"`
+	TotalPages int                `json:"total_pages"`
+}
+
+// GetAuditLogsResponse representa la respuesta del listado de auditoría
+type GetAuditLogsResponse struct {
+	Success bool                       `json:"success"`
+	Message string                     `json:"message"`
+	Data    PaginatedAuditLogsResponse `json:"data"`
+}
+
+// ErrorResponse representa una respuesta de error
+type ErrorResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
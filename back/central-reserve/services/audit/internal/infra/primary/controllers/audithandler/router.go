@@ -0,0 +1,21 @@
+package audithandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registra las rutas del handler de auditoría. Solo el super admin y los roles con
+// lectura de audit_logs (administradores del negocio) consultan la auditoría.
+func RegisterRoutes(v1Group *gin.RouterGroup, handler IAuditHandler, logger log.ILogger) {
+	auditLogs := v1Group.Group("/audit-logs")
+
+	read := middleware.RequirePermission(middleware.ResourceAuditLogs, middleware.ActionRead)
+
+	{
+		auditLogs.GET("", middleware.JWT(), read, handler.GetAuditLogsHandler)
+		auditLogs.GET("/export", middleware.JWT(), read, handler.ExportAuditLogsHandler)
+	}
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"central_reserve/services/audit/internal/domain"
+	"central_reserve/shared/audit"
+	"central_reserve/shared/db"
+	"central_reserve/shared/log"
+	"context"
+	"dbpostgres/app/infra/models"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	database db.IDatabase
+	logger   log.ILogger
+}
+
+func New(db db.IDatabase, logger log.ILogger) domain.IAuditRepository {
+	return &Repository{
+		database: db,
+		logger:   logger,
+	}
+}
+
+// auditLogRow es un registro con el email del usuario que hizo el cambio
+type auditLogRow struct {
+	models.AuditLog
+	UserEmail string
+}
+
+// Append inserta un registro de auditoría
+func (r *Repository) Append(ctx context.Context, record audit.Record) error {
+	changes, err := json.Marshal(record.Changes)
+	if err != nil {
+		return fmt.Errorf("error al serializar cambios: %w", err)
+	}
+
+	model := models.AuditLog{
+		CreatedAt:  record.CreatedAt,
+		BusinessID: optionalID(record.BusinessID),
+		UserID:     optionalID(record.UserID),
+		APIKeyID:   optionalID(record.APIKeyID),
+		IPAddress:  record.IPAddress,
+		EntityType: record.EntityType,
+		EntityID:   strconv.FormatUint(uint64(record.EntityID), 10),
+		Action:     record.Action,
+		Changes:    string(changes),
+	}
+	if err := r.database.Conn(ctx).Create(&model).Error; err != nil {
+		r.logger.Error().Err(err).Str("entity_type", record.EntityType).Msg("Error al insertar registro de auditoría")
+		return err
+	}
+	return nil
+}
+
+// ListAuditLogs obtiene una página de la auditoría, del registro más reciente al más antiguo
+func (r *Repository) ListAuditLogs(ctx context.Context, filters domain.AuditLogFilters) (*domain.PaginatedAuditLogs, error) {
+	total, err := r.CountAuditLogs(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []auditLogRow
+	if err := r.filtered(ctx, filters).
+		Select(`audit_log.*, u.email AS user_email`).
+		Joins(`LEFT JOIN "user" u ON u.id = audit_log.user_id`).
+		Order("audit_log.created_at DESC, audit_log.id DESC").
+		Offset((filters.Page - 1) * filters.PageSize).
+		Limit(filters.PageSize).
+		Scan(&rows).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", filters.BusinessID).Msg("Error al listar auditoría")
+		return nil, err
+	}
+
+	totalPages := int((total + int64(filters.PageSize) - 1) / int64(filters.PageSize))
+	return &domain.PaginatedAuditLogs{
+		Logs:       toAuditLogs(rows),
+		Total:      total,
+		Page:       filters.Page,
+		PageSize:   filters.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// CountAuditLogs cuenta los registros que cumplen los filtros
+func (r *Repository) CountAuditLogs(ctx context.Context, filters domain.AuditLogFilters) (int64, error) {
+	var total int64
+	if err := r.filtered(ctx, filters).Count(&total).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", filters.BusinessID).Msg("Error al contar auditoría")
+		return 0, err
+	}
+	return total, nil
+}
+
+// GetAuditLogsForExport obtiene hasta limit registros que cumplen los filtros, sin paginar
+func (r *Repository) GetAuditLogsForExport(ctx context.Context, filters domain.AuditLogFilters, limit int) ([]domain.AuditLog, error) {
+	var rows []auditLogRow
+	if err := r.filtered(ctx, filters).
+		Select(`audit_log.*, u.email AS user_email`).
+		Joins(`LEFT JOIN "user" u ON u.id = audit_log.user_id`).
+		Order("audit_log.created_at DESC, audit_log.id DESC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", filters.BusinessID).Msg("Error al exportar auditoría")
+		return nil, err
+	}
+	return toAuditLogs(rows), nil
+}
+
+// filtered arma la consulta base con los filtros del listado
+func (r *Repository) filtered(ctx context.Context, filters domain.AuditLogFilters) *gorm.DB {
+	query := r.database.Conn(ctx).Model(&models.AuditLog{})
+	if filters.BusinessID != 0 {
+		query = query.Where("audit_log.business_id = ?", filters.BusinessID)
+	}
+	if filters.UserID != nil {
+		query = query.Where("audit_log.user_id = ?", *filters.UserID)
+	}
+	if filters.APIKeyID != nil {
+		query = query.Where("audit_log.api_key_id = ?", *filters.APIKeyID)
+	}
+	if filters.EntityType != "" {
+		query = query.Where("audit_log.entity_type = ?", filters.EntityType)
+	}
+	if filters.EntityID != "" {
+		query = query.Where("audit_log.entity_id = ?", filters.EntityID)
+	}
+	if filters.Action != "" {
+		query = query.Where("audit_log.action = ?", filters.Action)
+	}
+	if filters.From != nil {
+		query = query.Where("audit_log.created_at >= ?", *filters.From)
+	}
+	if filters.To != nil {
+		query = query.Where("audit_log.created_at < ?", *filters.To)
+	}
+	return query
+}
+
+func toAuditLogs(rows []auditLogRow) []domain.AuditLog {
+	logs := make([]domain.AuditLog, 0, len(rows))
+	for _, row := range rows {
+		var changes audit.Changes
+		if row.Changes != "" {
+			_ = json.Unmarshal([]byte(row.Changes), &changes)
+		}
+		logs = append(logs, domain.AuditLog{
+			ID:         row.ID,
+			CreatedAt:  row.CreatedAt.In(time.UTC),
+			BusinessID: row.BusinessID,
+			UserID:     row.UserID,
+			UserEmail:  row.UserEmail,
+			APIKeyID:   row.APIKeyID,
+			IPAddress:  row.IPAddress,
+			EntityType: row.EntityType,
+			EntityID:   row.EntityID,
+			Action:     row.Action,
+			Changes:    changes,
+		})
+	}
+	return logs
+}
+
+func optionalID(id uint) *uint {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
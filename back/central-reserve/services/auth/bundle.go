@@ -14,10 +14,16 @@ import (
 	"central_reserve/services/auth/internal/infra/primary/controllers/resources"
 	"central_reserve/services/auth/internal/infra/primary/controllers/rolehandler"
 	"central_reserve/services/auth/internal/infra/primary/controllers/userhandler"
+	"central_reserve/services/auth/internal/infra/secondary/apikeycache"
+	"central_reserve/services/auth/internal/infra/secondary/featurecache"
+	"central_reserve/services/auth/internal/infra/secondary/oidcclient"
+	"central_reserve/services/auth/internal/infra/secondary/permissioncache"
 	"central_reserve/services/auth/internal/infra/secondary/repository"
+	"central_reserve/services/auth/middleware"
 	"central_reserve/shared/db"
 	"central_reserve/shared/env"
 	"central_reserve/shared/log"
+	"context"
 
 	"github.com/gin-gonic/gin"
 )
@@ -25,14 +31,22 @@ import (
 func New(db db.IDatabase, env env.IConfig, logger log.ILogger, s3 domain.IS3Service, v1Group *gin.RouterGroup, jwtService domain.IJWTService) {
 
 	repository := repository.New(db, logger)
+	permissions := permissioncache.New(domain.PermissionCacheTTL)
+	features := featurecache.New(domain.FeatureCacheTTL)
+	apiKeys := apikeycache.New(domain.APIKeyValidationCacheTTL)
+	oidc := oidcclient.New(domain.OIDCDiscoveryCacheTTL)
 
-	usecaseauth := usecaseauth.New(repository, jwtService, logger, env)
+	usecaseauth := usecaseauth.New(repository, jwtService, permissions, features, apiKeys, oidc, logger, env)
 	usecaseuser := usecaseuser.New(repository, logger, s3, env)
-	usecaserole := usecaserole.New(repository, logger)
-	usecasepermission := usecasepermission.New(repository, logger)
+	usecaserole := usecaserole.New(repository, permissions, logger)
+	usecasepermission := usecasepermission.New(repository, permissions, logger)
 	usecaseresource := usecaseresource.New(repository, logger)
 	usecaseaction := usecaseaction.New(repository, logger)
 
+	// InitFromEnv configura el middleware sin caso de uso de API Keys ni de sesiones; se registran aquí
+	middleware.ConfigureAPIKeys(usecaseauth)
+	middleware.ConfigureSessions(usecaseauth)
+
 	authhandler := authhandler.New(usecaseauth, logger)
 	userhandler := userhandler.New(usecaseuser, logger)
 	rolehandler := rolehandler.New(usecaserole, logger)
@@ -46,4 +60,11 @@ func New(db db.IDatabase, env env.IConfig, logger log.ILogger, s3 domain.IS3Serv
 	permhandler.RegisterRoutes(v1Group, permhandler, logger)
 	resources.RegisterRoutes(v1Group, resourcehandler, logger)
 	actions.RegisterRoutes(v1Group, actionhandler, logger)
+
+	// Los demás servicios validan permisos con middleware.RequirePermission y módulos con RequireFeature
+	middleware.ConfigurePermissions(usecaseauth)
+	middleware.ConfigureFeatures(usecaseauth)
+
+	// Persistencia por lotes del último uso de las API Keys
+	go usecaseauth.RunAPIKeyUsageFlusher(context.Background())
 }
@@ -2,6 +2,7 @@ package usecaseaction
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -58,6 +59,13 @@ func (uc *ActionUseCase) CreateAction(ctx context.Context, createDTO domain.Crea
 		Str("name", createDTO.Name).
 		Msg("Action creado exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "action",
+		EntityID:   createdAction.ID,
+		Action:     audit.ActionCreate,
+		After:      createdAction,
+	})
+
 	return actionDTO, nil
 }
 
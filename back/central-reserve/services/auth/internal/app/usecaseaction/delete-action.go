@@ -1,6 +1,7 @@
 package usecaseaction
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -33,5 +34,12 @@ func (uc *ActionUseCase) DeleteAction(ctx context.Context, id uint) (string, err
 		Str("action_name", existingAction.Name).
 		Msg("Action eliminado exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "action",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existingAction,
+	})
+
 	return message, nil
 }
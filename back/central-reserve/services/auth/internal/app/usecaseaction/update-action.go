@@ -2,6 +2,7 @@ package usecaseaction
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -73,6 +74,14 @@ func (uc *ActionUseCase) UpdateAction(ctx context.Context, id uint, updateDTO do
 		Str("name", updateDTO.Name).
 		Msg("Action actualizado exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "action",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existingAction,
+		After:      updatedAction,
+	})
+
 	return actionDTO, nil
 }
 
@@ -0,0 +1,115 @@
+package usecaseauth
+
+import (
+	"bytes"
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/email"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	"strings"
+)
+
+// accountEmailContent son los textos de un email de cuenta
+type accountEmailContent struct {
+	subject string
+	intro   string
+	action  string
+	expiry  string
+	path    string
+}
+
+var accountEmails = map[string]accountEmailContent{
+	domain.AccountTokenPasswordReset: {
+		subject: "Restablece tu contraseña",
+		intro:   "Recibimos una solicitud para restablecer la contraseña de tu cuenta.",
+		action:  "Restablecer contraseña",
+		expiry:  "El enlace vence en 1 hora y solo puede usarse una vez. Al cambiar la contraseña se cerrarán todas tus sesiones.",
+		path:    "/reset-password",
+	},
+	domain.AccountTokenEmailVerification: {
+		subject: "Verifica tu email",
+		intro:   "Confirma que este email es tuyo para terminar de configurar tu cuenta.",
+		action:  "Verificar email",
+		expiry:  "El enlace vence en 48 horas y solo puede usarse una vez.",
+		path:    "/verify-email",
+	},
+	domain.AccountTokenSSOLink: {
+		subject: "Confirma el inicio de sesión único",
+		intro:   "Alguien inició sesión con un proveedor de identidad usando este email. Confirma que fuiste tú para vincularlo a tu cuenta.",
+		action:  "Vincular cuenta",
+		expiry:  "El enlace vence en 24 horas y solo puede usarse una vez.",
+		path:    "/sso/link",
+	},
+}
+
+var accountEmailHTML = htmltemplate.Must(htmltemplate.New("account").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: Arial, sans-serif; color: #333;">
+	<p>Hola {{.Name}},</p>
+	<p>{{.Intro}}</p>
+	<p><a href="{{.URL}}" style="display: inline-block; padding: 10px 20px; background: #2563eb; color: #fff; text-decoration: none; border-radius: 4px;">{{.Action}}</a></p>
+	<p>{{.Expiry}}</p>
+	<p>Si no fuiste tú, ignora este mensaje.</p>
+</body>
+</html>`))
+
+// accountEmailMessage arma el email con el enlace del token
+func (uc *AuthUseCase) accountEmailMessage(purpose, to, name, token string) (email.Message, error) {
+	content := accountEmails[purpose]
+	link := uc.accountURL(content.path, token)
+
+	var html bytes.Buffer
+	if err := accountEmailHTML.Execute(&html, map[string]string{
+		"Name":   name,
+		"Intro":  content.intro,
+		"URL":    link,
+		"Action": content.action,
+		"Expiry": content.expiry,
+	}); err != nil {
+		return email.Message{}, fmt.Errorf("error al generar email: %w", err)
+	}
+
+	text := fmt.Sprintf("Hola %s,\n\n%s\n\n%s: %s\n\n%s\n\nSi no fuiste tú, ignora este mensaje.\n",
+		name, content.intro, content.action, link, content.expiry)
+
+	return email.Message{
+		To:      to,
+		Subject: content.subject,
+		HTML:    html.String(),
+		Text:    text,
+	}, nil
+}
+
+// accountURL arma el enlace del front-end con el token como parámetro
+func (uc *AuthUseCase) accountURL(path, token string) string {
+	base := ""
+	if uc.env != nil {
+		base = uc.env.Get("URL_BASE_FRONTEND")
+	}
+	if base == "" {
+		base = "http://localhost:3000" // Default para desarrollo
+	}
+	return fmt.Sprintf("%s%s?token=%s", strings.TrimRight(base, "/"), path, url.QueryEscape(token))
+}
+
+// enqueueAccountEmail guarda el email en el outbox de notificaciones; el worker lo envía y lo reintenta
+// si falla, de modo que un fallo SMTP o un reinicio no pierden el enlace. La request no espera el
+// envío, así que el tiempo de respuesta no revela si el email está registrado.
+func (uc *AuthUseCase) enqueueAccountEmail(ctx context.Context, msg email.Message, userID uint, purpose string) error {
+	id, err := uc.repository.EnqueueAccountEmail(ctx, domain.AccountEmail{
+		UserID:    userID,
+		Purpose:   purpose,
+		Recipient: msg.To,
+		Subject:   msg.Subject,
+		HTMLBody:  msg.HTML,
+		TextBody:  msg.Text,
+	})
+	if err != nil {
+		return fmt.Errorf("error al encolar email de cuenta: %w", err)
+	}
+
+	uc.log.Info().Uint("notification_id", id).Uint("user_id", userID).Str("purpose", purpose).Msg("Email de cuenta encolado")
+	return nil
+}
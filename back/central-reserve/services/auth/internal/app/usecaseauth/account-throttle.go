@@ -0,0 +1,55 @@
+package usecaseauth
+
+import (
+	"sync"
+	"time"
+)
+
+// accountThrottleWindow es el conteo de solicitudes de una clave (email o IP) en la ventana actual
+type accountThrottleWindow struct {
+	start time.Time
+	count int
+}
+
+// accountThrottle limita las solicitudes públicas de cuenta por email y por IP con ventanas fijas.
+// El conteo es en memoria, por instancia.
+type accountThrottle struct {
+	mu      sync.Mutex
+	windows map[string]*accountThrottleWindow
+	window  time.Duration
+}
+
+func newAccountThrottle(window time.Duration) *accountThrottle {
+	return &accountThrottle{
+		windows: make(map[string]*accountThrottleWindow),
+		window:  window,
+	}
+}
+
+// allow registra una solicitud de la clave y retorna si está dentro del límite
+func (t *accountThrottle) allow(key string, limit int, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current, ok := t.windows[key]
+	if !ok || !now.Before(current.start.Add(t.window)) {
+		t.purge(now)
+		current = &accountThrottleWindow{start: now}
+		t.windows[key] = current
+	}
+
+	if current.count >= limit {
+		return false
+	}
+	current.count++
+	return true
+}
+
+// purge descarta las ventanas vencidas
+func (t *accountThrottle) purge(now time.Time) {
+	for key, window := range t.windows {
+		if !now.Before(window.start.Add(t.window)) {
+			delete(t.windows, key)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newAPIKeyMaterial genera una API Key en claro, su prefijo de búsqueda y su hash bcrypt
+func newAPIKeyMaterial() (plain string, prefix string, hash string, err error) {
+	lookup := make([]byte, domain.APIKeyLookupBytes)
+	if _, err := rand.Read(lookup); err != nil {
+		return "", "", "", fmt.Errorf("error al generar prefijo de API Key: %w", err)
+	}
+	secret := make([]byte, domain.APIKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", fmt.Errorf("error al generar secreto de API Key: %w", err)
+	}
+
+	prefix = domain.APIKeyPrefix + hex.EncodeToString(lookup)
+	plain = prefix + domain.APIKeySeparator + base64.RawURLEncoding.EncodeToString(secret)
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", "", fmt.Errorf("error al hashear API Key: %w", err)
+	}
+	return plain, prefix, string(hashed), nil
+}
+
+// apiKeyDigest es el índice de la caché de validaciones; evita guardar la key en claro
+func apiKeyDigest(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// validateAPIKeyOwner verifica que el usuario de la key exista, esté activo y pertenezca al negocio,
+// ya que los permisos de la key son los de su rol en ese negocio
+func (uc *AuthUseCase) validateAPIKeyOwner(ctx context.Context, userID uint, businessID uint) error {
+	user, err := uc.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if user == nil {
+		return domain.ErrAPIKeyUserNotFound
+	}
+	if !user.IsActive {
+		return domain.ErrAPIKeyUserInactive
+	}
+
+	relation, err := uc.repository.GetBusinessStaffRelation(ctx, userID, &businessID)
+	if err != nil {
+		return fmt.Errorf("error al obtener relación del usuario con el negocio: %w", err)
+	}
+	if relation == nil {
+		return domain.ErrAPIKeyUserNotInBusiness
+	}
+	return nil
+}
+
+// getBusinessAPIKey obtiene una API Key verificando que pertenezca al negocio (0 = cualquiera, super admin)
+func (uc *AuthUseCase) getBusinessAPIKey(ctx context.Context, apiKeyID uint, businessID uint) (*domain.APIKey, error) {
+	apiKey, err := uc.repository.GetAPIKeyByID(ctx, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener API Key: %w", err)
+	}
+	if apiKey == nil || (businessID != 0 && apiKey.BusinessID != businessID) {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	return apiKey, nil
+}
+
+// toAPIKeyInfo convierte una API Key en su información pública
+func toAPIKeyInfo(apiKey domain.APIKey) domain.APIKeyInfo {
+	return domain.APIKeyInfo{
+		ID:          apiKey.ID,
+		UserID:      apiKey.UserID,
+		BusinessID:  apiKey.BusinessID,
+		CreatedByID: apiKey.CreatedByID,
+		Name:        apiKey.Name,
+		Description: apiKey.Description,
+		KeyPrefix:   apiKey.KeyPrefix,
+		LastUsedAt:  apiKey.LastUsedAt,
+		Revoked:     apiKey.Revoked,
+		RevokedAt:   apiKey.RevokedAt,
+		RateLimit:   apiKey.RateLimit,
+		IPWhitelist: domain.SplitIPWhitelist(apiKey.IPWhitelist),
+		CreatedAt:   apiKey.CreatedAt,
+	}
+}
+
+// joinIPWhitelist convierte la lista normalizada al formato guardado en base de datos
+func joinIPWhitelist(entries []string) string {
+	return strings.Join(entries, ",")
+}
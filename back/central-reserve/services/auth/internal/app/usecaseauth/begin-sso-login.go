@@ -0,0 +1,76 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// BeginSSOLogin prepara el login por SSO del negocio: guarda el state, el nonce y el verificador
+// PKCE y devuelve la URL del proveedor a la que el frontend debe redirigir al usuario
+func (uc *AuthUseCase) BeginSSOLogin(ctx context.Context, businessCode string) (*domain.SSOAuthorization, error) {
+	businessCode = strings.TrimSpace(businessCode)
+	if businessCode == "" {
+		return nil, fmt.Errorf("%w: business_code es requerido", domain.ErrSSOInvalidConfig)
+	}
+
+	provider, err := uc.repository.GetSSOProviderByBusinessCode(ctx, businessCode)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener proveedor SSO: %w", err)
+	}
+	if provider == nil || !provider.IsActive {
+		return nil, domain.ErrSSONotConfigured
+	}
+
+	metadata, err := uc.oidc.Discover(ctx, provider.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	state, stateHash, err := newOpaqueToken(domain.SSOStateBytes)
+	if err != nil {
+		return nil, err
+	}
+	nonce, _, err := newOpaqueToken(domain.SSOStateBytes)
+	if err != nil {
+		return nil, err
+	}
+	verifier, _, err := newOpaqueToken(domain.SSOStateBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(domain.SSOStateTTL)
+	if err := uc.repository.CreateSSOLoginState(ctx, domain.SSOLoginState{
+		StateHash:    stateHash,
+		ProviderID:   provider.ID,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    expiresAt,
+	}); err != nil {
+		return nil, fmt.Errorf("error al iniciar login SSO: %w", err)
+	}
+
+	authURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%w: authorization_endpoint inválido", domain.ErrSSOProviderUnavailable)
+	}
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.ClientID)
+	query.Set("redirect_uri", provider.RedirectURL)
+	query.Set("scope", provider.Scopes)
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", pkceChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return &domain.SSOAuthorization{
+		AuthorizationURL: authURL.String(),
+		ExpiresAt:        expiresAt,
+	}, nil
+}
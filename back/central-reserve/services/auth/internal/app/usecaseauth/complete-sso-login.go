@@ -0,0 +1,312 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CompleteSSOLogin canjea el código que devolvió el proveedor, valida el ID token y abre la sesión
+// del usuario. El usuario se resuelve por su identidad vinculada, luego por email verificado (con
+// confirmación por email si no es staff solo de este negocio) y, si el proveedor lo permite, se da
+// de alta. El rol sale de los grupos del usuario en cada login.
+func (uc *AuthUseCase) CompleteSSOLogin(ctx context.Context, request domain.CompleteSSOLoginRequest) (*domain.LoginResponse, error) {
+	if request.Code == "" || request.State == "" {
+		return nil, domain.ErrSSOInvalidState
+	}
+
+	now := time.Now()
+	if err := uc.checkLoginIP(ctx, request.IPAddress, now); err != nil {
+		uc.recordLoginFailure(ctx, nil, "", request.IPAddress, request.UserAgent, domain.LoginFailedIPBlocked, now)
+		return nil, err
+	}
+
+	state, err := uc.repository.ConsumeSSOLoginState(ctx, hashOpaqueToken(request.State))
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener login SSO: %w", err)
+	}
+	if state == nil {
+		return nil, domain.ErrSSOInvalidState
+	}
+
+	provider, err := uc.repository.GetSSOProviderByID(ctx, state.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener proveedor SSO: %w", err)
+	}
+	if provider == nil || !provider.IsActive {
+		return nil, domain.ErrSSONotConfigured
+	}
+
+	claims, err := uc.exchangeSSOCode(ctx, provider, state, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	email := strings.ToLower(strings.TrimSpace(claims.Email))
+
+	userID, err := uc.resolveSSOUser(ctx, provider, claims, email, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// El acceso al negocio se decide con los grupos de este login: sin rol mapeado solo entra quien
+	// ya pertenece al negocio
+	roleID := resolveSSORole(provider, claims.Groups)
+	if roleID == nil {
+		businessID := provider.BusinessID
+		relation, err := uc.repository.GetBusinessStaffRelation(ctx, userID, &businessID)
+		if err != nil {
+			return nil, fmt.Errorf("error al obtener relación con el negocio: %w", err)
+		}
+		if relation == nil {
+			uc.recordLoginFailure(ctx, &userID, email, request.IPAddress, request.UserAgent, domain.LoginFailedSSODenied, now)
+			return nil, domain.ErrSSOAccessDenied
+		}
+	}
+	if err := uc.repository.SyncSSOMembership(ctx, userID, provider.BusinessID, roleID); err != nil {
+		return nil, fmt.Errorf("error al asignar el negocio al usuario: %w", err)
+	}
+
+	userAuth, err := uc.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if userAuth == nil || !userAuth.IsActive {
+		uc.recordLoginFailure(ctx, &userID, email, request.IPAddress, request.UserAgent, domain.LoginFailedInactive, now)
+		return nil, fmt.Errorf("usuario inactivo")
+	}
+
+	// resolveSSOUser solo retorna identidades confirmadas; la confirmación guardada no se modifica
+	if err := uc.repository.SaveUserIdentity(ctx, domain.UserIdentity{
+		UserID:      userID,
+		ProviderID:  provider.ID,
+		Issuer:      claims.Issuer,
+		Subject:     claims.Subject,
+		Email:       email,
+		ConfirmedAt: &now,
+	}); err != nil {
+		return nil, fmt.Errorf("error al actualizar identidad SSO: %w", err)
+	}
+
+	// El segundo factor local se sigue exigiendo igual que en el login con contraseña
+	if challenge, err := uc.twoFactorChallenge(ctx, userAuth); err != nil || challenge != nil {
+		return challenge, err
+	}
+
+	return uc.completeLogin(ctx, userAuth, request.UserAgent, request.IPAddress)
+}
+
+// exchangeSSOCode canjea el código con el verificador PKCE y valida el ID token recibido
+func (uc *AuthUseCase) exchangeSSOCode(ctx context.Context, provider *domain.SSOProvider, state *domain.SSOLoginState, code string) (*domain.OIDCClaims, error) {
+	metadata, err := uc.oidc.Discover(ctx, provider.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	clientSecret := ""
+	if provider.ClientSecret != "" {
+		cipher, err := uc.ssoCipher()
+		if err != nil {
+			return nil, err
+		}
+		if clientSecret, err = cipher.Open(provider.ClientSecret); err != nil {
+			uc.log.Error().Err(err).Uint("provider_id", provider.ID).Msg("Error al descifrar client secret SSO")
+			return nil, fmt.Errorf("error interno del servidor")
+		}
+	}
+
+	rawIDToken, err := uc.oidc.ExchangeCode(ctx, metadata, domain.OIDCCodeExchange{
+		Code:         code,
+		RedirectURL:  provider.RedirectURL,
+		ClientID:     provider.ClientID,
+		ClientSecret: clientSecret,
+		CodeVerifier: state.CodeVerifier,
+	})
+	if err != nil {
+		uc.log.Warn().Err(err).Uint("provider_id", provider.ID).Msg("Canje de código SSO rechazado")
+		return nil, err
+	}
+
+	claims, err := uc.oidc.VerifyIDToken(ctx, metadata, rawIDToken, domain.OIDCIDTokenCheck{
+		ClientID:    provider.ClientID,
+		Nonce:       state.Nonce,
+		GroupsClaim: provider.GroupsClaim,
+	})
+	if err != nil {
+		uc.log.Warn().Err(err).Uint("provider_id", provider.ID).Msg("ID token SSO rechazado")
+		return nil, err
+	}
+	return claims, nil
+}
+
+// resolveSSOUser obtiene el usuario de la identidad del proveedor. Una cuenta existente solo se
+// vincula por email si el proveedor lo verificó, y solo sin confirmación si el usuario ya es staff
+// únicamente de este negocio: de lo contrario quien controle el proveedor podría tomar la cuenta de
+// un super admin o de staff de otros negocios. En esos casos el vínculo queda pendiente hasta que
+// el dueño de la cuenta lo confirme con el enlace que recibe por email.
+func (uc *AuthUseCase) resolveSSOUser(ctx context.Context, provider *domain.SSOProvider, claims *domain.OIDCClaims, email string, request domain.CompleteSSOLoginRequest) (uint, error) {
+	identity, err := uc.repository.GetUserIdentity(ctx, claims.Issuer, claims.Subject)
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener identidad SSO: %w", err)
+	}
+	if identity != nil {
+		if identity.ConfirmedAt != nil {
+			return identity.UserID, nil
+		}
+		user, err := uc.repository.GetUserByID(ctx, identity.UserID)
+		if err != nil {
+			return 0, fmt.Errorf("error al obtener usuario: %w", err)
+		}
+		return 0, uc.requestSSOLinkConfirmation(ctx, user, identity.ID, request)
+	}
+
+	if email == "" || !claims.EmailVerified {
+		uc.recordLoginFailure(ctx, nil, email, request.IPAddress, request.UserAgent, domain.LoginFailedSSODenied, time.Now())
+		return 0, domain.ErrSSOEmailNotVerified
+	}
+
+	newIdentity := domain.UserIdentity{
+		ProviderID: provider.ID,
+		Issuer:     claims.Issuer,
+		Subject:    claims.Subject,
+		Email:      email,
+	}
+
+	existing, err := uc.repository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if existing != nil {
+		newIdentity.UserID = existing.ID
+
+		autoLink, err := uc.canAutoLinkSSO(ctx, existing.ID, provider.BusinessID)
+		if err != nil {
+			return 0, err
+		}
+		if !autoLink {
+			if err := uc.repository.SaveUserIdentity(ctx, newIdentity); err != nil {
+				return 0, fmt.Errorf("error al guardar identidad SSO pendiente: %w", err)
+			}
+			pending, err := uc.repository.GetUserIdentity(ctx, claims.Issuer, claims.Subject)
+			if err != nil {
+				return 0, fmt.Errorf("error al obtener identidad SSO: %w", err)
+			}
+			if pending == nil {
+				return 0, fmt.Errorf("identidad SSO pendiente no encontrada")
+			}
+			uc.log.Info().Uint("user_id", existing.ID).Str("issuer", claims.Issuer).Msg("Vínculo SSO pendiente de confirmación por email")
+			return 0, uc.requestSSOLinkConfirmation(ctx, existing, pending.ID, request)
+		}
+
+		now := time.Now()
+		newIdentity.ConfirmedAt = &now
+		if err := uc.repository.SaveUserIdentity(ctx, newIdentity); err != nil {
+			return 0, fmt.Errorf("error al vincular identidad SSO: %w", err)
+		}
+		uc.log.Info().Uint("user_id", existing.ID).Str("issuer", claims.Issuer).Msg("Cuenta vinculada al proveedor SSO")
+		audit.Log(ssoAuditContext(ctx, existing.ID, provider.BusinessID, request.IPAddress), audit.Entry{
+			BusinessID: provider.BusinessID,
+			EntityType: "user_identity",
+			EntityID:   existing.ID,
+			Action:     audit.ActionCreate,
+			After:      map[string]any{"issuer": claims.Issuer, "subject": claims.Subject, "email": email},
+		})
+		return existing.ID, nil
+	}
+
+	if !provider.AllowProvisioning {
+		uc.recordLoginFailure(ctx, nil, email, request.IPAddress, request.UserAgent, domain.LoginFailedSSODenied, time.Now())
+		return 0, domain.ErrSSOAccessDenied
+	}
+
+	name := strings.TrimSpace(claims.Name)
+	if name == "" {
+		name = email
+	}
+	userID, err := uc.repository.CreateSSOUser(ctx, domain.SSOUser{Name: name, Email: email}, newIdentity)
+	if err != nil {
+		return 0, fmt.Errorf("error al crear usuario: %w", err)
+	}
+	uc.log.Info().Uint("user_id", userID).Uint("business_id", provider.BusinessID).Msg("Usuario creado desde SSO")
+	audit.Log(ssoAuditContext(ctx, userID, provider.BusinessID, request.IPAddress), audit.Entry{
+		BusinessID: provider.BusinessID,
+		EntityType: "user",
+		EntityID:   userID,
+		Action:     audit.ActionCreate,
+		After:      map[string]any{"name": name, "email": email, "issuer": claims.Issuer, "subject": claims.Subject},
+	})
+	return userID, nil
+}
+
+// canAutoLinkSSO indica si una cuenta existente puede vincularse al proveedor sin confirmación: el
+// usuario no es super admin y solo es staff del negocio del proveedor
+func (uc *AuthUseCase) canAutoLinkSSO(ctx context.Context, userID, businessID uint) (bool, error) {
+	roles, err := uc.repository.GetUserRoles(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("error al obtener roles del usuario: %w", err)
+	}
+	if isSuperAdmin(roles) {
+		return false, nil
+	}
+
+	businesses, err := uc.repository.GetUserBusinesses(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("error al obtener negocios del usuario: %w", err)
+	}
+	if len(businesses) == 0 {
+		return false, nil
+	}
+	for _, business := range businesses {
+		if business.ID != businessID {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// requestSSOLinkConfirmation envía al dueño de la cuenta el enlace para confirmar el vínculo
+// pendiente con el proveedor y rechaza el login. El límite por email evita que el login se use para
+// llenar su bandeja de entrada.
+func (uc *AuthUseCase) requestSSOLinkConfirmation(ctx context.Context, user *domain.UserAuthInfo, identityID uint, request domain.CompleteSSOLoginRequest) error {
+	now := time.Now()
+	if user == nil || !user.IsActive {
+		return fmt.Errorf("usuario inactivo")
+	}
+	uc.recordLoginFailure(ctx, &user.ID, user.Email, request.IPAddress, request.UserAgent, domain.LoginFailedSSOLinkPending, now)
+
+	if !uc.throttle.allow(domain.AccountTokenSSOLink+":"+user.Email, domain.AccountEmailThrottleLimit, now) {
+		uc.log.Warn().Uint("user_id", user.ID).Msg("Límite de emails de vínculo SSO alcanzado; no se envía")
+		return domain.ErrSSOLinkRequired
+	}
+
+	plain, hash, err := newOpaqueToken(domain.AccountTokenBytes)
+	if err != nil {
+		return err
+	}
+	if err := uc.repository.CreateAccountToken(ctx, domain.AccountToken{
+		UserID:     user.ID,
+		Purpose:    domain.AccountTokenSSOLink,
+		TokenHash:  hash,
+		Email:      user.Email,
+		IdentityID: &identityID,
+		ExpiresAt:  now.Add(domain.SSOLinkTokenTTL),
+	}); err != nil {
+		return fmt.Errorf("error al guardar token de cuenta: %w", err)
+	}
+
+	msg, err := uc.accountEmailMessage(domain.AccountTokenSSOLink, user.Email, user.Name, plain)
+	if err != nil {
+		return err
+	}
+	if err := uc.enqueueAccountEmail(ctx, msg, user.ID, domain.AccountTokenSSOLink); err != nil {
+		return err
+	}
+	return domain.ErrSSOLinkRequired
+}
+
+// ssoAuditContext registra como actor al propio usuario, ya que el login por SSO no tiene sesión previa
+func ssoAuditContext(ctx context.Context, userID, businessID uint, ipAddress string) context.Context {
+	return audit.WithActor(ctx, audit.Actor{UserID: userID, BusinessID: businessID, IPAddress: ipAddress})
+}
@@ -0,0 +1,121 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ResetPassword cambia la contraseña con el token del enlace enviado por email y cierra todas las
+// sesiones del usuario
+func (uc *AuthUseCase) ResetPassword(ctx context.Context, request domain.ResetPasswordRequest) error {
+	if !uc.throttle.allow("ip:"+request.IPAddress, domain.AccountIPThrottleLimit, time.Now()) {
+		return domain.ErrAccountRequestThrottled
+	}
+	if len(request.NewPassword) < domain.MinPasswordLength {
+		return domain.ErrWeakPassword
+	}
+
+	token, err := uc.validAccountToken(ctx, request.Token, domain.AccountTokenPasswordReset)
+	if err != nil {
+		return err
+	}
+
+	reset, err := uc.repository.ResetPasswordWithToken(ctx, token.ID, token.UserID, request.NewPassword)
+	if err != nil {
+		return fmt.Errorf("error al restablecer contraseña: %w", err)
+	}
+	if !reset {
+		return domain.ErrInvalidAccountToken
+	}
+
+	uc.log.Info().Uint("user_id", token.UserID).Msg("Contraseña restablecida; sesiones revocadas")
+	return nil
+}
+
+// VerifyEmail marca el email como verificado con el token del enlace enviado por email
+func (uc *AuthUseCase) VerifyEmail(ctx context.Context, request domain.VerifyEmailRequest) error {
+	if !uc.throttle.allow("ip:"+request.IPAddress, domain.AccountIPThrottleLimit, time.Now()) {
+		return domain.ErrAccountRequestThrottled
+	}
+
+	token, err := uc.validAccountToken(ctx, request.Token, domain.AccountTokenEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	verified, err := uc.repository.VerifyEmailWithToken(ctx, token.ID, token.UserID)
+	if err != nil {
+		return fmt.Errorf("error al verificar email: %w", err)
+	}
+	if !verified {
+		return domain.ErrInvalidAccountToken
+	}
+
+	uc.log.Info().Uint("user_id", token.UserID).Msg("Email verificado")
+	return nil
+}
+
+// ConfirmSSOLink confirma el vínculo pendiente con el proveedor SSO con el token del enlace enviado
+// por email; desde entonces el usuario puede entrar con el proveedor
+func (uc *AuthUseCase) ConfirmSSOLink(ctx context.Context, request domain.ConfirmSSOLinkRequest) error {
+	if !uc.throttle.allow("ip:"+request.IPAddress, domain.AccountIPThrottleLimit, time.Now()) {
+		return domain.ErrAccountRequestThrottled
+	}
+
+	token, err := uc.validAccountToken(ctx, request.Token, domain.AccountTokenSSOLink)
+	if err != nil {
+		return err
+	}
+	if token.IdentityID == nil {
+		return domain.ErrInvalidAccountToken
+	}
+
+	identity, err := uc.repository.ConfirmUserIdentityWithToken(ctx, token.ID, *token.IdentityID, token.UserID)
+	if err != nil {
+		return fmt.Errorf("error al confirmar vínculo SSO: %w", err)
+	}
+	if identity == nil {
+		return domain.ErrInvalidAccountToken
+	}
+
+	var businessID uint
+	if provider, err := uc.repository.GetSSOProviderByID(ctx, identity.ProviderID); err == nil && provider != nil {
+		businessID = provider.BusinessID
+	}
+	uc.log.Info().Uint("user_id", identity.UserID).Str("issuer", identity.Issuer).Msg("Vínculo SSO confirmado por email")
+	audit.Log(ssoAuditContext(ctx, identity.UserID, businessID, request.IPAddress), audit.Entry{
+		BusinessID: businessID,
+		EntityType: "user_identity",
+		EntityID:   identity.UserID,
+		Action:     audit.ActionCreate,
+		After:      map[string]any{"issuer": identity.Issuer, "subject": identity.Subject, "email": identity.Email},
+	})
+	return nil
+}
+
+// validAccountToken busca el token y comprueba que siga vigente, que el usuario esté activo y que
+// su email no haya cambiado desde que se envió el enlace
+func (uc *AuthUseCase) validAccountToken(ctx context.Context, plain string, purpose string) (*domain.AccountToken, error) {
+	if plain == "" {
+		return nil, domain.ErrInvalidAccountToken
+	}
+	token, err := uc.repository.GetAccountToken(ctx, hashOpaqueToken(plain), purpose)
+	if err != nil {
+		return nil, fmt.Errorf("error al buscar token de cuenta: %w", err)
+	}
+	if token == nil || token.UsedAt != nil || !time.Now().Before(token.ExpiresAt) {
+		return nil, domain.ErrInvalidAccountToken
+	}
+
+	user, err := uc.repository.GetUserByID(ctx, token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if user == nil || !user.IsActive || user.Email != token.Email {
+		return nil, domain.ErrInvalidAccountToken
+	}
+	return token, nil
+}
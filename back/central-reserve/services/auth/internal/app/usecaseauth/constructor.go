@@ -12,27 +12,71 @@ type IUseCaseAuth interface {
 	GetUserRolesPermissions(ctx context.Context, userID uint, businessID uint, token string) (*domain.UserRolesPermissionsResponse, error)
 	ChangePassword(ctx context.Context, request domain.ChangePasswordRequest) (*domain.ChangePasswordResponse, error)
 	GeneratePassword(ctx context.Context, request domain.GeneratePasswordRequest) (*domain.GeneratePasswordResponse, error)
-	GenerateBusinessToken(ctx context.Context, userID uint, businessID uint) (string, error)
-	// GenerateAPIKey(ctx context.Context, request domain.GenerateAPIKeyRequest) (*domain.GenerateAPIKeyResponse, error)
-	// ValidateAPIKey(ctx context.Context, request domain.ValidateAPIKeyRequest) (*domain.ValidateAPIKeyResponse, error)
+	GenerateBusinessToken(ctx context.Context, userID uint, businessID uint, sessionID uint) (string, error)
+	RefreshSession(ctx context.Context, request domain.RefreshSessionRequest) (*domain.SessionTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	IsSessionActive(ctx context.Context, sessionID uint) (bool, error)
+	RequestPasswordReset(ctx context.Context, request domain.AccountEmailRequest) error
+	ResetPassword(ctx context.Context, request domain.ResetPasswordRequest) error
+	RequestEmailVerification(ctx context.Context, request domain.AccountEmailRequest) error
+	VerifyEmail(ctx context.Context, request domain.VerifyEmailRequest) error
+	ConfirmSSOLink(ctx context.Context, request domain.ConfirmSSOLinkRequest) error
+	VerifyTwoFactorLogin(ctx context.Context, request domain.VerifyTwoFactorLoginRequest) (*domain.LoginResponse, error)
+	GetTwoFactorStatus(ctx context.Context, userID uint) (*domain.TwoFactorStatus, error)
+	BeginTwoFactorEnrollment(ctx context.Context, userID uint) (*domain.TwoFactorEnrollment, error)
+	EnableTwoFactor(ctx context.Context, request domain.EnableTwoFactorRequest) (*domain.EnableTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, userID uint, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error)
+	ResolvePermissions(ctx context.Context, userID uint, businessID uint, roleID *uint) (domain.PermissionSet, error)
+	ResolveFeatures(ctx context.Context, businessID uint) (domain.FeatureSet, error)
+	InvalidateFeatures(businessID uint)
+	GenerateAPIKey(ctx context.Context, request domain.GenerateAPIKeyRequest) (*domain.GenerateAPIKeyResponse, error)
+	ValidateAPIKey(ctx context.Context, request domain.ValidateAPIKeyRequest) (*domain.ValidateAPIKeyResponse, error)
+	ConsumeAPIKeyRateLimit(ctx context.Context, apiKeyID uint, limit int) (*domain.APIKeyRateLimitStatus, error)
+	ListAPIKeys(ctx context.Context, businessID uint) ([]domain.APIKeyInfo, error)
+	RotateAPIKey(ctx context.Context, request domain.RotateAPIKeyRequest) (*domain.GenerateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, apiKeyID uint, businessID uint) error
+	FlushAPIKeyUsage(ctx context.Context) error
+	RunAPIKeyUsageFlusher(ctx context.Context)
+	GetSSOProvider(ctx context.Context, businessID uint) (*domain.SSOProviderInfo, error)
+	SaveSSOProvider(ctx context.Context, request domain.SaveSSOProviderRequest) (*domain.SSOProviderInfo, error)
+	DeleteSSOProvider(ctx context.Context, businessID uint) error
+	BeginSSOLogin(ctx context.Context, businessCode string) (*domain.SSOAuthorization, error)
+	CompleteSSOLogin(ctx context.Context, request domain.CompleteSSOLoginRequest) (*domain.LoginResponse, error)
 }
 
 type IAuthUseCase interface {
 	ValidateAPIKey(ctx context.Context, request domain.ValidateAPIKeyRequest) (*domain.ValidateAPIKeyResponse, error)
+	ConsumeAPIKeyRateLimit(ctx context.Context, apiKeyID uint, limit int) (*domain.APIKeyRateLimitStatus, error)
 }
 
 type AuthUseCase struct {
-	repository domain.IAuthRepository
-	jwtService domain.IJWTService
-	log        log.ILogger
-	env        env.IConfig
+	repository  domain.IAuthRepository
+	jwtService  domain.IJWTService
+	permissions domain.IPermissionCache
+	features    domain.IFeatureCache
+	apiKeys     domain.IAPIKeyCache
+	usage       *apiKeyUsage
+	oidc        domain.IOIDCClient
+	throttle    *accountThrottle
+	// twoFactorAttempts limita los códigos de segundo factor por usuario
+	twoFactorAttempts *accountThrottle
+	log               log.ILogger
+	env               env.IConfig
 }
 
-func New(repository domain.IAuthRepository, jwtService domain.IJWTService, log log.ILogger, env env.IConfig) IUseCaseAuth {
+func New(repository domain.IAuthRepository, jwtService domain.IJWTService, permissions domain.IPermissionCache, features domain.IFeatureCache, apiKeys domain.IAPIKeyCache, oidc domain.IOIDCClient, log log.ILogger, env env.IConfig) IUseCaseAuth {
 	return &AuthUseCase{
-		repository: repository,
-		jwtService: jwtService,
-		log:        log,
-		env:        env,
+		repository:        repository,
+		jwtService:        jwtService,
+		permissions:       permissions,
+		features:          features,
+		apiKeys:           apiKeys,
+		usage:             newAPIKeyUsage(),
+		oidc:              oidc,
+		throttle:          newAccountThrottle(domain.AccountThrottleWindow),
+		twoFactorAttempts: newAccountThrottle(domain.TwoFactorAttemptWindow),
+		log:               log,
+		env:               env,
 	}
 }
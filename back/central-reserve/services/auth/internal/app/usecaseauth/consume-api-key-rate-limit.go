@@ -0,0 +1,28 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ConsumeAPIKeyRateLimit cuenta una request de la key en la ventana actual y retorna si está dentro
+// de su límite. Un límite <= 0 no restringe.
+func (uc *AuthUseCase) ConsumeAPIKeyRateLimit(ctx context.Context, apiKeyID uint, limit int) (*domain.APIKeyRateLimitStatus, error) {
+	if limit <= 0 {
+		return &domain.APIKeyRateLimitStatus{Allowed: true}, nil
+	}
+
+	windowStart := time.Now().UTC().Truncate(domain.APIKeyRateLimitWindow)
+	requests, allowed, err := uc.repository.IncrementAPIKeyRequests(ctx, apiKeyID, windowStart, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error al contar request de API Key: %w", err)
+	}
+
+	return &domain.APIKeyRateLimitStatus{
+		Allowed:   allowed,
+		Remaining: max(limit-requests, 0),
+		Reset:     windowStart.Add(domain.APIKeyRateLimitWindow),
+	}, nil
+}
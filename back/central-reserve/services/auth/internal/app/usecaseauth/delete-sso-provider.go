@@ -0,0 +1,38 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+)
+
+// DeleteSSOProvider elimina el inicio de sesión único del negocio. Los usuarios creados por el
+// proveedor conservan su cuenta, pero sin contraseña local no podrán entrar hasta restablecerla.
+func (uc *AuthUseCase) DeleteSSOProvider(ctx context.Context, businessID uint) error {
+	existing, err := uc.repository.GetSSOProvider(ctx, businessID)
+	if err != nil {
+		return fmt.Errorf("error al obtener proveedor SSO: %w", err)
+	}
+	if existing == nil {
+		return domain.ErrSSONotConfigured
+	}
+
+	deleted, err := uc.repository.DeleteSSOProvider(ctx, businessID)
+	if err != nil {
+		return fmt.Errorf("error al eliminar proveedor SSO: %w", err)
+	}
+	if !deleted {
+		return domain.ErrSSONotConfigured
+	}
+
+	uc.log.Info().Uint("business_id", businessID).Msg("Proveedor SSO eliminado")
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "sso_provider",
+		EntityID:   existing.ID,
+		Action:     audit.ActionDelete,
+		Before:     toSSOProviderInfo(existing),
+	})
+	return nil
+}
@@ -0,0 +1,95 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/totp"
+	"context"
+	"fmt"
+)
+
+// BeginTwoFactorEnrollment genera un secreto TOTP para que el usuario lo registre en su app. No se
+// activa hasta que el usuario confirma un código con EnableTwoFactor.
+func (uc *AuthUseCase) BeginTwoFactorEnrollment(ctx context.Context, userID uint) (*domain.TwoFactorEnrollment, error) {
+	user, err := uc.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		return nil, fmt.Errorf("usuario no encontrado")
+	}
+
+	current, err := uc.repository.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener segundo factor: %w", err)
+	}
+	if current != nil && current.EnabledAt != nil {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	cipher, err := uc.twoFactorCipher()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := cipher.Seal(secret)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repository.SaveTwoFactorSecret(ctx, userID, sealed); err != nil {
+		return nil, fmt.Errorf("error al guardar segundo factor: %w", err)
+	}
+
+	uc.log.Info().Uint("user_id", userID).Msg("Inscripción de segundo factor iniciada")
+	return &domain.TwoFactorEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(uc.twoFactorIssuer(), user.Email, secret),
+	}, nil
+}
+
+// EnableTwoFactor activa el segundo factor con el primer código de la app y emite los códigos de
+// recuperación. Si la inscripción se hizo durante el login, también inicia la sesión.
+func (uc *AuthUseCase) EnableTwoFactor(ctx context.Context, request domain.EnableTwoFactorRequest) (*domain.EnableTwoFactorResponse, error) {
+	twoFactor, err := uc.repository.GetTwoFactor(ctx, request.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener segundo factor: %w", err)
+	}
+	if twoFactor == nil {
+		return nil, domain.ErrTwoFactorNotEnrolled
+	}
+	if twoFactor.EnabledAt != nil {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	user, err := uc.repository.GetUserByID(ctx, request.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		return nil, fmt.Errorf("usuario no encontrado")
+	}
+
+	step, err := uc.checkTOTP(twoFactor.Secret, normalizeTwoFactorCode(request.Code))
+	if err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repository.EnableTwoFactor(ctx, request.UserID, step, hashes); err != nil {
+		return nil, fmt.Errorf("error al activar segundo factor: %w", err)
+	}
+	uc.log.Info().Uint("user_id", request.UserID).Msg("Segundo factor activado")
+
+	response := &domain.EnableTwoFactorResponse{RecoveryCodes: codes}
+	if request.CompleteLogin {
+		response.Login, err = uc.completeLogin(ctx, user, request.UserAgent, request.IPAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
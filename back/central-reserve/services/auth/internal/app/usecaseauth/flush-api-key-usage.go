@@ -0,0 +1,85 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"sync"
+	"time"
+)
+
+// apiKeyUsage acumula en memoria el último uso de cada API Key para no escribir en base de datos
+// en cada request
+type apiKeyUsage struct {
+	mu      sync.Mutex
+	pending map[uint]time.Time
+}
+
+func newAPIKeyUsage() *apiKeyUsage {
+	return &apiKeyUsage{pending: make(map[uint]time.Time)}
+}
+
+func (u *apiKeyUsage) record(apiKeyID uint, usedAt time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if current, ok := u.pending[apiKeyID]; !ok || usedAt.After(current) {
+		u.pending[apiKeyID] = usedAt
+	}
+}
+
+// drain retorna los usos pendientes y los vacía
+func (u *apiKeyUsage) drain() map[uint]time.Time {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	pending := u.pending
+	u.pending = make(map[uint]time.Time)
+	return pending
+}
+
+// restore devuelve usos que no se pudieron persistir, sin pisar usos más recientes
+func (u *apiKeyUsage) restore(usage map[uint]time.Time) {
+	for apiKeyID, usedAt := range usage {
+		u.record(apiKeyID, usedAt)
+	}
+}
+
+// FlushAPIKeyUsage persiste el último uso acumulado de las API Keys. Si falla, los usos se
+// conservan para el siguiente intento.
+func (uc *AuthUseCase) FlushAPIKeyUsage(ctx context.Context) error {
+	pending := uc.usage.drain()
+	if len(pending) == 0 {
+		return nil
+	}
+	if err := uc.repository.UpdateAPIKeysLastUsed(ctx, pending); err != nil {
+		uc.usage.restore(pending)
+		return err
+	}
+	return nil
+}
+
+// purgeAPIKeyRateWindows elimina los conteos de ventanas del límite de requests que ya terminaron
+func (uc *AuthUseCase) purgeAPIKeyRateWindows(ctx context.Context) error {
+	currentWindow := time.Now().UTC().Truncate(domain.APIKeyRateLimitWindow)
+	_, err := uc.repository.DeleteAPIKeyRateWindowsBefore(ctx, currentWindow)
+	return err
+}
+
+// RunAPIKeyUsageFlusher persiste periódicamente el último uso de las API Keys y elimina los conteos
+// de ventanas vencidas hasta que se cancele el contexto
+func (uc *AuthUseCase) RunAPIKeyUsageFlusher(ctx context.Context) {
+	ticker := time.NewTicker(domain.APIKeyUsageFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := uc.FlushAPIKeyUsage(ctx); err != nil {
+				uc.log.Error().Err(err).Msg("Error al persistir último uso de API Keys")
+			}
+			if err := uc.purgeAPIKeyRateWindows(ctx); err != nil {
+				uc.log.Error().Err(err).Msg("Error al eliminar ventanas vencidas de API Keys")
+			}
+		}
+	}
+}
@@ -1,114 +1,97 @@
 package usecaseauth
 
-// // GenerateAPIKey genera una API Key para un usuario específico
-// func (uc *AuthUseCase) GenerateAPIKey(ctx context.Context, request domain.GenerateAPIKeyRequest) (*domain.GenerateAPIKeyResponse, error) {
-// 	uc.log.Info().
-// 		Uint("requester_id", request.RequesterID).
-// 		Uint("target_user_id", request.UserID).
-// 		Uint("business_id", request.BusinessID).
-// 		Msg("Iniciando generación de API Key")
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+	"time"
+)
 
-// 	// 1. Verificar que el solicitante sea super administrador (rol 1)
-// 	requesterRoles, err := uc.repository.GetUserRoles(ctx, request.RequesterID)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Uint("requester_id", request.RequesterID).Msg("Error al obtener roles del solicitante")
-// 		return nil, fmt.Errorf("error al verificar permisos del solicitante")
-// 	}
+// GenerateAPIKey genera una API Key para que un usuario del negocio acceda por integración.
+// La key en claro solo se retorna aquí; se guarda su prefijo y su hash bcrypt.
+func (uc *AuthUseCase) GenerateAPIKey(ctx context.Context, request domain.GenerateAPIKeyRequest) (*domain.GenerateAPIKeyResponse, error) {
+	uc.log.Info().
+		Uint("requester_id", request.RequesterID).
+		Uint("target_user_id", request.UserID).
+		Uint("business_id", request.BusinessID).
+		Msg("Iniciando generación de API Key")
 
-// 	// Verificar si tiene el rol de super administrador
-// 	hasSuperAdminRole := false
-// 	for _, role := range requesterRoles {
-// 		if role.ID == domain.SuperAdministrator {
-// 			hasSuperAdminRole = true
-// 			break
-// 		}
-// 	}
+	// 1. Negocio y usuario de la key (por defecto, el solicitante)
+	if request.BusinessID == 0 {
+		return nil, domain.ErrAPIKeyBusinessRequired
+	}
+	userID := request.UserID
+	if userID == 0 {
+		userID = request.RequesterID
+	}
+	if err := uc.validateAPIKeyOwner(ctx, userID, request.BusinessID); err != nil {
+		return nil, err
+	}
 
-// 	if !hasSuperAdminRole {
-// 		uc.log.Error().
-// 			Uint("requester_id", request.RequesterID).
-// 			Interface("roles", requesterRoles).
-// 			Msg("Solicitante no tiene rol de super administrador")
-// 		return nil, fmt.Errorf("solo super administradores pueden generar API Keys")
-// 	}
+	// 2. Límite por hora e IPs permitidas
+	rateLimit := domain.DefaultAPIKeyRateLimit
+	if request.RateLimit != nil {
+		rateLimit = *request.RateLimit
+	}
+	if rateLimit < 1 || rateLimit > domain.MaxAPIKeyRateLimit {
+		return nil, domain.ErrAPIKeyInvalidRateLimit
+	}
+	ipWhitelist, err := domain.ParseIPWhitelist(request.IPWhitelist)
+	if err != nil {
+		return nil, err
+	}
+	if len(joinIPWhitelist(ipWhitelist)) > domain.MaxAPIKeyIPWhitelistLength {
+		return nil, domain.ErrAPIKeyInvalidIPWhitelist
+	}
 
-// 	// 2. Verificar que el usuario objetivo existe y está activo
-// 	targetUser, err := uc.repository.GetUserByID(ctx, request.UserID)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Uint("target_user_id", request.UserID).Msg("Error al obtener usuario objetivo")
-// 		return nil, fmt.Errorf("usuario objetivo no encontrado")
-// 	}
+	// 3. Generar la key
+	plain, prefix, hash, err := newAPIKeyMaterial()
+	if err != nil {
+		uc.log.Error().Err(err).Msg("Error al generar API Key")
+		return nil, err
+	}
 
-// 	if targetUser == nil {
-// 		uc.log.Error().Uint("target_user_id", request.UserID).Msg("Usuario objetivo no encontrado")
-// 		return nil, fmt.Errorf("usuario objetivo no encontrado")
-// 	}
+	// 4. Guardar
+	now := time.Now()
+	apiKey := domain.APIKey{
+		UserID:      userID,
+		BusinessID:  request.BusinessID,
+		CreatedByID: request.RequesterID,
+		Name:        request.Name,
+		Description: request.Description,
+		KeyPrefix:   prefix,
+		KeyHash:     hash,
+		RateLimit:   rateLimit,
+		IPWhitelist: joinIPWhitelist(ipWhitelist),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	apiKey.ID, err = uc.repository.CreateAPIKey(ctx, apiKey)
+	if err != nil {
+		return nil, fmt.Errorf("error al guardar API Key: %w", err)
+	}
 
-// 	if !targetUser.IsActive {
-// 		uc.log.Error().Uint("target_user_id", request.UserID).Msg("Usuario objetivo está inactivo")
-// 		return nil, fmt.Errorf("usuario objetivo está inactivo")
-// 	}
+	uc.log.Info().
+		Uint("requester_id", request.RequesterID).
+		Uint("target_user_id", userID).
+		Uint("business_id", request.BusinessID).
+		Uint("api_key_id", apiKey.ID).
+		Str("key_prefix", prefix).
+		Msg("API Key generada exitosamente")
 
-// 	// 3. Verificar que el business existe y está activo
-// 	// TODO: Implementar verificación de business cuando tengamos el repositorio
-// 	// Por ahora, asumimos que el business existe
+	audit.Log(ctx, audit.Entry{
+		BusinessID: apiKey.BusinessID,
+		EntityType: "api_key",
+		EntityID:   apiKey.ID,
+		Action:     audit.ActionCreate,
+		After:      toAPIKeyInfo(apiKey),
+	})
 
-// 	// 4. Generar API Key segura
-// 	apiKeyService := apikey.NewService()
-// 	apiKey, err := apiKeyService.GenerateAPIKey()
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Msg("Error al generar API Key")
-// 		return nil, fmt.Errorf("error al generar API Key: %w", err)
-// 	}
-
-// 	// 5. Generar hash de la API Key
-// 	keyHash, err := apiKeyService.HashAPIKey(apiKey)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Msg("Error al hashear API Key")
-// 		return nil, fmt.Errorf("error al procesar API Key: %w", err)
-// 	}
-
-// 	// 6. Crear entidad API Key
-// 	apiKeyEntity := domain.APIKey{
-// 		UserID:      request.UserID,
-// 		BusinessID:  request.BusinessID,
-// 		CreatedByID: request.RequesterID,
-// 		Name:        request.Name,
-// 		Description: request.Description,
-// 		RateLimit:   1000, // Límite por defecto
-// 		CreatedAt:   time.Now(),
-// 		UpdatedAt:   time.Now(),
-// 	}
-
-// 	// 7. Guardar en la base de datos
-// 	apiKeyID, err := uc.repository.CreateAPIKey(ctx, apiKeyEntity, keyHash)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Msg("Error al guardar API Key en base de datos")
-// 		return nil, fmt.Errorf("error al guardar API Key: %w", err)
-// 	}
-
-// 	// 8. Construir respuesta
-// 	response := &domain.GenerateAPIKeyResponse{
-// 		Success: true,
-// 		Message: "API Key generada exitosamente",
-// 		APIKey:  apiKey, // Solo se muestra una vez
-// 		APIKeyInfo: domain.APIKeyInfo{
-// 			ID:          apiKeyID,
-// 			UserID:      request.UserID,
-// 			BusinessID:  request.BusinessID,
-// 			Name:        request.Name,
-// 			Description: request.Description,
-// 			RateLimit:   1000,
-// 			CreatedAt:   time.Now(),
-// 		},
-// 	}
-
-// 	uc.log.Info().
-// 		Uint("requester_id", request.RequesterID).
-// 		Uint("target_user_id", request.UserID).
-// 		Uint("business_id", request.BusinessID).
-// 		Uint("api_key_id", apiKeyID).
-// 		Msg("API Key generada exitosamente")
-
-// 	return response, nil
-// }
+	return &domain.GenerateAPIKeyResponse{
+		Success:    true,
+		Message:    "API Key generada exitosamente. Guárdala ahora: no se volverá a mostrar",
+		APIKey:     plain,
+		APIKeyInfo: toAPIKeyInfo(apiKey),
+	}, nil
+}
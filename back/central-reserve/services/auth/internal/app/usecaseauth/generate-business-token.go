@@ -5,8 +5,8 @@ import (
 	"fmt"
 )
 
-// GenerateBusinessToken genera un token específico para un business
-func (uc *AuthUseCase) GenerateBusinessToken(ctx context.Context, userID uint, businessID uint) (string, error) {
+// GenerateBusinessToken genera un token específico para un business dentro de la sesión del token principal
+func (uc *AuthUseCase) GenerateBusinessToken(ctx context.Context, userID uint, businessID uint, sessionID uint) (string, error) {
 	uc.log.Info().
 		Uint("user_id", userID).
 		Uint("business_id", businessID).
@@ -65,6 +65,7 @@ func (uc *AuthUseCase) GenerateBusinessToken(ctx context.Context, userID uint, b
 			0, // business_id = 0 para super admin
 			0, // business_type_id = 0 para super admin
 			roleID,
+			sessionID,
 		)
 		if err != nil {
 			uc.log.Error().Err(err).
@@ -145,6 +146,7 @@ func (uc *AuthUseCase) GenerateBusinessToken(ctx context.Context, userID uint, b
 		businessID,
 		business.BusinessTypeID,
 		userRole.ID,
+		sessionID,
 	)
 	if err != nil {
 		uc.log.Error().Err(err).
@@ -0,0 +1,19 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+)
+
+// GetSSOProvider obtiene la configuración de inicio de sesión único del negocio, sin el client secret
+func (uc *AuthUseCase) GetSSOProvider(ctx context.Context, businessID uint) (*domain.SSOProviderInfo, error) {
+	provider, err := uc.repository.GetSSOProvider(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener proveedor SSO: %w", err)
+	}
+	if provider == nil {
+		return nil, domain.ErrSSONotConfigured
+	}
+	return toSSOProviderInfo(provider), nil
+}
@@ -0,0 +1,12 @@
+package usecaseauth
+
+import "context"
+
+// IsSessionActive indica si la sesión de un access token sigue vigente. Lo consulta el middleware
+// en cada request para que logout y revocaciones apliquen de inmediato.
+func (uc *AuthUseCase) IsSessionActive(ctx context.Context, sessionID uint) (bool, error) {
+	if sessionID == 0 {
+		return false, nil
+	}
+	return uc.repository.IsSessionActive(ctx, sessionID)
+}
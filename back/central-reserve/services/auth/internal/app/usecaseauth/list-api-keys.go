@@ -0,0 +1,20 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+)
+
+// ListAPIKeys lista las API Keys de un negocio, incluidas las revocadas. Nunca retorna el secreto.
+func (uc *AuthUseCase) ListAPIKeys(ctx context.Context, businessID uint) ([]domain.APIKeyInfo, error) {
+	if businessID == 0 {
+		return nil, domain.ErrAPIKeyBusinessRequired
+	}
+
+	apiKeys, err := uc.repository.GetAPIKeysByBusiness(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al listar API Keys: %w", err)
+	}
+	return apiKeys, nil
+}
@@ -0,0 +1,140 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// unknownUserHash se compara con la contraseña cuando el email no existe, para que el login tarde
+// lo mismo que con una cuenta real
+var unknownUserHash, _ = bcrypt.GenerateFromPassword([]byte("unknown-user"), bcrypt.DefaultCost)
+
+// checkLoginIP rechaza el login si la IP acumula demasiados intentos fallidos recientes. Si no se
+// puede consultar, el login sigue: la protección por cuenta sigue activa.
+func (uc *AuthUseCase) checkLoginIP(ctx context.Context, ipAddress string, now time.Time) error {
+	if ipAddress == "" {
+		return nil
+	}
+	failures, err := uc.repository.GetLoginIPFailures(ctx, ipAddress, now.Add(-domain.LoginIPFailureWindow))
+	if err != nil {
+		uc.log.Error().Err(err).Str("ip_address", ipAddress).Msg("No se pudieron contar los intentos fallidos de la IP")
+		return nil
+	}
+	if failures.LastFailureAt == nil {
+		return nil
+	}
+
+	if failures.Count >= domain.LoginIPFailureLimit {
+		return &domain.LoginBlockedError{
+			Err:        domain.ErrLoginThrottled,
+			RetryAfter: failures.LastFailureAt.Add(domain.LoginIPFailureWindow).Sub(now),
+		}
+	}
+	delay := domain.LoginDelay(int(failures.Count), domain.LoginIPDelayThreshold)
+	if wait := failures.LastFailureAt.Add(delay).Sub(now); delay > 0 && wait > 0 {
+		return &domain.LoginBlockedError{Err: domain.ErrLoginThrottled, RetryAfter: wait}
+	}
+	return nil
+}
+
+// checkAccountLock rechaza el login si la cuenta está bloqueada o si no pasó el retraso progresivo
+// desde su último intento fallido
+func checkAccountLock(user *domain.UserAuthInfo, now time.Time) error {
+	if user.LockedUntil != nil && now.Before(*user.LockedUntil) {
+		return &domain.LoginBlockedError{
+			Err:        domain.ErrAccountLocked,
+			RetryAfter: user.LockedUntil.Sub(now),
+		}
+	}
+	if user.LastFailedLoginAt == nil {
+		return nil
+	}
+	delay := domain.LoginDelay(user.FailedLoginCount, domain.LoginDelayThreshold)
+	if wait := user.LastFailedLoginAt.Add(delay).Sub(now); delay > 0 && wait > 0 {
+		return &domain.LoginBlockedError{Err: domain.ErrLoginThrottled, RetryAfter: wait}
+	}
+	return nil
+}
+
+// checkUnknownEmail aplica a un email sin cuenta el mismo retraso progresivo y bloqueo que a una
+// cuenta con esos intentos fallidos. Si no se puede consultar, el login sigue rechazado igual.
+func (uc *AuthUseCase) checkUnknownEmail(ctx context.Context, email string, now time.Time) error {
+	failures, err := uc.repository.GetUnknownEmailFailures(ctx, email)
+	if err != nil {
+		uc.log.Error().Err(err).Str("email", email).Msg("No se pudieron contar los intentos fallidos del email")
+		return nil
+	}
+	if failures.LastFailureAt == nil {
+		return nil
+	}
+
+	shadow := &domain.UserAuthInfo{
+		FailedLoginCount:  int(failures.Count),
+		LastFailedLoginAt: failures.LastFailureAt,
+	}
+	if failures.Count >= domain.AccountLockoutThreshold {
+		lockedUntil := failures.LastFailureAt.Add(domain.AccountLockoutDuration)
+		shadow.LockedUntil = &lockedUntil
+	}
+	return checkAccountLock(shadow, now)
+}
+
+// lockReason devuelve el motivo de auditoría de un login rechazado por checkAccountLock
+func lockReason(err error) string {
+	if errors.Is(err, domain.ErrAccountLocked) {
+		return domain.LoginFailedLocked
+	}
+	return domain.LoginFailedThrottled
+}
+
+// hideLock responde a un email bloqueado igual que a unas credenciales inválidas, conservando solo
+// cuándo se puede reintentar
+func hideLock(err error) error {
+	var blocked *domain.LoginBlockedError
+	if errors.As(err, &blocked) {
+		return &domain.LoginBlockedError{Err: domain.ErrInvalidCredentials, RetryAfter: blocked.RetryAfter}
+	}
+	return domain.ErrInvalidCredentials
+}
+
+// registerInvalidPassword suma el intento fallido a la cuenta (bloqueándola si llega al límite) y lo
+// registra en la auditoría
+func (uc *AuthUseCase) registerInvalidPassword(ctx context.Context, user *domain.UserAuthInfo, request domain.LoginRequest, now time.Time) {
+	if err := uc.repository.RegisterFailedLogin(ctx, user.ID, now, domain.AccountLockoutThreshold, now.Add(domain.AccountLockoutDuration)); err != nil {
+		uc.log.Error().Err(err).Uint("user_id", user.ID).Msg("No se pudo registrar el intento fallido")
+	}
+	if user.FailedLoginCount+1 >= domain.AccountLockoutThreshold {
+		uc.log.Warn().
+			Uint("user_id", user.ID).
+			Int("failed_login_count", user.FailedLoginCount+1).
+			Dur("locked_for", domain.AccountLockoutDuration).
+			Msg("Cuenta bloqueada por intentos fallidos")
+	}
+	uc.recordLoginFailure(ctx, &user.ID, user.Email, request.IPAddress, request.UserAgent, domain.LoginFailedInvalidPassword, now)
+}
+
+// recordLoginFailure registra un intento fallido en la auditoría. Un error al registrarlo no cambia
+// la respuesta del login.
+func (uc *AuthUseCase) recordLoginFailure(ctx context.Context, userID *uint, email, ipAddress, userAgent, reason string, now time.Time) {
+	uc.log.Warn().
+		Str("email", email).
+		Str("ip_address", ipAddress).
+		Str("reason", reason).
+		Msg("Intento de login fallido")
+
+	if err := uc.repository.RecordLoginAttempt(ctx, domain.LoginAttempt{
+		UserID:    userID,
+		Email:     email,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Success:   false,
+		Reason:    reason,
+		CreatedAt: now,
+	}); err != nil {
+		uc.log.Error().Err(err).Str("email", email).Msg("No se pudo registrar el intento de login")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -21,61 +22,61 @@ func (uc *AuthUseCase) Login(ctx context.Context, request domain.LoginRequest) (
 		return nil, fmt.Errorf("email y contraseña son requeridos")
 	}
 
+	// Rechazar IPs con demasiados intentos fallidos recientes
+	now := time.Now()
+	if err := uc.checkLoginIP(ctx, request.IPAddress, now); err != nil {
+		uc.recordLoginFailure(ctx, nil, normalizedEmail, request.IPAddress, request.UserAgent, domain.LoginFailedIPBlocked, now)
+		return nil, err
+	}
+
 	// Obtener usuario por email (normalizado)
 	userAuth, err := uc.repository.GetUserByEmail(ctx, normalizedEmail)
 	if err != nil {
 		uc.log.Error().Err(err).Str("email", request.Email).Msg("Error al obtener usuario por email")
-		return nil, fmt.Errorf("credenciales inválidas")
+		return nil, domain.ErrInvalidCredentials
 	}
 
+	// Un email sin cuenta recibe el mismo retraso, bloqueo y respuesta que una cuenta con contraseña
+	// incorrecta, para no revelar qué emails están registrados
 	if userAuth == nil {
-		uc.log.Error().Str("email", normalizedEmail).Msg("Usuario no encontrado")
-		return nil, fmt.Errorf("credenciales inválidas")
+		_ = bcrypt.CompareHashAndPassword(unknownUserHash, []byte(request.Password))
+		if err := uc.checkUnknownEmail(ctx, normalizedEmail, now); err != nil {
+			uc.recordLoginFailure(ctx, nil, normalizedEmail, request.IPAddress, request.UserAgent, lockReason(err), now)
+			return nil, hideLock(err)
+		}
+		uc.recordLoginFailure(ctx, nil, normalizedEmail, request.IPAddress, request.UserAgent, domain.LoginFailedUnknownUser, now)
+		return nil, domain.ErrInvalidCredentials
 	}
 
-	// Verificar que el usuario esté activo
-	if !userAuth.IsActive {
-		uc.log.Error().Str("email", request.Email).Msg("Usuario inactivo")
-		return nil, fmt.Errorf("usuario inactivo")
+	// Cuenta bloqueada o dentro del retraso progresivo tras intentos fallidos
+	if err := checkAccountLock(userAuth, now); err != nil {
+		uc.recordLoginFailure(ctx, &userAuth.ID, userAuth.Email, request.IPAddress, request.UserAgent, lockReason(err), now)
+		return nil, hideLock(err)
 	}
 
-	// Validar contraseña
-	uc.log.Debug().
-		Str("email", request.Email).
-		Str("stored_password_length", fmt.Sprintf("%d", len(userAuth.Password))).
-		Str("input_password_length", fmt.Sprintf("%d", len(request.Password))).
-		Str("stored_password_preview", userAuth.Password[:func() int {
-			if len(userAuth.Password) < 10 {
-				return len(userAuth.Password)
-			}
-			return 10
-		}()]).
-		Str("stored_password_starts_with_bcrypt", fmt.Sprintf("%t", len(userAuth.Password) >= 7 && userAuth.Password[:7] == "$2a$")).
-		Str("stored_password_first_7_chars", userAuth.Password[:func() int {
-			if len(userAuth.Password) < 7 {
-				return len(userAuth.Password)
-			}
-			return 7
-		}()]).
-		Msg("Comparando contraseñas")
+	// Verificar contraseña
+	if err := bcrypt.CompareHashAndPassword([]byte(userAuth.Password), []byte(request.Password)); err != nil {
+		uc.registerInvalidPassword(ctx, userAuth, request, now)
+		return nil, domain.ErrInvalidCredentials
+	}
 
-		// Verificar contraseña
-	uc.log.Debug().
-		Str("email", request.Email).
-		Str("input_password", request.Password).
-		Str("stored_password_hash", userAuth.Password).
-		Msg("Comparando contraseñas")
+	// Verificar que el usuario esté activo; solo se informa a quien conoce la contraseña
+	if !userAuth.IsActive {
+		uc.recordLoginFailure(ctx, &userAuth.ID, userAuth.Email, request.IPAddress, request.UserAgent, domain.LoginFailedInactive, now)
+		return nil, fmt.Errorf("usuario inactivo")
+	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(userAuth.Password), []byte(request.Password)); err != nil {
-		uc.log.Error().
-			Err(err).
-			Str("email", request.Email).
-			Str("input_password", request.Password).
-			Str("stored_password_hash", userAuth.Password).
-			Msg("Contraseña inválida")
-		return nil, fmt.Errorf("credenciales inválidas")
+	// Segundo factor: si el usuario lo activó o su rol lo exige, se responde con un token intermedio
+	if challenge, err := uc.twoFactorChallenge(ctx, userAuth); err != nil || challenge != nil {
+		return challenge, err
 	}
 
+	return uc.completeLogin(ctx, userAuth, request.UserAgent, request.IPAddress)
+}
+
+// completeLogin inicia la sesión y arma la respuesta del login una vez validadas las credenciales
+// (y el segundo factor, si corresponde)
+func (uc *AuthUseCase) completeLogin(ctx context.Context, userAuth *domain.UserAuthInfo, userAgent, ipAddress string) (*domain.LoginResponse, error) {
 	// Obtener roles del usuario para el token
 	roles, err := uc.repository.GetUserRoles(ctx, userAuth.ID)
 	if err != nil {
@@ -205,9 +206,9 @@ func (uc *AuthUseCase) Login(ctx context.Context, request domain.LoginRequest) (
 			Msg("Usuario sin businesses - usando business_id = 0")
 	}
 
-	token, err := uc.jwtService.GenerateToken(userAuth.ID)
+	session, err := uc.startSession(ctx, userAuth.ID, userAgent, ipAddress)
 	if err != nil {
-		uc.log.Error().Err(err).Uint("user_id", userAuth.ID).Msg("Error al generar token JWT")
+		uc.log.Error().Err(err).Uint("user_id", userAuth.ID).Msg("Error al iniciar sesión")
 		return nil, fmt.Errorf("error interno del servidor")
 	}
 
@@ -215,6 +216,7 @@ func (uc *AuthUseCase) Login(ctx context.Context, request domain.LoginRequest) (
 	uc.log.Info().
 		Uint("user_id", userAuth.ID).
 		Uint("token_business_id", businessID).
+		Uint("session_id", session.SessionID).
 		Str("user_email", userAuth.Email).
 		Strs("user_roles", roleNames).
 		Msg("Token JWT generado exitosamente")
@@ -229,9 +231,17 @@ func (uc *AuthUseCase) Login(ctx context.Context, request domain.LoginRequest) (
 			Msg("Primer login detectado - se requiere cambio de contraseña")
 	}
 
-	// Actualizar último login (siempre, excepto en el primer login real)
-	if err := uc.repository.UpdateLastLogin(ctx, userAuth.ID); err != nil {
-		uc.log.Warn().Err(err).Uint("user_id", userAuth.ID).Msg("Error al actualizar último login")
+	// Registrar el login en la auditoría, actualizar último login y reiniciar intentos fallidos
+	if err := uc.repository.RegisterSuccessfulLogin(ctx, domain.LoginAttempt{
+		UserID:    &userAuth.ID,
+		Email:     userAuth.Email,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Success:   true,
+		Reason:    domain.LoginSucceeded,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		uc.log.Warn().Err(err).Uint("user_id", userAuth.ID).Msg("Error al registrar login exitoso")
 		// No retornamos error aquí porque el login ya fue exitoso
 	} else {
 		uc.log.Info().Uint("user_id", userAuth.ID).Msg("Último login actualizado")
@@ -317,15 +327,19 @@ func (uc *AuthUseCase) Login(ctx context.Context, request domain.LoginRequest) (
 	// Construir respuesta simplificada
 	response := &domain.LoginResponse{
 		User: domain.UserInfo{
-			ID:          userAuth.ID,
-			Name:        userAuth.Name,
-			Email:       userAuth.Email,
-			Phone:       userAuth.Phone,
-			AvatarURL:   avatarURL,
-			IsActive:    userAuth.IsActive,
-			LastLoginAt: userAuth.LastLoginAt, // Mantiene el valor original (nil para primer login)
+			ID:            userAuth.ID,
+			Name:          userAuth.Name,
+			Email:         userAuth.Email,
+			Phone:         userAuth.Phone,
+			AvatarURL:     avatarURL,
+			IsActive:      userAuth.IsActive,
+			LastLoginAt:   userAuth.LastLoginAt, // Mantiene el valor original (nil para primer login)
+			EmailVerified: userAuth.EmailVerifiedAt != nil,
 		},
-		Token:                 token,
+		Token:                 session.Token,
+		TokenExpiresAt:        session.TokenExpiresAt,
+		RefreshToken:          session.RefreshToken,
+		RefreshTokenExpiresAt: session.RefreshTokenExpiresAt,
 		RequirePasswordChange: isFirstLogin,
 		Businesses:            businessesList,
 		Scope:                 userScope,
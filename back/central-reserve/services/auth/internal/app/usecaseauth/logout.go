@@ -0,0 +1,29 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+)
+
+// Logout revoca la sesión del refresh token. Sus access tokens dejan de aceptarse de inmediato.
+// Cerrar una sesión ya revocada no es un error.
+func (uc *AuthUseCase) Logout(ctx context.Context, refreshToken string) error {
+	current, err := uc.repository.GetRefreshToken(ctx, hashOpaqueToken(refreshToken))
+	if err != nil {
+		return fmt.Errorf("error al buscar refresh token: %w", err)
+	}
+	if current == nil {
+		return domain.ErrInvalidRefreshToken
+	}
+
+	if err := uc.repository.RevokeSession(ctx, current.SessionID, domain.SessionRevokedLogout); err != nil {
+		return fmt.Errorf("error al cerrar sesión: %w", err)
+	}
+
+	uc.log.Info().
+		Uint("user_id", current.UserID).
+		Uint("session_id", current.SessionID).
+		Msg("Sesión cerrada")
+	return nil
+}
@@ -0,0 +1,77 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+)
+
+// GetTwoFactorStatus retorna si el usuario tiene el segundo factor activo y si su rol lo exige
+func (uc *AuthUseCase) GetTwoFactorStatus(ctx context.Context, userID uint) (*domain.TwoFactorStatus, error) {
+	twoFactor, err := uc.repository.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener segundo factor: %w", err)
+	}
+	required, err := uc.repository.UserRequiresTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error al verificar segundo factor del rol: %w", err)
+	}
+
+	status := &domain.TwoFactorStatus{Required: required}
+	if twoFactor != nil && twoFactor.EnabledAt != nil {
+		status.Enabled = true
+		status.EnabledAt = twoFactor.EnabledAt
+		status.RecoveryCodesLeft, err = uc.repository.CountRecoveryCodes(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("error al contar códigos de recuperación: %w", err)
+		}
+	}
+	return status, nil
+}
+
+// DisableTwoFactor desactiva el segundo factor del usuario con un código válido. No se permite si
+// algún rol del usuario lo exige.
+func (uc *AuthUseCase) DisableTwoFactor(ctx context.Context, userID uint, code string) error {
+	twoFactor, err := uc.enabledTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+	required, err := uc.repository.UserRequiresTwoFactor(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error al verificar segundo factor del rol: %w", err)
+	}
+	if required {
+		return domain.ErrTwoFactorRequiredByRole
+	}
+	if err := uc.verifyTwoFactorCode(ctx, twoFactor, code); err != nil {
+		return err
+	}
+
+	if _, err := uc.repository.DeleteTwoFactor(ctx, userID); err != nil {
+		return fmt.Errorf("error al desactivar segundo factor: %w", err)
+	}
+	uc.log.Info().Uint("user_id", userID).Msg("Segundo factor desactivado por el usuario")
+	return nil
+}
+
+// RegenerateRecoveryCodes reemplaza los códigos de recuperación con un código válido. Los anteriores
+// dejan de servir.
+func (uc *AuthUseCase) RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error) {
+	twoFactor, err := uc.enabledTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.verifyTwoFactorCode(ctx, twoFactor, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repository.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("error al guardar códigos de recuperación: %w", err)
+	}
+	uc.log.Info().Uint("user_id", userID).Msg("Códigos de recuperación regenerados")
+	return codes, nil
+}
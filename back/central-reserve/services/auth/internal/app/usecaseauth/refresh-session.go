@@ -0,0 +1,103 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+	"time"
+
+	sharedjwt "central_reserve/shared/jwt"
+)
+
+// RefreshSession emite un nuevo token principal y rota el refresh token. Cada refresh token sirve
+// una sola vez: si se presenta uno ya usado se asume robado y se revoca toda la sesión.
+func (uc *AuthUseCase) RefreshSession(ctx context.Context, request domain.RefreshSessionRequest) (*domain.SessionTokens, error) {
+	// 1. Buscar el refresh token y validar su sesión
+	current, err := uc.repository.GetRefreshToken(ctx, hashOpaqueToken(request.RefreshToken))
+	if err != nil {
+		return nil, fmt.Errorf("error al buscar refresh token: %w", err)
+	}
+	if current == nil {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if current.Session.RevokedAt != nil {
+		return nil, domain.ErrSessionRevoked
+	}
+	if current.UsedAt != nil {
+		return nil, uc.revokeReusedSession(ctx, current)
+	}
+	now := time.Now()
+	if !now.Before(current.ExpiresAt) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	user, err := uc.repository.GetUserByID(ctx, current.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		if err := uc.repository.RevokeSession(ctx, current.SessionID, domain.SessionRevokedUserInactive); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrSessionRevoked
+	}
+
+	// 2. Rotar el refresh token
+	refreshToken, refreshHash, err := newOpaqueToken(domain.RefreshTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	refreshExpiresAt := now.Add(domain.RefreshTokenTTL)
+	rotated, err := uc.repository.RotateRefreshToken(ctx, current.ID, domain.RefreshTokenRecord{
+		SessionID: current.SessionID,
+		TokenHash: refreshHash,
+		ExpiresAt: refreshExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error al rotar refresh token: %w", err)
+	}
+	if !rotated {
+		// Otra request usó el mismo token entre la lectura y la rotación
+		return nil, uc.revokeReusedSession(ctx, current)
+	}
+
+	// 3. Emitir los tokens de acceso
+	token, err := uc.jwtService.GenerateToken(current.UserID, current.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("error al generar token: %w", err)
+	}
+	tokens := &domain.SessionTokens{
+		SessionID:             current.SessionID,
+		UserID:                current.UserID,
+		Token:                 token,
+		TokenExpiresAt:        now.Add(sharedjwt.AccessTokenTTL),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}
+	if request.BusinessID != nil {
+		tokens.BusinessToken, err = uc.GenerateBusinessToken(ctx, current.UserID, *request.BusinessID, current.SessionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	uc.log.Info().
+		Uint("user_id", current.UserID).
+		Uint("session_id", current.SessionID).
+		Msg("Sesión renovada")
+
+	return tokens, nil
+}
+
+// revokeReusedSession revoca la sesión de un refresh token reutilizado
+func (uc *AuthUseCase) revokeReusedSession(ctx context.Context, reused *domain.RefreshTokenRecord) error {
+	uc.log.Warn().
+		Uint("user_id", reused.UserID).
+		Uint("session_id", reused.SessionID).
+		Uint("refresh_token_id", reused.ID).
+		Msg("Refresh token reutilizado; se revoca la sesión")
+	if err := uc.repository.RevokeSession(ctx, reused.SessionID, domain.SessionRevokedReuseDetected); err != nil {
+		return err
+	}
+	return domain.ErrRefreshTokenReused
+}
@@ -0,0 +1,78 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RequestPasswordReset envía un enlace para restablecer la contraseña. Responde igual exista o no el
+// email, para no revelar qué cuentas están registradas.
+func (uc *AuthUseCase) RequestPasswordReset(ctx context.Context, request domain.AccountEmailRequest) error {
+	return uc.requestAccountEmail(ctx, domain.AccountTokenPasswordReset, domain.PasswordResetTokenTTL, request)
+}
+
+// RequestEmailVerification envía un enlace para verificar el email. Responde igual exista o no el
+// email, o si ya estaba verificado.
+func (uc *AuthUseCase) RequestEmailVerification(ctx context.Context, request domain.AccountEmailRequest) error {
+	return uc.requestAccountEmail(ctx, domain.AccountTokenEmailVerification, domain.EmailVerificationTokenTTL, request)
+}
+
+// requestAccountEmail emite un token de cuenta y envía su enlace. Solo el límite por IP se informa
+// al cliente; el límite por email y los emails inexistentes se descartan en silencio.
+func (uc *AuthUseCase) requestAccountEmail(ctx context.Context, purpose string, ttl time.Duration, request domain.AccountEmailRequest) error {
+	now := time.Now()
+	if !uc.throttle.allow("ip:"+request.IPAddress, domain.AccountIPThrottleLimit, now) {
+		uc.log.Warn().Str("ip", request.IPAddress).Str("purpose", purpose).Msg("Límite de solicitudes de cuenta por IP alcanzado")
+		return domain.ErrAccountRequestThrottled
+	}
+
+	normalizedEmail := strings.ToLower(strings.TrimSpace(request.Email))
+	if normalizedEmail == "" {
+		return nil
+	}
+	if !uc.throttle.allow(purpose+":"+normalizedEmail, domain.AccountEmailThrottleLimit, now) {
+		uc.log.Warn().Str("email", normalizedEmail).Str("purpose", purpose).Msg("Límite de emails de cuenta alcanzado; no se envía")
+		return nil
+	}
+
+	user, err := uc.repository.GetUserByEmail(ctx, normalizedEmail)
+	if err != nil {
+		return fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		uc.log.Info().Str("email", normalizedEmail).Str("purpose", purpose).Msg("Solicitud de cuenta para email sin usuario activo; no se envía")
+		return nil
+	}
+	if purpose == domain.AccountTokenEmailVerification && user.EmailVerifiedAt != nil {
+		uc.log.Info().Uint("user_id", user.ID).Msg("Email ya verificado; no se envía")
+		return nil
+	}
+
+	plain, hash, err := newOpaqueToken(domain.AccountTokenBytes)
+	if err != nil {
+		return err
+	}
+	if err := uc.repository.CreateAccountToken(ctx, domain.AccountToken{
+		UserID:    user.ID,
+		Purpose:   purpose,
+		TokenHash: hash,
+		Email:     user.Email,
+		ExpiresAt: now.Add(ttl),
+	}); err != nil {
+		return fmt.Errorf("error al guardar token de cuenta: %w", err)
+	}
+
+	msg, err := uc.accountEmailMessage(purpose, user.Email, user.Name, plain)
+	if err != nil {
+		return err
+	}
+	if err := uc.enqueueAccountEmail(ctx, msg, user.ID, purpose); err != nil {
+		return err
+	}
+
+	uc.log.Info().Uint("user_id", user.ID).Str("purpose", purpose).Msg("Token de cuenta emitido")
+	return nil
+}
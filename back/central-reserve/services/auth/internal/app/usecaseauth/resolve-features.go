@@ -0,0 +1,53 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+)
+
+// ResolveFeatures obtiene los módulos habilitados de un negocio: sus recursos configurados activos,
+// más delivery y pickup según los flags del negocio. Las reservas requieren además el flag
+// EnableReservations. Un negocio inactivo o inexistente no tiene módulos. El resultado se guarda
+// en caché por negocio.
+func (uc *AuthUseCase) ResolveFeatures(ctx context.Context, businessID uint) (domain.FeatureSet, error) {
+	if features, ok := uc.features.Get(businessID); ok {
+		return features, nil
+	}
+
+	business, err := uc.repository.GetBusinessByID(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener negocio: %w", err)
+	}
+
+	features := domain.FeatureSet{}
+	if business != nil && business.IsActive {
+		resourceNames, err := uc.repository.GetBusinessActiveResourceNames(ctx, businessID)
+		if err != nil {
+			return nil, fmt.Errorf("error al obtener recursos activos del negocio: %w", err)
+		}
+		for _, name := range resourceNames {
+			features[domain.FeatureKey(name)] = true
+		}
+
+		if !business.EnableReservations {
+			delete(features, domain.FeatureReservations)
+		}
+		if business.EnableDelivery {
+			features[domain.FeatureDelivery] = true
+		}
+		if business.EnablePickup {
+			features[domain.FeaturePickup] = true
+		}
+	}
+
+	uc.features.Set(businessID, features)
+	return features, nil
+}
+
+// InvalidateFeatures descarta los módulos y permisos en caché de un negocio; se llama cuando
+// cambian sus recursos activos o sus flags
+func (uc *AuthUseCase) InvalidateFeatures(businessID uint) {
+	uc.features.Invalidate(businessID)
+	uc.permissions.InvalidateBusiness(businessID)
+}
@@ -0,0 +1,54 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+)
+
+// ResolvePermissions obtiene los permisos efectivos de un usuario en un negocio: los del rol,
+// limitados a los recursos activos del negocio. Si roleID es nil (API Key) el rol se toma de
+// business_staff. El resultado se guarda en caché por rol y negocio.
+func (uc *AuthUseCase) ResolvePermissions(ctx context.Context, userID uint, businessID uint, roleID *uint) (domain.PermissionSet, error) {
+	if roleID == nil {
+		relation, err := uc.repository.GetBusinessStaffRelation(ctx, userID, &businessID)
+		if err != nil {
+			return nil, fmt.Errorf("error al obtener rol del usuario en el negocio: %w", err)
+		}
+		if relation == nil || relation.RoleID == nil {
+			// Sin rol en el negocio no hay permisos
+			return domain.PermissionSet{}, nil
+		}
+		roleID = relation.RoleID
+	}
+
+	if permissions, ok := uc.permissions.Get(*roleID, businessID); ok {
+		return permissions, nil
+	}
+
+	rolePermissions, err := uc.repository.GetRolePermissions(ctx, *roleID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener permisos del rol: %w", err)
+	}
+
+	activeResourceIDs, err := uc.repository.GetBusinessConfiguredResourcesIDs(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener recursos activos del negocio: %w", err)
+	}
+	activeResources := make(map[uint]bool, len(activeResourceIDs))
+	for _, resourceID := range activeResourceIDs {
+		activeResources[resourceID] = true
+	}
+
+	// Igual que en GetUserRolesPermissions: un recurso inactivo en el negocio no concede permisos
+	permissions := make(domain.PermissionSet, len(rolePermissions))
+	for _, permission := range rolePermissions {
+		if !activeResources[permission.ResourceID] {
+			continue
+		}
+		permissions[domain.PermissionKey(permission.Resource, permission.Action)] = true
+	}
+
+	uc.permissions.Set(*roleID, businessID, permissions)
+	return permissions, nil
+}
@@ -0,0 +1,35 @@
+package usecaseauth
+
+import (
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+)
+
+// RevokeAPIKey revoca una API Key del negocio (0 = cualquiera, super admin). Revocar una key ya
+// revocada no es un error.
+func (uc *AuthUseCase) RevokeAPIKey(ctx context.Context, apiKeyID uint, businessID uint) error {
+	apiKey, err := uc.getBusinessAPIKey(ctx, apiKeyID, businessID)
+	if err != nil {
+		return err
+	}
+
+	if !apiKey.Revoked {
+		if err := uc.repository.RevokeAPIKey(ctx, apiKey.ID); err != nil {
+			return fmt.Errorf("error al revocar API Key: %w", err)
+		}
+		audit.Log(ctx, audit.Entry{
+			BusinessID: apiKey.BusinessID,
+			EntityType: "api_key",
+			EntityID:   apiKey.ID,
+			Action:     audit.ActionRevoke,
+		})
+	}
+	uc.apiKeys.Invalidate(apiKey.ID)
+
+	uc.log.Info().
+		Uint("api_key_id", apiKey.ID).
+		Uint("business_id", apiKey.BusinessID).
+		Msg("API Key revocada")
+	return nil
+}
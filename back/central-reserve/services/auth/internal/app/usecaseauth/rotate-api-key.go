@@ -0,0 +1,79 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+	"time"
+)
+
+// RotateAPIKey revoca una API Key y genera otra con el mismo usuario, nombre, límite e IPs
+// permitidas. La nueva key en claro solo se retorna aquí.
+func (uc *AuthUseCase) RotateAPIKey(ctx context.Context, request domain.RotateAPIKeyRequest) (*domain.GenerateAPIKeyResponse, error) {
+	current, err := uc.getBusinessAPIKey(ctx, request.APIKeyID, request.BusinessID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Revoked {
+		return nil, domain.ErrAPIKeyRevoked
+	}
+	if err := uc.validateAPIKeyOwner(ctx, current.UserID, current.BusinessID); err != nil {
+		return nil, err
+	}
+
+	plain, prefix, hash, err := newAPIKeyMaterial()
+	if err != nil {
+		uc.log.Error().Err(err).Msg("Error al generar API Key")
+		return nil, err
+	}
+
+	now := time.Now()
+	replacement := domain.APIKey{
+		UserID:      current.UserID,
+		BusinessID:  current.BusinessID,
+		CreatedByID: request.RequesterID,
+		Name:        current.Name,
+		Description: current.Description,
+		KeyPrefix:   prefix,
+		KeyHash:     hash,
+		RateLimit:   current.RateLimit,
+		IPWhitelist: current.IPWhitelist,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	replacement.ID, err = uc.repository.RotateAPIKey(ctx, current.ID, replacement)
+	if err != nil {
+		return nil, fmt.Errorf("error al rotar API Key: %w", err)
+	}
+	uc.apiKeys.Invalidate(current.ID)
+
+	uc.log.Info().
+		Uint("requester_id", request.RequesterID).
+		Uint("api_key_id", current.ID).
+		Uint("new_api_key_id", replacement.ID).
+		Uint("business_id", current.BusinessID).
+		Msg("API Key rotada exitosamente")
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: current.BusinessID,
+		EntityType: "api_key",
+		EntityID:   current.ID,
+		Action:     audit.ActionRevoke,
+		After:      map[string]any{"replaced_by": replacement.ID},
+	})
+	audit.Log(ctx, audit.Entry{
+		BusinessID: replacement.BusinessID,
+		EntityType: "api_key",
+		EntityID:   replacement.ID,
+		Action:     audit.ActionCreate,
+		After:      toAPIKeyInfo(replacement),
+	})
+
+	return &domain.GenerateAPIKeyResponse{
+		Success:    true,
+		Message:    "API Key rotada exitosamente. Guarda la nueva key ahora: no se volverá a mostrar",
+		APIKey:     plain,
+		APIKeyInfo: toAPIKeyInfo(replacement),
+	}, nil
+}
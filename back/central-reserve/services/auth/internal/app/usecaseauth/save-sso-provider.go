@@ -0,0 +1,192 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// SaveSSOProvider crea o reemplaza el proveedor OIDC del negocio. Antes de guardar se consulta el
+// discovery del emisor para detectar una configuración errónea en el momento y no en el login.
+func (uc *AuthUseCase) SaveSSOProvider(ctx context.Context, request domain.SaveSSOProviderRequest) (*domain.SSOProviderInfo, error) {
+	issuerURL := strings.TrimRight(strings.TrimSpace(request.IssuerURL), "/")
+	if err := validateSSOURL(issuerURL, true); err != nil {
+		return nil, fmt.Errorf("%w: issuer_url %v", domain.ErrSSOInvalidConfig, err)
+	}
+	redirectURL := strings.TrimSpace(request.RedirectURL)
+	if err := validateSSOURL(redirectURL, false); err != nil {
+		return nil, fmt.Errorf("%w: redirect_url %v", domain.ErrSSOInvalidConfig, err)
+	}
+	clientID := strings.TrimSpace(request.ClientID)
+	if clientID == "" {
+		return nil, fmt.Errorf("%w: client_id es requerido", domain.ErrSSOInvalidConfig)
+	}
+
+	business, err := uc.repository.GetBusinessByID(ctx, request.BusinessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener negocio: %w", err)
+	}
+	if business == nil {
+		return nil, fmt.Errorf("%w: el negocio no existe", domain.ErrSSOInvalidConfig)
+	}
+
+	mappings, err := uc.validateSSORoleMappings(ctx, business.BusinessTypeID, request.RoleMappings)
+	if err != nil {
+		return nil, err
+	}
+	if request.DefaultRoleID != nil {
+		if err := uc.validateSSORole(ctx, business.BusinessTypeID, *request.DefaultRoleID); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := uc.oidc.Discover(ctx, issuerURL); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.repository.GetSSOProvider(ctx, request.BusinessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener proveedor SSO: %w", err)
+	}
+
+	// Un secreto vacío conserva el actual, salvo que se pida eliminarlo
+	sealedSecret := ""
+	switch {
+	case request.ClientSecret != "":
+		cipher, err := uc.ssoCipher()
+		if err != nil {
+			return nil, err
+		}
+		if sealedSecret, err = cipher.Seal(request.ClientSecret); err != nil {
+			return nil, fmt.Errorf("error al cifrar client secret: %w", err)
+		}
+	case !request.ClearClientSecret && existing != nil:
+		sealedSecret = existing.ClientSecret
+	}
+
+	saved, err := uc.repository.SaveSSOProvider(ctx, domain.SSOProvider{
+		BusinessID:        request.BusinessID,
+		IssuerURL:         issuerURL,
+		ClientID:          clientID,
+		ClientSecret:      sealedSecret,
+		RedirectURL:       redirectURL,
+		Scopes:            normalizeSSOScopes(request.Scopes),
+		GroupsClaim:       firstNonEmpty(strings.TrimSpace(request.GroupsClaim), domain.DefaultSSOGroupsClaim),
+		AllowProvisioning: request.AllowProvisioning,
+		DefaultRoleID:     request.DefaultRoleID,
+		IsActive:          request.IsActive,
+		RoleMappings:      mappings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error al guardar proveedor SSO: %w", err)
+	}
+
+	uc.log.Info().Uint("business_id", request.BusinessID).Str("issuer", issuerURL).Msg("Proveedor SSO configurado")
+
+	info := toSSOProviderInfo(saved)
+	entry := audit.Entry{
+		BusinessID: request.BusinessID,
+		EntityType: "sso_provider",
+		EntityID:   saved.ID,
+		Action:     audit.ActionCreate,
+		After:      info,
+	}
+	if existing != nil {
+		entry.Action = audit.ActionUpdate
+		entry.Before = toSSOProviderInfo(existing)
+	}
+	audit.Log(ctx, entry)
+
+	return info, nil
+}
+
+// validateSSORoleMappings normaliza los mapeos y verifica que los roles correspondan al tipo de
+// negocio. El orden de la lista es la prioridad.
+func (uc *AuthUseCase) validateSSORoleMappings(ctx context.Context, businessTypeID uint, requested []domain.SSORoleMapping) ([]domain.SSORoleMapping, error) {
+	mappings := make([]domain.SSORoleMapping, 0, len(requested))
+	seen := make(map[string]bool, len(requested))
+	for i, mapping := range requested {
+		group := strings.TrimSpace(mapping.GroupName)
+		if group == "" {
+			return nil, fmt.Errorf("%w: el grupo del mapeo %d está vacío", domain.ErrSSOInvalidConfig, i+1)
+		}
+		if seen[group] {
+			return nil, fmt.Errorf("%w: el grupo %q está repetido", domain.ErrSSOInvalidConfig, group)
+		}
+		seen[group] = true
+
+		if err := uc.validateSSORole(ctx, businessTypeID, mapping.RoleID); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, domain.SSORoleMapping{GroupName: group, RoleID: mapping.RoleID, Priority: i})
+	}
+	return mappings, nil
+}
+
+// validateSSORole verifica que el rol exista y sea del tipo de negocio
+func (uc *AuthUseCase) validateSSORole(ctx context.Context, businessTypeID, roleID uint) error {
+	role, err := uc.repository.GetRoleByID(ctx, roleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: el rol %d no existe", domain.ErrSSOInvalidConfig, roleID)
+		}
+		return fmt.Errorf("error al obtener rol: %w", err)
+	}
+	if role.BusinessTypeID != businessTypeID {
+		return fmt.Errorf("%w: el rol %d no corresponde al tipo de negocio", domain.ErrSSOInvalidConfig, roleID)
+	}
+	return nil
+}
+
+// validateSSOURL exige una URL absoluta https. El emisor acepta http solo en localhost, para
+// probar con un proveedor local.
+func validateSSOURL(raw string, issuer bool) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("debe ser una URL absoluta")
+	}
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if !issuer || isLocalHost(parsed.Hostname()) {
+			return nil
+		}
+		return fmt.Errorf("debe usar https")
+	}
+	return fmt.Errorf("debe usar https")
+}
+
+func isLocalHost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// normalizeSSOScopes separa por espacios, quita duplicados y asegura el scope openid
+func normalizeSSOScopes(scopes string) string {
+	fields := strings.Fields(scopes)
+	if len(fields) == 0 {
+		return domain.DefaultSSOScopes
+	}
+	result := []string{"openid"}
+	seen := map[string]bool{"openid": true}
+	for _, scope := range fields {
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+func firstNonEmpty(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
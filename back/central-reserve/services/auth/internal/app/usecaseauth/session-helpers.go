@@ -0,0 +1,68 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sharedjwt "central_reserve/shared/jwt"
+)
+
+// newOpaqueToken genera un token aleatorio (refresh token o enlace de cuenta) y el hash SHA-256 que se guarda
+func newOpaqueToken(size int) (plain string, hash string, err error) {
+	raw := make([]byte, size)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("error al generar token: %w", err)
+	}
+	plain = base64.RawURLEncoding.EncodeToString(raw)
+	return plain, hashOpaqueToken(plain), nil
+}
+
+// hashOpaqueToken calcula el hash con el que se busca un token opaco. Basta SHA-256 porque el
+// token es aleatorio de 256 bits.
+func hashOpaqueToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
+
+// startSession crea una sesión para el usuario y emite su token principal y su refresh token
+func (uc *AuthUseCase) startSession(ctx context.Context, userID uint, userAgent, ipAddress string) (*domain.SessionTokens, error) {
+	refreshToken, refreshHash, err := newOpaqueToken(domain.RefreshTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	refreshExpiresAt := now.Add(domain.RefreshTokenTTL)
+	sessionID, err := uc.repository.CreateSession(ctx, domain.Session{
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		LastUsedAt: now,
+	}, domain.RefreshTokenRecord{
+		TokenHash: refreshHash,
+		ExpiresAt: refreshExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error al crear sesión: %w", err)
+	}
+
+	token, err := uc.jwtService.GenerateToken(userID, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("error al generar token: %w", err)
+	}
+
+	return &domain.SessionTokens{
+		SessionID:             sessionID,
+		UserID:                userID,
+		Token:                 token,
+		TokenExpiresAt:        now.Add(sharedjwt.AccessTokenTTL),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}, nil
+}
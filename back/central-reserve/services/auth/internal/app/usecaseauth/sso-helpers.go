@@ -0,0 +1,67 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/totp"
+	"crypto/sha256"
+	"encoding/base64"
+	"sort"
+)
+
+// ssoCipher cifra los client secrets de los proveedores con SSO_ENCRYPTION_KEY o, si no está
+// configurada, con JWT_SECRET
+func (uc *AuthUseCase) ssoCipher() (*totp.Cipher, error) {
+	key := ""
+	if uc.env != nil {
+		key = uc.env.Get("SSO_ENCRYPTION_KEY")
+		if key == "" {
+			key = uc.env.Get("JWT_SECRET")
+		}
+	}
+	return totp.NewCipher(key)
+}
+
+// pkceChallenge calcula el code_challenge S256 del verificador (RFC 7636)
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// resolveSSORole elige el rol del usuario a partir de sus grupos: el mapeo de menor prioridad entre
+// los grupos del usuario o, si ninguno coincide, el rol por defecto del proveedor (nil si no tiene)
+func resolveSSORole(provider *domain.SSOProvider, groups []string) *uint {
+	member := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		member[group] = true
+	}
+
+	mappings := append([]domain.SSORoleMapping(nil), provider.RoleMappings...)
+	sort.SliceStable(mappings, func(i, j int) bool { return mappings[i].Priority < mappings[j].Priority })
+	for _, mapping := range mappings {
+		if member[mapping.GroupName] {
+			roleID := mapping.RoleID
+			return &roleID
+		}
+	}
+	return provider.DefaultRoleID
+}
+
+// toSSOProviderInfo oculta el client secret del proveedor
+func toSSOProviderInfo(provider *domain.SSOProvider) *domain.SSOProviderInfo {
+	return &domain.SSOProviderInfo{
+		ID:                provider.ID,
+		BusinessID:        provider.BusinessID,
+		IssuerURL:         provider.IssuerURL,
+		ClientID:          provider.ClientID,
+		HasClientSecret:   provider.ClientSecret != "",
+		RedirectURL:       provider.RedirectURL,
+		Scopes:            provider.Scopes,
+		GroupsClaim:       provider.GroupsClaim,
+		AllowProvisioning: provider.AllowProvisioning,
+		DefaultRoleID:     provider.DefaultRoleID,
+		IsActive:          provider.IsActive,
+		RoleMappings:      provider.RoleMappings,
+		CreatedAt:         provider.CreatedAt,
+		UpdatedAt:         provider.UpdatedAt,
+	}
+}
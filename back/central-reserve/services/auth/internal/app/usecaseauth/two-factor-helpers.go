@@ -0,0 +1,130 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/totp"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// twoFactorCipher cifra los secretos TOTP con TOTP_ENCRYPTION_KEY o, si no está configurada, con JWT_SECRET
+func (uc *AuthUseCase) twoFactorCipher() (*totp.Cipher, error) {
+	key := ""
+	if uc.env != nil {
+		key = uc.env.Get("TOTP_ENCRYPTION_KEY")
+		if key == "" {
+			key = uc.env.Get("JWT_SECRET")
+		}
+	}
+	return totp.NewCipher(key)
+}
+
+// twoFactorIssuer es el nombre con el que la app TOTP muestra la cuenta
+func (uc *AuthUseCase) twoFactorIssuer() string {
+	if uc.env != nil {
+		if issuer := uc.env.Get("TOTP_ISSUER"); issuer != "" {
+			return issuer
+		}
+	}
+	return domain.DefaultTwoFactorIssuer
+}
+
+// newRecoveryCodes genera los códigos de recuperación (xxxxx-xxxxx) y los hashes que se guardan
+func newRecoveryCodes() (codes []string, hashes []string, err error) {
+	codes = make([]string, domain.RecoveryCodeCount)
+	hashes = make([]string, domain.RecoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, domain.RecoveryCodeBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("error al generar códigos de recuperación: %w", err)
+		}
+		code := hex.EncodeToString(raw)
+		codes[i] = code[:len(code)/2] + "-" + code[len(code)/2:]
+		hashes[i] = hashOpaqueToken(code)
+	}
+	return codes, hashes, nil
+}
+
+// normalizeTwoFactorCode quita espacios y guiones y pasa a minúsculas
+func normalizeTwoFactorCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
+
+// isTOTPCode indica si el código tiene la forma de un código TOTP (solo dígitos)
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	_, err := strconv.Atoi(code)
+	return err == nil
+}
+
+// checkTOTP valida un código TOTP contra el secreto cifrado y retorna su paso
+func (uc *AuthUseCase) checkTOTP(sealedSecret, code string) (int64, error) {
+	cipher, err := uc.twoFactorCipher()
+	if err != nil {
+		return 0, err
+	}
+	secret, err := cipher.Open(sealedSecret)
+	if err != nil {
+		return 0, err
+	}
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return 0, domain.ErrInvalidTwoFactorCode
+	}
+	return step, nil
+}
+
+// verifyTwoFactorCode valida un código TOTP (que no puede reutilizarse) o un código de recuperación
+// (que se consume) del segundo factor activo del usuario
+func (uc *AuthUseCase) verifyTwoFactorCode(ctx context.Context, twoFactor *domain.TwoFactor, code string) error {
+	if !uc.twoFactorAttempts.allow(strconv.FormatUint(uint64(twoFactor.UserID), 10), domain.TwoFactorMaxAttempts, time.Now()) {
+		uc.log.Warn().Uint("user_id", twoFactor.UserID).Msg("Límite de intentos de segundo factor alcanzado")
+		return domain.ErrTwoFactorAttempts
+	}
+
+	code = normalizeTwoFactorCode(code)
+	if isTOTPCode(code) {
+		step, err := uc.checkTOTP(twoFactor.Secret, code)
+		if err != nil {
+			return err
+		}
+		fresh, err := uc.repository.UpdateTwoFactorStep(ctx, twoFactor.UserID, step)
+		if err != nil {
+			return fmt.Errorf("error al registrar código: %w", err)
+		}
+		if !fresh {
+			uc.log.Warn().Uint("user_id", twoFactor.UserID).Msg("Código TOTP reutilizado")
+			return domain.ErrInvalidTwoFactorCode
+		}
+		return nil
+	}
+
+	used, err := uc.repository.UseRecoveryCode(ctx, twoFactor.UserID, hashOpaqueToken(code))
+	if err != nil {
+		return fmt.Errorf("error al usar código de recuperación: %w", err)
+	}
+	if !used {
+		return domain.ErrInvalidTwoFactorCode
+	}
+	uc.log.Info().Uint("user_id", twoFactor.UserID).Msg("Código de recuperación usado")
+	return nil
+}
+
+// enabledTwoFactor obtiene el segundo factor activo del usuario
+func (uc *AuthUseCase) enabledTwoFactor(ctx context.Context, userID uint) (*domain.TwoFactor, error) {
+	twoFactor, err := uc.repository.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener segundo factor: %w", err)
+	}
+	if twoFactor == nil || twoFactor.EnabledAt == nil {
+		return nil, domain.ErrTwoFactorNotEnrolled
+	}
+	return twoFactor, nil
+}
@@ -0,0 +1,102 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/totp"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testConfig es una configuración en memoria para los tests
+type testConfig map[string]string
+
+func (c testConfig) Get(key string) string {
+	return c[key]
+}
+
+func TestNormalizeTwoFactorCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		want     string
+		wantTOTP bool
+	}{
+		{code: "123456", want: "123456", wantTOTP: true},
+		{code: " 123 456 ", want: "123456", wantTOTP: true},
+		{code: "123-456", want: "123456", wantTOTP: true},
+		{code: "ABCD-EF12", want: "abcdef12", wantTOTP: false},
+		{code: "12345", want: "12345", wantTOTP: false},
+		{code: "12345a", want: "12345a", wantTOTP: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got := normalizeTwoFactorCode(tt.code)
+			if got != tt.want {
+				t.Errorf("normalizeTwoFactorCode() = %q, se esperaba %q", got, tt.want)
+			}
+			if isTOTP := isTOTPCode(got); isTOTP != tt.wantTOTP {
+				t.Errorf("isTOTPCode(%q) = %v, se esperaba %v", got, isTOTP, tt.wantTOTP)
+			}
+		})
+	}
+}
+
+func TestCheckTOTP(t *testing.T) {
+	uc := &AuthUseCase{env: testConfig{"JWT_SECRET": "secreto-de-prueba"}}
+	cipher, err := uc.twoFactorCipher()
+	if err != nil {
+		t.Fatalf("twoFactorCipher() error = %v", err)
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	sealed, err := cipher.Seal(secret)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	// checkTOTP usa la hora actual: se evita que el paso cambie a mitad del test
+	if remaining := totp.Period - time.Duration(time.Now().UnixNano())%totp.Period; remaining < 2*time.Second {
+		time.Sleep(remaining)
+	}
+	now := totp.Step(time.Now())
+	codeAt := func(step int64) string {
+		code, err := totp.CodeAt(secret, step)
+		if err != nil {
+			t.Fatalf("CodeAt() error = %v", err)
+		}
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		wantStep int64
+		wantErr  error
+	}{
+		{name: "paso actual", code: codeAt(now), wantStep: now},
+		{name: "paso anterior por desfase de reloj", code: codeAt(now - 1), wantStep: now - 1},
+		{name: "paso siguiente por desfase de reloj", code: codeAt(now + 1), wantStep: now + 1},
+		{name: "fuera de la tolerancia", code: codeAt(now - 3), wantErr: domain.ErrInvalidTwoFactorCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := uc.checkTOTP(sealed, tt.code)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("checkTOTP() error = %v, se esperaba %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkTOTP() error = %v", err)
+			}
+			if step != tt.wantStep {
+				t.Errorf("checkTOTP() paso = %d, se esperaba %d", step, tt.wantStep)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package usecaseauth
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+	"time"
+
+	sharedjwt "central_reserve/shared/jwt"
+)
+
+// twoFactorChallenge decide si el login necesita un segundo paso. Retorna nil si el usuario puede
+// entrar solo con la contraseña.
+func (uc *AuthUseCase) twoFactorChallenge(ctx context.Context, userAuth *domain.UserAuthInfo) (*domain.LoginResponse, error) {
+	twoFactor, err := uc.repository.GetTwoFactor(ctx, userAuth.ID)
+	if err != nil {
+		uc.log.Error().Err(err).Uint("user_id", userAuth.ID).Msg("Error al obtener segundo factor")
+		return nil, fmt.Errorf("error interno del servidor")
+	}
+
+	tokenType := ""
+	if twoFactor != nil && twoFactor.EnabledAt != nil {
+		tokenType = sharedjwt.TwoFactorTokenChallenge
+	} else {
+		required, err := uc.repository.UserRequiresTwoFactor(ctx, userAuth.ID)
+		if err != nil {
+			uc.log.Error().Err(err).Uint("user_id", userAuth.ID).Msg("Error al verificar si el rol exige segundo factor")
+			return nil, fmt.Errorf("error interno del servidor")
+		}
+		if !required {
+			return nil, nil
+		}
+		tokenType = sharedjwt.TwoFactorTokenEnrollment
+	}
+
+	token, err := uc.jwtService.GenerateTwoFactorToken(userAuth.ID, tokenType)
+	if err != nil {
+		uc.log.Error().Err(err).Uint("user_id", userAuth.ID).Msg("Error al generar token de segundo factor")
+		return nil, fmt.Errorf("error interno del servidor")
+	}
+
+	uc.log.Info().
+		Uint("user_id", userAuth.ID).
+		Str("token_type", tokenType).
+		Msg("Contraseña válida; se requiere segundo factor")
+
+	return &domain.LoginResponse{
+		Success: true,
+		User: domain.UserInfo{
+			ID:            userAuth.ID,
+			Name:          userAuth.Name,
+			Email:         userAuth.Email,
+			IsActive:      userAuth.IsActive,
+			EmailVerified: userAuth.EmailVerifiedAt != nil,
+		},
+		TwoFactorRequired:           tokenType == sharedjwt.TwoFactorTokenChallenge,
+		TwoFactorEnrollmentRequired: tokenType == sharedjwt.TwoFactorTokenEnrollment,
+		TwoFactorToken:              token,
+	}, nil
+}
+
+// VerifyTwoFactorLogin completa el login con el token intermedio y el código TOTP o de recuperación
+func (uc *AuthUseCase) VerifyTwoFactorLogin(ctx context.Context, request domain.VerifyTwoFactorLoginRequest) (*domain.LoginResponse, error) {
+	userID, tokenType, err := uc.jwtService.ValidateTwoFactorToken(request.TwoFactorToken)
+	if err != nil || tokenType != sharedjwt.TwoFactorTokenChallenge {
+		return nil, domain.ErrInvalidTwoFactorToken
+	}
+
+	user, err := uc.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener usuario: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		return nil, domain.ErrInvalidTwoFactorToken
+	}
+
+	twoFactor, err := uc.enabledTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.verifyTwoFactorCode(ctx, twoFactor, request.Code); err != nil {
+		uc.recordLoginFailure(ctx, &user.ID, user.Email, request.IPAddress, request.UserAgent, domain.LoginFailedTwoFactor, time.Now())
+		return nil, err
+	}
+
+	return uc.completeLogin(ctx, user, request.UserAgent, request.IPAddress)
+}
@@ -1,75 +1,86 @@
 package usecaseauth
 
-// // ValidateAPIKey valida una API Key contra la base de datos y retorna la información del usuario
-// func (uc *AuthUseCase) ValidateAPIKey(ctx context.Context, request domain.ValidateAPIKeyRequest) (*domain.ValidateAPIKeyResponse, error) {
-// 	uc.log.Debug().Str("api_key", request.APIKey[:10]+"...").Msg("Validando API Key")
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"fmt"
+	"time"
 
-// 	// Validar formato de API Key
-// 	apiKeyService := apikey.NewService()
-// 	if !apiKeyService.IsValidAPIKeyFormat(request.APIKey) {
-// 		uc.log.Warn().Str("api_key", request.APIKey[:10]+"...").Msg("Formato de API Key inválido")
-// 		return nil, errs.New("Formato de API Key inválido")
-// 	}
+	"golang.org/x/crypto/bcrypt"
+)
 
-// 	// Buscar la API Key en la base de datos
-// 	apiKeyEntity, err := uc.repository.ValidateAPIKey(ctx, request.APIKey)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Str("api_key", request.APIKey[:10]+"...").Msg("Error al validar API Key en base de datos")
-// 		return nil, errs.New("API Key inválida o no encontrada")
-// 	}
+// ValidateAPIKey valida una API Key y retorna el usuario, negocio y límites asociados. Busca la key
+// por su prefijo y compara el hash bcrypt; las validaciones se guardan en caché por poco tiempo
+// para no repetir bcrypt en cada request. El último uso se registra en memoria y se persiste por lotes.
+func (uc *AuthUseCase) ValidateAPIKey(ctx context.Context, request domain.ValidateAPIKeyRequest) (*domain.ValidateAPIKeyResponse, error) {
+	prefix, ok := domain.ParseAPIKeyPrefix(request.APIKey)
+	if !ok {
+		return nil, domain.ErrAPIKeyInvalid
+	}
 
-// 	// Verificar que la API Key no esté revocada
-// 	if apiKeyEntity.Revoked {
-// 		uc.log.Warn().Uint("api_key_id", apiKeyEntity.ID).Msg("API Key revocada")
-// 		return nil, errs.New("API Key revocada")
-// 	}
+	digest := apiKeyDigest(request.APIKey)
+	if cached, ok := uc.apiKeys.Get(digest); ok {
+		uc.usage.record(cached.APIKeyID, time.Now())
+		return cached, nil
+	}
 
-// 	// Obtener información del usuario asociado
-// 	userInfo, err := uc.repository.GetUserByID(ctx, apiKeyEntity.UserID)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Uint("user_id", apiKeyEntity.UserID).Msg("Error al obtener información del usuario")
-// 		return nil, errs.New("Error al obtener información del usuario")
-// 	}
+	apiKey, err := uc.repository.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("error al buscar API Key: %w", err)
+	}
+	if apiKey == nil {
+		uc.log.Warn().Str("key_prefix", prefix).Msg("API Key no encontrada")
+		return nil, domain.ErrAPIKeyInvalid
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(apiKey.KeyHash), []byte(request.APIKey)); err != nil {
+		uc.log.Warn().Uint("api_key_id", apiKey.ID).Msg("Secreto de API Key incorrecto")
+		return nil, domain.ErrAPIKeyInvalid
+	}
+	if apiKey.Revoked {
+		uc.log.Warn().Uint("api_key_id", apiKey.ID).Msg("API Key revocada")
+		return nil, domain.ErrAPIKeyRevoked
+	}
 
-// 	// Verificar que el usuario esté activo
-// 	if !userInfo.IsActive {
-// 		uc.log.Warn().Uint("user_id", apiKeyEntity.UserID).Msg("Usuario inactivo")
-// 		return nil, errs.New("Usuario inactivo")
-// 	}
+	user, err := uc.repository.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener usuario de la API Key: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrAPIKeyInvalid
+	}
+	if !user.IsActive {
+		uc.log.Warn().Uint("user_id", apiKey.UserID).Msg("Usuario de la API Key inactivo")
+		return nil, domain.ErrAPIKeyUserInactive
+	}
 
-// 	// Obtener roles del usuario
-// 	userRoles, err := uc.repository.GetUserRoles(ctx, apiKeyEntity.UserID)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Uint("user_id", apiKeyEntity.UserID).Msg("Error al obtener roles del usuario")
-// 		return nil, errs.New("Error al obtener roles del usuario")
-// 	}
+	userRoles, err := uc.repository.GetUserRoles(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener roles del usuario: %w", err)
+	}
+	roleNames := make([]string, 0, len(userRoles))
+	for _, role := range userRoles {
+		roleNames = append(roleNames, role.Name)
+	}
 
-// 	// Convertir roles a strings
-// 	var roleNames []string
-// 	for _, role := range userRoles {
-// 		roleNames = append(roleNames, role.Name)
-// 	}
+	validated := domain.ValidateAPIKeyResponse{
+		Success:     true,
+		Message:     "API Key válida",
+		UserID:      apiKey.UserID,
+		Email:       user.Email,
+		BusinessID:  apiKey.BusinessID,
+		Roles:       roleNames,
+		APIKeyID:    apiKey.ID,
+		RateLimit:   apiKey.RateLimit,
+		IPWhitelist: domain.SplitIPWhitelist(apiKey.IPWhitelist),
+	}
+	uc.apiKeys.Set(digest, validated)
+	uc.usage.record(apiKey.ID, time.Now())
 
-// 	// Actualizar último uso de la API Key
-// 	err = uc.repository.UpdateAPIKeyLastUsed(ctx, apiKeyEntity.ID)
-// 	if err != nil {
-// 		uc.log.Error().Err(err).Uint("api_key_id", apiKeyEntity.ID).Msg("Error al actualizar último uso de API Key")
-// 		// No fallamos la validación por este error, solo lo registramos
-// 	}
+	uc.log.Debug().
+		Uint("api_key_id", apiKey.ID).
+		Uint("user_id", apiKey.UserID).
+		Uint("business_id", apiKey.BusinessID).
+		Msg("API Key validada exitosamente")
 
-// 	uc.log.Info().
-// 		Uint("api_key_id", apiKeyEntity.ID).
-// 		Uint("user_id", apiKeyEntity.UserID).
-// 		Uint("business_id", apiKeyEntity.BusinessID).
-// 		Msg("API Key validada exitosamente")
-
-// 	return &dtos.ValidateAPIKeyResponse{
-// 		Success:    true,
-// 		Message:    "API Key válida",
-// 		UserID:     apiKeyEntity.UserID,
-// 		Email:      userInfo.Email,
-// 		BusinessID: apiKeyEntity.BusinessID,
-// 		Roles:      roleNames,
-// 		APIKeyID:   apiKeyEntity.ID,
-// 	}, nil
-// }
+	return &validated, nil
+}
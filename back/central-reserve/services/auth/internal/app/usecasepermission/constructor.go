@@ -18,14 +18,16 @@ type IUseCasePermission interface {
 }
 
 type PermissionUseCase struct {
-	repository domain.IAuthRepository
-	logger     log.ILogger
+	repository  domain.IAuthRepository
+	permissions domain.IPermissionCache
+	logger      log.ILogger
 }
 
 // NewPermissionUseCase crea una nueva instancia del caso de uso de permisos
-func New(repository domain.IAuthRepository, logger log.ILogger) IUseCasePermission {
+func New(repository domain.IAuthRepository, permissions domain.IPermissionCache, logger log.ILogger) IUseCasePermission {
 	return &PermissionUseCase{
-		repository: repository,
-		logger:     logger,
+		repository:  repository,
+		permissions: permissions,
+		logger:      logger,
 	}
 }
@@ -2,6 +2,7 @@ package usecasepermission
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -55,6 +56,13 @@ func (uc *PermissionUseCase) CreatePermission(ctx context.Context, permissionDTO
 	}
 
 	uc.logger.Info().Str("result", result).Msg("Permiso creado exitosamente")
+
+	audit.Log(ctx, audit.Entry{
+		EntityType: "permission",
+		Action:     audit.ActionCreate,
+		After:      permission,
+	})
+
 	return result, nil
 }
 
@@ -1,6 +1,7 @@
 package usecasepermission
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"errors"
 	"fmt"
@@ -30,11 +31,22 @@ func (uc *PermissionUseCase) DeletePermission(ctx context.Context, id uint) (str
 		return "", err
 	}
 
+	// El permiso puede estar asignado a varios roles: se descarta toda la caché
+	uc.permissions.InvalidateAll()
+
 	uc.logger.Info().
 		Uint("id", id).
 		Str("resource", existingPermission.Resource).
 		Str("action", existingPermission.Action).
 		Str("result", result).
 		Msg("Permiso eliminado exitosamente")
+
+	audit.Log(ctx, audit.Entry{
+		EntityType: "permission",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existingPermission,
+	})
+
 	return result, nil
 }
@@ -2,6 +2,7 @@ package usecasepermission
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"errors"
 	"fmt"
@@ -44,7 +45,19 @@ func (uc *PermissionUseCase) UpdatePermission(ctx context.Context, id uint, perm
 		return "", err
 	}
 
+	// El permiso puede estar asignado a varios roles: se descarta toda la caché
+	uc.permissions.InvalidateAll()
+
 	uc.logger.Info().Uint("id", id).Str("result", result).Msg("Permiso actualizado exitosamente")
+
+	audit.Log(ctx, audit.Entry{
+		EntityType: "permission",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existingPermission,
+		After:      updatedPermission,
+	})
+
 	return result, nil
 }
 
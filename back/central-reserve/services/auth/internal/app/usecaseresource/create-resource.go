@@ -2,6 +2,7 @@ package usecaseresource
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -65,6 +66,13 @@ func (uc *ResourceUseCase) CreateResource(ctx context.Context, createDTO domain.
 		Str("name", createDTO.Name).
 		Msg("Recurso creado exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "resource",
+		EntityID:   createdResource.ID,
+		Action:     audit.ActionCreate,
+		After:      createdResource,
+	})
+
 	return resourceDTO, nil
 }
 
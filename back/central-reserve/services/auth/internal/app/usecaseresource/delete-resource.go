@@ -1,6 +1,7 @@
 package usecaseresource
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -38,5 +39,12 @@ func (uc *ResourceUseCase) DeleteResource(ctx context.Context, id uint) (string,
 		Str("resource_name", existingResource.Name).
 		Msg("Recurso eliminado exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "resource",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existingResource,
+	})
+
 	return message, nil
 }
@@ -2,6 +2,7 @@ package usecaseresource
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -81,6 +82,14 @@ func (uc *ResourceUseCase) UpdateResource(ctx context.Context, id uint, updateDT
 		Str("name", updateDTO.Name).
 		Msg("Recurso actualizado exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "resource",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existingResource,
+		After:      updatedResource,
+	})
+
 	return resourceDTO, nil
 }
 
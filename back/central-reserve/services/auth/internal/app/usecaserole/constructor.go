@@ -2,9 +2,11 @@ package usecaserole
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 	"context"
 	"fmt"
+	"sort"
 )
 
 type IUseCaseRole interface {
@@ -22,18 +24,35 @@ type IUseCaseRole interface {
 
 // RoleUseCase implementa los casos de uso para roles
 type RoleUseCase struct {
-	repository domain.IAuthRepository
-	log        log.ILogger
+	repository  domain.IAuthRepository
+	permissions domain.IPermissionCache
+	log         log.ILogger
 }
 
 // NewRoleUseCase crea una nueva instancia del caso de uso de roles
-func New(repository domain.IAuthRepository, log log.ILogger) IUseCaseRole {
+func New(repository domain.IAuthRepository, permissions domain.IPermissionCache, log log.ILogger) IUseCaseRole {
 	return &RoleUseCase{
-		repository: repository,
-		log:        log,
+		repository:  repository,
+		permissions: permissions,
+		log:         log,
 	}
 }
 
+// rolePermissionCodes obtiene los códigos de permiso del rol para la auditoría
+func (uc *RoleUseCase) rolePermissionCodes(ctx context.Context, roleID uint) map[string]any {
+	permissions, err := uc.repository.GetRolePermissions(ctx, roleID)
+	if err != nil {
+		uc.log.Warn().Err(err).Uint("role_id", roleID).Msg("No se pudieron obtener los permisos del rol para la auditoría")
+		return nil
+	}
+	codes := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		codes = append(codes, permission.Code)
+	}
+	sort.Strings(codes)
+	return map[string]any{"permissions": codes}
+}
+
 // CreateRole crea un nuevo rol
 func (uc *RoleUseCase) CreateRole(ctx context.Context, roleDTO domain.CreateRoleDTO) (*domain.Role, error) {
 	uc.log.Info().
@@ -52,6 +71,13 @@ func (uc *RoleUseCase) CreateRole(ctx context.Context, roleDTO domain.CreateRole
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "role",
+		EntityID:   role.ID,
+		Action:     audit.ActionCreate,
+		After:      role,
+	})
+
 	uc.log.Info().
 		Uint("role_id", role.ID).
 		Str("name", role.Name).
@@ -113,6 +139,14 @@ func (uc *RoleUseCase) UpdateRole(ctx context.Context, id uint, roleDTO domain.U
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "role",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existingRole,
+		After:      role,
+	})
+
 	uc.log.Info().
 		Uint("role_id", role.ID).
 		Str("name", role.Name).
@@ -1,6 +1,7 @@
 package usecaserole
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -29,6 +30,8 @@ func (uc *RoleUseCase) RemovePermissionFromRole(ctx context.Context, roleID uint
 		return fmt.Errorf("rol no encontrado")
 	}
 
+	before := uc.rolePermissionCodes(ctx, roleID)
+
 	// Eliminar permiso usando el repositorio
 	err = uc.repository.RemovePermissionFromRole(ctx, roleID, permissionID)
 	if err != nil {
@@ -40,6 +43,17 @@ func (uc *RoleUseCase) RemovePermissionFromRole(ctx context.Context, roleID uint
 		return err
 	}
 
+	// Los permisos en caché del rol quedaron desactualizados
+	uc.permissions.InvalidateRole(roleID)
+
+	audit.Log(ctx, audit.Entry{
+		EntityType: "role_permissions",
+		EntityID:   roleID,
+		Action:     audit.ActionRevoke,
+		Before:     before,
+		After:      uc.rolePermissionCodes(ctx, roleID),
+	})
+
 	uc.log.Info().
 		Uint("role_id", roleID).
 		Uint("permission_id", permissionID).
@@ -2,6 +2,7 @@ package usecaseuser
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -34,6 +35,16 @@ func (uc *UserUseCase) AssignRoleToUserBusiness(ctx context.Context, userID uint
 		return fmt.Errorf("error al asignar roles: %w", err)
 	}
 
+	for _, assignment := range assignments {
+		audit.Log(ctx, audit.Entry{
+			BusinessID: assignment.BusinessID,
+			EntityType: "user_role",
+			EntityID:   userID,
+			Action:     audit.ActionAssign,
+			After:      map[string]any{"role_id": assignment.RoleID},
+		})
+	}
+
 	uc.log.Info().
 		Uint("user_id", userID).
 		Int("assignments_count", len(assignments)).
@@ -15,6 +15,9 @@ type IUseCaseUser interface {
 	UpdateUser(ctx context.Context, id uint, user domain.UpdateUserDTO) (string, error)
 	DeleteUser(ctx context.Context, id uint) (string, error)
 	AssignRoleToUserBusiness(ctx context.Context, userID uint, assignments []domain.BusinessRoleAssignment) error
+	RevokeUserSessions(ctx context.Context, request domain.RevokeUserSessionsRequest) (int64, error)
+	ResetUserTwoFactor(ctx context.Context, request domain.ResetUserTwoFactorRequest) error
+	UnlockUser(ctx context.Context, request domain.UnlockUserRequest) error
 }
 
 // UserUseCase implementa los casos de uso para usuarios
@@ -2,6 +2,7 @@ package usecaseuser
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"crypto/rand"
 	"fmt"
@@ -117,6 +118,19 @@ func (uc *UserUseCase) CreateUser(ctx context.Context, userDTO domain.CreateUser
 		uc.log.Info().Uint("user_id", userID).Int("businesses_count", len(userDTO.BusinessIDs)).Msg("Businesses asignados exitosamente")
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "user",
+		EntityID:   userID,
+		Action:     audit.ActionCreate,
+		After: map[string]any{
+			"name":         user.Name,
+			"email":        user.Email,
+			"phone":        user.Phone,
+			"is_active":    user.IsActive,
+			"business_ids": userDTO.BusinessIDs,
+		},
+	})
+
 	message := fmt.Sprintf("Usuario creado con ID: %d", userID)
 	uc.log.Info().
 		Uint("user_id", userID).
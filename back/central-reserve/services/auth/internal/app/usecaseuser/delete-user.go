@@ -1,6 +1,7 @@
 package usecaseuser
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -23,6 +24,13 @@ func (uc *UserUseCase) DeleteUser(ctx context.Context, id uint) (string, error)
 		return "", err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "user",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existingUser,
+	})
+
 	uc.log.Info().Uint("user_id", id).Msg("Usuario eliminado exitosamente")
 	return message, nil
 }
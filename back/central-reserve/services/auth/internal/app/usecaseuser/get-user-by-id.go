@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // GetUserByID obtiene un usuario por su ID
@@ -64,6 +65,9 @@ func (uc *UserUseCase) GetUserByID(ctx context.Context, id uint) (*domain.UserDT
 		UpdatedAt:   user.UpdatedAt,
 		DeletedAt:   user.DeletedAt,
 	}
+	if user.LockedUntil != nil && time.Now().Before(*user.LockedUntil) {
+		userDTO.LockedUntil = user.LockedUntil
+	}
 
 	// Obtener roles del usuario
 	roles, err := uc.repository.GetUserRoles(ctx, user.ID)
@@ -0,0 +1,49 @@
+package usecaseuser
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+)
+
+// ResetUserTwoFactor elimina el segundo factor y los códigos de recuperación de un usuario que perdió
+// su dispositivo, y cierra sus sesiones. En el siguiente login vuelve a inscribirse si su rol lo exige.
+// Un administrador de negocio solo puede hacerlo con usuarios de su negocio.
+func (uc *UserUseCase) ResetUserTwoFactor(ctx context.Context, request domain.ResetUserTwoFactorRequest) error {
+	uc.log.Info().
+		Uint("user_id", request.UserID).
+		Uint("business_id", request.BusinessID).
+		Uint("requester_id", request.RequesterID).
+		Msg("Iniciando caso de uso: restablecer segundo factor de usuario")
+
+	if err := uc.ensureUserInBusiness(ctx, request.UserID, request.BusinessID); err != nil {
+		return err
+	}
+
+	deleted, err := uc.repository.DeleteTwoFactor(ctx, request.UserID)
+	if err != nil {
+		uc.log.Error().Err(err).Uint("user_id", request.UserID).Msg("Error al eliminar segundo factor desde el repositorio")
+		return err
+	}
+	if !deleted {
+		return domain.ErrTwoFactorNotEnrolled
+	}
+
+	if _, err := uc.repository.RevokeUserSessions(ctx, request.UserID, domain.SessionRevokedTwoFactor); err != nil {
+		uc.log.Error().Err(err).Uint("user_id", request.UserID).Msg("Error al revocar sesiones tras restablecer segundo factor")
+		return err
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: request.BusinessID,
+		EntityType: "user_two_factor",
+		EntityID:   request.UserID,
+		Action:     audit.ActionDelete,
+	})
+
+	uc.log.Info().
+		Uint("user_id", request.UserID).
+		Uint("requester_id", request.RequesterID).
+		Msg("Segundo factor de usuario restablecido exitosamente")
+	return nil
+}
@@ -0,0 +1,65 @@
+package usecaseuser
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+)
+
+// RevokeUserSessions cierra todas las sesiones de un usuario. Sus tokens dejan de aceptarse en la
+// siguiente request y debe volver a iniciar sesión. Un administrador de negocio solo puede revocar
+// las sesiones de usuarios de su negocio.
+func (uc *UserUseCase) RevokeUserSessions(ctx context.Context, request domain.RevokeUserSessionsRequest) (int64, error) {
+	uc.log.Info().
+		Uint("user_id", request.UserID).
+		Uint("business_id", request.BusinessID).
+		Uint("requester_id", request.RequesterID).
+		Msg("Iniciando caso de uso: revocar sesiones de usuario")
+
+	if err := uc.ensureUserInBusiness(ctx, request.UserID, request.BusinessID); err != nil {
+		return 0, err
+	}
+
+	revoked, err := uc.repository.RevokeUserSessions(ctx, request.UserID, domain.SessionRevokedAdmin)
+	if err != nil {
+		uc.log.Error().Err(err).Uint("user_id", request.UserID).Msg("Error al revocar sesiones desde el repositorio")
+		return 0, err
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: request.BusinessID,
+		EntityType: "user_sessions",
+		EntityID:   request.UserID,
+		Action:     audit.ActionRevoke,
+		After:      map[string]any{"revoked": revoked},
+	})
+
+	uc.log.Info().
+		Uint("user_id", request.UserID).
+		Uint("requester_id", request.RequesterID).
+		Int64("revoked", revoked).
+		Msg("Sesiones de usuario revocadas exitosamente")
+	return revoked, nil
+}
+
+// ensureUserInBusiness verifica que el usuario existe y, si businessID no es 0, que pertenece al
+// negocio. En ambos casos responde "usuario no encontrado" para no revelar usuarios de otros negocios.
+func (uc *UserUseCase) ensureUserInBusiness(ctx context.Context, userID, businessID uint) error {
+	existingUser, err := uc.repository.GetUserByID(ctx, userID)
+	if err != nil || existingUser == nil {
+		uc.log.Error().Uint("user_id", userID).Msg("Usuario no encontrado")
+		return fmt.Errorf("usuario no encontrado")
+	}
+	if businessID != 0 {
+		relation, err := uc.repository.GetBusinessStaffRelation(ctx, userID, &businessID)
+		if err != nil || relation == nil {
+			uc.log.Error().
+				Uint("user_id", userID).
+				Uint("business_id", businessID).
+				Msg("El usuario no pertenece al negocio")
+			return fmt.Errorf("usuario no encontrado")
+		}
+	}
+	return nil
+}
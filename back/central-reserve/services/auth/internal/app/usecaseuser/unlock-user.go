@@ -0,0 +1,40 @@
+package usecaseuser
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+)
+
+// UnlockUser quita el bloqueo por intentos fallidos de la cuenta de un usuario y reinicia su conteo.
+// Un administrador de negocio solo puede hacerlo con usuarios de su negocio.
+func (uc *UserUseCase) UnlockUser(ctx context.Context, request domain.UnlockUserRequest) error {
+	uc.log.Info().
+		Uint("user_id", request.UserID).
+		Uint("business_id", request.BusinessID).
+		Uint("requester_id", request.RequesterID).
+		Msg("Iniciando caso de uso: desbloquear usuario")
+
+	if err := uc.ensureUserInBusiness(ctx, request.UserID, request.BusinessID); err != nil {
+		return err
+	}
+
+	if err := uc.repository.UnlockUser(ctx, request.UserID); err != nil {
+		uc.log.Error().Err(err).Uint("user_id", request.UserID).Msg("Error al desbloquear usuario desde el repositorio")
+		return err
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: request.BusinessID,
+		EntityType: "user",
+		EntityID:   request.UserID,
+		Action:     audit.ActionUpdate,
+		After:      map[string]any{"locked": false},
+	})
+
+	uc.log.Info().
+		Uint("user_id", request.UserID).
+		Uint("requester_id", request.RequesterID).
+		Msg("Usuario desbloqueado exitosamente")
+	return nil
+}
@@ -2,6 +2,7 @@ package usecaseuser
 
 import (
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -103,6 +104,16 @@ func (uc *UserUseCase) UpdateUser(ctx context.Context, id uint, userDTO domain.U
 		uc.log.Info().Uint("user_id", id).Int("businesses_count", len(userDTO.BusinessIDs)).Msg("Businesses actualizados exitosamente")
 	}
 
+	if updatedUser, err := uc.repository.GetUserByID(ctx, id); err == nil && updatedUser != nil {
+		audit.Log(ctx, audit.Entry{
+			EntityType: "user",
+			EntityID:   id,
+			Action:     audit.ActionUpdate,
+			Before:     existingUser,
+			After:      updatedUser,
+		})
+	}
+
 	uc.log.Info().Uint("user_id", id).Msg("Usuario actualizado exitosamente")
 	return message, nil
 }
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Propósitos de los tokens de cuenta
+const (
+	AccountTokenPasswordReset     = "password_reset"
+	AccountTokenEmailVerification = "email_verification"
+	AccountTokenSSOLink           = "sso_link"
+)
+
+const (
+	// PasswordResetTokenTTL es la vigencia del enlace para restablecer la contraseña
+	PasswordResetTokenTTL = time.Hour
+	// EmailVerificationTokenTTL es la vigencia del enlace para verificar el email
+	EmailVerificationTokenTTL = 48 * time.Hour
+	// SSOLinkTokenTTL es la vigencia del enlace para confirmar el vínculo con el proveedor SSO
+	SSOLinkTokenTTL = 24 * time.Hour
+	// AccountTokenBytes son los bytes aleatorios de los tokens de cuenta
+	AccountTokenBytes = 32
+
+	// AccountThrottleWindow es la ventana de los límites de solicitudes públicas de cuenta
+	AccountThrottleWindow = time.Hour
+	// AccountEmailThrottleLimit son los emails que se envían como máximo a una dirección por ventana
+	AccountEmailThrottleLimit = 3
+	// AccountIPThrottleLimit son las solicitudes públicas de cuenta que acepta una IP por ventana
+	AccountIPThrottleLimit = 20
+	// AccountEmailMaxAttempts son los intentos de entrega de un email de cuenta en el outbox
+	AccountEmailMaxAttempts = 6
+	// AccountEmailPending es el estado del outbox con que se encola un email de cuenta
+	AccountEmailPending = "pending"
+)
+
+var (
+	ErrInvalidAccountToken     = errors.New("el enlace es inválido o ya venció")
+	ErrAccountRequestThrottled = errors.New("demasiadas solicitudes, intenta más tarde")
+	ErrWeakPassword            = errors.New("la contraseña debe tener al menos 8 caracteres")
+)
+
+// MinPasswordLength es el largo mínimo de una contraseña elegida por el usuario
+const MinPasswordLength = 8
+
+// AccountToken es un token de un solo uso enviado por email (solo se guarda su hash). IdentityID
+// es la identidad SSO que confirma un token sso_link.
+type AccountToken struct {
+	ID         uint
+	UserID     uint
+	Purpose    string
+	TokenHash  string
+	Email      string
+	IdentityID *uint
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
+}
+
+// AccountEmail es un email de cuenta que se encola en el outbox de notificaciones; el worker de
+// envío lo entrega y lo reintenta si falla
+type AccountEmail struct {
+	UserID    uint
+	Purpose   string
+	Recipient string
+	Subject   string
+	HTMLBody  string
+	TextBody  string
+}
+
+// AccountEmailRequest solicita el envío de un enlace de cuenta a un email
+type AccountEmailRequest struct {
+	Email     string
+	IPAddress string
+}
+
+// ResetPasswordRequest restablece la contraseña con el token recibido por email
+type ResetPasswordRequest struct {
+	Token       string
+	NewPassword string
+	IPAddress   string
+}
+
+// VerifyEmailRequest confirma el email con el token recibido por email
+type VerifyEmailRequest struct {
+	Token     string
+	IPAddress string
+}
+
+// ConfirmSSOLinkRequest confirma el vínculo con el proveedor SSO con el token recibido por email
+type ConfirmSSOLinkRequest struct {
+	Token     string
+	IPAddress string
+}
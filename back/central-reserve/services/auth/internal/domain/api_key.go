@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"time"
+)
+
+// Formato de las API Keys: crk_<prefijo>_<secreto>. El prefijo es público y sirve para buscar la
+// key; solo se guarda el hash bcrypt de la key completa, que se muestra una única vez.
+const (
+	APIKeyPrefix       = "crk_"
+	APIKeyLookupBytes  = 6  // bytes aleatorios del prefijo (12 caracteres hex)
+	APIKeySecretBytes  = 32 // bytes aleatorios del secreto
+	APIKeySeparator    = "_"
+	apiKeyLookupLength = APIKeyLookupBytes * 2
+)
+
+const (
+	// DefaultAPIKeyRateLimit es el límite de requests por hora si no se indica otro
+	DefaultAPIKeyRateLimit = 1000
+	// MaxAPIKeyRateLimit acota el límite configurable por key
+	MaxAPIKeyRateLimit = 100000
+	// MaxAPIKeyIPWhitelistLength es el tamaño de la columna ip_whitelist
+	MaxAPIKeyIPWhitelistLength = 1000
+	// APIKeyRateLimitWindow es la ventana del límite de requests. El conteo se guarda en base de datos,
+	// por lo que el límite se comparte entre instancias
+	APIKeyRateLimitWindow = time.Hour
+	// APIKeyValidationCacheTTL evita repetir bcrypt en cada request. Revocar o rotar invalida la caché
+	// de esta instancia; en las demás la validación vence tras este tiempo
+	APIKeyValidationCacheTTL = time.Minute
+	// APIKeyUsageFlushInterval es cada cuánto se persiste last_used_at de las keys usadas
+	APIKeyUsageFlushInterval = time.Minute
+)
+
+// APIKeyRateLimitStatus es el resultado de contar una request contra el límite de la key
+type APIKeyRateLimitStatus struct {
+	Allowed   bool
+	Remaining int
+	Reset     time.Time // Fin de la ventana actual
+}
+
+var (
+	ErrAPIKeyInvalid            = errors.New("API Key inválida")
+	ErrAPIKeyRevoked            = errors.New("API Key revocada")
+	ErrAPIKeyNotFound           = errors.New("API Key no encontrada")
+	ErrAPIKeyUserNotFound       = errors.New("usuario no encontrado")
+	ErrAPIKeyUserInactive       = errors.New("usuario inactivo")
+	ErrAPIKeyUserNotInBusiness  = errors.New("el usuario no pertenece al negocio")
+	ErrAPIKeyInvalidIPWhitelist = errors.New("lista de IPs permitidas inválida")
+	ErrAPIKeyInvalidRateLimit   = errors.New("límite de requests inválido")
+	ErrAPIKeyBusinessRequired   = errors.New("se requiere business_id")
+)
+
+// ParseAPIKeyPrefix extrae el prefijo de búsqueda (crk_<prefijo>) de una API Key completa
+func ParseAPIKeyPrefix(apiKey string) (string, bool) {
+	if !strings.HasPrefix(apiKey, APIKeyPrefix) {
+		return "", false
+	}
+	rest := strings.TrimPrefix(apiKey, APIKeyPrefix)
+	lookup, secret, found := strings.Cut(rest, APIKeySeparator)
+	if !found || len(lookup) != apiKeyLookupLength || secret == "" {
+		return "", false
+	}
+	return APIKeyPrefix + lookup, true
+}
+
+// ParseIPWhitelist normaliza una lista de IPs o rangos CIDR. Una lista vacía permite cualquier IP.
+func ParseIPWhitelist(entries []string) ([]string, error) {
+	normalized := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, ErrAPIKeyInvalidIPWhitelist
+			}
+			normalized = append(normalized, network.String())
+			continue
+		}
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return nil, ErrAPIKeyInvalidIPWhitelist
+		}
+		normalized = append(normalized, ip.String())
+	}
+	return normalized, nil
+}
+
+// SplitIPWhitelist separa la lista guardada en base de datos (separada por comas)
+func SplitIPWhitelist(stored string) []string {
+	var entries []string
+	for _, entry := range strings.Split(stored, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// IPAllowed indica si la IP está en la lista de IPs o rangos permitidos. Una lista vacía permite cualquier IP.
+func IPAllowed(whitelist []string, clientIP string) bool {
+	if len(whitelist) == 0 {
+		return true
+	}
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+	for _, entry := range whitelist {
+		if strings.Contains(entry, "/") {
+			if _, network, err := net.ParseCIDR(entry); err == nil && network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if allowed := net.ParseIP(entry); allowed != nil && allowed.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// IAPIKeyCache guarda las validaciones recientes de API Keys, indexadas por el hash SHA-256 de la key
+type IAPIKeyCache interface {
+	Get(keyDigest string) (*ValidateAPIKeyResponse, bool)
+	Set(keyDigest string, validated ValidateAPIKeyResponse)
+	Invalidate(apiKeyID uint)
+}
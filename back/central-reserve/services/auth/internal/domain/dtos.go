@@ -6,8 +6,10 @@ import (
 )
 
 type LoginRequest struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	UserAgent string
+	IPAddress string
 }
 
 type LoginResponse struct {
@@ -15,34 +17,48 @@ type LoginResponse struct {
 	Message               string
 	User                  UserInfo
 	Token                 string
+	TokenExpiresAt        time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
 	RequirePasswordChange bool
 	Businesses            []BusinessInfo
 	Scope                 string // Scope del usuario (platform, business, etc.)
 	IsSuperAdmin          bool   // Indica si es super admin (scope platform o scope_id 1)
+
+	// Login en dos pasos: sin Token, el cliente debe completar el segundo factor con TwoFactorToken
+	TwoFactorRequired           bool   // Enviar el código TOTP o de recuperación
+	TwoFactorEnrollmentRequired bool   // El rol exige 2FA: configurarlo antes de entrar
+	TwoFactorToken              string // Token intermedio de corta duración
 }
 
 type UserInfo struct {
-	ID          uint
-	Name        string
-	Email       string
-	Phone       string
-	AvatarURL   string
-	IsActive    bool
-	LastLoginAt *time.Time
+	ID            uint
+	Name          string
+	Email         string
+	Phone         string
+	AvatarURL     string
+	IsActive      bool
+	LastLoginAt   *time.Time
+	EmailVerified bool // Indica si el usuario confirmó su email
 }
 
 type UserAuthInfo struct {
-	ID          uint
-	Name        string
-	Email       string
-	Password    string
-	Phone       string
-	AvatarURL   string
-	IsActive    bool
-	LastLoginAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   *time.Time
+	ID              uint
+	Name            string
+	Email           string
+	Password        string
+	Phone           string
+	AvatarURL       string
+	IsActive        bool
+	LastLoginAt     *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+	EmailVerifiedAt *time.Time
+
+	FailedLoginCount  int
+	LastFailedLoginAt *time.Time
+	LockedUntil       *time.Time
 }
 
 type BusinessInfo struct {
@@ -137,6 +153,16 @@ type GenerateAPIKeyRequest struct {
 	BusinessID  uint
 	Name        string
 	Description string
+	RateLimit   *int     // nil usa DefaultAPIKeyRateLimit
+	IPWhitelist []string // IPs o rangos CIDR; vacía permite cualquier IP
+	RequesterID uint
+}
+
+// RotateAPIKeyRequest reemplaza una API Key por otra con la misma configuración.
+// BusinessID limita la key al negocio del solicitante; 0 para super admin.
+type RotateAPIKeyRequest struct {
+	APIKeyID    uint
+	BusinessID  uint
 	RequesterID uint
 }
 
@@ -152,13 +178,15 @@ type ValidateAPIKeyRequest struct {
 }
 
 type ValidateAPIKeyResponse struct {
-	Success    bool
-	Message    string
-	UserID     uint
-	Email      string
-	BusinessID uint
-	Roles      []string
-	APIKeyID   uint
+	Success     bool
+	Message     string
+	UserID      uint
+	Email       string
+	BusinessID  uint
+	Roles       []string
+	APIKeyID    uint
+	RateLimit   int      // Requests por hora permitidos
+	IPWhitelist []string // IPs o rangos CIDR permitidos; vacía permite cualquier IP
 }
 
 // RoleDTO representa un rol para casos de uso
@@ -169,6 +197,7 @@ type RoleDTO struct {
 	Description      string
 	Level            int
 	IsSystem         bool
+	RequireTwoFactor bool // Los usuarios con este rol deben usar 2FA
 	ScopeID          uint
 	ScopeName        string // Nombre del scope para mostrar
 	ScopeCode        string // Código del scope para mostrar
@@ -187,22 +216,24 @@ type RoleFilters struct {
 
 // CreateRoleDTO representa los datos para crear un nuevo rol
 type CreateRoleDTO struct {
-	Name           string
-	Description    string
-	Level          int
-	IsSystem       bool
-	ScopeID        uint
-	BusinessTypeID uint
+	Name             string
+	Description      string
+	Level            int
+	IsSystem         bool
+	RequireTwoFactor bool
+	ScopeID          uint
+	BusinessTypeID   uint
 }
 
 // UpdateRoleDTO representa los datos para actualizar un rol existente
 type UpdateRoleDTO struct {
-	Name           *string
-	Description    *string
-	Level          *int
-	IsSystem       *bool
-	ScopeID        *uint
-	BusinessTypeID *uint
+	Name             *string
+	Description      *string
+	Level            *int
+	IsSystem         *bool
+	RequireTwoFactor *bool
+	ScopeID          *uint
+	BusinessTypeID   *uint
 }
 
 // PermissionDTO representa un permiso para casos de uso
@@ -302,6 +333,7 @@ type UserDTO struct {
 	AvatarURL               string
 	IsActive                bool
 	LastLoginAt             *time.Time
+	LockedUntil             *time.Time                       // Bloqueo por intentos fallidos; nil si no está bloqueado
 	IsSuperUser             bool                             // Indica si es super usuario (scope platform)
 	BusinessRoleAssignments []BusinessRoleAssignmentDetailed // Parejas business-rol con información completa
 	Roles                   []RoleDTO                        // Mantener por compatibilidad
@@ -409,6 +441,7 @@ type UserFilters struct {
 
 type JWTClaims struct {
 	UserID    uint
+	SessionID uint
 	TokenType string
 }
 
@@ -418,6 +451,7 @@ type BusinessTokenClaims struct {
 	BusinessID     uint
 	BusinessTypeID uint
 	RoleID         uint
+	SessionID      uint
 	TokenType      string
 }
 
@@ -462,6 +496,7 @@ type Role struct {
 	Description      string
 	Level            int
 	IsSystem         bool
+	RequireTwoFactor bool // Los usuarios con este rol deben usar 2FA
 	ScopeID          uint
 	ScopeName        string
 	ScopeCode        string
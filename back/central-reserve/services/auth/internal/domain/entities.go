@@ -107,6 +107,7 @@ type APIKey struct {
 	CreatedByID uint       `json:"created_by_id"`
 	Name        string     `json:"name"`
 	Description string     `json:"description"`
+	KeyPrefix   string     `json:"key_prefix"`
 	KeyHash     string     `json:"-"` // No se serializa en JSON por seguridad
 	LastUsedAt  *time.Time `json:"last_used_at"`
 	Revoked     bool       `json:"revoked"`
@@ -122,11 +123,15 @@ type APIKeyInfo struct {
 	ID          uint
 	UserID      uint
 	BusinessID  uint
+	CreatedByID uint
 	Name        string
 	Description string
+	KeyPrefix   string
 	LastUsedAt  *time.Time
 	Revoked     bool
+	RevokedAt   *time.Time
 	RateLimit   int
+	IPWhitelist []string
 	CreatedAt   time.Time
 }
 
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Funcionalidades que dependen de los flags del negocio y no de un recurso configurado
+const (
+	FeatureReservations = "reservations"
+	FeatureDelivery     = "delivery"
+	FeaturePickup       = "pickup"
+)
+
+// FeatureCacheTTL acota cuánto puede quedar desactualizado el conjunto de funcionalidades de un
+// negocio en otra instancia; en la propia se invalida al activar o desactivar un recurso
+const FeatureCacheTTL = 5 * time.Minute
+
+// FeatureSet son los módulos habilitados de un negocio, indexados por nombre en minúsculas
+type FeatureSet map[string]bool
+
+// FeatureKey normaliza el nombre de un módulo a la clave del conjunto
+func FeatureKey(feature string) string {
+	return strings.ToLower(strings.TrimSpace(feature))
+}
+
+// Enabled indica si el módulo está habilitado
+func (s FeatureSet) Enabled(feature string) bool {
+	return s[FeatureKey(feature)]
+}
+
+// Names retorna los módulos habilitados ordenados por nombre
+func (s FeatureSet) Names() []string {
+	names := make([]string, 0, len(s))
+	for name, enabled := range s {
+		if enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IFeatureCache guarda las funcionalidades efectivas por negocio
+type IFeatureCache interface {
+	Get(businessID uint) (FeatureSet, bool)
+	Set(businessID uint, features FeatureSet)
+	Invalidate(businessID uint)
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ActionManage es la acción que concede todas las demás sobre un recurso
+const ActionManage = "manage"
+
+// PermissionCacheTTL acota cuánto puede quedar desactualizado un conjunto de permisos en caché
+// cuando cambia algo que no lo invalida explícitamente (recursos activos del negocio, otra instancia)
+const PermissionCacheTTL = 5 * time.Minute
+
+// PermissionSet son los permisos efectivos de un rol en un negocio, indexados por "recurso:acción"
+// en minúsculas
+type PermissionSet map[string]bool
+
+// PermissionKey normaliza un par recurso/acción a la clave del conjunto
+func PermissionKey(resource, action string) string {
+	return strings.ToLower(strings.TrimSpace(resource)) + ":" + strings.ToLower(strings.TrimSpace(action))
+}
+
+// Allows indica si el conjunto concede la acción sobre el recurso
+func (s PermissionSet) Allows(resource, action string) bool {
+	return s[PermissionKey(resource, action)] || s[PermissionKey(resource, ActionManage)]
+}
+
+// IPermissionCache guarda los permisos efectivos por rol y negocio
+type IPermissionCache interface {
+	Get(roleID, businessID uint) (PermissionSet, bool)
+	Set(roleID, businessID uint, permissions PermissionSet)
+	InvalidateRole(roleID uint)
+	InvalidateBusiness(businessID uint)
+	InvalidateAll()
+}
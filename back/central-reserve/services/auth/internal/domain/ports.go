@@ -4,17 +4,21 @@ import (
 	"context"
 	"io"
 	"mime/multipart"
+	"time"
 )
 
 // IJWTService define las operaciones de JWT
 type IJWTService interface {
-	GenerateToken(userID uint) (string, error)
+	GenerateToken(userID, sessionID uint) (string, error)
 	ValidateToken(tokenString string) (*JWTClaims, error)
-	RefreshToken(tokenString string) (string, error)
 
 	// Tokens para business
-	GenerateBusinessToken(userID, businessID, businessTypeID, roleID uint) (string, error)
+	GenerateBusinessToken(userID, businessID, businessTypeID, roleID, sessionID uint) (string, error)
 	ValidateBusinessToken(tokenString string) (*BusinessTokenClaims, error)
+
+	// Token intermedio del login con segundo factor
+	GenerateTwoFactorToken(userID uint, tokenType string) (string, error)
+	ValidateTwoFactorToken(tokenString string) (uint, string, error)
 }
 
 // IAuthService define las operaciones de autenticación (métodos de repositorio)
@@ -24,7 +28,7 @@ type IAuthService interface {
 	GetUserRoles(ctx context.Context, userID uint) ([]Role, error)
 	GetRolePermissions(ctx context.Context, roleID uint) ([]Permission, error)
 	ValidatePassword(hashedPassword, password string) error
-	GenerateToken(userID uint) (string, error)
+	GenerateToken(userID, sessionID uint) (string, error)
 	UpdateLastLogin(ctx context.Context, userID uint) error
 }
 
@@ -40,12 +44,56 @@ type IAuthRepository interface {
 	GetUserRoleByBusiness(ctx context.Context, userID uint, businessID uint) (*Role, error)
 	GetUserRoleIDFromBusinessStaff(ctx context.Context, userID uint, businessID *uint) (*uint, error)
 	GetBusinessStaffRelation(ctx context.Context, userID uint, businessID *uint) (*BusinessStaffRelation, error)
-	CreateAPIKey(ctx context.Context, apiKey APIKey, keyHash string) (uint, error)
-	ValidateAPIKey(ctx context.Context, apiKey string) (*APIKey, error)
-	UpdateAPIKeyLastUsed(ctx context.Context, apiKeyID uint) error
+	CreateAPIKey(ctx context.Context, apiKey APIKey) (uint, error)
+	GetAPIKeyByPrefix(ctx context.Context, keyPrefix string) (*APIKey, error)
+	GetAPIKeyByID(ctx context.Context, apiKeyID uint) (*APIKey, error)
+	UpdateAPIKeysLastUsed(ctx context.Context, lastUsed map[uint]time.Time) error
+	IncrementAPIKeyRequests(ctx context.Context, apiKeyID uint, windowStart time.Time, limit int) (int, bool, error)
+	DeleteAPIKeyRateWindowsBefore(ctx context.Context, before time.Time) (int64, error)
 	GetAPIKeysByUser(ctx context.Context, userID uint) ([]APIKeyInfo, error)
+	GetAPIKeysByBusiness(ctx context.Context, businessID uint) ([]APIKeyInfo, error)
 	RevokeAPIKey(ctx context.Context, apiKeyID uint) error
+	RotateAPIKey(ctx context.Context, oldAPIKeyID uint, replacement APIKey) (uint, error)
+	CreateSession(ctx context.Context, session Session, refreshToken RefreshTokenRecord) (uint, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshTokenRecord, error)
+	RotateRefreshToken(ctx context.Context, usedTokenID uint, replacement RefreshTokenRecord) (bool, error)
+	RevokeSession(ctx context.Context, sessionID uint, reason string) error
+	RevokeUserSessions(ctx context.Context, userID uint, reason string) (int64, error)
+	IsSessionActive(ctx context.Context, sessionID uint) (bool, error)
+	CreateAccountToken(ctx context.Context, token AccountToken) error
+	GetAccountToken(ctx context.Context, tokenHash string, purpose string) (*AccountToken, error)
+	EnqueueAccountEmail(ctx context.Context, email AccountEmail) (uint, error)
+	ResetPasswordWithToken(ctx context.Context, tokenID uint, userID uint, newPassword string) (bool, error)
+	VerifyEmailWithToken(ctx context.Context, tokenID uint, userID uint) (bool, error)
+	ConfirmUserIdentityWithToken(ctx context.Context, tokenID uint, identityID uint, userID uint) (*UserIdentity, error)
+	GetTwoFactor(ctx context.Context, userID uint) (*TwoFactor, error)
+	SaveTwoFactorSecret(ctx context.Context, userID uint, sealedSecret string) error
+	EnableTwoFactor(ctx context.Context, userID uint, step int64, recoveryCodeHashes []string) error
+	UpdateTwoFactorStep(ctx context.Context, userID uint, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error)
+	ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error
+	CountRecoveryCodes(ctx context.Context, userID uint) (int64, error)
+	DeleteTwoFactor(ctx context.Context, userID uint) (bool, error)
+	UserRequiresTwoFactor(ctx context.Context, userID uint) (bool, error)
+	RecordLoginAttempt(ctx context.Context, attempt LoginAttempt) error
+	GetLoginIPFailures(ctx context.Context, ipAddress string, since time.Time) (*LoginFailures, error)
+	GetUnknownEmailFailures(ctx context.Context, email string) (*LoginFailures, error)
+	RegisterFailedLogin(ctx context.Context, userID uint, at time.Time, lockThreshold int, lockUntil time.Time) error
+	RegisterSuccessfulLogin(ctx context.Context, attempt LoginAttempt) error
+	UnlockUser(ctx context.Context, userID uint) error
+	GetSSOProvider(ctx context.Context, businessID uint) (*SSOProvider, error)
+	GetSSOProviderByID(ctx context.Context, providerID uint) (*SSOProvider, error)
+	GetSSOProviderByBusinessCode(ctx context.Context, businessCode string) (*SSOProvider, error)
+	SaveSSOProvider(ctx context.Context, provider SSOProvider) (*SSOProvider, error)
+	DeleteSSOProvider(ctx context.Context, businessID uint) (bool, error)
+	CreateSSOLoginState(ctx context.Context, state SSOLoginState) error
+	ConsumeSSOLoginState(ctx context.Context, stateHash string) (*SSOLoginState, error)
+	GetUserIdentity(ctx context.Context, issuer, subject string) (*UserIdentity, error)
+	SaveUserIdentity(ctx context.Context, identity UserIdentity) error
+	CreateSSOUser(ctx context.Context, user SSOUser, identity UserIdentity) (uint, error)
+	SyncSSOMembership(ctx context.Context, userID, businessID uint, roleID *uint) error
 	GetBusinessConfiguredResourcesIDs(ctx context.Context, businessID uint) ([]uint, error)
+	GetBusinessActiveResourceNames(ctx context.Context, businessID uint) ([]string, error)
 	GetBusinessByID(ctx context.Context, businessID uint) (*BusinessInfo, error)
 	GetBusinessTypeByID(ctx context.Context, businessTypeID uint) (*BusinessTypeInfo, error)
 	CreateRole(ctx context.Context, role CreateRoleDTO) (*Role, error)
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// RefreshTokenTTL es la vigencia de un refresh token; cada refresh emite uno nuevo con esta vigencia
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	// RefreshTokenBytes son los bytes aleatorios del refresh token opaco
+	RefreshTokenBytes = 32
+)
+
+// Motivos de revocación de una sesión
+const (
+	SessionRevokedLogout        = "logout"
+	SessionRevokedAdmin         = "admin"
+	SessionRevokedReuseDetected = "reuse_detected"
+	SessionRevokedUserInactive  = "user_inactive"
+	SessionRevokedPasswordReset = "password_reset"
+	SessionRevokedTwoFactor     = "two_factor_reset"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("refresh token inválido o vencido")
+	ErrRefreshTokenReused  = errors.New("refresh token reutilizado: la sesión fue revocada")
+	ErrSessionRevoked      = errors.New("la sesión fue revocada")
+)
+
+// Session es una sesión de login. Los access tokens la referencian con el claim sid y dejan de
+// aceptarse cuando se revoca.
+type Session struct {
+	ID            uint
+	UserID        uint
+	UserAgent     string
+	IPAddress     string
+	LastUsedAt    time.Time
+	ExpiresAt     time.Time
+	RevokedAt     *time.Time
+	RevokedReason string
+	CreatedAt     time.Time
+}
+
+// RefreshTokenRecord es un refresh token guardado (solo su hash) con el estado de su sesión
+type RefreshTokenRecord struct {
+	ID        uint
+	SessionID uint
+	UserID    uint
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	Session   Session
+}
+
+// RefreshSessionRequest solicita nuevos tokens con un refresh token. Si BusinessID no es nil
+// también se emite un business token para ese negocio (0 para super admin).
+type RefreshSessionRequest struct {
+	RefreshToken string
+	BusinessID   *uint
+	UserAgent    string
+	IPAddress    string
+}
+
+// SessionTokens son los tokens emitidos al iniciar o renovar una sesión. El refresh token anterior
+// deja de servir.
+type SessionTokens struct {
+	SessionID             uint
+	UserID                uint
+	Token                 string
+	TokenExpiresAt        time.Time
+	BusinessToken         string // Solo si se pidió un negocio
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// RevokeUserSessionsRequest revoca todas las sesiones de un usuario. BusinessID limita la acción a
+// usuarios del negocio del administrador; 0 para super admin.
+type RevokeUserSessionsRequest struct {
+	UserID      uint
+	BusinessID  uint
+	RequesterID uint
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Inicio de sesión único (OIDC, authorization code + PKCE). El frontend pide la URL de autorización
+// del negocio, el proveedor redirige a RedirectURL con code y state, y el frontend los envía a
+// /auth/sso/callback para obtener la misma respuesta que el login con contraseña.
+const (
+	// SSOStateTTL es el tiempo que tiene el usuario para autenticarse en el proveedor
+	SSOStateTTL = 10 * time.Minute
+	// SSOStateBytes son los bytes aleatorios del state, el nonce y el verificador PKCE
+	SSOStateBytes = 32
+	// OIDCDiscoveryCacheTTL es el tiempo que se reutilizan el discovery y las claves del proveedor
+	OIDCDiscoveryCacheTTL = time.Hour
+	// OIDCClockSkew es la tolerancia al validar la expiración del ID token
+	OIDCClockSkew = time.Minute
+	// DefaultSSOScopes son los scopes que se piden si el proveedor no configura otros
+	DefaultSSOScopes = "openid email profile"
+	// DefaultSSOGroupsClaim es el claim del ID token con los grupos del usuario
+	DefaultSSOGroupsClaim = "groups"
+)
+
+var (
+	ErrSSONotConfigured       = errors.New("el negocio no tiene inicio de sesión único configurado")
+	ErrSSOInvalidConfig       = errors.New("configuración de inicio de sesión único inválida")
+	ErrSSOInvalidState        = errors.New("la solicitud de inicio de sesión es inválida o venció, vuelve a intentarlo")
+	ErrSSOProviderUnavailable = errors.New("no se pudo contactar al proveedor de identidad")
+	ErrSSOIdentityRejected    = errors.New("el proveedor de identidad no autenticó al usuario")
+	ErrSSOEmailNotVerified    = errors.New("el proveedor de identidad no verificó el email del usuario")
+	ErrSSOAccessDenied        = errors.New("el usuario no tiene acceso a este negocio")
+	ErrSSOLinkRequired        = errors.New("ya existe una cuenta con este email: confirma el vínculo con el enlace que te enviamos por email")
+)
+
+// SSORoleMapping asigna un rol del negocio a los miembros de un grupo del proveedor. Si el usuario
+// está en varios grupos mapeados gana el de menor Priority.
+type SSORoleMapping struct {
+	GroupName string
+	RoleID    uint
+	Priority  int
+}
+
+// SSOProvider es el proveedor OIDC de un negocio. ClientSecret está cifrado.
+type SSOProvider struct {
+	ID                uint
+	BusinessID        uint
+	IssuerURL         string
+	ClientID          string
+	ClientSecret      string
+	RedirectURL       string
+	Scopes            string
+	GroupsClaim       string
+	AllowProvisioning bool
+	DefaultRoleID     *uint
+	IsActive          bool
+	RoleMappings      []SSORoleMapping
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// SaveSSOProviderRequest crea o reemplaza el proveedor del negocio. Un ClientSecret vacío conserva
+// el actual; ClearClientSecret lo elimina (cliente público).
+type SaveSSOProviderRequest struct {
+	BusinessID        uint
+	IssuerURL         string
+	ClientID          string
+	ClientSecret      string
+	ClearClientSecret bool
+	RedirectURL       string
+	Scopes            string
+	GroupsClaim       string
+	AllowProvisioning bool
+	DefaultRoleID     *uint
+	IsActive          bool
+	RoleMappings      []SSORoleMapping
+}
+
+// SSOProviderInfo es el proveedor sin el secreto
+type SSOProviderInfo struct {
+	ID                uint
+	BusinessID        uint
+	IssuerURL         string
+	ClientID          string
+	HasClientSecret   bool
+	RedirectURL       string
+	Scopes            string
+	GroupsClaim       string
+	AllowProvisioning bool
+	DefaultRoleID     *uint
+	IsActive          bool
+	RoleMappings      []SSORoleMapping
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// SSOLoginState es un login en curso. StateHash es el SHA-256 del state enviado al proveedor.
+type SSOLoginState struct {
+	StateHash    string
+	ProviderID   uint
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+// SSOAuthorization es la URL del proveedor a la que el frontend redirige al usuario
+type SSOAuthorization struct {
+	AuthorizationURL string
+	ExpiresAt        time.Time
+}
+
+// CompleteSSOLoginRequest son el code y el state que el proveedor entregó al frontend
+type CompleteSSOLoginRequest struct {
+	Code      string
+	State     string
+	UserAgent string
+	IPAddress string
+}
+
+// UserIdentity vincula un usuario con su cuenta en un proveedor (issuer + subject). Mientras
+// ConfirmedAt sea nil el vínculo está pendiente y no sirve para iniciar sesión.
+type UserIdentity struct {
+	ID          uint
+	UserID      uint
+	ProviderID  uint
+	Issuer      string
+	Subject     string
+	Email       string
+	ConfirmedAt *time.Time
+}
+
+// SSOUser es un usuario dado de alta en su primer login por SSO
+type SSOUser struct {
+	Name  string
+	Email string
+}
+
+// OIDCProviderMetadata son los endpoints publicados en /.well-known/openid-configuration
+type OIDCProviderMetadata struct {
+	Issuer                string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	JWKSURI               string
+}
+
+// OIDCCodeExchange canjea el código de autorización por tokens
+type OIDCCodeExchange struct {
+	Code         string
+	RedirectURL  string
+	ClientID     string
+	ClientSecret string
+	CodeVerifier string
+}
+
+// OIDCIDTokenCheck son los valores con los que se valida el ID token
+type OIDCIDTokenCheck struct {
+	ClientID    string
+	Nonce       string
+	GroupsClaim string
+}
+
+// OIDCClaims son los datos del usuario tomados del ID token ya validado
+type OIDCClaims struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Groups        []string
+}
+
+// IOIDCClient habla con el proveedor de identidad
+type IOIDCClient interface {
+	Discover(ctx context.Context, issuerURL string) (*OIDCProviderMetadata, error)
+	ExchangeCode(ctx context.Context, metadata *OIDCProviderMetadata, exchange OIDCCodeExchange) (string, error)
+	VerifyIDToken(ctx context.Context, metadata *OIDCProviderMetadata, rawIDToken string, check OIDCIDTokenCheck) (*OIDCClaims, error)
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// RecoveryCodeCount son los códigos de recuperación que se emiten al activar 2FA
+	RecoveryCodeCount = 10
+	// RecoveryCodeBytes son los bytes aleatorios de cada código de recuperación
+	RecoveryCodeBytes = 5
+	// TwoFactorMaxAttempts son los códigos inválidos que se aceptan por usuario en TwoFactorAttemptWindow
+	TwoFactorMaxAttempts = 5
+	// TwoFactorAttemptWindow es la ventana del límite de intentos de segundo factor
+	TwoFactorAttemptWindow = 5 * time.Minute
+	// DefaultTwoFactorIssuer es el emisor que muestran las apps TOTP si no se configura TOTP_ISSUER
+	DefaultTwoFactorIssuer = "Central Reserve"
+)
+
+var (
+	ErrInvalidTwoFactorCode    = errors.New("código de verificación inválido")
+	ErrInvalidTwoFactorToken   = errors.New("token de segundo factor inválido o vencido")
+	ErrTwoFactorAttempts       = errors.New("demasiados intentos, espera unos minutos")
+	ErrTwoFactorNotEnrolled    = errors.New("el usuario no tiene configurado el segundo factor")
+	ErrTwoFactorAlreadyEnabled = errors.New("el segundo factor ya está activado")
+	ErrTwoFactorRequiredByRole = errors.New("tu rol exige el segundo factor: no puede desactivarse")
+)
+
+// TwoFactor es el segundo factor TOTP de un usuario. Secret está cifrado.
+type TwoFactor struct {
+	UserID       uint
+	Secret       string
+	EnabledAt    *time.Time
+	LastUsedStep int64
+}
+
+// TwoFactorStatus resume el estado del segundo factor de un usuario
+type TwoFactorStatus struct {
+	Enabled           bool
+	Required          bool // Algún rol del usuario lo exige
+	EnabledAt         *time.Time
+	RecoveryCodesLeft int64
+}
+
+// TwoFactorEnrollment es el secreto que el usuario registra en su app, en texto y como URI para el QR
+type TwoFactorEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// VerifyTwoFactorLoginRequest completa el login con el código TOTP o un código de recuperación
+type VerifyTwoFactorLoginRequest struct {
+	TwoFactorToken string
+	Code           string
+	UserAgent      string
+	IPAddress      string
+}
+
+// EnableTwoFactorRequest confirma el primer código y activa el segundo factor. Con CompleteLogin
+// (inscripción obligatoria durante el login) también se inicia la sesión.
+type EnableTwoFactorRequest struct {
+	UserID        uint
+	Code          string
+	CompleteLogin bool
+	UserAgent     string
+	IPAddress     string
+}
+
+// EnableTwoFactorResponse son los códigos de recuperación, que solo se muestran una vez, y el login
+// si se completó
+type EnableTwoFactorResponse struct {
+	RecoveryCodes []string
+	Login         *LoginResponse
+}
+
+// ResetUserTwoFactorRequest elimina el segundo factor de un usuario. BusinessID limita la acción a
+// usuarios del negocio del administrador; 0 para super admin.
+type ResetUserTwoFactorRequest struct {
+	UserID      uint
+	BusinessID  uint
+	RequesterID uint
+}
@@ -0,0 +1,34 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// accountEmailSentMessage es la respuesta a toda solicitud de enlace, exista o no el email
+const accountEmailSentMessage = "Si el email está registrado, recibirás un enlace en los próximos minutos"
+
+// respondAccountError responde los errores conocidos de los flujos de cuenta; retorna false si el error es interno
+func respondAccountError(c *gin.Context, err error) bool {
+	status := 0
+	switch {
+	case errors.Is(err, domain.ErrAccountRequestThrottled):
+		status = http.StatusTooManyRequests
+		c.Header("Retry-After", "3600")
+	case errors.Is(err, domain.ErrInvalidAccountToken):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrWeakPassword):
+		status = http.StatusBadRequest
+	default:
+		return false
+	}
+
+	c.JSON(status, response.AccountErrorResponse{
+		Error: err.Error(),
+	})
+	return true
+}
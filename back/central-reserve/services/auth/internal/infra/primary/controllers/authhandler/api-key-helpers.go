@@ -0,0 +1,59 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// resolveAPIKeyBusiness determina el negocio sobre el que se gestionan API Keys: el del business
+// token, o el indicado por el super admin (0 si no indica ninguno). Responde 403 si un usuario de
+// negocio pide otro negocio.
+func resolveAPIKeyBusiness(c *gin.Context, requested uint) (uint, bool) {
+	if middleware.IsSuperAdmin(c) {
+		return requested, true
+	}
+
+	businessID, ok := middleware.GetBusinessIDFromContext(c)
+	if !ok || businessID == 0 {
+		c.JSON(http.StatusUnauthorized, response.GenerateAPIKeyErrorResponse{
+			Error: "Token inválido o no autorizado",
+		})
+		return 0, false
+	}
+	if requested != 0 && requested != businessID {
+		c.JSON(http.StatusForbidden, response.GenerateAPIKeyErrorResponse{
+			Error: "Acceso denegado: solo puedes gestionar las API Keys de tu negocio",
+		})
+		return 0, false
+	}
+	return businessID, true
+}
+
+// respondAPIKeyError responde los errores conocidos de API Keys; retorna false si el error es interno
+func respondAPIKeyError(c *gin.Context, err error) bool {
+	status := 0
+	switch {
+	case errors.Is(err, domain.ErrAPIKeyNotFound), errors.Is(err, domain.ErrAPIKeyUserNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrAPIKeyRevoked):
+		status = http.StatusConflict
+	case errors.Is(err, domain.ErrAPIKeyBusinessRequired),
+		errors.Is(err, domain.ErrAPIKeyInvalidIPWhitelist),
+		errors.Is(err, domain.ErrAPIKeyInvalidRateLimit):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrAPIKeyUserInactive), errors.Is(err, domain.ErrAPIKeyUserNotInBusiness):
+		status = http.StatusUnprocessableEntity
+	default:
+		return false
+	}
+
+	c.JSON(status, response.GenerateAPIKeyErrorResponse{
+		Error: err.Error(),
+	})
+	return true
+}
@@ -0,0 +1,55 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BeginSSOLoginHandler inicia el login por SSO de un negocio
+//
+//	@Summary		Iniciar login por SSO
+//	@Description	Retorna la URL del proveedor de identidad del negocio (OpenID Connect, authorization code + PKCE) a la que el frontend debe redirigir al usuario. El proveedor redirige de vuelta a la redirect_url configurada con code y state, que se envían a /auth/sso/callback.
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			business_code	query		string									true	"Código del negocio"
+//	@Success		200				{object}	response.SSOAuthorizationSuccessResponse	"URL de autorización"
+//	@Failure		400				{object}	response.SSOErrorResponse				"business_code requerido"
+//	@Failure		404				{object}	response.SSOErrorResponse				"El negocio no tiene SSO activo"
+//	@Failure		502				{object}	response.SSOErrorResponse				"Proveedor de identidad no disponible"
+//	@Failure		500				{object}	response.SSOErrorResponse				"Error interno del servidor"
+//	@Router			/auth/sso/authorize [get]
+func (h *AuthHandler) BeginSSOLoginHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "BeginSSOLoginHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	businessCode := c.Query("business_code")
+	if businessCode == "" {
+		c.JSON(http.StatusBadRequest, response.SSOErrorResponse{
+			Error: "business_code es requerido",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	authorization, err := h.usecase.BeginSSOLogin(ctx, businessCode)
+	if err != nil {
+		if respondSSOError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Str("business_code", businessCode).Msg("Error al iniciar login por SSO")
+		c.JSON(http.StatusInternalServerError, response.SSOErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.SSOAuthorizationSuccessResponse{
+		Success: true,
+		Data:    mapper.ToSSOAuthorizationResponse(authorization),
+	})
+}
@@ -0,0 +1,68 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CompleteSSOLoginHandler completa el login por SSO
+//
+//	@Summary		Completar login por SSO
+//	@Description	Canjea el code y el state que el proveedor de identidad entregó en el redirect por los tokens de la sesión, con la misma respuesta que /auth/login (incluido el segundo factor local). El state sirve una sola vez. Un usuario sin cuenta vinculada se vincula por email verificado si ya es staff solo de este negocio; cualquier otra cuenta existente (super admin o staff de otros negocios) recibe un enlace por email para confirmar el vínculo en /auth/sso/link y el login responde 409. Si no hay cuenta y el negocio lo permite, se crea; su rol en el negocio sale de los grupos del proveedor.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.CompleteSSOLoginRequest	true	"Code y state del proveedor"
+//	@Success		200		{object}	response.LoginSuccessResponse	"Login exitoso"
+//	@Failure		400		{object}	response.SSOErrorResponse		"State inválido o vencido"
+//	@Failure		401		{object}	response.SSOErrorResponse		"El proveedor no autenticó al usuario"
+//	@Failure		403		{object}	response.SSOErrorResponse		"Email no verificado, usuario inactivo o sin acceso al negocio"
+//	@Failure		404		{object}	response.SSOErrorResponse		"El negocio no tiene SSO activo"
+//	@Failure		409		{object}	response.SSOErrorResponse		"Cuenta existente: falta confirmar el vínculo con el enlace enviado por email"
+//	@Failure		429		{object}	response.SSOErrorResponse		"Demasiados intentos; ver Retry-After"
+//	@Failure		502		{object}	response.SSOErrorResponse		"Proveedor de identidad no disponible"
+//	@Failure		500		{object}	response.SSOErrorResponse		"Error interno del servidor"
+//	@Router			/auth/sso/callback [post]
+func (h *AuthHandler) CompleteSSOLoginHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "CompleteSSOLoginHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var callbackRequest request.CompleteSSOLoginRequest
+	if err := c.ShouldBindJSON(&callbackRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.SSOErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	domainResponse, err := h.usecase.CompleteSSOLogin(ctx, domain.CompleteSSOLoginRequest{
+		Code:      callbackRequest.Code,
+		State:     callbackRequest.State,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	})
+	if err != nil {
+		if respondSSOError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Msg("Error al completar login por SSO")
+		c.JSON(http.StatusInternalServerError, response.SSOErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.LoginSuccessResponse{
+		Success: true,
+		Data:    *mapper.ToLoginResponse(domainResponse),
+	})
+}
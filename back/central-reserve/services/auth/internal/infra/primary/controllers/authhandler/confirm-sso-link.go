@@ -0,0 +1,59 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ConfirmSSOLinkHandler confirma el vínculo con el proveedor SSO con el token del enlace
+//
+//	@Summary		Confirmar vínculo con SSO
+//	@Description	Confirma el vínculo pendiente entre la cuenta y el proveedor de identidad con el token recibido por email. Se pide cuando el login por SSO encuentra una cuenta existente que no es staff solo del negocio del proveedor. El token solo sirve una vez; después el usuario vuelve a iniciar sesión por SSO.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.ConfirmSSOLinkRequest		true	"Token del enlace"
+//	@Success		200		{object}	response.AccountSuccessResponse	"Vínculo confirmado"
+//	@Failure		400		{object}	response.AccountErrorResponse	"Token inválido o vencido"
+//	@Failure		429		{object}	response.AccountErrorResponse	"Demasiadas solicitudes"
+//	@Failure		500		{object}	response.AccountErrorResponse	"Error interno del servidor"
+//	@Router			/auth/sso/link [post]
+func (h *AuthHandler) ConfirmSSOLinkHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "ConfirmSSOLinkHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var body request.ConfirmSSOLinkRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, response.AccountErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.ConfirmSSOLink(ctx, domain.ConfirmSSOLinkRequest{
+		Token:     body.Token,
+		IPAddress: c.ClientIP(),
+	}); err != nil {
+		if respondAccountError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Msg("Error al confirmar vínculo SSO")
+		c.JSON(http.StatusInternalServerError, response.AccountErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.AccountSuccessResponse{
+		Success: true,
+		Message: "Cuenta vinculada al proveedor de identidad; ya puedes iniciar sesión",
+	})
+}
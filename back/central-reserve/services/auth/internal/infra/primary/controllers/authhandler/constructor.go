@@ -12,12 +12,34 @@ type IAuthHandler interface {
 	LoginHandler(c *gin.Context)
 	VerifyHandler(c *gin.Context)
 	GetUserRolesPermissionsHandler(c *gin.Context)
+	GetBusinessFeaturesHandler(c *gin.Context)
 	ChangePasswordHandler(c *gin.Context)
 	GeneratePasswordHandler(c *gin.Context)
 	GenerateBusinessTokenHandler(c *gin.Context)
 	RegisterRoutes(v1Group *gin.RouterGroup, handler IAuthHandler, logger log.ILogger)
-	// GenerateAPIKeyHandler(c *gin.Context)
-	// ValidateAPIKeyHandler(c *gin.Context)
+	GenerateAPIKeyHandler(c *gin.Context)
+	ListAPIKeysHandler(c *gin.Context)
+	RotateAPIKeyHandler(c *gin.Context)
+	RevokeAPIKeyHandler(c *gin.Context)
+	ValidateAPIKeyHandler(c *gin.Context)
+	RefreshSessionHandler(c *gin.Context)
+	LogoutHandler(c *gin.Context)
+	ForgotPasswordHandler(c *gin.Context)
+	ResetPasswordHandler(c *gin.Context)
+	RequestEmailVerificationHandler(c *gin.Context)
+	VerifyEmailHandler(c *gin.Context)
+	VerifyTwoFactorLoginHandler(c *gin.Context)
+	GetTwoFactorStatusHandler(c *gin.Context)
+	EnrollTwoFactorHandler(c *gin.Context)
+	EnableTwoFactorHandler(c *gin.Context)
+	DisableTwoFactorHandler(c *gin.Context)
+	RegenerateRecoveryCodesHandler(c *gin.Context)
+	BeginSSOLoginHandler(c *gin.Context)
+	CompleteSSOLoginHandler(c *gin.Context)
+	ConfirmSSOLinkHandler(c *gin.Context)
+	GetSSOProviderHandler(c *gin.Context)
+	SaveSSOProviderHandler(c *gin.Context)
+	DeleteSSOProviderHandler(c *gin.Context)
 }
 
 type AuthHandler struct {
@@ -0,0 +1,56 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteSSOProviderHandler elimina el proveedor OIDC del negocio
+//
+//	@Summary		Eliminar proveedor SSO
+//	@Description	Desactiva el inicio de sesión único del negocio y elimina las cuentas vinculadas al proveedor. Los usuarios conservan su cuenta; los creados por SSO deberán restablecer una contraseña para entrar. Super admin debe indicar business_id.
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			business_id	query		int											false	"ID del negocio (solo super admin)"
+//	@Success		200			{object}	response.DeleteSSOProviderSuccessResponse	"Proveedor eliminado"
+//	@Failure		400			{object}	response.SSOErrorResponse					"business_id inválido o requerido"
+//	@Failure		401			{object}	response.SSOErrorResponse					"No autorizado"
+//	@Failure		403			{object}	response.SSOErrorResponse					"Acceso denegado"
+//	@Failure		404			{object}	response.SSOErrorResponse					"El negocio no tiene SSO configurado"
+//	@Failure		500			{object}	response.SSOErrorResponse					"Error interno del servidor"
+//	@Router			/auth/sso/provider [delete]
+func (h *AuthHandler) DeleteSSOProviderHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "DeleteSSOProviderHandler")
+
+	// 1. Negocio ─────────────────────────────────────────────
+	requested, ok := requestedBusinessID(c)
+	if !ok {
+		return
+	}
+	businessID, ok := resolveSSOBusiness(c, requested)
+	if !ok {
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.DeleteSSOProvider(ctx, businessID); err != nil {
+		if respondSSOError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("business_id", businessID).Msg("Error al eliminar proveedor SSO")
+		c.JSON(http.StatusInternalServerError, response.SSOErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.DeleteSSOProviderSuccessResponse{
+		Success: true,
+		Message: "Inicio de sesión único eliminado",
+	})
+}
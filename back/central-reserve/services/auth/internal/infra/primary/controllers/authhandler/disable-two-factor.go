@@ -0,0 +1,67 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DisableTwoFactorHandler desactiva el segundo factor del usuario autenticado
+//
+//	@Summary		Desactivar segundo factor
+//	@Description	Desactiva el segundo factor con un código TOTP o de recuperación. No se permite si algún rol del usuario lo exige.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.TwoFactorCodeRequest		true	"Código TOTP o de recuperación"
+//	@Success		200		{object}	response.TwoFactorSuccessResponse	"Segundo factor desactivado"
+//	@Failure		400		{object}	response.TwoFactorErrorResponse		"Código inválido"
+//	@Failure		401		{object}	response.TwoFactorErrorResponse		"No autorizado"
+//	@Failure		409		{object}	response.TwoFactorErrorResponse		"Sin segundo factor o exigido por el rol"
+//	@Failure		429		{object}	response.TwoFactorErrorResponse		"Demasiados intentos"
+//	@Failure		500		{object}	response.TwoFactorErrorResponse		"Error interno del servidor"
+//	@Router			/auth/2fa/disable [post]
+func (h *AuthHandler) DisableTwoFactorHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "DisableTwoFactorHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var codeRequest request.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&codeRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.TwoFactorErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, response.TwoFactorErrorResponse{
+			Error: "Usuario no autenticado",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.DisableTwoFactor(ctx, userID, codeRequest.Code); err != nil {
+		if respondTwoFactorError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("user_id", userID).Msg("Error al desactivar segundo factor")
+		c.JSON(http.StatusInternalServerError, response.TwoFactorErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.TwoFactorSuccessResponse{
+		Success: true,
+		Message: "Segundo factor desactivado",
+	})
+}
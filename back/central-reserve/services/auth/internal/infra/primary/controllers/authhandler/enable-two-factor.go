@@ -0,0 +1,76 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EnableTwoFactorHandler activa el segundo factor con el primer código de la app
+//
+//	@Summary		Activar segundo factor
+//	@Description	Confirma el primer código TOTP y activa el segundo factor. Retorna los códigos de recuperación, que solo se muestran una vez. Si se usó el two_factor_token de inscripción del login, también retorna la sesión iniciada en data.login.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.TwoFactorCodeRequest			true	"Código TOTP"
+//	@Success		200		{object}	response.EnableTwoFactorSuccessResponse	"Segundo factor activado"
+//	@Failure		400		{object}	response.TwoFactorErrorResponse			"Código inválido"
+//	@Failure		401		{object}	response.TwoFactorErrorResponse			"No autorizado"
+//	@Failure		409		{object}	response.TwoFactorErrorResponse			"Sin inscripción iniciada o ya activado"
+//	@Failure		500		{object}	response.TwoFactorErrorResponse			"Error interno del servidor"
+//	@Router			/auth/2fa/enable [post]
+func (h *AuthHandler) EnableTwoFactorHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "EnableTwoFactorHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var codeRequest request.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&codeRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.TwoFactorErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, response.TwoFactorErrorResponse{
+			Error: "Usuario no autenticado",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	enabled, err := h.usecase.EnableTwoFactor(ctx, domain.EnableTwoFactorRequest{
+		UserID:        userID,
+		Code:          codeRequest.Code,
+		CompleteLogin: middleware.IsTwoFactorEnrollment(c),
+		UserAgent:     c.Request.UserAgent(),
+		IPAddress:     c.ClientIP(),
+	})
+	if err != nil {
+		if respondTwoFactorError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("user_id", userID).Msg("Error al activar segundo factor")
+		c.JSON(http.StatusInternalServerError, response.TwoFactorErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.EnableTwoFactorSuccessResponse{
+		Success: true,
+		Message: "Segundo factor activado. Guarda los códigos de recuperación: no se volverán a mostrar",
+		Data:    mapper.ToEnableTwoFactorResponse(enabled),
+	})
+}
@@ -0,0 +1,55 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EnrollTwoFactorHandler inicia la inscripción del segundo factor
+//
+//	@Summary		Iniciar inscripción del segundo factor
+//	@Description	Genera un secreto TOTP y su URI otpauth:// para mostrar como código QR. El segundo factor no se activa hasta confirmar un código en /auth/2fa/enable. Acepta un business token o el two_factor_token de inscripción que emite el login cuando el rol exige segundo factor.
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	response.TwoFactorEnrollmentSuccessResponse	"Secreto generado"
+//	@Failure		401	{object}	response.TwoFactorErrorResponse				"No autorizado"
+//	@Failure		409	{object}	response.TwoFactorErrorResponse				"El segundo factor ya está activado"
+//	@Failure		500	{object}	response.TwoFactorErrorResponse				"Error interno del servidor"
+//	@Router			/auth/2fa/enroll [post]
+func (h *AuthHandler) EnrollTwoFactorHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "EnrollTwoFactorHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, response.TwoFactorErrorResponse{
+			Error: "Usuario no autenticado",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	enrollment, err := h.usecase.BeginTwoFactorEnrollment(ctx, userID)
+	if err != nil {
+		if respondTwoFactorError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("user_id", userID).Msg("Error al iniciar inscripción del segundo factor")
+		c.JSON(http.StatusInternalServerError, response.TwoFactorErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.TwoFactorEnrollmentSuccessResponse{
+		Success: true,
+		Data:    mapper.ToTwoFactorEnrollmentResponse(enrollment),
+	})
+}
@@ -0,0 +1,59 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ForgotPasswordHandler envía un enlace para restablecer la contraseña
+//
+//	@Summary		Olvidé mi contraseña
+//	@Description	Envía al email un enlace de un solo uso para restablecer la contraseña (vence en 1 hora). Responde igual exista o no el email.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.AccountEmailRequest		true	"Email de la cuenta"
+//	@Success		202		{object}	response.AccountSuccessResponse	"Solicitud recibida"
+//	@Failure		400		{object}	response.AccountErrorResponse	"Datos de entrada inválidos"
+//	@Failure		429		{object}	response.AccountErrorResponse	"Demasiadas solicitudes"
+//	@Failure		500		{object}	response.AccountErrorResponse	"Error interno del servidor"
+//	@Router			/auth/forgot-password [post]
+func (h *AuthHandler) ForgotPasswordHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "ForgotPasswordHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var body request.AccountEmailRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, response.AccountErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.RequestPasswordReset(ctx, domain.AccountEmailRequest{
+		Email:     body.Email,
+		IPAddress: c.ClientIP(),
+	}); err != nil {
+		if respondAccountError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Msg("Error al solicitar restablecimiento de contraseña")
+		c.JSON(http.StatusInternalServerError, response.AccountErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusAccepted, response.AccountSuccessResponse{
+		Success: true,
+		Message: accountEmailSentMessage,
+	})
+}
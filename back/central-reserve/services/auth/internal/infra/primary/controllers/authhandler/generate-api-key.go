@@ -1,88 +1,80 @@
 package authhandler
 
-// // GenerateAPIKeyHandler maneja la solicitud de generación de API Key
-// // @Summary Generar API Key
-// // @Description Genera una API Key para un usuario específico (solo super administradores)
-// // @Tags Auth
-// // @Accept json
-// // @Produce json
-// // @Security     BearerAuth
-// // @Param request body request.GenerateAPIKeyRequest true "Datos para generar API Key"
-// // @Success 200 {object} response.GenerateAPIKeySuccessResponse "API Key generada exitosamente"
-// // @Failure 400 {object} response.GenerateAPIKeyErrorResponse "Datos de entrada inválidos"
-// // @Failure 401 {object} response.GenerateAPIKeyErrorResponse "No autorizado"
-// // @Failure 403 {object} response.GenerateAPIKeyErrorResponse "Acceso denegado - solo super administradores"
-// // @Failure 404 {object} response.GenerateAPIKeyErrorResponse "Usuario o business no encontrado"
-// // @Failure 500 {object} response.GenerateAPIKeyErrorResponse "Error interno del servidor"
-// // @Router /auth/generate-api-key [post]
-// func (h *AuthHandler) GenerateAPIKeyHandler(c *gin.Context) {
-// 	var apiKeyRequest request.GenerateAPIKeyRequest
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+	"net/http"
 
-// 	// Validar y bindear el request
-// 	if err := c.ShouldBindJSON(&apiKeyRequest); err != nil {
-// 		h.logger.Error().Err(err).Msg("Error al validar request de generación de API Key")
-// 		c.JSON(http.StatusBadRequest, response.GenerateAPIKeyErrorResponse{
-// 			Error:   "Datos de entrada inválidos",
-// 			Details: err.Error(),
-// 		})
-// 		return
-// 	}
+	"github.com/gin-gonic/gin"
+)
 
-// 	// Obtener el ID del usuario que hace la solicitud desde el contexto
-// 	requesterID, exists := middleware.GetUserID(c)
-// 	if !exists {
-// 		h.logger.Error().Msg("Usuario no autenticado")
-// 		c.JSON(http.StatusUnauthorized, response.GenerateAPIKeyErrorResponse{
-// 			Error: "Usuario no autenticado",
-// 		})
-// 		return
-// 	}
+// GenerateAPIKeyHandler maneja la solicitud de generación de API Key
+//
+//	@Summary		Generar API Key
+//	@Description	Genera una API Key para un usuario del negocio. La key tiene el formato crk_<prefijo>_<secreto> y solo se muestra en esta respuesta. Se envía en el header X-API-Key y tiene los permisos del rol del usuario en el negocio. Super admin debe indicar business_id.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.GenerateAPIKeyRequest				true	"Datos para generar API Key"
+//	@Success		201		{object}	response.GenerateAPIKeySuccessResponse	"API Key generada exitosamente"
+//	@Failure		400		{object}	response.GenerateAPIKeyErrorResponse		"Datos de entrada inválidos"
+//	@Failure		401		{object}	response.GenerateAPIKeyErrorResponse		"No autorizado"
+//	@Failure		403		{object}	response.GenerateAPIKeyErrorResponse		"Acceso denegado"
+//	@Failure		404		{object}	response.GenerateAPIKeyErrorResponse		"Usuario no encontrado"
+//	@Failure		422		{object}	response.GenerateAPIKeyErrorResponse		"Usuario inactivo o sin acceso al negocio"
+//	@Failure		500		{object}	response.GenerateAPIKeyErrorResponse		"Error interno del servidor"
+//	@Router			/auth/api-keys [post]
+func (h *AuthHandler) GenerateAPIKeyHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "GenerateAPIKeyHandler")
 
-// 	// Convertir request a dominio
-// 	domainRequest := mapper.ToGenerateAPIKeyRequest(apiKeyRequest, requesterID)
+	// 1. Entrada ──────────────────────────────────────────────
+	var apiKeyRequest request.GenerateAPIKeyRequest
+	if err := c.ShouldBindJSON(&apiKeyRequest); err != nil {
+		h.logger.Error(ctx).Err(err).Msg("Error al validar request de generación de API Key")
+		c.JSON(http.StatusBadRequest, response.GenerateAPIKeyErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
 
-// 	// Ejecutar caso de uso
-// 	domainResponse, err := h.usecase.GenerateAPIKey(c.Request.Context(), domainRequest)
-// 	if err != nil {
-// 		h.logger.Error().Err(err).
-// 			Uint("requester_id", requesterID).
-// 			Uint("target_user_id", apiKeyRequest.UserID).
-// 			Uint("business_id", apiKeyRequest.BusinessID).
-// 			Msg("Error en proceso de generación de API Key")
+	requesterID, exists := middleware.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, response.GenerateAPIKeyErrorResponse{
+			Error: "Usuario no autenticado",
+		})
+		return
+	}
 
-// 		// Determinar el código de estado HTTP apropiado
-// 		statusCode := http.StatusInternalServerError
-// 		errorMessage := "Error interno del servidor"
+	// 2. Negocio ─────────────────────────────────────────────
+	businessID, ok := resolveAPIKeyBusiness(c, apiKeyRequest.BusinessID)
+	if !ok {
+		return
+	}
 
-// 		if err.Error() == "solo super administradores pueden generar API Keys" {
-// 			statusCode = http.StatusForbidden
-// 			errorMessage = "Acceso denegado: solo super administradores pueden generar API Keys"
-// 		} else if err.Error() == "usuario objetivo no encontrado" || err.Error() == "usuario objetivo está inactivo" {
-// 			statusCode = http.StatusNotFound
-// 			errorMessage = err.Error()
-// 		} else if err.Error() == "error al verificar permisos del solicitante" {
-// 			statusCode = http.StatusUnauthorized
-// 			errorMessage = "Error al verificar permisos"
-// 		}
+	// 3. Caso de uso ─────────────────────────────────────────
+	domainResponse, err := h.usecase.GenerateAPIKey(ctx, mapper.ToGenerateAPIKeyRequest(apiKeyRequest, businessID, requesterID))
+	if err != nil {
+		if respondAPIKeyError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).
+			Uint("requester_id", requesterID).
+			Uint("business_id", businessID).
+			Msg("Error en proceso de generación de API Key")
+		c.JSON(http.StatusInternalServerError, response.GenerateAPIKeyErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
 
-// 		c.JSON(statusCode, response.GenerateAPIKeyErrorResponse{
-// 			Error: errorMessage,
-// 		})
-// 		return
-// 	}
-
-// 	// Convertir respuesta de dominio a response
-// 	apiKeyResponse := mapper.ToGenerateAPIKeyResponse(domainResponse)
-
-// 	h.logger.Info().
-// 		Uint("requester_id", requesterID).
-// 		Uint("target_user_id", apiKeyRequest.UserID).
-// 		Uint("business_id", apiKeyRequest.BusinessID).
-// 		Msg("API Key generada exitosamente")
-
-// 	// Retornar respuesta exitosa
-// 	c.JSON(http.StatusOK, response.GenerateAPIKeySuccessResponse{
-// 		Success: true,
-// 		Data:    apiKeyResponse,
-// 	})
-// }
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusCreated, response.GenerateAPIKeySuccessResponse{
+		Success: true,
+		Data:    mapper.ToGenerateAPIKeyResponse(domainResponse),
+	})
+}
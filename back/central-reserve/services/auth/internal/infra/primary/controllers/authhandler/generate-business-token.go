@@ -38,6 +38,12 @@ func (h *AuthHandler) GenerateBusinessTokenHandler(c *gin.Context) {
 		return
 	}
 
+	// El business token pertenece a la misma sesión que el token principal
+	var sessionID uint
+	if claims, ok := middleware.GetJWTClaims(c); ok && claims != nil {
+		sessionID = claims.SessionID
+	}
+
 	// Parsear el body
 	var businessTokenRequest request.GenerateBusinessTokenRequest
 	if err := c.ShouldBindJSON(&businessTokenRequest); err != nil {
@@ -66,6 +72,7 @@ func (h *AuthHandler) GenerateBusinessTokenHandler(c *gin.Context) {
 		ctx,
 		userID,
 		businessTokenRequest.BusinessID,
+		sessionID,
 	)
 
 	if err != nil {
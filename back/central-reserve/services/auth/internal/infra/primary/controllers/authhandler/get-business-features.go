@@ -0,0 +1,58 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/db"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetBusinessFeaturesHandler retorna los módulos habilitados del negocio
+//
+//	@Summary		Módulos habilitados del negocio
+//	@Description	Lista los módulos que el negocio del token tiene habilitados: sus recursos configurados activos y los flags de reservas, delivery y pickup. Super admin debe indicar business_id.
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			business_id	query		int										false	"ID del negocio (solo super admin, obligatorio para él)"
+//	@Success		200			{object}	response.BusinessFeaturesSuccessResponse	"Módulos del negocio"
+//	@Failure		400			{object}	response.BusinessFeaturesErrorResponse	"business_id inválido o requerido"
+//	@Failure		401			{object}	response.BusinessFeaturesErrorResponse	"No autorizado"
+//	@Failure		403			{object}	response.BusinessFeaturesErrorResponse	"Acceso denegado"
+//	@Failure		500			{object}	response.BusinessFeaturesErrorResponse	"Error interno del servidor"
+//	@Router			/auth/features [get]
+func (h *AuthHandler) GetBusinessFeaturesHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "GetBusinessFeaturesHandler")
+
+	// 1. Negocio ─────────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err == nil && businessID == db.AllBusinesses {
+		err = middleware.ErrBusinessRequired
+	}
+	if err != nil {
+		c.JSON(middleware.BusinessScopeStatus(err), response.BusinessFeaturesErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	features, err := h.usecase.ResolveFeatures(ctx, businessID)
+	if err != nil {
+		h.logger.Error(ctx).Err(err).Uint("business_id", businessID).Msg("Error al obtener módulos del negocio")
+		c.JSON(http.StatusInternalServerError, response.BusinessFeaturesErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.BusinessFeaturesSuccessResponse{
+		Success: true,
+		Data:    mapper.ToBusinessFeaturesResponse(businessID, features),
+	})
+}
@@ -0,0 +1,58 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetSSOProviderHandler obtiene el proveedor OIDC del negocio
+//
+//	@Summary		Obtener proveedor SSO
+//	@Description	Obtiene la configuración de inicio de sesión único del negocio del token, sin el client secret. Super admin debe indicar business_id.
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			business_id	query		int									false	"ID del negocio (solo super admin)"
+//	@Success		200			{object}	response.SSOProviderSuccessResponse	"Proveedor del negocio"
+//	@Failure		400			{object}	response.SSOErrorResponse			"business_id inválido o requerido"
+//	@Failure		401			{object}	response.SSOErrorResponse			"No autorizado"
+//	@Failure		403			{object}	response.SSOErrorResponse			"Acceso denegado"
+//	@Failure		404			{object}	response.SSOErrorResponse			"El negocio no tiene SSO configurado"
+//	@Failure		500			{object}	response.SSOErrorResponse			"Error interno del servidor"
+//	@Router			/auth/sso/provider [get]
+func (h *AuthHandler) GetSSOProviderHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "GetSSOProviderHandler")
+
+	// 1. Negocio ─────────────────────────────────────────────
+	requested, ok := requestedBusinessID(c)
+	if !ok {
+		return
+	}
+	businessID, ok := resolveSSOBusiness(c, requested)
+	if !ok {
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	provider, err := h.usecase.GetSSOProvider(ctx, businessID)
+	if err != nil {
+		if respondSSOError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("business_id", businessID).Msg("Error al obtener proveedor SSO")
+		c.JSON(http.StatusInternalServerError, response.SSOErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.SSOProviderSuccessResponse{
+		Success: true,
+		Data:    mapper.ToSSOProviderResponse(provider),
+	})
+}
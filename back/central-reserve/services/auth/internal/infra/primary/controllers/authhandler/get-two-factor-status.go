@@ -0,0 +1,51 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetTwoFactorStatusHandler retorna el estado del segundo factor del usuario autenticado
+//
+//	@Summary		Estado del segundo factor
+//	@Description	Indica si el usuario tiene el segundo factor activo, si su rol lo exige y cuántos códigos de recuperación le quedan
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	response.TwoFactorStatusSuccessResponse	"Estado del segundo factor"
+//	@Failure		401	{object}	response.TwoFactorErrorResponse			"No autorizado"
+//	@Failure		500	{object}	response.TwoFactorErrorResponse			"Error interno del servidor"
+//	@Router			/auth/2fa [get]
+func (h *AuthHandler) GetTwoFactorStatusHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "GetTwoFactorStatusHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, response.TwoFactorErrorResponse{
+			Error: "Usuario no autenticado",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	status, err := h.usecase.GetTwoFactorStatus(ctx, userID)
+	if err != nil {
+		h.logger.Error(ctx).Err(err).Uint("user_id", userID).Msg("Error al obtener estado del segundo factor")
+		c.JSON(http.StatusInternalServerError, response.TwoFactorErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.TwoFactorStatusSuccessResponse{
+		Success: true,
+		Data:    mapper.ToTwoFactorStatusResponse(status),
+	})
+}
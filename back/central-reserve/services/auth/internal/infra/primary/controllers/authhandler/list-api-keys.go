@@ -0,0 +1,65 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListAPIKeysHandler lista las API Keys del negocio
+//
+//	@Summary		Listar API Keys
+//	@Description	Lista las API Keys del negocio del token, incluidas las revocadas. Nunca incluye el secreto. Super admin debe indicar business_id.
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			business_id	query		int									false	"ID del negocio (solo super admin)"
+//	@Success		200			{object}	response.ListAPIKeysSuccessResponse	"API Keys del negocio"
+//	@Failure		400			{object}	response.GenerateAPIKeyErrorResponse	"business_id inválido o requerido"
+//	@Failure		401			{object}	response.GenerateAPIKeyErrorResponse	"No autorizado"
+//	@Failure		403			{object}	response.GenerateAPIKeyErrorResponse	"Acceso denegado"
+//	@Failure		500			{object}	response.GenerateAPIKeyErrorResponse	"Error interno del servidor"
+//	@Router			/auth/api-keys [get]
+func (h *AuthHandler) ListAPIKeysHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "ListAPIKeysHandler")
+
+	// 1. Negocio ─────────────────────────────────────────────
+	var requested uint
+	if raw := c.Query("business_id"); raw != "" {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, response.GenerateAPIKeyErrorResponse{
+				Error: "business_id inválido",
+			})
+			return
+		}
+		requested = uint(id)
+	}
+	businessID, ok := resolveAPIKeyBusiness(c, requested)
+	if !ok {
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	apiKeys, err := h.usecase.ListAPIKeys(ctx, businessID)
+	if err != nil {
+		if respondAPIKeyError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("business_id", businessID).Msg("Error al listar API Keys")
+		c.JSON(http.StatusInternalServerError, response.GenerateAPIKeyErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.ListAPIKeysSuccessResponse{
+		Success: true,
+		Data:    mapper.ToAPIKeyResponseSlice(apiKeys),
+	})
+}
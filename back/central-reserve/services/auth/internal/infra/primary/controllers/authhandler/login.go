@@ -6,7 +6,10 @@ import (
 	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
 	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
 	"central_reserve/shared/log"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,15 +17,16 @@ import (
 // LoginHandler maneja la solicitud de login
 //
 //	@Summary		Autenticar usuario
-//	@Description	Autentica un usuario con email y contraseña, retornando información del usuario y token de acceso
+//	@Description	Autentica un usuario con email y contraseña, retornando información del usuario, un token de acceso de corta duración y un refresh token para renovarlo. Si el usuario tiene segundo factor (o su rol lo exige) no se emiten tokens: se retorna two_factor_token para completar el login en /auth/login/2fa o inscribirse en /auth/2fa/enroll. Los intentos fallidos se registran por email y por IP: imponen un retraso progresivo y, tras demasiados, un bloqueo temporal. Un email bloqueado responde 401 con Retry-After, igual exista o no la cuenta
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		request.LoginRequest				true	"Credenciales de login"
 //	@Success		200		{object}	response.LoginSuccessResponse		"Login exitoso"
 //	@Failure		400		{object}	response.LoginBadRequestResponse	"Datos de entrada inválidos"
-//	@Failure		401		{object}	response.LoginErrorResponse			"Credenciales inválidas"
+//	@Failure		401		{object}	response.LoginErrorResponse			"Credenciales inválidas (con Retry-After si el email está bloqueado)"
 //	@Failure		403		{object}	response.LoginErrorResponse			"Usuario inactivo"
+//	@Failure		429		{object}	response.LoginErrorResponse			"Demasiados intentos desde la IP; ver Retry-After"
 //	@Failure		500		{object}	response.LoginErrorResponse			"Error interno del servidor"
 //	@Router			/auth/login [post]
 func (h *AuthHandler) LoginHandler(c *gin.Context) {
@@ -42,20 +46,35 @@ func (h *AuthHandler) LoginHandler(c *gin.Context) {
 
 	// Convertir request a dominio
 	domainRequest := domain.LoginRequest{
-		Email:    loginRequest.Email,
-		Password: loginRequest.Password,
+		Email:     loginRequest.Email,
+		Password:  loginRequest.Password,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
 	}
 
 	// Ejecutar caso de uso
 	domainResponse, err := h.usecase.Login(ctx, domainRequest)
 	if err != nil {
+		// Demasiados intentos: indicar cuándo reintentar. Un email bloqueado responde igual que unas
+		// credenciales inválidas; solo la IP bloqueada responde 429.
+		var blocked *domain.LoginBlockedError
+		if errors.As(err, &blocked) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+			if errors.Is(err, domain.ErrLoginThrottled) {
+				c.JSON(http.StatusTooManyRequests, response.LoginErrorResponse{
+					Error: blocked.Error(),
+				})
+				return
+			}
+		}
+
 		h.logger.Error(ctx).Err(err).Str("email", loginRequest.Email).Msg("Error en proceso de login")
 
 		// Determinar el código de estado HTTP apropiado
 		statusCode := http.StatusInternalServerError
 		errorMessage := "Error interno del servidor"
 
-		if err.Error() == "credenciales inválidas" {
+		if errors.Is(err, domain.ErrInvalidCredentials) {
 			statusCode = http.StatusUnauthorized
 			errorMessage = "Credenciales inválidas"
 		} else if err.Error() == "usuario inactivo" {
@@ -0,0 +1,59 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LogoutHandler cierra la sesión del refresh token
+//
+//	@Summary		Cerrar sesión
+//	@Description	Revoca la sesión del refresh token. El refresh token y los tokens de acceso de la sesión dejan de aceptarse de inmediato.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.LogoutRequest			true	"Refresh token de la sesión"
+//	@Success		200		{object}	response.LogoutSuccessResponse	"Sesión cerrada"
+//	@Failure		400		{object}	response.SessionErrorResponse	"Datos de entrada inválidos"
+//	@Failure		401		{object}	response.SessionErrorResponse	"Refresh token inválido"
+//	@Failure		500		{object}	response.SessionErrorResponse	"Error interno del servidor"
+//	@Router			/auth/logout [post]
+func (h *AuthHandler) LogoutHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "LogoutHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var logoutRequest request.LogoutRequest
+	if err := c.ShouldBindJSON(&logoutRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.SessionErrorResponse{
+			Error: "Datos de entrada inválidos: " + err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.Logout(ctx, logoutRequest.RefreshToken); err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			c.JSON(http.StatusUnauthorized, response.SessionErrorResponse{
+				Error: err.Error(),
+			})
+			return
+		}
+		h.logger.Error(ctx).Err(err).Msg("Error al cerrar sesión")
+		c.JSON(http.StatusInternalServerError, response.SessionErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.LogoutSuccessResponse{
+		Success: true,
+		Message: "Sesión cerrada exitosamente",
+	})
+}
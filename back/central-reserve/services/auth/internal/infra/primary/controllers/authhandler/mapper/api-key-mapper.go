@@ -7,12 +7,14 @@ import (
 )
 
 // ToGenerateAPIKeyRequest convierte el request HTTP a DTO de dominio
-func ToGenerateAPIKeyRequest(req request.GenerateAPIKeyRequest, requesterID uint) domain.GenerateAPIKeyRequest {
+func ToGenerateAPIKeyRequest(req request.GenerateAPIKeyRequest, businessID uint, requesterID uint) domain.GenerateAPIKeyRequest {
 	return domain.GenerateAPIKeyRequest{
 		UserID:      req.UserID,
-		BusinessID:  req.BusinessID,
+		BusinessID:  businessID,
 		Name:        req.Name,
 		Description: req.Description,
+		RateLimit:   req.RateLimit,
+		IPWhitelist: req.IPWhitelist,
 		RequesterID: requesterID,
 	}
 }
@@ -23,11 +25,50 @@ func ToGenerateAPIKeyResponse(dto *domain.GenerateAPIKeyResponse) response.Gener
 		Success:     dto.Success,
 		Message:     dto.Message,
 		APIKey:      dto.APIKey,
+		ID:          dto.APIKeyInfo.ID,
+		KeyPrefix:   dto.APIKeyInfo.KeyPrefix,
 		UserID:      dto.APIKeyInfo.UserID,
 		BusinessID:  dto.APIKeyInfo.BusinessID,
 		Name:        dto.APIKeyInfo.Name,
 		Description: dto.APIKeyInfo.Description,
 		RateLimit:   dto.APIKeyInfo.RateLimit,
+		IPWhitelist: nonNilStrings(dto.APIKeyInfo.IPWhitelist),
 		CreatedAt:   dto.APIKeyInfo.CreatedAt,
 	}
 }
+
+// ToAPIKeyResponse convierte la información de una API Key a response HTTP
+func ToAPIKeyResponse(info domain.APIKeyInfo) response.APIKeyResponse {
+	return response.APIKeyResponse{
+		ID:          info.ID,
+		KeyPrefix:   info.KeyPrefix,
+		UserID:      info.UserID,
+		BusinessID:  info.BusinessID,
+		CreatedByID: info.CreatedByID,
+		Name:        info.Name,
+		Description: info.Description,
+		RateLimit:   info.RateLimit,
+		IPWhitelist: nonNilStrings(info.IPWhitelist),
+		LastUsedAt:  info.LastUsedAt,
+		Revoked:     info.Revoked,
+		RevokedAt:   info.RevokedAt,
+		CreatedAt:   info.CreatedAt,
+	}
+}
+
+// ToAPIKeyResponseSlice convierte una lista de API Keys a response HTTP
+func ToAPIKeyResponseSlice(infos []domain.APIKeyInfo) []response.APIKeyResponse {
+	result := make([]response.APIKeyResponse, len(infos))
+	for i, info := range infos {
+		result[i] = ToAPIKeyResponse(info)
+	}
+	return result
+}
+
+// nonNilStrings serializa una lista vacía como [] en lugar de null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
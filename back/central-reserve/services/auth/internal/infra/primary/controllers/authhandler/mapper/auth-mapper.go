@@ -45,19 +45,26 @@ func ToLoginResponse(domainResponse *domain.LoginResponse) *response.LoginRespon
 
 	return &response.LoginResponse{
 		User: response.UserInfo{
-			ID:          domainResponse.User.ID,
-			Name:        domainResponse.User.Name,
-			Email:       domainResponse.User.Email,
-			Phone:       domainResponse.User.Phone,
-			AvatarURL:   domainResponse.User.AvatarURL,
-			IsActive:    domainResponse.User.IsActive,
-			LastLoginAt: domainResponse.User.LastLoginAt,
+			ID:            domainResponse.User.ID,
+			Name:          domainResponse.User.Name,
+			Email:         domainResponse.User.Email,
+			Phone:         domainResponse.User.Phone,
+			AvatarURL:     domainResponse.User.AvatarURL,
+			IsActive:      domainResponse.User.IsActive,
+			LastLoginAt:   domainResponse.User.LastLoginAt,
+			EmailVerified: domainResponse.User.EmailVerified,
 		},
-		Token:                 domainResponse.Token,
-		RequirePasswordChange: domainResponse.RequirePasswordChange,
-		Businesses:            businesses,
-		Scope:                 domainResponse.Scope,
-		IsSuperAdmin:          domainResponse.IsSuperAdmin,
+		Token:                       domainResponse.Token,
+		TokenExpiresAt:              domainResponse.TokenExpiresAt,
+		RefreshToken:                domainResponse.RefreshToken,
+		RefreshTokenExpiresAt:       domainResponse.RefreshTokenExpiresAt,
+		RequirePasswordChange:       domainResponse.RequirePasswordChange,
+		Businesses:                  businesses,
+		Scope:                       domainResponse.Scope,
+		IsSuperAdmin:                domainResponse.IsSuperAdmin,
+		TwoFactorRequired:           domainResponse.TwoFactorRequired,
+		TwoFactorEnrollmentRequired: domainResponse.TwoFactorEnrollmentRequired,
+		TwoFactorToken:              domainResponse.TwoFactorToken,
 	}
 }
 
@@ -0,0 +1,14 @@
+package mapper
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+)
+
+// ToBusinessFeaturesResponse convierte los módulos habilitados de un negocio a su respuesta
+func ToBusinessFeaturesResponse(businessID uint, features domain.FeatureSet) response.BusinessFeaturesResponse {
+	return response.BusinessFeaturesResponse{
+		BusinessID: businessID,
+		Features:   features.Names(),
+	}
+}
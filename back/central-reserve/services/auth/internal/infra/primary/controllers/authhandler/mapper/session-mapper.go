@@ -0,0 +1,20 @@
+package mapper
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+)
+
+// ToSessionTokensResponse convierte domain.SessionTokens a response.SessionTokensResponse
+func ToSessionTokensResponse(tokens *domain.SessionTokens) response.SessionTokensResponse {
+	if tokens == nil {
+		return response.SessionTokensResponse{}
+	}
+	return response.SessionTokensResponse{
+		Token:                 tokens.Token,
+		TokenExpiresAt:        tokens.TokenExpiresAt,
+		BusinessToken:         tokens.BusinessToken,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+	}
+}
@@ -0,0 +1,69 @@
+package mapper
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+)
+
+// ToSaveSSOProviderRequest convierte el request HTTP a DTO de dominio. El orden de los mapeos es su prioridad.
+func ToSaveSSOProviderRequest(req request.SaveSSOProviderRequest, businessID uint) domain.SaveSSOProviderRequest {
+	mappings := make([]domain.SSORoleMapping, len(req.RoleMappings))
+	for i, mapping := range req.RoleMappings {
+		mappings[i] = domain.SSORoleMapping{
+			GroupName: mapping.GroupName,
+			RoleID:    mapping.RoleID,
+			Priority:  i,
+		}
+	}
+	return domain.SaveSSOProviderRequest{
+		BusinessID:        businessID,
+		IssuerURL:         req.IssuerURL,
+		ClientID:          req.ClientID,
+		ClientSecret:      req.ClientSecret,
+		ClearClientSecret: req.ClearClientSecret,
+		RedirectURL:       req.RedirectURL,
+		Scopes:            req.Scopes,
+		GroupsClaim:       req.GroupsClaim,
+		AllowProvisioning: req.AllowProvisioning,
+		DefaultRoleID:     req.DefaultRoleID,
+		IsActive:          req.IsActive,
+		RoleMappings:      mappings,
+	}
+}
+
+// ToSSOProviderResponse convierte el proveedor a response HTTP
+func ToSSOProviderResponse(info *domain.SSOProviderInfo) response.SSOProviderResponse {
+	mappings := make([]response.SSORoleMappingResponse, len(info.RoleMappings))
+	for i, mapping := range info.RoleMappings {
+		mappings[i] = response.SSORoleMappingResponse{
+			GroupName: mapping.GroupName,
+			RoleID:    mapping.RoleID,
+			Priority:  mapping.Priority,
+		}
+	}
+	return response.SSOProviderResponse{
+		ID:                info.ID,
+		BusinessID:        info.BusinessID,
+		IssuerURL:         info.IssuerURL,
+		ClientID:          info.ClientID,
+		HasClientSecret:   info.HasClientSecret,
+		RedirectURL:       info.RedirectURL,
+		Scopes:            info.Scopes,
+		GroupsClaim:       info.GroupsClaim,
+		AllowProvisioning: info.AllowProvisioning,
+		DefaultRoleID:     info.DefaultRoleID,
+		IsActive:          info.IsActive,
+		RoleMappings:      mappings,
+		CreatedAt:         info.CreatedAt,
+		UpdatedAt:         info.UpdatedAt,
+	}
+}
+
+// ToSSOAuthorizationResponse convierte la URL de autorización a response HTTP
+func ToSSOAuthorizationResponse(authorization *domain.SSOAuthorization) response.SSOAuthorizationResponse {
+	return response.SSOAuthorizationResponse{
+		AuthorizationURL: authorization.AuthorizationURL,
+		ExpiresAt:        authorization.ExpiresAt,
+	}
+}
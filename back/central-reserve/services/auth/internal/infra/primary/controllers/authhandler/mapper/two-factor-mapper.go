@@ -0,0 +1,41 @@
+package mapper
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+)
+
+// ToTwoFactorStatusResponse convierte domain.TwoFactorStatus a response.TwoFactorStatusResponse
+func ToTwoFactorStatusResponse(status *domain.TwoFactorStatus) response.TwoFactorStatusResponse {
+	if status == nil {
+		return response.TwoFactorStatusResponse{}
+	}
+	return response.TwoFactorStatusResponse{
+		Enabled:           status.Enabled,
+		Required:          status.Required,
+		EnabledAt:         status.EnabledAt,
+		RecoveryCodesLeft: status.RecoveryCodesLeft,
+	}
+}
+
+// ToTwoFactorEnrollmentResponse convierte domain.TwoFactorEnrollment a response.TwoFactorEnrollmentResponse
+func ToTwoFactorEnrollmentResponse(enrollment *domain.TwoFactorEnrollment) response.TwoFactorEnrollmentResponse {
+	if enrollment == nil {
+		return response.TwoFactorEnrollmentResponse{}
+	}
+	return response.TwoFactorEnrollmentResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}
+}
+
+// ToEnableTwoFactorResponse convierte domain.EnableTwoFactorResponse a response.EnableTwoFactorResponse
+func ToEnableTwoFactorResponse(enabled *domain.EnableTwoFactorResponse) response.EnableTwoFactorResponse {
+	if enabled == nil {
+		return response.EnableTwoFactorResponse{}
+	}
+	return response.EnableTwoFactorResponse{
+		RecoveryCodes: enabled.RecoveryCodes,
+		Login:         ToLoginResponse(enabled.Login),
+	}
+}
@@ -0,0 +1,77 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RefreshSessionHandler renueva los tokens de una sesión
+//
+//	@Summary		Renovar sesión
+//	@Description	Canjea un refresh token por un nuevo token de acceso y un nuevo refresh token. Cada refresh token sirve una sola vez: reutilizarlo revoca la sesión. Con business_id también se emite un business token.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.RefreshSessionRequest			true	"Refresh token"
+//	@Success		200		{object}	response.RefreshSessionSuccessResponse	"Sesión renovada"
+//	@Failure		400		{object}	response.SessionErrorResponse			"Datos de entrada inválidos"
+//	@Failure		401		{object}	response.SessionErrorResponse			"Refresh token inválido, vencido o reutilizado"
+//	@Failure		403		{object}	response.SessionErrorResponse			"Sin acceso al business"
+//	@Failure		500		{object}	response.SessionErrorResponse			"Error interno del servidor"
+//	@Router			/auth/refresh [post]
+func (h *AuthHandler) RefreshSessionHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "RefreshSessionHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var refreshRequest request.RefreshSessionRequest
+	if err := c.ShouldBindJSON(&refreshRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.SessionErrorResponse{
+			Error: "Datos de entrada inválidos: " + err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	tokens, err := h.usecase.RefreshSession(ctx, domain.RefreshSessionRequest{
+		RefreshToken: refreshRequest.RefreshToken,
+		BusinessID:   refreshRequest.BusinessID,
+		UserAgent:    c.Request.UserAgent(),
+		IPAddress:    c.ClientIP(),
+	})
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := "Error interno del servidor"
+		switch {
+		case errors.Is(err, domain.ErrInvalidRefreshToken),
+			errors.Is(err, domain.ErrRefreshTokenReused),
+			errors.Is(err, domain.ErrSessionRevoked):
+			status = http.StatusUnauthorized
+			message = err.Error()
+		case err.Error() == "el usuario no tiene acceso a este business":
+			status = http.StatusForbidden
+			message = err.Error()
+		case err.Error() == "business no encontrado":
+			status = http.StatusNotFound
+			message = err.Error()
+		default:
+			h.logger.Error(ctx).Err(err).Msg("Error al renovar sesión")
+		}
+		c.JSON(status, response.SessionErrorResponse{
+			Error: message,
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.RefreshSessionSuccessResponse{
+		Success: true,
+		Data:    mapper.ToSessionTokensResponse(tokens),
+	})
+}
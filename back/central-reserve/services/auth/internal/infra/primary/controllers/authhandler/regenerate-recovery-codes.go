@@ -0,0 +1,69 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegenerateRecoveryCodesHandler emite nuevos códigos de recuperación
+//
+//	@Summary		Regenerar códigos de recuperación
+//	@Description	Reemplaza los códigos de recuperación del usuario autenticado; los anteriores dejan de servir. Requiere un código TOTP o de recuperación.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.TwoFactorCodeRequest			true	"Código TOTP o de recuperación"
+//	@Success		200		{object}	response.RecoveryCodesSuccessResponse	"Códigos regenerados"
+//	@Failure		400		{object}	response.TwoFactorErrorResponse			"Código inválido"
+//	@Failure		401		{object}	response.TwoFactorErrorResponse			"No autorizado"
+//	@Failure		409		{object}	response.TwoFactorErrorResponse			"Sin segundo factor activo"
+//	@Failure		429		{object}	response.TwoFactorErrorResponse			"Demasiados intentos"
+//	@Failure		500		{object}	response.TwoFactorErrorResponse			"Error interno del servidor"
+//	@Router			/auth/2fa/recovery-codes [post]
+func (h *AuthHandler) RegenerateRecoveryCodesHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "RegenerateRecoveryCodesHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var codeRequest request.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&codeRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.TwoFactorErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, response.TwoFactorErrorResponse{
+			Error: "Usuario no autenticado",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	codes, err := h.usecase.RegenerateRecoveryCodes(ctx, userID, codeRequest.Code)
+	if err != nil {
+		if respondTwoFactorError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("user_id", userID).Msg("Error al regenerar códigos de recuperación")
+		c.JSON(http.StatusInternalServerError, response.TwoFactorErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.RecoveryCodesSuccessResponse{
+		Success:       true,
+		Message:       "Códigos regenerados. Guárdalos: no se volverán a mostrar",
+		RecoveryCodes: codes,
+	})
+}
@@ -0,0 +1,17 @@
+package request
+
+// AccountEmailRequest representa la solicitud de un enlace de cuenta (restablecer contraseña o verificar email)
+type AccountEmailRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetPasswordRequest representa la solicitud para restablecer la contraseña con el token del enlace
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8"`
+}
+
+// VerifyEmailRequest representa la solicitud para verificar el email con el token del enlace
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
@@ -2,8 +2,10 @@ package request
 
 // GenerateAPIKeyRequest representa la solicitud para generar una API Key
 type GenerateAPIKeyRequest struct {
-	UserID      uint   `json:"user_id" binding:"required"`
-	BusinessID  uint   `json:"business_id" binding:"required"`
-	Name        string `json:"name" binding:"required"` // Nombre de referencia de la API Key
-	Description string `json:"description"`             // Descripción opcional
+	UserID      uint     `json:"user_id"`                                         // Usuario de la key; por defecto, el solicitante
+	BusinessID  uint     `json:"business_id"`                                     // Solo super admin; los demás usan el negocio del token
+	Name        string   `json:"name" binding:"required,max=255"`                 // Nombre de referencia de la API Key
+	Description string   `json:"description" binding:"max=500"`                   // Descripción opcional
+	RateLimit   *int     `json:"rate_limit" binding:"omitempty,min=1,max=100000"` // Requests por hora (por defecto 1000)
+	IPWhitelist []string `json:"ip_whitelist"`                                    // IPs o rangos CIDR permitidos; vacía permite cualquier IP
 }
@@ -0,0 +1,12 @@
+package request
+
+// RefreshSessionRequest representa la solicitud para renovar los tokens de una sesión
+type RefreshSessionRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+	BusinessID   *uint  `json:"business_id"` // Opcional: también emite un business token (0 para super admin)
+}
+
+// LogoutRequest representa la solicitud para cerrar una sesión
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
@@ -0,0 +1,34 @@
+package request
+
+// SaveSSOProviderRequest representa la configuración del proveedor OIDC de un negocio
+type SaveSSOProviderRequest struct {
+	BusinessID        uint                    `json:"business_id"` // Solo super admin; los demás usan el negocio del token
+	IssuerURL         string                  `json:"issuer_url" binding:"required,max=500"`
+	ClientID          string                  `json:"client_id" binding:"required,max=255"`
+	ClientSecret      string                  `json:"client_secret" binding:"max=1000"` // Vacío conserva el actual
+	ClearClientSecret bool                    `json:"clear_client_secret"`              // Elimina el secreto (cliente público)
+	RedirectURL       string                  `json:"redirect_url" binding:"required,max=500"`
+	Scopes            string                  `json:"scopes" binding:"max=500"`       // Separados por espacio; siempre incluye openid
+	GroupsClaim       string                  `json:"groups_claim" binding:"max=100"` // Claim del ID token con los grupos (por defecto groups)
+	AllowProvisioning bool                    `json:"allow_provisioning"`             // Crea el usuario en su primer login
+	DefaultRoleID     *uint                   `json:"default_role_id"`                // Rol si ningún grupo coincide
+	IsActive          bool                    `json:"is_active"`
+	RoleMappings      []SSORoleMappingRequest `json:"role_mappings" binding:"dive"` // En orden de prioridad
+}
+
+// SSORoleMappingRequest asigna un rol a los miembros de un grupo del proveedor
+type SSORoleMappingRequest struct {
+	GroupName string `json:"group_name" binding:"required,max=255"`
+	RoleID    uint   `json:"role_id" binding:"required"`
+}
+
+// CompleteSSOLoginRequest representa el code y el state que el proveedor entregó en el redirect
+type CompleteSSOLoginRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+// ConfirmSSOLinkRequest representa la confirmación del vínculo con el proveedor con el token del enlace
+type ConfirmSSOLinkRequest struct {
+	Token string `json:"token" binding:"required"`
+}
@@ -0,0 +1,12 @@
+package request
+
+// VerifyTwoFactorLoginRequest representa el segundo paso del login
+type VerifyTwoFactorLoginRequest struct {
+	TwoFactorToken string `json:"two_factor_token" binding:"required"`
+	Code           string `json:"code" binding:"required"` // Código TOTP o código de recuperación
+}
+
+// TwoFactorCodeRequest representa una solicitud confirmada con un código TOTP o de recuperación
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
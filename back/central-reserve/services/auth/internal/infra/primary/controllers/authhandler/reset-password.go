@@ -0,0 +1,60 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResetPasswordHandler restablece la contraseña con el token del enlace
+//
+//	@Summary		Restablecer contraseña
+//	@Description	Cambia la contraseña con el token recibido por email. El token solo sirve una vez y todas las sesiones del usuario se cierran.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.ResetPasswordRequest	true	"Token y nueva contraseña"
+//	@Success		200		{object}	response.AccountSuccessResponse	"Contraseña restablecida"
+//	@Failure		400		{object}	response.AccountErrorResponse	"Token inválido o vencido, o contraseña inválida"
+//	@Failure		429		{object}	response.AccountErrorResponse	"Demasiadas solicitudes"
+//	@Failure		500		{object}	response.AccountErrorResponse	"Error interno del servidor"
+//	@Router			/auth/reset-password [post]
+func (h *AuthHandler) ResetPasswordHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "ResetPasswordHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var body request.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, response.AccountErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.ResetPassword(ctx, domain.ResetPasswordRequest{
+		Token:       body.Token,
+		NewPassword: body.NewPassword,
+		IPAddress:   c.ClientIP(),
+	}); err != nil {
+		if respondAccountError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Msg("Error al restablecer contraseña")
+		c.JSON(http.StatusInternalServerError, response.AccountErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.AccountSuccessResponse{
+		Success: true,
+		Message: "Contraseña restablecida exitosamente. Inicia sesión con tu nueva contraseña",
+	})
+}
@@ -0,0 +1,13 @@
+package response
+
+// AccountSuccessResponse representa la respuesta exitosa de los flujos de cuenta
+type AccountSuccessResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// AccountErrorResponse representa la respuesta de error de los flujos de cuenta
+type AccountErrorResponse struct {
+	Error   string `json:"error"`
+	Details string `json:"details,omitempty"`
+}
@@ -0,0 +1,32 @@
+package response
+
+import "time"
+
+// APIKeyResponse representa una API Key sin su secreto
+type APIKeyResponse struct {
+	ID          uint       `json:"id"`
+	KeyPrefix   string     `json:"key_prefix"`
+	UserID      uint       `json:"user_id"`
+	BusinessID  uint       `json:"business_id"`
+	CreatedByID uint       `json:"created_by_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	RateLimit   int        `json:"rate_limit"`
+	IPWhitelist []string   `json:"ip_whitelist"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	Revoked     bool       `json:"revoked"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListAPIKeysSuccessResponse representa la lista de API Keys de un negocio
+type ListAPIKeysSuccessResponse struct {
+	Success bool             `json:"success"`
+	Data    []APIKeyResponse `json:"data"`
+}
+
+// RevokeAPIKeySuccessResponse representa la respuesta de revocación de una API Key
+type RevokeAPIKeySuccessResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
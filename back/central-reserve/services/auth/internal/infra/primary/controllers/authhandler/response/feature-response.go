@@ -0,0 +1,18 @@
+package response
+
+// BusinessFeaturesResponse representa los módulos habilitados de un negocio
+type BusinessFeaturesResponse struct {
+	BusinessID uint     `json:"business_id"`
+	Features   []string `json:"features"`
+}
+
+// BusinessFeaturesSuccessResponse representa la respuesta exitosa de los módulos del negocio
+type BusinessFeaturesSuccessResponse struct {
+	Success bool                     `json:"success"`
+	Data    BusinessFeaturesResponse `json:"data"`
+}
+
+// BusinessFeaturesErrorResponse representa la respuesta de error de los módulos del negocio
+type BusinessFeaturesErrorResponse struct {
+	Error string `json:"error"`
+}
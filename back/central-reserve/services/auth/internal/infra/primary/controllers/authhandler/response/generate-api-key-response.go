@@ -2,16 +2,20 @@ package response
 
 import "time"
 
-// GenerateAPIKeyResponse representa la respuesta de generación de API Key
+// GenerateAPIKeyResponse representa la respuesta de generación o rotación de API Key.
+// APIKey solo se muestra en esta respuesta.
 type GenerateAPIKeyResponse struct {
 	Success     bool      `json:"success"`
 	Message     string    `json:"message"`
 	APIKey      string    `json:"api_key"`
+	ID          uint      `json:"id"`
+	KeyPrefix   string    `json:"key_prefix"`
 	UserID      uint      `json:"user_id"`
 	BusinessID  uint      `json:"business_id"`
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
 	RateLimit   int       `json:"rate_limit"`
+	IPWhitelist []string  `json:"ip_whitelist"`
 	CreatedAt   time.Time `json:"created_at"`
 }
 
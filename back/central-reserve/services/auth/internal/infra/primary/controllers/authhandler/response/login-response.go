@@ -6,21 +6,29 @@ import "time"
 type LoginResponse struct {
 	User                  UserInfo       `json:"user"`
 	Token                 string         `json:"token"`
+	TokenExpiresAt        time.Time      `json:"token_expires_at"`
+	RefreshToken          string         `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time      `json:"refresh_token_expires_at"`
 	RequirePasswordChange bool           `json:"require_password_change"`
 	Businesses            []BusinessInfo `json:"businesses"`
 	Scope                 string         `json:"scope"`          // Scope del usuario (platform, business, etc.)
 	IsSuperAdmin          bool           `json:"is_super_admin"` // Indica si es super admin (scope platform o scope_id 1)
+	// Segundo paso del login: si alguno es true, no se emiten tokens y se debe usar two_factor_token
+	TwoFactorRequired           bool   `json:"two_factor_required"`
+	TwoFactorEnrollmentRequired bool   `json:"two_factor_enrollment_required"`
+	TwoFactorToken              string `json:"two_factor_token,omitempty"`
 }
 
 // UserInfo representa la información del usuario en la respuesta
 type UserInfo struct {
-	ID          uint       `json:"id"`
-	Name        string     `json:"name"`
-	Email       string     `json:"email"`
-	Phone       string     `json:"phone"`
-	AvatarURL   string     `json:"avatar_url"`
-	IsActive    bool       `json:"is_active"`
-	LastLoginAt *time.Time `json:"last_login_at"`
+	ID            uint       `json:"id"`
+	Name          string     `json:"name"`
+	Email         string     `json:"email"`
+	Phone         string     `json:"phone"`
+	AvatarURL     string     `json:"avatar_url"`
+	IsActive      bool       `json:"is_active"`
+	LastLoginAt   *time.Time `json:"last_login_at"`
+	EmailVerified bool       `json:"email_verified"`
 }
 
 // RoleInfo representa la información del rol en la respuesta
@@ -0,0 +1,29 @@
+package response
+
+import "time"
+
+// SessionTokensResponse representa los tokens emitidos al renovar una sesión
+type SessionTokensResponse struct {
+	Token                 string    `json:"token"`
+	TokenExpiresAt        time.Time `json:"token_expires_at"`
+	BusinessToken         string    `json:"business_token,omitempty"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+// RefreshSessionSuccessResponse representa la respuesta exitosa de renovar una sesión
+type RefreshSessionSuccessResponse struct {
+	Success bool                  `json:"success"`
+	Data    SessionTokensResponse `json:"data"`
+}
+
+// LogoutSuccessResponse representa la respuesta exitosa de cerrar una sesión
+type LogoutSuccessResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// SessionErrorResponse representa la respuesta de error de sesiones
+type SessionErrorResponse struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,58 @@
+package response
+
+import "time"
+
+// SSOAuthorizationResponse representa la URL del proveedor a la que se redirige al usuario
+type SSOAuthorizationResponse struct {
+	AuthorizationURL string    `json:"authorization_url"`
+	ExpiresAt        time.Time `json:"expires_at"` // Límite para completar el login en el proveedor
+}
+
+// SSOAuthorizationSuccessResponse representa la respuesta exitosa de iniciar el login por SSO
+type SSOAuthorizationSuccessResponse struct {
+	Success bool                     `json:"success"`
+	Data    SSOAuthorizationResponse `json:"data"`
+}
+
+// SSORoleMappingResponse representa el rol asignado a un grupo del proveedor
+type SSORoleMappingResponse struct {
+	GroupName string `json:"group_name"`
+	RoleID    uint   `json:"role_id"`
+	Priority  int    `json:"priority"`
+}
+
+// SSOProviderResponse representa el proveedor OIDC de un negocio sin su secreto
+type SSOProviderResponse struct {
+	ID                uint                     `json:"id"`
+	BusinessID        uint                     `json:"business_id"`
+	IssuerURL         string                   `json:"issuer_url"`
+	ClientID          string                   `json:"client_id"`
+	HasClientSecret   bool                     `json:"has_client_secret"`
+	RedirectURL       string                   `json:"redirect_url"`
+	Scopes            string                   `json:"scopes"`
+	GroupsClaim       string                   `json:"groups_claim"`
+	AllowProvisioning bool                     `json:"allow_provisioning"`
+	DefaultRoleID     *uint                    `json:"default_role_id"`
+	IsActive          bool                     `json:"is_active"`
+	RoleMappings      []SSORoleMappingResponse `json:"role_mappings"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
+}
+
+// SSOProviderSuccessResponse representa la respuesta exitosa con el proveedor del negocio
+type SSOProviderSuccessResponse struct {
+	Success bool                `json:"success"`
+	Data    SSOProviderResponse `json:"data"`
+}
+
+// DeleteSSOProviderSuccessResponse representa la respuesta de eliminar el proveedor del negocio
+type DeleteSSOProviderSuccessResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// SSOErrorResponse representa un error del inicio de sesión único
+type SSOErrorResponse struct {
+	Error   string `json:"error"`
+	Details string `json:"details,omitempty"`
+}
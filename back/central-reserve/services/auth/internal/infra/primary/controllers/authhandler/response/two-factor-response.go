@@ -0,0 +1,62 @@
+package response
+
+import "time"
+
+// TwoFactorStatusResponse representa el estado del segundo factor del usuario
+type TwoFactorStatusResponse struct {
+	Enabled           bool       `json:"enabled"`
+	Required          bool       `json:"required"` // Algún rol del usuario lo exige
+	EnabledAt         *time.Time `json:"enabled_at"`
+	RecoveryCodesLeft int64      `json:"recovery_codes_left"`
+}
+
+// TwoFactorStatusSuccessResponse representa la respuesta exitosa del estado del segundo factor
+type TwoFactorStatusSuccessResponse struct {
+	Success bool                    `json:"success"`
+	Data    TwoFactorStatusResponse `json:"data"`
+}
+
+// TwoFactorEnrollmentResponse representa el secreto a registrar en la app TOTP
+type TwoFactorEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// para generar el código QR
+}
+
+// TwoFactorEnrollmentSuccessResponse representa la respuesta exitosa de iniciar la inscripción
+type TwoFactorEnrollmentSuccessResponse struct {
+	Success bool                        `json:"success"`
+	Data    TwoFactorEnrollmentResponse `json:"data"`
+}
+
+// EnableTwoFactorResponse representa los códigos de recuperación y, si la inscripción fue durante el
+// login, los datos de la sesión iniciada
+type EnableTwoFactorResponse struct {
+	RecoveryCodes []string       `json:"recovery_codes"`
+	Login         *LoginResponse `json:"login,omitempty"`
+}
+
+// EnableTwoFactorSuccessResponse representa la respuesta exitosa de activar el segundo factor
+type EnableTwoFactorSuccessResponse struct {
+	Success bool                    `json:"success"`
+	Message string                  `json:"message"`
+	Data    EnableTwoFactorResponse `json:"data"`
+}
+
+// RecoveryCodesSuccessResponse representa los códigos de recuperación regenerados
+type RecoveryCodesSuccessResponse struct {
+	Success       bool     `json:"success"`
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorSuccessResponse representa una respuesta exitosa sin datos
+type TwoFactorSuccessResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// TwoFactorErrorResponse representa la respuesta de error del segundo factor
+type TwoFactorErrorResponse struct {
+	Error   string `json:"error"`
+	Details string `json:"details,omitempty"`
+}
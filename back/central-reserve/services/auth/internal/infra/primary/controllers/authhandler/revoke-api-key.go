@@ -0,0 +1,61 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RevokeAPIKeyHandler revoca una API Key
+//
+//	@Summary		Revocar API Key
+//	@Description	Revoca la API Key; deja de aceptarse de inmediato. Revocar una key ya revocada no es un error.
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int										true	"ID de la API Key"
+//	@Success		200	{object}	response.RevokeAPIKeySuccessResponse	"API Key revocada"
+//	@Failure		400	{object}	response.GenerateAPIKeyErrorResponse		"ID inválido"
+//	@Failure		401	{object}	response.GenerateAPIKeyErrorResponse		"No autorizado"
+//	@Failure		404	{object}	response.GenerateAPIKeyErrorResponse		"API Key no encontrada"
+//	@Failure		500	{object}	response.GenerateAPIKeyErrorResponse		"Error interno del servidor"
+//	@Router			/auth/api-keys/{id} [delete]
+func (h *AuthHandler) RevokeAPIKeyHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "RevokeAPIKeyHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	apiKeyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || apiKeyID == 0 {
+		c.JSON(http.StatusBadRequest, response.GenerateAPIKeyErrorResponse{
+			Error: "ID de API Key inválido",
+		})
+		return
+	}
+
+	// 2. Negocio ─────────────────────────────────────────────
+	businessID, ok := resolveAPIKeyBusiness(c, 0)
+	if !ok {
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.RevokeAPIKey(ctx, uint(apiKeyID), businessID); err != nil {
+		if respondAPIKeyError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint64("api_key_id", apiKeyID).Msg("Error al revocar API Key")
+		c.JSON(http.StatusInternalServerError, response.GenerateAPIKeyErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.RevokeAPIKeySuccessResponse{
+		Success: true,
+		Message: "API Key revocada exitosamente",
+	})
+}
@@ -0,0 +1,72 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RotateAPIKeyHandler reemplaza una API Key por otra nueva
+//
+//	@Summary		Rotar API Key
+//	@Description	Revoca la API Key y genera otra con el mismo usuario, nombre, límite e IPs permitidas. La nueva key solo se muestra en esta respuesta.
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int										true	"ID de la API Key"
+//	@Success		201	{object}	response.GenerateAPIKeySuccessResponse	"API Key rotada exitosamente"
+//	@Failure		400	{object}	response.GenerateAPIKeyErrorResponse		"ID inválido"
+//	@Failure		401	{object}	response.GenerateAPIKeyErrorResponse		"No autorizado"
+//	@Failure		404	{object}	response.GenerateAPIKeyErrorResponse		"API Key no encontrada"
+//	@Failure		409	{object}	response.GenerateAPIKeyErrorResponse		"La API Key ya está revocada"
+//	@Failure		422	{object}	response.GenerateAPIKeyErrorResponse		"El usuario de la key está inactivo o ya no pertenece al negocio"
+//	@Failure		500	{object}	response.GenerateAPIKeyErrorResponse		"Error interno del servidor"
+//	@Router			/auth/api-keys/{id}/rotate [post]
+func (h *AuthHandler) RotateAPIKeyHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "RotateAPIKeyHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	apiKeyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || apiKeyID == 0 {
+		c.JSON(http.StatusBadRequest, response.GenerateAPIKeyErrorResponse{
+			Error: "ID de API Key inválido",
+		})
+		return
+	}
+	requesterID, _ := middleware.GetUserID(c)
+
+	// 2. Negocio ─────────────────────────────────────────────
+	businessID, ok := resolveAPIKeyBusiness(c, 0)
+	if !ok {
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	domainResponse, err := h.usecase.RotateAPIKey(ctx, domain.RotateAPIKeyRequest{
+		APIKeyID:    uint(apiKeyID),
+		BusinessID:  businessID,
+		RequesterID: requesterID,
+	})
+	if err != nil {
+		if respondAPIKeyError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint64("api_key_id", apiKeyID).Msg("Error al rotar API Key")
+		c.JSON(http.StatusInternalServerError, response.GenerateAPIKeyErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusCreated, response.GenerateAPIKeySuccessResponse{
+		Success: true,
+		Data:    mapper.ToGenerateAPIKeyResponse(domainResponse),
+	})
+}
@@ -11,10 +11,41 @@ func (h *AuthHandler) RegisterRoutes(v1Group *gin.RouterGroup, handler IAuthHand
 	authGroup := v1Group.Group("/auth")
 	{
 		authGroup.POST("/login", handler.LoginHandler)
+		authGroup.POST("/login/2fa", handler.VerifyTwoFactorLoginHandler)
+		authGroup.POST("/refresh", handler.RefreshSessionHandler)
+		authGroup.POST("/logout", handler.LogoutHandler)
+		authGroup.POST("/forgot-password", handler.ForgotPasswordHandler)
+		authGroup.POST("/reset-password", handler.ResetPasswordHandler)
+		authGroup.POST("/verify-email/request", handler.RequestEmailVerificationHandler)
+		authGroup.POST("/verify-email", handler.VerifyEmailHandler)
 		authGroup.GET("/verify", middleware.JWT(), handler.VerifyHandler)
 		authGroup.GET("/roles-permissions", middleware.JWT(), handler.GetUserRolesPermissionsHandler)
+		authGroup.GET("/features", middleware.Auto(), handler.GetBusinessFeaturesHandler)
 		authGroup.POST("/change-password", middleware.JWT(), handler.ChangePasswordHandler)
 		authGroup.POST("/generate-password", middleware.JWT(), handler.GeneratePasswordHandler)
 		authGroup.POST("/business-token", middleware.BusinessTokenAuth(), handler.GenerateBusinessTokenHandler)
+
+		// Segundo factor: la inscripción también acepta el token intermedio del login
+		authGroup.GET("/2fa", middleware.JWT(), handler.GetTwoFactorStatusHandler)
+		authGroup.POST("/2fa/enroll", middleware.TwoFactorEnrollmentAuth(), handler.EnrollTwoFactorHandler)
+		authGroup.POST("/2fa/enable", middleware.TwoFactorEnrollmentAuth(), handler.EnableTwoFactorHandler)
+		authGroup.POST("/2fa/disable", middleware.JWT(), handler.DisableTwoFactorHandler)
+		authGroup.POST("/2fa/recovery-codes", middleware.JWT(), handler.RegenerateRecoveryCodesHandler)
+
+		// API Keys: las gestiona quien puede configurar el negocio
+		configure := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionConfigure)
+		authGroup.GET("/api-keys/validate", middleware.APIKey(), handler.ValidateAPIKeyHandler)
+		authGroup.POST("/api-keys", middleware.JWT(), configure, handler.GenerateAPIKeyHandler)
+		authGroup.GET("/api-keys", middleware.JWT(), configure, handler.ListAPIKeysHandler)
+		authGroup.POST("/api-keys/:id/rotate", middleware.JWT(), configure, handler.RotateAPIKeyHandler)
+		authGroup.DELETE("/api-keys/:id", middleware.JWT(), configure, handler.RevokeAPIKeyHandler)
+
+		// Inicio de sesión único (OIDC): el login es público; la configuración, de quien configura el negocio
+		authGroup.GET("/sso/authorize", handler.BeginSSOLoginHandler)
+		authGroup.POST("/sso/callback", handler.CompleteSSOLoginHandler)
+		authGroup.POST("/sso/link", handler.ConfirmSSOLinkHandler)
+		authGroup.GET("/sso/provider", middleware.JWT(), configure, handler.GetSSOProviderHandler)
+		authGroup.PUT("/sso/provider", middleware.JWT(), configure, handler.SaveSSOProviderHandler)
+		authGroup.DELETE("/sso/provider", middleware.JWT(), configure, handler.DeleteSSOProviderHandler)
 	}
 }
@@ -0,0 +1,66 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SaveSSOProviderHandler crea o reemplaza el proveedor OIDC del negocio
+//
+//	@Summary		Configurar proveedor SSO
+//	@Description	Crea o reemplaza el proveedor OpenID Connect del negocio. Se consulta el discovery del emisor antes de guardar. El client secret se guarda cifrado; enviarlo vacío conserva el actual y clear_client_secret lo elimina (cliente público con PKCE). role_mappings asigna roles del negocio por grupo del proveedor, en orden de prioridad; default_role_id se usa si ningún grupo coincide. Super admin debe indicar business_id.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		request.SaveSSOProviderRequest		true	"Configuración del proveedor"
+//	@Success		200		{object}	response.SSOProviderSuccessResponse	"Proveedor guardado"
+//	@Failure		400		{object}	response.SSOErrorResponse			"Configuración inválida"
+//	@Failure		401		{object}	response.SSOErrorResponse			"No autorizado"
+//	@Failure		403		{object}	response.SSOErrorResponse			"Acceso denegado"
+//	@Failure		502		{object}	response.SSOErrorResponse			"No se pudo consultar el discovery del emisor"
+//	@Failure		500		{object}	response.SSOErrorResponse			"Error interno del servidor"
+//	@Router			/auth/sso/provider [put]
+func (h *AuthHandler) SaveSSOProviderHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "SaveSSOProviderHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var providerRequest request.SaveSSOProviderRequest
+	if err := c.ShouldBindJSON(&providerRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.SSOErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// 2. Negocio ─────────────────────────────────────────────
+	businessID, ok := resolveSSOBusiness(c, providerRequest.BusinessID)
+	if !ok {
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	provider, err := h.usecase.SaveSSOProvider(ctx, mapper.ToSaveSSOProviderRequest(providerRequest, businessID))
+	if err != nil {
+		if respondSSOError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Uint("business_id", businessID).Msg("Error al guardar proveedor SSO")
+		c.JSON(http.StatusInternalServerError, response.SSOErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.SSOProviderSuccessResponse{
+		Success: true,
+		Data:    mapper.ToSSOProviderResponse(provider),
+	})
+}
@@ -0,0 +1,95 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/services/auth/middleware"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// resolveSSOBusiness determina el negocio cuyo proveedor se gestiona: el del business token, o el
+// indicado por el super admin. Responde 403 si un usuario de negocio pide otro negocio.
+func resolveSSOBusiness(c *gin.Context, requested uint) (uint, bool) {
+	if middleware.IsSuperAdmin(c) {
+		if requested == 0 {
+			c.JSON(http.StatusBadRequest, response.SSOErrorResponse{
+				Error: "business_id es requerido",
+			})
+			return 0, false
+		}
+		return requested, true
+	}
+
+	businessID, ok := middleware.GetBusinessIDFromContext(c)
+	if !ok || businessID == 0 {
+		c.JSON(http.StatusUnauthorized, response.SSOErrorResponse{
+			Error: "Token inválido o no autorizado",
+		})
+		return 0, false
+	}
+	if requested != 0 && requested != businessID {
+		c.JSON(http.StatusForbidden, response.SSOErrorResponse{
+			Error: "Acceso denegado: solo puedes configurar el inicio de sesión único de tu negocio",
+		})
+		return 0, false
+	}
+	return businessID, true
+}
+
+// requestedBusinessID lee el business_id opcional de la query (solo super admin)
+func requestedBusinessID(c *gin.Context) (uint, bool) {
+	raw := c.Query("business_id")
+	if raw == "" {
+		return 0, true
+	}
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.SSOErrorResponse{
+			Error: "business_id inválido",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondSSOError responde los errores conocidos del inicio de sesión único; retorna false si el error es interno
+func respondSSOError(c *gin.Context, err error) bool {
+	var blocked *domain.LoginBlockedError
+	if errors.As(err, &blocked) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, response.SSOErrorResponse{
+			Error: blocked.Error(),
+		})
+		return true
+	}
+
+	status := 0
+	switch {
+	case errors.Is(err, domain.ErrSSONotConfigured):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrSSOInvalidConfig), errors.Is(err, domain.ErrSSOInvalidState):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrSSOIdentityRejected):
+		status = http.StatusUnauthorized
+	case errors.Is(err, domain.ErrSSOEmailNotVerified), errors.Is(err, domain.ErrSSOAccessDenied):
+		status = http.StatusForbidden
+	case errors.Is(err, domain.ErrSSOLinkRequired):
+		status = http.StatusConflict
+	case errors.Is(err, domain.ErrSSOProviderUnavailable):
+		status = http.StatusBadGateway
+	case err.Error() == "usuario inactivo":
+		status = http.StatusForbidden
+	default:
+		return false
+	}
+
+	c.JSON(status, response.SSOErrorResponse{
+		Error: err.Error(),
+	})
+	return true
+}
@@ -0,0 +1,38 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondTwoFactorError responde los errores conocidos del segundo factor; retorna false si el error es interno
+func respondTwoFactorError(c *gin.Context, err error) bool {
+	status := 0
+	switch {
+	case errors.Is(err, domain.ErrTwoFactorAttempts):
+		status = http.StatusTooManyRequests
+		c.Header("Retry-After", strconv.Itoa(int(domain.TwoFactorAttemptWindow.Seconds())))
+	case errors.Is(err, domain.ErrInvalidTwoFactorToken):
+		status = http.StatusUnauthorized
+	case errors.Is(err, domain.ErrInvalidTwoFactorCode):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrTwoFactorNotEnrolled),
+		errors.Is(err, domain.ErrTwoFactorAlreadyEnabled),
+		errors.Is(err, domain.ErrTwoFactorRequiredByRole):
+		status = http.StatusConflict
+	case err.Error() == "usuario no encontrado":
+		status = http.StatusNotFound
+	default:
+		return false
+	}
+
+	c.JSON(status, response.TwoFactorErrorResponse{
+		Error: err.Error(),
+	})
+	return true
+}
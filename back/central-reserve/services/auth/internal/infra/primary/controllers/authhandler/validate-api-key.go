@@ -1,34 +1,41 @@
 package authhandler
 
-// // ValidateAPIKeyHandler maneja la validación de API Keys
-// func (h *AuthHandler) ValidateAPIKeyHandler(c *gin.Context) {
-// 	// Obtener la API Key del header o query parameter
-// 	apiKey := c.GetHeader("X-API-Key")
-// 	if apiKey == "" {
-// 		apiKey = c.Query("api_key")
-// 	}
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/middleware"
+	"net/http"
 
-// 	if apiKey == "" {
-// 		errorResponse := mapper.ToValidateAPIKeyErrorResponse("API Key requerida")
-// 		c.JSON(http.StatusBadRequest, errorResponse)
-// 		return
-// 	}
+	"github.com/gin-gonic/gin"
+)
 
-// 	// Crear la solicitud para el caso de uso
-// 	request := domain.ValidateAPIKeyRequest{
-// 		APIKey: apiKey,
-// 	}
+// ValidateAPIKeyHandler retorna la información de la API Key con la que se autenticó la request.
+// La validación, el límite por hora y las IPs permitidas los aplica middleware.APIKey().
+//
+//	@Summary		Validar API Key
+//	@Description	Verifica la API Key enviada en el header X-API-Key y retorna el usuario y negocio asociados. Cuenta para el límite de requests de la key.
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			X-API-Key	header		string									true	"API Key"
+//	@Success		200			{object}	response.ValidateAPIKeySuccessResponse	"API Key válida"
+//	@Failure		401			{object}	map[string]interface{}					"API Key inválida o revocada"
+//	@Failure		403			{object}	map[string]interface{}					"IP no permitida"
+//	@Failure		429			{object}	map[string]interface{}					"Límite de requests excedido"
+//	@Router			/auth/api-keys/validate [get]
+func (h *AuthHandler) ValidateAPIKeyHandler(c *gin.Context) {
+	authInfo, exists := middleware.GetAuthInfo(c)
+	if !exists || authInfo.Type != middleware.AuthTypeAPIKey {
+		c.JSON(http.StatusUnauthorized, mapper.ToValidateAPIKeyErrorResponse("API Key requerida"))
+		return
+	}
 
-// 	// Llamar al caso de uso
-// 	domainResponse, err := h.usecase.ValidateAPIKey(c.Request.Context(), request)
-// 	if err != nil {
-// 		h.logger.Error().Err(err).Str("api_key", apiKey[:10]+"...").Msg("Error al validar API Key")
-// 		errorResponse := mapper.ToValidateAPIKeyErrorResponse(err.Error())
-// 		c.JSON(http.StatusUnauthorized, errorResponse)
-// 		return
-// 	}
-
-// 	// Convertir la respuesta del dominio a la respuesta HTTP
-// 	httpResponse := mapper.ToValidateAPIKeySuccessResponse(domainResponse)
-// 	c.JSON(http.StatusOK, httpResponse)
-// }
+	c.JSON(http.StatusOK, mapper.ToValidateAPIKeySuccessResponse(&domain.ValidateAPIKeyResponse{
+		Success:    true,
+		Message:    "API Key válida",
+		UserID:     authInfo.UserID,
+		Email:      authInfo.Email,
+		BusinessID: authInfo.BusinessID,
+		Roles:      authInfo.Roles,
+		APIKeyID:   authInfo.APIKeyID,
+	}))
+}
@@ -0,0 +1,59 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyEmailHandler verifica el email con el token del enlace
+//
+//	@Summary		Verificar email
+//	@Description	Marca el email del usuario como verificado con el token recibido por email. El token solo sirve una vez.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.VerifyEmailRequest		true	"Token del enlace"
+//	@Success		200		{object}	response.AccountSuccessResponse	"Email verificado"
+//	@Failure		400		{object}	response.AccountErrorResponse	"Token inválido o vencido"
+//	@Failure		429		{object}	response.AccountErrorResponse	"Demasiadas solicitudes"
+//	@Failure		500		{object}	response.AccountErrorResponse	"Error interno del servidor"
+//	@Router			/auth/verify-email [post]
+func (h *AuthHandler) VerifyEmailHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "VerifyEmailHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var body request.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, response.AccountErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.VerifyEmail(ctx, domain.VerifyEmailRequest{
+		Token:     body.Token,
+		IPAddress: c.ClientIP(),
+	}); err != nil {
+		if respondAccountError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Msg("Error al verificar email")
+		c.JSON(http.StatusInternalServerError, response.AccountErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.AccountSuccessResponse{
+		Success: true,
+		Message: "Email verificado exitosamente",
+	})
+}
@@ -0,0 +1,64 @@
+package authhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/mapper"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/authhandler/response"
+	"central_reserve/shared/log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyTwoFactorLoginHandler completa el login de un usuario con segundo factor
+//
+//	@Summary		Completar login con segundo factor
+//	@Description	Canjea el two_factor_token del login y un código TOTP (o un código de recuperación, que se consume) por los tokens de la sesión. Un mismo código TOTP no se acepta dos veces.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		request.VerifyTwoFactorLoginRequest	true	"Token intermedio y código"
+//	@Success		200		{object}	response.LoginSuccessResponse		"Login exitoso"
+//	@Failure		400		{object}	response.TwoFactorErrorResponse		"Código inválido"
+//	@Failure		401		{object}	response.TwoFactorErrorResponse		"Token de segundo factor inválido o vencido"
+//	@Failure		429		{object}	response.TwoFactorErrorResponse		"Demasiados intentos"
+//	@Failure		500		{object}	response.TwoFactorErrorResponse		"Error interno del servidor"
+//	@Router			/auth/login/2fa [post]
+func (h *AuthHandler) VerifyTwoFactorLoginHandler(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "VerifyTwoFactorLoginHandler")
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var verifyRequest request.VerifyTwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&verifyRequest); err != nil {
+		c.JSON(http.StatusBadRequest, response.TwoFactorErrorResponse{
+			Error:   "Datos de entrada inválidos",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	domainResponse, err := h.usecase.VerifyTwoFactorLogin(ctx, domain.VerifyTwoFactorLoginRequest{
+		TwoFactorToken: verifyRequest.TwoFactorToken,
+		Code:           verifyRequest.Code,
+		UserAgent:      c.Request.UserAgent(),
+		IPAddress:      c.ClientIP(),
+	})
+	if err != nil {
+		if respondTwoFactorError(c, err) {
+			return
+		}
+		h.logger.Error(ctx).Err(err).Msg("Error al completar login con segundo factor")
+		c.JSON(http.StatusInternalServerError, response.TwoFactorErrorResponse{
+			Error: "Error interno del servidor",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.LoginSuccessResponse{
+		Success: true,
+		Data:    *mapper.ToLoginResponse(domainResponse),
+	})
+}
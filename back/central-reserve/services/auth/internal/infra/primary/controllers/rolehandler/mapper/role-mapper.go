@@ -23,6 +23,7 @@ func ToRoleResponse(dto domain.RoleDTO) response.RoleResponse {
 		Description:      dto.Description,
 		Level:            dto.Level,
 		IsSystem:         dto.IsSystem,
+		RequireTwoFactor: dto.RequireTwoFactor,
 		ScopeID:          dto.ScopeID,
 		ScopeName:        dto.ScopeName,
 		ScopeCode:        dto.ScopeCode,
@@ -8,6 +8,7 @@ type RoleResponse struct {
 	Description      string `json:"description" example:"Rol de administrador del sistema"`
 	Level            int    `json:"level" example:"2"`
 	IsSystem         bool   `json:"is_system" example:"true"`
+	RequireTwoFactor bool   `json:"require_two_factor" example:"false"`
 	ScopeID          uint   `json:"scope_id" example:"1"`
 	ScopeName        string `json:"scope_name" example:"Sistema"`
 	ScopeCode        string `json:"scope_code" example:"system"`
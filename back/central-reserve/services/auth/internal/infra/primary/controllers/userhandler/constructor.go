@@ -15,6 +15,9 @@ type IUserHandler interface {
 	UpdateUserHandler(c *gin.Context)
 	DeleteUserHandler(c *gin.Context)
 	AssignRoleToUserBusinessHandler(c *gin.Context)
+	RevokeUserSessionsHandler(c *gin.Context)
+	ResetUserTwoFactorHandler(c *gin.Context)
+	UnlockUserHandler(c *gin.Context)
 	RegisterRoutes(router *gin.RouterGroup, handler IUserHandler, logger log.ILogger)
 }
 
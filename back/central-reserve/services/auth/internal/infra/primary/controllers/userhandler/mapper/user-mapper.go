@@ -132,6 +132,7 @@ func ToUserResponse(dto domain.UserDTO) response.UserResponse {
 		IsActive:                dto.IsActive,
 		IsSuperUser:             dto.IsSuperUser,
 		LastLoginAt:             dto.LastLoginAt,
+		LockedUntil:             dto.LockedUntil,
 		BusinessRoleAssignments: businessRoleAssignments,
 		CreatedAt:               dto.CreatedAt,
 		UpdatedAt:               dto.UpdatedAt,
@@ -0,0 +1,6 @@
+package request
+
+// ResetUserTwoFactorRequest representa la solicitud para restablecer el segundo factor de un usuario
+type ResetUserTwoFactorRequest struct {
+	ID uint `uri:"id" binding:"required,min=1"`
+}
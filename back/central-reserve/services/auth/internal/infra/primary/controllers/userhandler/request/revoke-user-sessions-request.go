@@ -0,0 +1,6 @@
+package request
+
+// RevokeUserSessionsRequest representa la solicitud para revocar las sesiones de un usuario
+type RevokeUserSessionsRequest struct {
+	ID uint `uri:"id" binding:"required,min=1"`
+}
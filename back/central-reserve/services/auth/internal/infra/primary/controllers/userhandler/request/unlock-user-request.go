@@ -0,0 +1,6 @@
+package request
+
+// UnlockUserRequest representa la solicitud para desbloquear la cuenta de un usuario
+type UnlockUserRequest struct {
+	ID uint `uri:"id" binding:"required,min=1"`
+}
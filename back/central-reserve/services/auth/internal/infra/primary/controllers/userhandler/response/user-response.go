@@ -54,6 +54,7 @@ type UserResponse struct {
 	IsActive                bool                             `json:"is_active"`
 	IsSuperUser             bool                             `json:"is_super_user"`
 	LastLoginAt             *time.Time                       `json:"last_login_at"`
+	LockedUntil             *time.Time                       `json:"locked_until,omitempty"` // Bloqueo por intentos fallidos
 	BusinessRoleAssignments []BusinessRoleAssignmentResponse `json:"business_role_assignments"`
 	CreatedAt               time.Time                        `json:"created_at"`
 	UpdatedAt               time.Time                        `json:"updated_at"`
@@ -88,6 +89,13 @@ type UserMessageResponse struct {
 	Message string `json:"message"`
 }
 
+// RevokeUserSessionsResponse representa la respuesta al revocar las sesiones de un usuario
+type RevokeUserSessionsResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Revoked int64  `json:"revoked"` // Sesiones que estaban vigentes
+}
+
 // UserErrorResponse representa la respuesta de error para usuarios
 type UserErrorResponse struct {
 	Error string `json:"error"`
@@ -18,5 +18,8 @@ func (h *UserHandler) RegisterRoutes(router *gin.RouterGroup, handler IUserHandl
 		usersGroup.PUT("/:id", middleware.JWT(), handler.UpdateUserHandler)
 		usersGroup.DELETE("/:id", middleware.JWT(), handler.DeleteUserHandler)
 		usersGroup.POST("/:id/assign-role", middleware.JWT(), handler.AssignRoleToUserBusinessHandler)
+		usersGroup.POST("/:id/revoke-sessions", middleware.JWT(), middleware.RequirePermission(middleware.ResourceUsers, middleware.ActionUpdate), handler.RevokeUserSessionsHandler)
+		usersGroup.POST("/:id/reset-2fa", middleware.JWT(), middleware.RequirePermission(middleware.ResourceUsers, middleware.ActionUpdate), handler.ResetUserTwoFactorHandler)
+		usersGroup.POST("/:id/unlock", middleware.JWT(), middleware.RequirePermission(middleware.ResourceUsers, middleware.ActionUpdate), handler.UnlockUserHandler)
 	}
 }
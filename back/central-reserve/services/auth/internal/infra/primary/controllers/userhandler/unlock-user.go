@@ -0,0 +1,79 @@
+package userhandler
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/primary/controllers/userhandler/request"
+	"central_reserve/services/auth/internal/infra/primary/controllers/userhandler/response"
+	"central_reserve/services/auth/middleware"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UnlockUserHandler maneja la solicitud de desbloquear la cuenta de un usuario
+//
+//	@Summary		Desbloquear usuario
+//	@Description	Quita el bloqueo temporal por intentos de login fallidos y reinicia el conteo. Un administrador de negocio solo puede hacerlo con usuarios de su negocio.
+//	@Tags			Users
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int							true	"ID del usuario"	minimum(1)
+//	@Success		200	{object}	response.UserMessageResponse	"Usuario desbloqueado"
+//	@Failure		400	{object}	response.UserErrorResponse		"ID inválido"
+//	@Failure		401	{object}	response.UserErrorResponse		"Token de acceso requerido"
+//	@Failure		403	{object}	response.UserErrorResponse		"Sin permiso"
+//	@Failure		404	{object}	response.UserErrorResponse		"Usuario no encontrado"
+//	@Failure		500	{object}	response.UserErrorResponse		"Error interno del servidor"
+//	@Router			/users/{id}/unlock [post]
+func (h *UserHandler) UnlockUserHandler(c *gin.Context) {
+	var req request.UnlockUserRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		h.logger.Error().Err(err).Msg("Error al validar ID del usuario")
+		c.JSON(http.StatusBadRequest, response.UserErrorResponse{
+			Error: "ID inválido: " + err.Error(),
+		})
+		return
+	}
+
+	// El super admin puede desbloquear a cualquier usuario; los demás, solo a los de su negocio
+	var businessID uint
+	if !middleware.IsSuperAdmin(c) {
+		var ok bool
+		businessID, ok = middleware.GetBusinessIDFromContext(c)
+		if !ok || businessID == 0 {
+			c.JSON(http.StatusUnauthorized, response.UserErrorResponse{
+				Error: "Token inválido o no autorizado",
+			})
+			return
+		}
+	}
+	requesterID, _ := middleware.GetUserID(c)
+
+	err := h.usecase.UnlockUser(c.Request.Context(), domain.UnlockUserRequest{
+		UserID:      req.ID,
+		BusinessID:  businessID,
+		RequesterID: requesterID,
+	})
+	if err != nil {
+		h.logger.Error().Err(err).Uint("id", req.ID).Msg("Error al desbloquear usuario desde el caso de uso")
+
+		statusCode := http.StatusInternalServerError
+		errorMessage := "Error interno del servidor"
+
+		if err.Error() == "usuario no encontrado" {
+			statusCode = http.StatusNotFound
+			errorMessage = "Usuario no encontrado"
+		}
+
+		c.JSON(statusCode, response.UserErrorResponse{
+			Error: errorMessage,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.UserMessageResponse{
+		Success: true,
+		Message: "Usuario desbloqueado exitosamente",
+	})
+}
@@ -0,0 +1,68 @@
+package apikeycache
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	validated domain.ValidateAPIKeyResponse
+	expiresAt time.Time
+}
+
+// Cache es una caché en memoria de API Keys validadas con vencimiento. Nunca guarda la key en
+// claro: se indexa por su hash SHA-256.
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+	ttl     time.Duration
+}
+
+// New crea una caché cuyas entradas vencen tras ttl
+func New(ttl time.Duration) domain.IAPIKeyCache {
+	return &Cache{
+		entries: make(map[string]cacheEntry),
+		ttl:     ttl,
+	}
+}
+
+// Get retorna la validación vigente de una key
+func (c *Cache) Get(keyDigest string) (*domain.ValidateAPIKeyResponse, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[keyDigest]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	validated := entry.validated
+	return &validated, true
+}
+
+// Set guarda la validación de una key
+func (c *Cache) Set(keyDigest string, validated domain.ValidateAPIKeyResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	// Purga perezosa para que las keys que dejan de usarse no se acumulen
+	for digest, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, digest)
+		}
+	}
+	c.entries[keyDigest] = cacheEntry{
+		validated: validated,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+// Invalidate descarta la validación de una API Key (revocada o rotada)
+func (c *Cache) Invalidate(apiKeyID uint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for digest, entry := range c.entries {
+		if entry.validated.APIKeyID == apiKeyID {
+			delete(c.entries, digest)
+		}
+	}
+}
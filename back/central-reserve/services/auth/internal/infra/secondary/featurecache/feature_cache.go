@@ -0,0 +1,55 @@
+package featurecache
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	features  domain.FeatureSet
+	expiresAt time.Time
+}
+
+// Cache es una caché en memoria de las funcionalidades de cada negocio con vencimiento
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[uint]cacheEntry
+	ttl     time.Duration
+}
+
+// New crea una caché cuyas entradas vencen tras ttl
+func New(ttl time.Duration) domain.IFeatureCache {
+	return &Cache{
+		entries: make(map[uint]cacheEntry),
+		ttl:     ttl,
+	}
+}
+
+// Get retorna las funcionalidades vigentes de un negocio
+func (c *Cache) Get(businessID uint) (domain.FeatureSet, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[businessID]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.features, true
+}
+
+// Set guarda las funcionalidades de un negocio
+func (c *Cache) Set(businessID uint, features domain.FeatureSet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[businessID] = cacheEntry{
+		features:  features,
+		expiresAt: time.Now().Add(c.ttl),
+	}
+}
+
+// Invalidate descarta las funcionalidades de un negocio
+func (c *Cache) Invalidate(businessID uint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, businessID)
+}
@@ -0,0 +1,371 @@
+package oidcclient
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// idTokenMethods son los algoritmos aceptados para el ID token; HS256 se excluye porque se firmaría
+// con el client secret, y none nunca es válido
+var idTokenMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// maxResponseBytes acota las respuestas del proveedor
+const maxResponseBytes = 1 << 20
+
+type cachedMetadata struct {
+	metadata  domain.OIDCProviderMetadata
+	expiresAt time.Time
+}
+
+type cachedKeys struct {
+	keys      map[string]crypto.PublicKey
+	expiresAt time.Time
+}
+
+// Client es un relying party OIDC: discovery, canje del código y validación del ID token. El discovery
+// y las claves se guardan en memoria por instancia.
+type Client struct {
+	httpClient *http.Client
+	ttl        time.Duration
+
+	mu       sync.Mutex
+	metadata map[string]cachedMetadata
+	keys     map[string]cachedKeys
+}
+
+func New(ttl time.Duration) domain.IOIDCClient {
+	return &Client{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		ttl:        ttl,
+		metadata:   make(map[string]cachedMetadata),
+		keys:       make(map[string]cachedKeys),
+	}
+}
+
+// Discover obtiene los endpoints del proveedor desde /.well-known/openid-configuration
+func (c *Client) Discover(ctx context.Context, issuerURL string) (*domain.OIDCProviderMetadata, error) {
+	issuer := strings.TrimRight(issuerURL, "/")
+
+	c.mu.Lock()
+	cached, ok := c.metadata[issuer]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		metadata := cached.metadata
+		return &metadata, nil
+	}
+
+	var document struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	if err := c.getJSON(ctx, issuer+"/.well-known/openid-configuration", &document); err != nil {
+		return nil, err
+	}
+	// El emisor publicado debe ser el configurado: evita que otro proveedor suplante al del negocio
+	if strings.TrimRight(document.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("%w: el discovery informa el emisor %q", domain.ErrSSOInvalidConfig, document.Issuer)
+	}
+	if document.AuthorizationEndpoint == "" || document.TokenEndpoint == "" || document.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: el discovery no publica los endpoints requeridos", domain.ErrSSOInvalidConfig)
+	}
+
+	metadata := domain.OIDCProviderMetadata{
+		Issuer:                document.Issuer,
+		AuthorizationEndpoint: document.AuthorizationEndpoint,
+		TokenEndpoint:         document.TokenEndpoint,
+		JWKSURI:               document.JWKSURI,
+	}
+	c.mu.Lock()
+	c.metadata[issuer] = cachedMetadata{metadata: metadata, expiresAt: time.Now().Add(c.ttl)}
+	c.mu.Unlock()
+	return &metadata, nil
+}
+
+// ExchangeCode canjea el código de autorización y retorna el ID token. Con secreto se autentica con
+// client_secret_basic; sin él, el verificador PKCE es la única prueba (cliente público).
+func (c *Client) ExchangeCode(ctx context.Context, metadata *domain.OIDCProviderMetadata, exchange domain.OIDCCodeExchange) (string, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {exchange.Code},
+		"redirect_uri":  {exchange.RedirectURL},
+		"code_verifier": {exchange.CodeVerifier},
+	}
+	if exchange.ClientSecret == "" {
+		form.Set("client_id", exchange.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", domain.ErrSSOInvalidConfig, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if exchange.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(exchange.ClientID), url.QueryEscape(exchange.ClientSecret))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", domain.ErrSSOProviderUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&body); err != nil {
+		return "", fmt.Errorf("%w: respuesta del token endpoint inválida (HTTP %d)", domain.ErrSSOProviderUnavailable, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK || body.Error != "" {
+		return "", fmt.Errorf("%w: %s", domain.ErrSSOIdentityRejected, strings.TrimSpace(body.Error+" "+body.ErrorDescription))
+	}
+	if body.IDToken == "" {
+		return "", fmt.Errorf("%w: el proveedor no retornó ID token", domain.ErrSSOIdentityRejected)
+	}
+	return body.IDToken, nil
+}
+
+// VerifyIDToken valida firma, emisor, audiencia, expiración y nonce del ID token y extrae los datos
+// del usuario
+func (c *Client) VerifyIDToken(ctx context.Context, metadata *domain.OIDCProviderMetadata, rawIDToken string, check domain.OIDCIDTokenCheck) (*domain.OIDCClaims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(idTokenMethods),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(check.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(domain.OIDCClockSkew),
+	)
+
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return c.signingKey(ctx, metadata.JWKSURI, kid)
+	}); err != nil {
+		if errors.Is(err, domain.ErrSSOProviderUnavailable) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: ID token inválido: %v", domain.ErrSSOIdentityRejected, err)
+	}
+
+	if nonce, _ := claims["nonce"].(string); nonce == "" || nonce != check.Nonce {
+		return nil, fmt.Errorf("%w: nonce inválido", domain.ErrSSOIdentityRejected)
+	}
+	// Con varias audiencias, azp debe ser este cliente
+	if audience, _ := claims.GetAudience(); len(audience) > 1 {
+		if azp, _ := claims["azp"].(string); azp != check.ClientID {
+			return nil, fmt.Errorf("%w: azp inválido", domain.ErrSSOIdentityRejected)
+		}
+	}
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return nil, fmt.Errorf("%w: el ID token no incluye sub", domain.ErrSSOIdentityRejected)
+	}
+
+	email, _ := claims["email"].(string)
+	name, _ := claims["name"].(string)
+	return &domain.OIDCClaims{
+		Issuer:        metadata.Issuer,
+		Subject:       subject,
+		Email:         strings.ToLower(strings.TrimSpace(email)),
+		EmailVerified: claimBool(claims["email_verified"]),
+		Name:          strings.TrimSpace(name),
+		Groups:        claimStrings(claims[check.GroupsClaim]),
+	}, nil
+}
+
+// signingKey busca la clave del kid en el JWKS. Si no está se vuelve a descargar una vez, porque el
+// proveedor pudo rotar sus claves.
+func (c *Client) signingKey(ctx context.Context, jwksURI, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	cached, ok := c.keys[jwksURI]
+	c.mu.Unlock()
+
+	if !ok || time.Now().After(cached.expiresAt) || lookupKey(cached.keys, kid) == nil {
+		keys, err := c.fetchKeys(ctx, jwksURI)
+		if err != nil {
+			return nil, err
+		}
+		cached = cachedKeys{keys: keys, expiresAt: time.Now().Add(c.ttl)}
+		c.mu.Lock()
+		c.keys[jwksURI] = cached
+		c.mu.Unlock()
+	}
+
+	key := lookupKey(cached.keys, kid)
+	if key == nil {
+		return nil, fmt.Errorf("clave de firma %q desconocida", kid)
+	}
+	return key, nil
+}
+
+// lookupKey retorna la clave del kid; sin kid solo sirve si el proveedor publica una única clave
+func lookupKey(keys map[string]crypto.PublicKey, kid string) crypto.PublicKey {
+	if kid != "" {
+		return keys[kid]
+	}
+	if len(keys) == 1 {
+		for _, key := range keys {
+			return key
+		}
+	}
+	return nil
+}
+
+func (c *Client) fetchKeys(ctx context.Context, jwksURI string) (map[string]crypto.PublicKey, error) {
+	var document struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := c.getJSON(ctx, jwksURI, &document); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(document.Keys))
+	for _, jwk := range document.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var (
+			key crypto.PublicKey
+			err error
+		)
+		switch jwk.Kty {
+		case "RSA":
+			key, err = rsaKey(jwk.N, jwk.E)
+		case "EC":
+			key, err = ecKey(jwk.Crv, jwk.X, jwk.Y)
+		default:
+			continue
+		}
+		if err != nil {
+			continue // Una clave mal formada no invalida las demás
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func rsaKey(n, e string) (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, err
+	}
+	exponent, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, err
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(new(big.Int).SetBytes(exponent).Int64()),
+	}, nil
+}
+
+func ecKey(crv, x, y string) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("curva %q no soportada", crv)
+	}
+	xBytes, err := base64.RawURLEncoding.DecodeString(x)
+	if err != nil {
+		return nil, err
+	}
+	yBytes, err := base64.RawURLEncoding.DecodeString(y)
+	if err != nil {
+		return nil, err
+	}
+	key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(xBytes), Y: new(big.Int).SetBytes(yBytes)}
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, fmt.Errorf("punto fuera de la curva")
+	}
+	return key, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, target string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrSSOInvalidConfig, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrSSOProviderUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s respondió HTTP %d", domain.ErrSSOProviderUnavailable, target, resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(out); err != nil {
+		return fmt.Errorf("%w: respuesta inválida de %s", domain.ErrSSOProviderUnavailable, target)
+	}
+	return nil
+}
+
+// claimBool acepta email_verified como booleano o como texto (algunos proveedores lo envían así)
+func claimBool(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return strings.EqualFold(v, "true")
+	}
+	return false
+}
+
+// claimStrings acepta el claim de grupos como lista o como un único texto
+func claimStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []interface{}:
+		groups := make([]string, 0, len(v))
+		for _, item := range v {
+			if group, ok := item.(string); ok && group != "" {
+				groups = append(groups, group)
+			}
+		}
+		return groups
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package permissioncache
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"sync"
+	"time"
+)
+
+type cacheKey struct {
+	roleID     uint
+	businessID uint
+}
+
+type cacheEntry struct {
+	permissions domain.PermissionSet
+	expiresAt   time.Time
+}
+
+// Cache es una caché en memoria de permisos por rol y negocio con vencimiento
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[cacheKey]cacheEntry
+	ttl     time.Duration
+}
+
+// New crea una caché cuyas entradas vencen tras ttl
+func New(ttl time.Duration) domain.IPermissionCache {
+	return &Cache{
+		entries: make(map[cacheKey]cacheEntry),
+		ttl:     ttl,
+	}
+}
+
+// Get retorna los permisos vigentes de un rol en un negocio
+func (c *Cache) Get(roleID, businessID uint) (domain.PermissionSet, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[cacheKey{roleID, businessID}]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.permissions, true
+}
+
+// Set guarda los permisos de un rol en un negocio
+func (c *Cache) Set(roleID, businessID uint, permissions domain.PermissionSet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[cacheKey{roleID, businessID}] = cacheEntry{
+		permissions: permissions,
+		expiresAt:   time.Now().Add(c.ttl),
+	}
+}
+
+// InvalidateRole descarta los permisos de un rol en todos los negocios
+func (c *Cache) InvalidateRole(roleID uint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.roleID == roleID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// InvalidateBusiness descarta los permisos de todos los roles en un negocio (cambian sus recursos activos)
+func (c *Cache) InvalidateBusiness(businessID uint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.businessID == businessID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// InvalidateAll descarta toda la caché (cambios en permisos compartidos por varios roles)
+func (c *Cache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[cacheKey]cacheEntry)
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// CreateAccountToken guarda un token de cuenta e invalida los anteriores del mismo tipo del
+// usuario, de modo que solo el último enlace enviado sirve
+func (r *Repository) CreateAccountToken(ctx context.Context, token domain.AccountToken) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.UserAccountToken{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", token.UserID, token.Purpose).
+			Update("used_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.UserAccountToken{
+			UserID:     token.UserID,
+			Purpose:    token.Purpose,
+			TokenHash:  token.TokenHash,
+			Email:      token.Email,
+			IdentityID: token.IdentityID,
+			ExpiresAt:  token.ExpiresAt,
+		}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", token.UserID).Str("purpose", token.Purpose).Msg("Error al crear token de cuenta")
+		return err
+	}
+	return nil
+}
+
+// EnqueueAccountEmail guarda un email de cuenta en el outbox de notificaciones para que el worker lo
+// envíe. No pertenece a ningún negocio, por lo que solo el super admin lo ve en el outbox.
+func (r *Repository) EnqueueAccountEmail(ctx context.Context, email domain.AccountEmail) (uint, error) {
+	record := models.NotificationOutbox{
+		Code:          email.Purpose,
+		Recipient:     email.Recipient,
+		Subject:       email.Subject,
+		HTMLBody:      email.HTMLBody,
+		TextBody:      email.TextBody,
+		Status:        domain.AccountEmailPending,
+		MaxAttempts:   domain.AccountEmailMaxAttempts,
+		NextAttemptAt: time.Now(),
+	}
+	if err := r.database.Conn(ctx).Create(&record).Error; err != nil {
+		r.logger.Error().Err(err).Uint("user_id", email.UserID).Str("purpose", email.Purpose).Msg("Error al encolar email de cuenta")
+		return 0, err
+	}
+	return record.Model.ID, nil
+}
+
+// GetAccountToken busca un token de cuenta por su hash y propósito. Retorna nil si no existe.
+func (r *Repository) GetAccountToken(ctx context.Context, tokenHash string, purpose string) (*domain.AccountToken, error) {
+	var token models.UserAccountToken
+	if err := r.database.Conn(ctx).
+		Where("token_hash = ? AND purpose = ?", tokenHash, purpose).
+		First(&token).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Str("purpose", purpose).Msg("Error al buscar token de cuenta")
+		return nil, err
+	}
+
+	return &domain.AccountToken{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		Purpose:    token.Purpose,
+		TokenHash:  token.TokenHash,
+		Email:      token.Email,
+		IdentityID: token.IdentityID,
+		ExpiresAt:  token.ExpiresAt,
+		UsedAt:     token.UsedAt,
+	}, nil
+}
+
+// consumeAccountToken marca el token como usado si sigue vigente; retorna false si ya se usó o venció
+func consumeAccountToken(tx *gorm.DB, tokenID uint, now time.Time) (bool, error) {
+	result := tx.Model(&models.UserAccountToken{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", tokenID, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ResetPasswordWithToken usa el token y cambia la contraseña en una sola transacción. Además marca el
+// email como verificado (el usuario demostró acceso a él) y revoca todas las sesiones del usuario.
+// Retorna false si el token ya se usó o venció.
+func (r *Repository) ResetPasswordWithToken(ctx context.Context, tokenID uint, userID uint, newPassword string) (bool, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Error al hashear nueva contraseña")
+		return false, fmt.Errorf("error al procesar contraseña")
+	}
+
+	reset := false
+	now := time.Now()
+	err = r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		consumed, err := consumeAccountToken(tx, tokenID, now)
+		if err != nil || !consumed {
+			return err
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("id = ?", userID).
+			Updates(map[string]interface{}{
+				"password":          string(hashedPassword),
+				"email_verified_at": gorm.Expr("COALESCE(email_verified_at, ?)", now),
+			}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.UserSession{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Updates(map[string]interface{}{
+				"revoked_at":     now,
+				"revoked_reason": domain.SessionRevokedPasswordReset,
+			}).Error; err != nil {
+			return err
+		}
+		reset = true
+		return nil
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al restablecer contraseña")
+		return false, err
+	}
+	return reset, nil
+}
+
+// VerifyEmailWithToken usa el token y marca el email del usuario como verificado. Retorna false si el
+// token ya se usó o venció.
+func (r *Repository) VerifyEmailWithToken(ctx context.Context, tokenID uint, userID uint) (bool, error) {
+	verified := false
+	now := time.Now()
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		consumed, err := consumeAccountToken(tx, tokenID, now)
+		if err != nil || !consumed {
+			return err
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("id = ? AND email_verified_at IS NULL", userID).
+			Update("email_verified_at", now).Error; err != nil {
+			return err
+		}
+		verified = true
+		return nil
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al verificar email")
+		return false, err
+	}
+	return verified, nil
+}
+
+// ConfirmUserIdentityWithToken usa el token y confirma la identidad SSO pendiente del usuario.
+// Retorna nil si el token ya se usó o venció, o si la identidad ya no está pendiente.
+func (r *Repository) ConfirmUserIdentityWithToken(ctx context.Context, tokenID uint, identityID uint, userID uint) (*domain.UserIdentity, error) {
+	var confirmed *domain.UserIdentity
+	now := time.Now()
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		consumed, err := consumeAccountToken(tx, tokenID, now)
+		if err != nil || !consumed {
+			return err
+		}
+
+		result := tx.Model(&models.UserIdentity{}).
+			Where("id = ? AND user_id = ? AND confirmed_at IS NULL", identityID, userID).
+			Update("confirmed_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		var identity models.UserIdentity
+		if err := tx.First(&identity, identityID).Error; err != nil {
+			return err
+		}
+		confirmed = toUserIdentity(identity)
+		return nil
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al confirmar identidad SSO")
+		return nil, err
+	}
+	return confirmed, nil
+}
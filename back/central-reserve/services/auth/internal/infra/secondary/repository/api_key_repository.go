@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"central_reserve/services/auth/internal/infra/secondary/repository/mappers"
+	"context"
+	"dbpostgres/app/infra/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateAPIKey guarda una API Key (solo su prefijo y hash) y retorna su ID
+func (r *Repository) CreateAPIKey(ctx context.Context, apiKey domain.APIKey) (uint, error) {
+	dbAPIKey := mappers.CreateAPIKeyModel(apiKey)
+
+	if err := r.database.Conn(ctx).Model(&models.APIKey{}).Create(&dbAPIKey).Error; err != nil {
+		r.logger.Error().Err(err).
+			Uint("user_id", apiKey.UserID).
+			Uint("business_id", apiKey.BusinessID).
+			Msg("Error al crear API Key")
+		return 0, err
+	}
+
+	return dbAPIKey.Model.ID, nil
+}
+
+// GetAPIKeyByPrefix busca una API Key por su prefijo público. Retorna nil si no existe.
+func (r *Repository) GetAPIKeyByPrefix(ctx context.Context, keyPrefix string) (*domain.APIKey, error) {
+	var dbAPIKey models.APIKey
+	if err := r.database.Conn(ctx).
+		Model(&models.APIKey{}).
+		Where("key_prefix = ?", keyPrefix).
+		First(&dbAPIKey).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Str("key_prefix", keyPrefix).Msg("Error al buscar API Key por prefijo")
+		return nil, err
+	}
+
+	entity := mappers.ToAPIKeyEntity(dbAPIKey)
+	return &entity, nil
+}
+
+// GetAPIKeyByID obtiene una API Key por ID. Retorna nil si no existe.
+func (r *Repository) GetAPIKeyByID(ctx context.Context, apiKeyID uint) (*domain.APIKey, error) {
+	var dbAPIKey models.APIKey
+	if err := r.database.Conn(ctx).
+		Model(&models.APIKey{}).
+		Where("id = ?", apiKeyID).
+		First(&dbAPIKey).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Uint("api_key_id", apiKeyID).Msg("Error al obtener API Key")
+		return nil, err
+	}
+
+	entity := mappers.ToAPIKeyEntity(dbAPIKey)
+	return &entity, nil
+}
+
+// UpdateAPIKeysLastUsed persiste en una transacción el último uso de varias API Keys.
+// Usa UpdateColumn para no tocar updated_at, que refleja cambios de configuración.
+func (r *Repository) UpdateAPIKeysLastUsed(ctx context.Context, lastUsed map[uint]time.Time) error {
+	if len(lastUsed) == 0 {
+		return nil
+	}
+
+	return r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		for apiKeyID, usedAt := range lastUsed {
+			if err := tx.Model(&models.APIKey{}).
+				Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", apiKeyID, usedAt).
+				UpdateColumn("last_used_at", usedAt).Error; err != nil {
+				r.logger.Error().Uint("api_key_id", apiKeyID).Err(err).Msg("Error al actualizar último uso de API Key")
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// IncrementAPIKeyRequests suma una request al conteo de la key en la ventana y retorna el conteo.
+// El upsert es atómico, así que varias instancias comparten el límite; si la ventana ya alcanzó el
+// límite no se suma y retorna false.
+func (r *Repository) IncrementAPIKeyRequests(ctx context.Context, apiKeyID uint, windowStart time.Time, limit int) (int, bool, error) {
+	window := models.APIKeyRateWindow{
+		APIKeyID:    apiKeyID,
+		WindowStart: windowStart,
+		Requests:    1,
+	}
+	result := r.database.Conn(ctx).
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "api_key_id"}, {Name: "window_start"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"requests":   gorm.Expr("api_key_rate_window.requests + 1"),
+					"updated_at": time.Now(),
+				}),
+				Where: clause.Where{Exprs: []clause.Expression{gorm.Expr("api_key_rate_window.requests < ?", limit)}},
+			},
+			clause.Returning{Columns: []clause.Column{{Name: "requests"}}},
+		).
+		Create(&window)
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("api_key_id", apiKeyID).Msg("Error al contar request de API Key")
+		return 0, false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return limit, false, nil
+	}
+	return window.Requests, true, nil
+}
+
+// DeleteAPIKeyRateWindowsBefore elimina los conteos de ventanas que empezaron antes de before
+func (r *Repository) DeleteAPIKeyRateWindowsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.database.Conn(ctx).Unscoped().
+		Where("window_start < ?", before).
+		Delete(&models.APIKeyRateWindow{})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Msg("Error al eliminar ventanas vencidas de API Keys")
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *Repository) GetAPIKeysByUser(ctx context.Context, userID uint) ([]domain.APIKeyInfo, error) {
+	var dbAPIKeys []models.APIKey
+
+	err := r.database.Conn(ctx).
+		Model(&models.APIKey{}).
+		Where("user_id = ?", userID).
+		Find(&dbAPIKeys).Error
+
+	if err != nil {
+		r.logger.Error().Uint("user_id", userID).Err(err).Msg("Error al obtener API Keys del usuario")
+		return nil, err
+	}
+
+	apiKeys := mappers.ToAPIKeyInfoEntitySlice(dbAPIKeys)
+	return apiKeys, nil
+}
+
+// GetAPIKeysByBusiness lista las API Keys de un negocio, las más recientes primero
+func (r *Repository) GetAPIKeysByBusiness(ctx context.Context, businessID uint) ([]domain.APIKeyInfo, error) {
+	var dbAPIKeys []models.APIKey
+
+	err := r.database.Conn(ctx).
+		Model(&models.APIKey{}).
+		Where("business_id = ?", businessID).
+		Order("created_at DESC").
+		Find(&dbAPIKeys).Error
+
+	if err != nil {
+		r.logger.Error().Uint("business_id", businessID).Err(err).Msg("Error al obtener API Keys del negocio")
+		return nil, err
+	}
+
+	return mappers.ToAPIKeyInfoEntitySlice(dbAPIKeys), nil
+}
+
+func (r *Repository) RevokeAPIKey(ctx context.Context, apiKeyID uint) error {
+	return revokeAPIKey(r.database.Conn(ctx), apiKeyID, time.Now())
+}
+
+// RotateAPIKey revoca una API Key y crea su reemplazo en la misma transacción
+func (r *Repository) RotateAPIKey(ctx context.Context, oldAPIKeyID uint, replacement domain.APIKey) (uint, error) {
+	dbAPIKey := mappers.CreateAPIKeyModel(replacement)
+
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := revokeAPIKey(tx, oldAPIKeyID, time.Now()); err != nil {
+			return err
+		}
+		return tx.Model(&models.APIKey{}).Create(&dbAPIKey).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("api_key_id", oldAPIKeyID).Msg("Error al rotar API Key")
+		return 0, err
+	}
+
+	return dbAPIKey.Model.ID, nil
+}
+
+func revokeAPIKey(db *gorm.DB, apiKeyID uint, now time.Time) error {
+	return db.Model(&models.APIKey{}).
+		Where("id = ? AND revoked = ?", apiKeyID, false).
+		Updates(map[string]interface{}{
+			"revoked":    true,
+			"revoked_at": now,
+			"updated_at": now,
+		}).Error
+}
@@ -16,7 +16,7 @@ func (r *Repository) GetUserByEmailForAuth(ctx context.Context, email string) (*
 	var userAuth domain.UserAuthInfo
 	if err := r.database.Conn(ctx).
 		Model(&models.User{}).
-		Select("id, name, email, password, phone, avatar_url, is_active, last_login_at, created_at, updated_at, deleted_at").
+		Select("id, name, email, password, phone, avatar_url, is_active, last_login_at, created_at, updated_at, deleted_at, email_verified_at").
 		Where("email = ?", email).
 		First(&userAuth).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -45,16 +45,17 @@ func (r *Repository) GetUserRoles(ctx context.Context, userID uint) ([]domain.Ro
 
 	for _, role := range user.Roles {
 		roles = append(roles, domain.Role{
-			ID:          role.Model.ID,
-			Name:        role.Name,
-			Description: role.Description,
-			Level:       role.Level,
-			IsSystem:    role.IsSystem,
-			ScopeID:     role.ScopeID,
-			ScopeName:   role.Scope.Name,
-			ScopeCode:   role.Scope.Code,
-			CreatedAt:   role.Model.CreatedAt,
-			UpdatedAt:   role.Model.UpdatedAt,
+			ID:               role.Model.ID,
+			Name:             role.Name,
+			Description:      role.Description,
+			Level:            role.Level,
+			IsSystem:         role.IsSystem,
+			RequireTwoFactor: role.RequireTwoFactor,
+			ScopeID:          role.ScopeID,
+			ScopeName:        role.Scope.Name,
+			ScopeCode:        role.Scope.Code,
+			CreatedAt:        role.Model.CreatedAt,
+			UpdatedAt:        role.Model.UpdatedAt,
 		})
 	}
 
@@ -132,6 +133,7 @@ func (r *Repository) GetUserRoleByBusiness(ctx context.Context, userID uint, bus
 		Description:      selectedRole.Description,
 		Level:            selectedRole.Level,
 		IsSystem:         selectedRole.IsSystem,
+		RequireTwoFactor: selectedRole.RequireTwoFactor,
 		ScopeID:          selectedRole.ScopeID,
 		ScopeName:        selectedRole.Scope.Name,
 		ScopeCode:        selectedRole.Scope.Code,
@@ -363,7 +365,18 @@ func (r *Repository) CreateUser(ctx context.Context, user domain.UsersEntity) (u
 }
 
 func (r *Repository) UpdateUser(ctx context.Context, id uint, user domain.UsersEntity) (string, error) {
-	if err := r.database.Conn(ctx).Model(&models.User{}).Where("id = ?", id).Updates(&user).Error; err != nil {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		// Un email nuevo debe verificarse de nuevo
+		if user.Email != "" {
+			if err := tx.Model(&models.User{}).
+				Where("id = ? AND email <> ?", id, user.Email).
+				Update("email_verified_at", nil).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&models.User{}).Where("id = ?", id).Updates(&user).Error
+	})
+	if err != nil {
 		r.logger.Error().Uint("id", id).Err(err).Msg("Error al actualizar usuario")
 		return "", err
 	}
@@ -812,92 +825,6 @@ func (r *Repository) GetUserByID(ctx context.Context, id uint) (*domain.UserAuth
 	return &user, nil
 }
 
-func (r *Repository) CreateAPIKey(ctx context.Context, apiKey domain.APIKey, keyHash string) (uint, error) {
-	dbAPIKey := mappers.CreateAPIKeyModel(apiKey, keyHash)
-
-	if err := r.database.Conn(ctx).Model(&models.APIKey{}).Create(&dbAPIKey).Error; err != nil {
-		r.logger.Error().Err(err).
-			Uint("user_id", apiKey.UserID).
-			Uint("business_id", apiKey.BusinessID).
-			Msg("Error al crear API Key")
-		return 0, err
-	}
-
-	return dbAPIKey.Model.ID, nil
-}
-
-func (r *Repository) ValidateAPIKey(ctx context.Context, apiKey string) (*domain.APIKey, error) {
-	var dbAPIKeys []models.APIKey
-
-	err := r.database.Conn(ctx).
-		Model(&models.APIKey{}).
-		Where("revoked = ?", false).
-		Find(&dbAPIKeys).Error
-
-	if err != nil {
-		r.logger.Error().Err(err).Msg("Error al buscar API Keys")
-		return nil, err
-	}
-
-	for _, dbAPIKey := range dbAPIKeys {
-		if apiKey == dbAPIKey.KeyHash {
-			if err := r.UpdateAPIKeyLastUsed(ctx, dbAPIKey.Model.ID); err != nil {
-				r.logger.Warn().Uint("api_key_id", dbAPIKey.Model.ID).Err(err).Msg("Error al actualizar último uso")
-			}
-
-			entity := mappers.ToAPIKeyEntity(dbAPIKey)
-			return &entity, nil
-		}
-	}
-
-	return nil, nil
-}
-
-func (r *Repository) UpdateAPIKeyLastUsed(ctx context.Context, apiKeyID uint) error {
-	now := time.Now()
-	if err := r.database.Conn(ctx).
-		Model(&models.APIKey{}).
-		Where("id = ?", apiKeyID).
-		Update("last_used_at", now).Error; err != nil {
-		r.logger.Error().Uint("api_key_id", apiKeyID).Err(err).Msg("Error al actualizar último uso de API Key")
-		return err
-	}
-
-	return nil
-}
-
-func (r *Repository) GetAPIKeysByUser(ctx context.Context, userID uint) ([]domain.APIKeyInfo, error) {
-	var dbAPIKeys []models.APIKey
-
-	err := r.database.Conn(ctx).
-		Model(&models.APIKey{}).
-		Where("user_id = ?", userID).
-		Find(&dbAPIKeys).Error
-
-	if err != nil {
-		r.logger.Error().Uint("user_id", userID).Err(err).Msg("Error al obtener API Keys del usuario")
-		return nil, err
-	}
-
-	apiKeys := mappers.ToAPIKeyInfoEntitySlice(dbAPIKeys)
-	return apiKeys, nil
-}
-
-func (r *Repository) RevokeAPIKey(ctx context.Context, apiKeyID uint) error {
-	now := time.Now()
-	if err := r.database.Conn(ctx).
-		Model(&models.APIKey{}).
-		Where("id = ?", apiKeyID).
-		Updates(map[string]interface{}{
-			"revoked":    true,
-			"revoked_at": now,
-			"updated_at": now,
-		}).Error; err != nil {
-		return err
-	}
-	return nil
-}
-
 // GetBusinessConfiguredResourcesIDs obtiene los IDs de recursos ACTIVOS configurados para un business específico
 func (r *Repository) GetBusinessConfiguredResourcesIDs(ctx context.Context, businessID uint) ([]uint, error) {
 	var resourcesIDs []uint
@@ -918,6 +845,24 @@ func (r *Repository) GetBusinessConfiguredResourcesIDs(ctx context.Context, busi
 	return resourcesIDs, nil
 }
 
+// GetBusinessActiveResourceNames obtiene los nombres de los recursos ACTIVOS configurados para un business
+func (r *Repository) GetBusinessActiveResourceNames(ctx context.Context, businessID uint) ([]string, error) {
+	var names []string
+
+	err := r.database.Conn(ctx).
+		Model(&models.BusinessResourceConfigured{}).
+		Joins("JOIN resource ON resource.id = business_resource_configured.resource_id AND resource.deleted_at IS NULL").
+		Where("business_resource_configured.business_id = ? AND business_resource_configured.active = ?", businessID, true).
+		Pluck("resource.name", &names).Error
+
+	if err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener nombres de recursos activos del business")
+		return nil, err
+	}
+
+	return names, nil
+}
+
 // AssignPermissionsToRole asigna permisos a un rol
 func (r *Repository) AssignPermissionsToRole(ctx context.Context, roleID uint, permissionIDs []uint) error {
 	db := r.database.Conn(ctx)
@@ -26,11 +26,14 @@ func (r *Repository) GetBusinessByID(ctx context.Context, businessID uint) (*dom
 	}
 
 	return &domain.BusinessInfo{
-		ID:             business.ID,
-		Name:           business.Name,
-		Code:           business.Code,
-		BusinessTypeID: business.BusinessTypeID,
-		IsActive:       business.IsActive,
+		ID:                 business.ID,
+		Name:               business.Name,
+		Code:               business.Code,
+		BusinessTypeID:     business.BusinessTypeID,
+		IsActive:           business.IsActive,
+		EnableDelivery:     business.EnableDelivery,
+		EnablePickup:       business.EnablePickup,
+		EnableReservations: business.EnableReservations,
 		BusinessType: domain.BusinessTypeInfo{
 			ID:          business.BusinessType.ID,
 			Name:        business.BusinessType.Name,
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RecordLoginAttempt registra un intento de login en la auditoría
+func (r *Repository) RecordLoginAttempt(ctx context.Context, attempt domain.LoginAttempt) error {
+	if err := r.database.Conn(ctx).Create(toLoginAttemptModel(attempt)).Error; err != nil {
+		r.logger.Error().Err(err).Str("email", attempt.Email).Msg("Error al registrar intento de login")
+		return err
+	}
+	return nil
+}
+
+// GetLoginIPFailures cuenta los intentos fallidos de una IP desde since y retorna el más reciente
+func (r *Repository) GetLoginIPFailures(ctx context.Context, ipAddress string, since time.Time) (*domain.LoginFailures, error) {
+	var result struct {
+		Count         int64
+		LastFailureAt *time.Time
+	}
+	if err := r.database.Conn(ctx).
+		Model(&models.LoginAttempt{}).
+		Select("COUNT(*) AS count, MAX(created_at) AS last_failure_at").
+		Where("ip_address = ? AND success = ? AND created_at >= ?", ipAddress, false, since).
+		Scan(&result).Error; err != nil {
+		r.logger.Error().Err(err).Str("ip_address", ipAddress).Msg("Error al contar intentos fallidos de la IP")
+		return nil, err
+	}
+	return &domain.LoginFailures{
+		Count:         result.Count,
+		LastFailureAt: result.LastFailureAt,
+	}, nil
+}
+
+// GetUnknownEmailFailures cuenta los intentos fallidos de un email que no corresponde a ningún
+// usuario y retorna el más reciente. Equivalen a los intentos fallidos acumulados de una cuenta.
+func (r *Repository) GetUnknownEmailFailures(ctx context.Context, email string) (*domain.LoginFailures, error) {
+	var result struct {
+		Count         int64
+		LastFailureAt *time.Time
+	}
+	if err := r.database.Conn(ctx).
+		Model(&models.LoginAttempt{}).
+		Select("COUNT(*) AS count, MAX(created_at) AS last_failure_at").
+		Where("email = ? AND user_id IS NULL AND reason = ?", email, domain.LoginFailedUnknownUser).
+		Scan(&result).Error; err != nil {
+		r.logger.Error().Err(err).Str("email", email).Msg("Error al contar intentos fallidos del email")
+		return nil, err
+	}
+	return &domain.LoginFailures{
+		Count:         result.Count,
+		LastFailureAt: result.LastFailureAt,
+	}, nil
+}
+
+// RegisterFailedLogin suma un intento fallido a la cuenta y la bloquea hasta lockUntil si llega a
+// lockThreshold. El incremento es atómico para que intentos concurrentes no se pierdan.
+func (r *Repository) RegisterFailedLogin(ctx context.Context, userID uint, at time.Time, lockThreshold int, lockUntil time.Time) error {
+	if err := r.database.Conn(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		UpdateColumns(map[string]interface{}{
+			"failed_login_count":   gorm.Expr("failed_login_count + 1"),
+			"last_failed_login_at": at,
+			"locked_until":         gorm.Expr("CASE WHEN failed_login_count + 1 >= ? THEN ?::timestamptz ELSE locked_until END", lockThreshold, lockUntil),
+		}).Error; err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al registrar intento fallido")
+		return err
+	}
+	return nil
+}
+
+// RegisterSuccessfulLogin registra el login exitoso en la auditoría, actualiza el último login y
+// reinicia los intentos fallidos de la cuenta
+func (r *Repository) RegisterSuccessfulLogin(ctx context.Context, attempt domain.LoginAttempt) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(toLoginAttemptModel(attempt)).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.User{}).
+			Where("id = ?", *attempt.UserID).
+			UpdateColumns(map[string]interface{}{
+				"last_login_at":        attempt.CreatedAt,
+				"failed_login_count":   0,
+				"last_failed_login_at": nil,
+				"locked_until":         nil,
+			}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", *attempt.UserID).Msg("Error al registrar login exitoso")
+		return err
+	}
+	return nil
+}
+
+// UnlockUser quita el bloqueo y reinicia los intentos fallidos de una cuenta
+func (r *Repository) UnlockUser(ctx context.Context, userID uint) error {
+	if err := r.database.Conn(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		UpdateColumns(map[string]interface{}{
+			"failed_login_count":   0,
+			"last_failed_login_at": nil,
+			"locked_until":         nil,
+		}).Error; err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al desbloquear usuario")
+		return err
+	}
+	return nil
+}
+
+func toLoginAttemptModel(attempt domain.LoginAttempt) *models.LoginAttempt {
+	return &models.LoginAttempt{
+		CreatedAt: attempt.CreatedAt,
+		UserID:    attempt.UserID,
+		Email:     attempt.Email,
+		IPAddress: attempt.IPAddress,
+		UserAgent: attempt.UserAgent,
+		Success:   attempt.Success,
+		Reason:    attempt.Reason,
+	}
+}
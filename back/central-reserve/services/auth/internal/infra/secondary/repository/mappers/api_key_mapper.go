@@ -20,6 +20,7 @@ func ToAPIKeyModel(apiKey domain.APIKey) models.APIKey {
 		CreatedByID: apiKey.CreatedByID,
 		Name:        apiKey.Name,
 		Description: apiKey.Description,
+		KeyPrefix:   apiKey.KeyPrefix,
 		KeyHash:     apiKey.KeyHash,
 		LastUsedAt:  apiKey.LastUsedAt,
 		Revoked:     apiKey.Revoked,
@@ -38,6 +39,7 @@ func ToAPIKeyEntity(model models.APIKey) domain.APIKey {
 		CreatedByID: model.CreatedByID,
 		Name:        model.Name,
 		Description: model.Description,
+		KeyPrefix:   model.KeyPrefix,
 		KeyHash:     model.KeyHash,
 		LastUsedAt:  model.LastUsedAt,
 		Revoked:     model.Revoked,
@@ -55,11 +57,15 @@ func ToAPIKeyInfoEntity(model models.APIKey) domain.APIKeyInfo {
 		ID:          model.Model.ID,
 		UserID:      model.UserID,
 		BusinessID:  model.BusinessID,
+		CreatedByID: model.CreatedByID,
 		Name:        model.Name,
 		Description: model.Description,
+		KeyPrefix:   model.KeyPrefix,
 		LastUsedAt:  model.LastUsedAt,
 		Revoked:     model.Revoked,
+		RevokedAt:   model.RevokedAt,
 		RateLimit:   model.RateLimit,
+		IPWhitelist: domain.SplitIPWhitelist(model.IPWhitelist),
 		CreatedAt:   model.Model.CreatedAt,
 	}
 }
@@ -83,14 +89,15 @@ func ToAPIKeyEntitySlice(models []models.APIKey) []domain.APIKey {
 }
 
 // CreateAPIKeyModel crea un modelo APIKey para inserción (sin ID)
-func CreateAPIKeyModel(apiKey domain.APIKey, keyHash string) models.APIKey {
+func CreateAPIKeyModel(apiKey domain.APIKey) models.APIKey {
 	return models.APIKey{
 		UserID:      apiKey.UserID,
 		BusinessID:  apiKey.BusinessID,
 		CreatedByID: apiKey.CreatedByID,
 		Name:        apiKey.Name,
 		Description: apiKey.Description,
-		KeyHash:     keyHash,
+		KeyPrefix:   apiKey.KeyPrefix,
+		KeyHash:     apiKey.KeyHash,
 		RateLimit:   apiKey.RateLimit,
 		IPWhitelist: apiKey.IPWhitelist,
 	}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreateSession crea una sesión con su primer refresh token y retorna el ID de la sesión
+func (r *Repository) CreateSession(ctx context.Context, session domain.Session, refreshToken domain.RefreshTokenRecord) (uint, error) {
+	dbSession := models.UserSession{
+		UserID:     session.UserID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		LastUsedAt: session.LastUsedAt,
+		ExpiresAt:  refreshToken.ExpiresAt,
+	}
+
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&dbSession).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.RefreshToken{
+			SessionID: dbSession.ID,
+			TokenHash: refreshToken.TokenHash,
+			ExpiresAt: refreshToken.ExpiresAt,
+		}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", session.UserID).Msg("Error al crear sesión")
+		return 0, err
+	}
+
+	return dbSession.ID, nil
+}
+
+// GetRefreshToken busca un refresh token por su hash junto con su sesión. Retorna nil si no existe.
+func (r *Repository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshTokenRecord, error) {
+	var token models.RefreshToken
+	if err := r.database.Conn(ctx).
+		Preload("Session").
+		Where("token_hash = ?", tokenHash).
+		First(&token).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Msg("Error al buscar refresh token")
+		return nil, err
+	}
+
+	return &domain.RefreshTokenRecord{
+		ID:        token.ID,
+		SessionID: token.SessionID,
+		UserID:    token.Session.UserID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		UsedAt:    token.UsedAt,
+		Session: domain.Session{
+			ID:            token.Session.ID,
+			UserID:        token.Session.UserID,
+			UserAgent:     token.Session.UserAgent,
+			IPAddress:     token.Session.IPAddress,
+			LastUsedAt:    token.Session.LastUsedAt,
+			ExpiresAt:     token.Session.ExpiresAt,
+			RevokedAt:     token.Session.RevokedAt,
+			RevokedReason: token.Session.RevokedReason,
+			CreatedAt:     token.Session.CreatedAt,
+		},
+	}, nil
+}
+
+// RotateRefreshToken marca como usado un refresh token y crea su reemplazo en la misma sesión.
+// Retorna false si el token ya estaba usado (otra request lo rotó primero), sin crear el reemplazo.
+func (r *Repository) RotateRefreshToken(ctx context.Context, usedTokenID uint, replacement domain.RefreshTokenRecord) (bool, error) {
+	rotated := false
+	now := time.Now()
+
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL", usedTokenID).
+			Update("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Create(&models.RefreshToken{
+			SessionID: replacement.SessionID,
+			TokenHash: replacement.TokenHash,
+			ExpiresAt: replacement.ExpiresAt,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.UserSession{}).
+			Where("id = ?", replacement.SessionID).
+			Updates(map[string]interface{}{
+				"last_used_at": now,
+				"expires_at":   replacement.ExpiresAt,
+			}).Error; err != nil {
+			return err
+		}
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("session_id", replacement.SessionID).Msg("Error al rotar refresh token")
+		return false, err
+	}
+
+	return rotated, nil
+}
+
+// RevokeSession revoca una sesión; revocar una sesión ya revocada conserva el motivo original
+func (r *Repository) RevokeSession(ctx context.Context, sessionID uint, reason string) error {
+	if err := r.database.Conn(ctx).
+		Model(&models.UserSession{}).
+		Where("id = ? AND revoked_at IS NULL", sessionID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error; err != nil {
+		r.logger.Error().Err(err).Uint("session_id", sessionID).Msg("Error al revocar sesión")
+		return err
+	}
+	return nil
+}
+
+// RevokeUserSessions revoca todas las sesiones vigentes de un usuario y retorna cuántas revocó
+func (r *Repository) RevokeUserSessions(ctx context.Context, userID uint, reason string) (int64, error) {
+	result := r.database.Conn(ctx).
+		Model(&models.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("user_id", userID).Msg("Error al revocar sesiones del usuario")
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// IsSessionActive indica si la sesión sigue vigente: no revocada, no vencida y con el usuario
+// activo y no eliminado
+func (r *Repository) IsSessionActive(ctx context.Context, sessionID uint) (bool, error) {
+	var count int64
+	if err := r.database.Conn(ctx).
+		Model(&models.UserSession{}).
+		Joins(`JOIN "user" ON "user".id = user_session.user_id AND "user".deleted_at IS NULL`).
+		Where("user_session.id = ?", sessionID).
+		Where("user_session.revoked_at IS NULL AND user_session.expires_at > ?", time.Now()).
+		Where(`"user".is_active = ?`, true).
+		Count(&count).Error; err != nil {
+		r.logger.Error().Err(err).Uint("session_id", sessionID).Msg("Error al verificar sesión")
+		return false, err
+	}
+	return count > 0, nil
+}
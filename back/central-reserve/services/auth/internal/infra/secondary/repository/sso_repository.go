@@ -0,0 +1,310 @@
+package repository
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetSSOProvider obtiene el proveedor OIDC del negocio. Retorna nil si no tiene.
+func (r *Repository) GetSSOProvider(ctx context.Context, businessID uint) (*domain.SSOProvider, error) {
+	return r.findSSOProvider(ctx, "business_id = ?", businessID)
+}
+
+// GetSSOProviderByID obtiene un proveedor OIDC por ID. Retorna nil si no existe.
+func (r *Repository) GetSSOProviderByID(ctx context.Context, providerID uint) (*domain.SSOProvider, error) {
+	return r.findSSOProvider(ctx, "id = ?", providerID)
+}
+
+// GetSSOProviderByBusinessCode obtiene el proveedor OIDC de un negocio activo a partir de su código
+func (r *Repository) GetSSOProviderByBusinessCode(ctx context.Context, businessCode string) (*domain.SSOProvider, error) {
+	return r.findSSOProvider(ctx,
+		"business_id = (SELECT id FROM business WHERE code = ? AND is_active = ? AND deleted_at IS NULL)",
+		businessCode, true)
+}
+
+func (r *Repository) findSSOProvider(ctx context.Context, query string, args ...interface{}) (*domain.SSOProvider, error) {
+	var provider models.SSOProvider
+	if err := r.database.Conn(ctx).
+		Preload("RoleMappings", func(db *gorm.DB) *gorm.DB {
+			return db.Order("priority ASC, id ASC")
+		}).
+		Where(query, args...).
+		First(&provider).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Msg("Error al obtener proveedor SSO")
+		return nil, err
+	}
+	return toSSOProviderDomain(provider), nil
+}
+
+// SaveSSOProvider crea o reemplaza el proveedor OIDC del negocio junto con sus mapeos de grupos
+func (r *Repository) SaveSSOProvider(ctx context.Context, provider domain.SSOProvider) (*domain.SSOProvider, error) {
+	var providerID uint
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var model models.SSOProvider
+		err := tx.Where("business_id = ?", provider.BusinessID).First(&model).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+
+		model.BusinessID = provider.BusinessID
+		model.IssuerURL = provider.IssuerURL
+		model.ClientID = provider.ClientID
+		model.ClientSecret = provider.ClientSecret
+		model.RedirectURL = provider.RedirectURL
+		model.Scopes = provider.Scopes
+		model.GroupsClaim = provider.GroupsClaim
+		model.AllowProvisioning = provider.AllowProvisioning
+		model.DefaultRoleID = provider.DefaultRoleID
+		model.IsActive = provider.IsActive
+
+		// Save escribe también los booleanos en false, que Updates omitiría
+		if err := tx.Omit(clause.Associations).Save(&model).Error; err != nil {
+			return err
+		}
+		providerID = model.ID
+
+		if err := tx.Where("provider_id = ?", model.ID).Delete(&models.SSORoleMapping{}).Error; err != nil {
+			return err
+		}
+		if len(provider.RoleMappings) == 0 {
+			return nil
+		}
+		mappings := make([]models.SSORoleMapping, len(provider.RoleMappings))
+		for i, mapping := range provider.RoleMappings {
+			mappings[i] = models.SSORoleMapping{
+				ProviderID: model.ID,
+				GroupName:  mapping.GroupName,
+				RoleID:     mapping.RoleID,
+				Priority:   mapping.Priority,
+			}
+		}
+		return tx.Create(&mappings).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("business_id", provider.BusinessID).Msg("Error al guardar proveedor SSO")
+		return nil, err
+	}
+	return r.GetSSOProviderByID(ctx, providerID)
+}
+
+// DeleteSSOProvider elimina el proveedor OIDC del negocio. Las identidades vinculadas se eliminan en
+// cascada; los usuarios conservan su cuenta. Retorna false si el negocio no tenía proveedor.
+func (r *Repository) DeleteSSOProvider(ctx context.Context, businessID uint) (bool, error) {
+	result := r.database.Conn(ctx).Unscoped().
+		Where("business_id = ?", businessID).
+		Delete(&models.SSOProvider{})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("business_id", businessID).Msg("Error al eliminar proveedor SSO")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateSSOLoginState guarda un login OIDC en curso y descarta los vencidos
+func (r *Repository) CreateSSOLoginState(ctx context.Context, state domain.SSOLoginState) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("expires_at < ?", time.Now()).Delete(&models.SSOLoginState{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.SSOLoginState{
+			StateHash:    state.StateHash,
+			ProviderID:   state.ProviderID,
+			Nonce:        state.Nonce,
+			CodeVerifier: state.CodeVerifier,
+			ExpiresAt:    state.ExpiresAt,
+		}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("provider_id", state.ProviderID).Msg("Error al guardar estado de login SSO")
+		return err
+	}
+	return nil
+}
+
+// ConsumeSSOLoginState obtiene y elimina un login en curso, de modo que el state solo sirve una vez.
+// Retorna nil si no existe o venció.
+func (r *Repository) ConsumeSSOLoginState(ctx context.Context, stateHash string) (*domain.SSOLoginState, error) {
+	var states []models.SSOLoginState
+	if err := r.database.Conn(ctx).
+		Clauses(clause.Returning{}).
+		Where("state_hash = ?", stateHash).
+		Delete(&states).Error; err != nil {
+		r.logger.Error().Err(err).Msg("Error al consumir estado de login SSO")
+		return nil, err
+	}
+	if len(states) == 0 || time.Now().After(states[0].ExpiresAt) {
+		return nil, nil
+	}
+	return &domain.SSOLoginState{
+		StateHash:    states[0].StateHash,
+		ProviderID:   states[0].ProviderID,
+		Nonce:        states[0].Nonce,
+		CodeVerifier: states[0].CodeVerifier,
+		ExpiresAt:    states[0].ExpiresAt,
+	}, nil
+}
+
+// GetUserIdentity obtiene la identidad vinculada a la cuenta del proveedor. Retorna nil si no existe.
+func (r *Repository) GetUserIdentity(ctx context.Context, issuer, subject string) (*domain.UserIdentity, error) {
+	var identity models.UserIdentity
+	if err := r.database.Conn(ctx).
+		Where("issuer = ? AND subject = ?", issuer, subject).
+		First(&identity).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Str("issuer", issuer).Msg("Error al obtener identidad SSO")
+		return nil, err
+	}
+	return toUserIdentity(identity), nil
+}
+
+// toUserIdentity convierte el modelo de identidad SSO al dominio
+func toUserIdentity(identity models.UserIdentity) *domain.UserIdentity {
+	return &domain.UserIdentity{
+		ID:          identity.ID,
+		UserID:      identity.UserID,
+		ProviderID:  identity.ProviderID,
+		Issuer:      identity.Issuer,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		ConfirmedAt: identity.ConfirmedAt,
+	}
+}
+
+// SaveUserIdentity vincula la cuenta del proveedor con el usuario o, si ya estaba vinculada,
+// actualiza el email y el último login. Una identidad sin ConfirmedAt se guarda pendiente; la
+// confirmación nunca se modifica al actualizar.
+func (r *Repository) SaveUserIdentity(ctx context.Context, identity domain.UserIdentity) error {
+	now := time.Now()
+	var lastLoginAt *time.Time
+	if identity.ConfirmedAt != nil {
+		lastLoginAt = &now
+	}
+	if err := r.database.Conn(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "issuer"}, {Name: "subject"}},
+			DoUpdates: clause.AssignmentColumns([]string{"email", "provider_id", "last_login_at", "updated_at"}),
+		}).
+		Create(&models.UserIdentity{
+			UserID:      identity.UserID,
+			ProviderID:  identity.ProviderID,
+			Issuer:      identity.Issuer,
+			Subject:     identity.Subject,
+			Email:       identity.Email,
+			LastLoginAt: lastLoginAt,
+			ConfirmedAt: identity.ConfirmedAt,
+		}).Error; err != nil {
+		r.logger.Error().Err(err).Uint("user_id", identity.UserID).Msg("Error al guardar identidad SSO")
+		return err
+	}
+	return nil
+}
+
+// CreateSSOUser da de alta un usuario desde el proveedor junto con su identidad. El usuario no tiene
+// contraseña local: solo puede entrar por SSO hasta que restablezca una.
+func (r *Repository) CreateSSOUser(ctx context.Context, user domain.SSOUser, identity domain.UserIdentity) (uint, error) {
+	var userID uint
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		model := models.User{
+			Name:            user.Name,
+			Email:           user.Email,
+			IsActive:        true,
+			EmailVerifiedAt: &now, // El proveedor ya verificó el email
+		}
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+		userID = model.ID
+
+		return tx.Create(&models.UserIdentity{
+			UserID:      model.ID,
+			ProviderID:  identity.ProviderID,
+			Issuer:      identity.Issuer,
+			Subject:     identity.Subject,
+			Email:       identity.Email,
+			LastLoginAt: &now,
+			ConfirmedAt: &now, // Cuenta nueva: no hay nadie a quien pedirle confirmación
+		}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Str("email", user.Email).Msg("Error al crear usuario desde SSO")
+		return 0, err
+	}
+	return userID, nil
+}
+
+// SyncSSOMembership asegura que el usuario pertenezca al negocio. Con roleID se asigna ese rol; sin él
+// se conserva el rol actual.
+func (r *Repository) SyncSSOMembership(ctx context.Context, userID, businessID uint, roleID *uint) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var staff models.BusinessStaff
+		err := tx.Where("user_id = ? AND business_id = ?", userID, businessID).First(&staff).Error
+		switch {
+		case err == gorm.ErrRecordNotFound:
+			bid := businessID
+			if err := tx.Create(&models.BusinessStaff{
+				UserID:     userID,
+				BusinessID: &bid,
+				RoleID:     roleID,
+			}).Error; err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		case roleID != nil && (staff.RoleID == nil || *staff.RoleID != *roleID):
+			if err := tx.Model(&staff).Update("role_id", *roleID).Error; err != nil {
+				return err
+			}
+		}
+
+		// Relación many-to-many que se mantiene por compatibilidad
+		return tx.Table("user_businesses").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(map[string]interface{}{
+				"user_id":     userID,
+				"business_id": businessID,
+			}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Uint("business_id", businessID).Msg("Error al sincronizar acceso SSO al negocio")
+		return err
+	}
+	return nil
+}
+
+func toSSOProviderDomain(model models.SSOProvider) *domain.SSOProvider {
+	mappings := make([]domain.SSORoleMapping, len(model.RoleMappings))
+	for i, mapping := range model.RoleMappings {
+		mappings[i] = domain.SSORoleMapping{
+			GroupName: mapping.GroupName,
+			RoleID:    mapping.RoleID,
+			Priority:  mapping.Priority,
+		}
+	}
+	return &domain.SSOProvider{
+		ID:                model.ID,
+		BusinessID:        model.BusinessID,
+		IssuerURL:         model.IssuerURL,
+		ClientID:          model.ClientID,
+		ClientSecret:      model.ClientSecret,
+		RedirectURL:       model.RedirectURL,
+		Scopes:            model.Scopes,
+		GroupsClaim:       model.GroupsClaim,
+		AllowProvisioning: model.AllowProvisioning,
+		DefaultRoleID:     model.DefaultRoleID,
+		IsActive:          model.IsActive,
+		RoleMappings:      mappings,
+		CreatedAt:         model.CreatedAt,
+		UpdatedAt:         model.UpdatedAt,
+	}
+}
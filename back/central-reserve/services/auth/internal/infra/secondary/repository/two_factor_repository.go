@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GetTwoFactor obtiene el segundo factor del usuario. Retorna nil si no lo configuró.
+func (r *Repository) GetTwoFactor(ctx context.Context, userID uint) (*domain.TwoFactor, error) {
+	var twoFactor models.UserTwoFactor
+	if err := r.database.Conn(ctx).
+		Where("user_id = ?", userID).
+		First(&twoFactor).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al obtener segundo factor")
+		return nil, err
+	}
+
+	return &domain.TwoFactor{
+		UserID:       twoFactor.UserID,
+		Secret:       twoFactor.Secret,
+		EnabledAt:    twoFactor.EnabledAt,
+		LastUsedStep: twoFactor.LastUsedStep,
+	}, nil
+}
+
+// SaveTwoFactorSecret guarda un secreto pendiente de confirmar, reemplazando una inscripción
+// anterior sin confirmar
+func (r *Repository) SaveTwoFactorSecret(ctx context.Context, userID uint, sealedSecret string) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Where("user_id = ? AND enabled_at IS NULL", userID).
+			Delete(&models.UserTwoFactor{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.UserTwoFactor{
+			UserID: userID,
+			Secret: sealedSecret,
+		}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al guardar secreto de segundo factor")
+		return err
+	}
+	return nil
+}
+
+// EnableTwoFactor activa el segundo factor con el paso del código confirmado y reemplaza los
+// códigos de recuperación
+func (r *Repository) EnableTwoFactor(ctx context.Context, userID uint, step int64, recoveryCodeHashes []string) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.UserTwoFactor{}).
+			Where("user_id = ?", userID).
+			Updates(map[string]interface{}{
+				"enabled_at":     time.Now(),
+				"last_used_step": step,
+			}).Error; err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(tx, userID, recoveryCodeHashes)
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al activar segundo factor")
+		return err
+	}
+	return nil
+}
+
+// UpdateTwoFactorStep registra el paso del último código aceptado. Retorna false si ese paso (o uno
+// posterior) ya se usó: el código no puede reutilizarse.
+func (r *Repository) UpdateTwoFactorStep(ctx context.Context, userID uint, step int64) (bool, error) {
+	result := r.database.Conn(ctx).
+		Model(&models.UserTwoFactor{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("user_id", userID).Msg("Error al registrar código de segundo factor")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UseRecoveryCode marca como usado un código de recuperación. Retorna false si no existe o ya se usó.
+func (r *Repository) UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error) {
+	result := r.database.Conn(ctx).
+		Model(&models.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("user_id", userID).Msg("Error al usar código de recuperación")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ReplaceRecoveryCodes reemplaza todos los códigos de recuperación del usuario
+func (r *Repository) ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al reemplazar códigos de recuperación")
+		return err
+	}
+	return nil
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID uint, codeHashes []string) error {
+	if err := tx.Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+	codes := make([]models.UserRecoveryCode, len(codeHashes))
+	for i, hash := range codeHashes {
+		codes[i] = models.UserRecoveryCode{UserID: userID, CodeHash: hash}
+	}
+	return tx.Create(&codes).Error
+}
+
+// CountRecoveryCodes cuenta los códigos de recuperación sin usar
+func (r *Repository) CountRecoveryCodes(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	if err := r.database.Conn(ctx).
+		Model(&models.UserRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error; err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al contar códigos de recuperación")
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteTwoFactor elimina el segundo factor y los códigos de recuperación del usuario. Retorna false
+// si no tenía segundo factor.
+func (r *Repository) DeleteTwoFactor(ctx context.Context, userID uint) (bool, error) {
+	deleted := false
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Where("user_id = ?", userID).
+			Delete(&models.UserTwoFactor{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected > 0
+		return replaceRecoveryCodes(tx, userID, nil)
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al eliminar segundo factor")
+		return false, err
+	}
+	return deleted, nil
+}
+
+// UserRequiresTwoFactor indica si algún rol del usuario, global o en alguno de sus negocios, exige
+// segundo factor
+func (r *Repository) UserRequiresTwoFactor(ctx context.Context, userID uint) (bool, error) {
+	var count int64
+	db := r.database.Conn(ctx)
+	subquery := db.Session(&gorm.Session{NewDB: true})
+	if err := db.
+		Model(&models.Role{}).
+		Where("role.require_two_factor = ?", true).
+		Where("role.id IN (?) OR role.id IN (?)",
+			subquery.Table("user_roles").Select("role_id").Where("user_id = ?", userID),
+			subquery.Model(&models.BusinessStaff{}).Select("role_id").Where("user_id = ? AND role_id IS NOT NULL", userID),
+		).
+		Count(&count).Error; err != nil {
+		r.logger.Error().Err(err).Uint("user_id", userID).Msg("Error al verificar si el usuario requiere segundo factor")
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -3,11 +3,16 @@ package middleware
 import (
 	"central_reserve/services/auth/internal/app/usecaseauth"
 	"central_reserve/services/auth/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/env"
 	"central_reserve/shared/log"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	sharedjwt "central_reserve/shared/jwt"
 
@@ -20,8 +25,8 @@ type jwtAdapter struct {
 	impl sharedjwt.IJWTService
 }
 
-func (a jwtAdapter) GenerateToken(userID uint) (string, error) {
-	return a.impl.GenerateToken(userID)
+func (a jwtAdapter) GenerateToken(userID, sessionID uint) (string, error) {
+	return a.impl.GenerateToken(userID, sessionID)
 }
 
 func (a jwtAdapter) ValidateToken(tokenString string) (*domain.JWTClaims, error) {
@@ -31,16 +36,21 @@ func (a jwtAdapter) ValidateToken(tokenString string) (*domain.JWTClaims, error)
 	}
 	return &domain.JWTClaims{
 		UserID:    claims.UserID,
+		SessionID: claims.SessionID,
 		TokenType: claims.TokenType,
 	}, nil
 }
 
-func (a jwtAdapter) RefreshToken(tokenString string) (string, error) {
-	return a.impl.RefreshToken(tokenString)
+func (a jwtAdapter) GenerateBusinessToken(userID, businessID, businessTypeID, roleID, sessionID uint) (string, error) {
+	return a.impl.GenerateBusinessToken(userID, businessID, businessTypeID, roleID, sessionID)
 }
 
-func (a jwtAdapter) GenerateBusinessToken(userID, businessID, businessTypeID, roleID uint) (string, error) {
-	return a.impl.GenerateBusinessToken(userID, businessID, businessTypeID, roleID)
+func (a jwtAdapter) GenerateTwoFactorToken(userID uint, tokenType string) (string, error) {
+	return a.impl.GenerateTwoFactorToken(userID, tokenType)
+}
+
+func (a jwtAdapter) ValidateTwoFactorToken(tokenString string) (uint, string, error) {
+	return a.impl.ValidateTwoFactorToken(tokenString)
 }
 
 func (a jwtAdapter) ValidateBusinessToken(tokenString string) (*domain.BusinessTokenClaims, error) {
@@ -53,6 +63,7 @@ func (a jwtAdapter) ValidateBusinessToken(tokenString string) (*domain.BusinessT
 		BusinessID:     claims.BusinessID,
 		BusinessTypeID: claims.BusinessTypeID,
 		RoleID:         claims.RoleID,
+		SessionID:      claims.SessionID,
 		TokenType:      claims.TokenType,
 	}, nil
 }
@@ -91,6 +102,7 @@ type AuthInfo struct {
 	Roles      []string
 	BusinessID uint
 	APIKey     string
+	APIKeyID   uint
 	JWTClaims  *domain.JWTClaims
 }
 
@@ -111,6 +123,7 @@ func AuthMiddleware(jwtService domain.IJWTService, logger log.ILogger) gin.Handl
 		c.Set("auth_type", authInfo.Type)
 		c.Set("user_id", authInfo.UserID)
 		c.Set("jwt_claims", authInfo.JWTClaims)
+		setAuditActor(c, authInfo)
 
 		// Agregar información al logger para trazabilidad
 		logger.Debug().
@@ -134,16 +147,32 @@ func APIKeyMiddleware(authUseCase usecaseauth.IAuthUseCase, logger log.ILogger)
 			return
 		}
 
+		if authUseCase == nil {
+			logger.Error().Msg("Autenticación por API Key usada sin configurar el caso de uso")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "No se pudo validar la API Key",
+			})
+			c.Abort()
+			return
+		}
+
 		request := domain.ValidateAPIKeyRequest{
 			APIKey: apiKey,
 		}
 
 		response, err := authUseCase.ValidateAPIKey(c.Request.Context(), request)
 		if err != nil {
-			logger.Error().Err(err).Msg("Error al validar API Key")
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": err.Error(),
-			})
+			if isAPIKeyAuthError(err) {
+				logger.Warn().Err(err).Msg("API Key rechazada")
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"error": err.Error(),
+				})
+			} else {
+				logger.Error().Err(err).Msg("Error al validar API Key")
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"error": "No se pudo validar la API Key",
+				})
+			}
 			c.Abort()
 			return
 		}
@@ -157,6 +186,47 @@ func APIKeyMiddleware(authUseCase usecaseauth.IAuthUseCase, logger log.ILogger)
 			return
 		}
 
+		// IPs permitidas
+		if !domain.IPAllowed(response.IPWhitelist, c.ClientIP()) {
+			logger.Warn().
+				Uint("api_key_id", response.APIKeyID).
+				Str("client_ip", c.ClientIP()).
+				Msg("IP no permitida para la API Key")
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": "IP no permitida para esta API Key",
+			})
+			c.Abort()
+			return
+		}
+
+		// Límite de requests por hora, compartido entre instancias
+		limit, err := authUseCase.ConsumeAPIKeyRateLimit(c.Request.Context(), response.APIKeyID, response.RateLimit)
+		if err != nil {
+			logger.Error().Err(err).Uint("api_key_id", response.APIKeyID).Msg("Error al contar request de API Key")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "No se pudo validar la API Key",
+			})
+			c.Abort()
+			return
+		}
+		if response.RateLimit > 0 {
+			c.Header("X-RateLimit-Limit", strconv.Itoa(response.RateLimit))
+			c.Header("X-RateLimit-Remaining", strconv.Itoa(limit.Remaining))
+			c.Header("X-RateLimit-Reset", strconv.FormatInt(limit.Reset.Unix(), 10))
+		}
+		if !limit.Allowed {
+			logger.Warn().
+				Uint("api_key_id", response.APIKeyID).
+				Int("rate_limit", response.RateLimit).
+				Msg("Límite de requests de la API Key excedido")
+			c.Header("Retry-After", strconv.Itoa(int(time.Until(limit.Reset).Seconds())+1))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error": "Límite de requests por hora excedido para esta API Key",
+			})
+			c.Abort()
+			return
+		}
+
 		authInfo := &AuthInfo{
 			Type:       AuthTypeAPIKey,
 			UserID:     response.UserID,
@@ -164,6 +234,7 @@ func APIKeyMiddleware(authUseCase usecaseauth.IAuthUseCase, logger log.ILogger)
 			Roles:      response.Roles,
 			BusinessID: response.BusinessID,
 			APIKey:     apiKey,
+			APIKeyID:   response.APIKeyID,
 		}
 
 		c.Set("auth_info", authInfo)
@@ -173,6 +244,7 @@ func APIKeyMiddleware(authUseCase usecaseauth.IAuthUseCase, logger log.ILogger)
 		c.Set("user_roles", authInfo.Roles)
 		c.Set("business_id", authInfo.BusinessID)
 		c.Set("jwt_claims", nil)
+		setAuditActor(c, authInfo)
 
 		logger.Debug().
 			Str("auth_type", string(authInfo.Type)).
@@ -180,12 +252,20 @@ func APIKeyMiddleware(authUseCase usecaseauth.IAuthUseCase, logger log.ILogger)
 			Str("user_email", authInfo.Email).
 			Strs("user_roles", authInfo.Roles).
 			Uint("business_id", authInfo.BusinessID).
+			Uint("api_key_id", authInfo.APIKeyID).
 			Msg("Usuario autenticado con API Key")
 
 		c.Next()
 	}
 }
 
+// isAPIKeyAuthError indica si el error se debe a la key (401) y no a un fallo interno
+func isAPIKeyAuthError(err error) bool {
+	return errors.Is(err, domain.ErrAPIKeyInvalid) ||
+		errors.Is(err, domain.ErrAPIKeyRevoked) ||
+		errors.Is(err, domain.ErrAPIKeyUserInactive)
+}
+
 func AutoAuthMiddleware(jwtService domain.IJWTService, authUseCase usecaseauth.IAuthUseCase, logger log.ILogger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Detectar si tiene Authorization header (JWT)
@@ -251,6 +331,11 @@ func validateJWT(c *gin.Context, jwtService domain.IJWTService) (*AuthInfo, erro
 		return nil, &AuthError{Message: "Se requiere un business token válido"}
 	}
 
+	// Verificar que la sesión del token no haya sido revocada
+	if err := checkSession(c, businessClaims.SessionID); err != nil {
+		return nil, err
+	}
+
 	// Es un business token, guardar toda la información
 	logger := log.New()
 
@@ -286,6 +371,18 @@ func validateJWT(c *gin.Context, jwtService domain.IJWTService) (*AuthInfo, erro
 	return authInfo, nil
 }
 
+// setAuditActor deja el actor en el contexto de la petición para que los casos de uso lo
+// registren en la auditoría
+func setAuditActor(c *gin.Context, authInfo *AuthInfo) {
+	actor := audit.Actor{
+		UserID:     authInfo.UserID,
+		APIKeyID:   authInfo.APIKeyID,
+		BusinessID: authInfo.BusinessID,
+		IPAddress:  c.ClientIP(),
+	}
+	c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), actor))
+}
+
 func extractAPIKey(c *gin.Context) string {
 	apiKey := c.GetHeader("X-API-Key")
 	if apiKey == "" {
@@ -557,6 +654,44 @@ func Configure(jwtService domain.IJWTService, authUseCase usecaseauth.IAuthUseCa
 	initialized = true
 }
 
+// ConfigureAPIKeys registra el caso de uso que valida API Keys. InitFromEnv configura el middleware
+// antes de que exista el servicio de auth, por lo que este lo registra al crearse.
+func ConfigureAPIKeys(authUseCase usecaseauth.IAuthUseCase) {
+	defaultAuthUseCase = authUseCase
+}
+
+// SessionChecker indica si la sesión de un token sigue vigente
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, sessionID uint) (bool, error)
+}
+
+var defaultSessionChecker SessionChecker
+
+// ConfigureSessions registra quién verifica las sesiones de los tokens. Sin él, los tokens se
+// rechazan: no se puede saber si su sesión fue revocada.
+func ConfigureSessions(checker SessionChecker) {
+	defaultSessionChecker = checker
+}
+
+// checkSession rechaza tokens sin sesión o cuya sesión fue revocada, venció o es de un usuario
+// inactivo. Se consulta en cada request para que logout y revocaciones apliquen de inmediato.
+func checkSession(c *gin.Context, sessionID uint) error {
+	if sessionID == 0 {
+		return &AuthError{Message: "Token sin sesión: inicia sesión nuevamente"}
+	}
+	if defaultSessionChecker == nil {
+		return &AuthError{Message: "Verificación de sesiones no configurada"}
+	}
+	active, err := defaultSessionChecker.IsSessionActive(c.Request.Context(), sessionID)
+	if err != nil {
+		return &AuthError{Message: "No se pudo verificar la sesión"}
+	}
+	if !active {
+		return &AuthError{Message: "La sesión fue cerrada o revocada"}
+	}
+	return nil
+}
+
 func ensureInitialized() {
 	if !initialized {
 		panic("auth middleware not configured: call middleware.Configure(...) during service bootstrap")
@@ -569,16 +704,21 @@ func JWT() gin.HandlerFunc {
 	return AuthMiddleware(defaultJWTService, defaultLogger)
 }
 
-// APIKey retorna el middleware de autenticación por API Key usando la configuración global
+// APIKey retorna el middleware de autenticación por API Key usando la configuración global.
+// El caso de uso se resuelve en cada request porque ConfigureAPIKeys puede llamarse después.
 func APIKey() gin.HandlerFunc {
 	ensureInitialized()
-	return APIKeyMiddleware(defaultAuthUseCase, defaultLogger)
+	return func(c *gin.Context) {
+		APIKeyMiddleware(defaultAuthUseCase, defaultLogger)(c)
+	}
 }
 
 // Auto retorna el middleware que detecta JWT o API Key usando la configuración global
 func Auto() gin.HandlerFunc {
 	ensureInitialized()
-	return AutoAuthMiddleware(defaultJWTService, defaultAuthUseCase, defaultLogger)
+	return func(c *gin.Context) {
+		AutoAuthMiddleware(defaultJWTService, defaultAuthUseCase, defaultLogger)(c)
+	}
 }
 
 // BusinessTokenAuth es un middleware específico para el endpoint de generar business token
@@ -630,6 +770,16 @@ func BusinessTokenAuthMiddleware(jwtService domain.IJWTService, logger log.ILogg
 			return
 		}
 
+		// Verificar que la sesión del token no haya sido revocada
+		if err := checkSession(c, mainClaims.SessionID); err != nil {
+			logger.Error().Err(err).Uint("user_id", mainClaims.UserID).Msg("Sesión no válida")
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+			c.Abort()
+			return
+		}
+
 		// Log de los claims del token principal
 		logger.Debug().
 			Uint("user_id", mainClaims.UserID).
@@ -638,6 +788,7 @@ func BusinessTokenAuthMiddleware(jwtService domain.IJWTService, logger log.ILogg
 		// Guardar información en el contexto
 		c.Set("user_id", mainClaims.UserID)
 		c.Set("jwt_claims", mainClaims)
+		setAuditActor(c, &AuthInfo{Type: AuthTypeJWT, UserID: mainClaims.UserID})
 
 		c.Next()
 	}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FeatureResolver obtiene los módulos habilitados de un negocio y descarta los que tenga en caché
+type FeatureResolver interface {
+	ResolveFeatures(ctx context.Context, businessID uint) (domain.FeatureSet, error)
+	InvalidateFeatures(businessID uint)
+}
+
+var defaultFeatureResolver FeatureResolver
+
+var errFeaturesNotConfigured = errors.New("resolvedor de módulos no configurado")
+
+// ConfigureFeatures registra el resolvedor de módulos usado por RequireFeature
+func ConfigureFeatures(resolver FeatureResolver) {
+	defaultFeatureResolver = resolver
+}
+
+// InvalidateBusinessFeatures descarta la caché de módulos de un negocio. Lo llaman los servicios
+// que cambian sus recursos activos o sus flags para que el cambio aplique de inmediato.
+func InvalidateBusinessFeatures(businessID uint) {
+	if defaultFeatureResolver != nil {
+		defaultFeatureResolver.InvalidateFeatures(businessID)
+	}
+}
+
+// ResolveBusinessFeatures obtiene los módulos habilitados de un negocio
+func ResolveBusinessFeatures(ctx context.Context, businessID uint) (domain.FeatureSet, error) {
+	if defaultFeatureResolver == nil {
+		return nil, errFeaturesNotConfigured
+	}
+	return defaultFeatureResolver.ResolveFeatures(ctx, businessID)
+}
+
+// RequireFeature crea un middleware que rechaza la petición si el negocio no tiene habilitado el
+// módulo (recurso configurado activo). Debe ir después de JWT(), APIKey() o Auto(). El super admin
+// (business_id = 0) no se restringe.
+func RequireFeature(feature string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authInfo, exists := GetAuthInfo(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Se requiere autenticación",
+			})
+			c.Abort()
+			return
+		}
+
+		if authInfo.Type == AuthTypeJWT && IsSuperAdmin(c) {
+			c.Next()
+			return
+		}
+
+		features, err := ResolveBusinessFeatures(c.Request.Context(), authInfo.BusinessID)
+		if err != nil {
+			defaultLogger.Error().Err(err).
+				Uint("business_id", authInfo.BusinessID).
+				Msg("Error al resolver módulos del negocio")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "No se pudieron verificar los módulos del negocio",
+			})
+			c.Abort()
+			return
+		}
+
+		if !features.Enabled(feature) {
+			defaultLogger.Warn().
+				Uint("business_id", authInfo.BusinessID).
+				Str("feature", feature).
+				Msg("Acceso denegado: módulo no habilitado")
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "feature_disabled",
+				"message": "El módulo " + feature + " no está habilitado para este negocio",
+				"feature": feature,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"central_reserve/services/auth/internal/domain"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recursos protegidos con RequirePermission. Se comparan con Resource.Name sin distinguir mayúsculas.
+const (
+	ResourceBusinesses           = "businesses"
+	ResourceBusinessTypes        = "business_types"
+	ResourceClients              = "clients"
+	ResourceTables               = "tables"
+	ResourceRooms                = "rooms"
+	ResourceReservations         = "reservations"
+	ResourceNotifications        = "notifications"
+	ResourceHorizontalProperties = "horizontal_properties"
+	ResourcePropertyUnits        = "property_units"
+	ResourceResidents            = "residents"
+	ResourceVotings              = "votings"
+	ResourceAttendance           = "attendance"
+	ResourceUsers                = "users"
+	ResourceAuditLogs            = "audit_logs"
+)
+
+// Acciones de los permisos. Se comparan con Action.Name sin distinguir mayúsculas;
+// manage concede todas las acciones del recurso.
+const (
+	ActionRead      = "read"
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionDelete    = "delete"
+	ActionConfigure = "configure"
+	ActionManage    = domain.ActionManage
+)
+
+// PermissionResolver obtiene los permisos efectivos de un usuario en un negocio.
+// roleID es el rol del business token; nil si la petición se autenticó con API Key.
+type PermissionResolver interface {
+	ResolvePermissions(ctx context.Context, userID uint, businessID uint, roleID *uint) (domain.PermissionSet, error)
+}
+
+var defaultPermissionResolver PermissionResolver
+
+// ConfigurePermissions registra el resolvedor de permisos usado por RequirePermission
+func ConfigurePermissions(resolver PermissionResolver) {
+	defaultPermissionResolver = resolver
+}
+
+// RequirePermission crea un middleware que exige que el rol del usuario en su negocio tenga la
+// acción sobre el recurso. Debe ir después de JWT(), APIKey() o Auto(). El super admin
+// (business_id = 0) no se restringe.
+func RequirePermission(resource, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authInfo, exists := GetAuthInfo(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Se requiere autenticación",
+			})
+			c.Abort()
+			return
+		}
+
+		if authInfo.Type == AuthTypeJWT && IsSuperAdmin(c) {
+			c.Next()
+			return
+		}
+
+		if defaultPermissionResolver == nil {
+			defaultLogger.Error().Msg("RequirePermission usado sin configurar el resolvedor de permisos")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "No se pudieron verificar los permisos",
+			})
+			c.Abort()
+			return
+		}
+
+		var roleID *uint
+		if id, ok := GetRoleID(c); ok && authInfo.Type == AuthTypeJWT {
+			roleID = &id
+		}
+
+		permissions, err := defaultPermissionResolver.ResolvePermissions(c.Request.Context(), authInfo.UserID, authInfo.BusinessID, roleID)
+		if err != nil {
+			defaultLogger.Error().Err(err).
+				Uint("user_id", authInfo.UserID).
+				Uint("business_id", authInfo.BusinessID).
+				Msg("Error al resolver permisos")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "No se pudieron verificar los permisos",
+			})
+			c.Abort()
+			return
+		}
+
+		if !permissions.Allows(resource, action) {
+			defaultLogger.Warn().
+				Uint("user_id", authInfo.UserID).
+				Uint("business_id", authInfo.BusinessID).
+				Str("resource", resource).
+				Str("action", action).
+				Msg("Acceso denegado por permisos")
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":    "Acceso denegado: permiso requerido",
+				"resource": resource,
+				"action":   action,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Set("permissions", permissions)
+		c.Next()
+	}
+}
+
+// GetPermissions obtiene los permisos resueltos por RequirePermission desde el contexto
+func GetPermissions(c *gin.Context) (domain.PermissionSet, bool) {
+	permissions, exists := c.Get("permissions")
+	if !exists {
+		return nil, false
+	}
+	if p, ok := permissions.(domain.PermissionSet); ok {
+		return p, true
+	}
+	return nil, false
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"central_reserve/shared/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AllBusinessesFilter es el valor de ?business_id= con que el super admin consulta todos los negocios
+const AllBusinessesFilter = "all"
+
+var (
+	// ErrBusinessScopeUnavailable indica que el token no trae un negocio con el que acotar la request
+	ErrBusinessScopeUnavailable = errors.New("el token no tiene un negocio asociado")
+	// ErrInvalidBusinessFilter indica que el business_id recibido no es un número válido
+	ErrInvalidBusinessFilter = errors.New("business_id inválido")
+	// ErrBusinessFilterForbidden indica que un usuario de negocio intentó operar sobre otro negocio
+	ErrBusinessFilterForbidden = errors.New("solo puede operar sobre los datos de su negocio")
+	// ErrBusinessRequired indica que el super admin debe indicar el negocio sobre el que opera
+	ErrBusinessRequired = errors.New("business_id es requerido")
+)
+
+// ScopeBusiness determina el negocio al que se acota una operación. Para usuarios de negocio es
+// siempre el del business token; si piden explícitamente otro negocio se rechaza. El super admin
+// debe indicar el negocio: no indicarlo nunca equivale a todos.
+func ScopeBusiness(c *gin.Context, requested uint) (uint, error) {
+	if requested == db.AllBusinesses {
+		return 0, ErrInvalidBusinessFilter
+	}
+	if IsSuperAdmin(c) {
+		if requested == 0 {
+			return 0, ErrBusinessRequired
+		}
+		return requested, nil
+	}
+
+	businessID, ok := GetBusinessIDFromContext(c)
+	if !ok || businessID == 0 {
+		return 0, ErrBusinessScopeUnavailable
+	}
+	if requested != 0 && requested != businessID {
+		return 0, ErrBusinessFilterForbidden
+	}
+	return businessID, nil
+}
+
+// ResolveBusinessScope acota una request al negocio del token, tomando el filtro ?business_id= que
+// solo el super admin puede usar para consultar otros negocios (y que para él es obligatorio). Con
+// ?business_id=all el super admin opta explícitamente por todos los negocios: devuelve db.AllBusinesses.
+func ResolveBusinessScope(c *gin.Context) (uint, error) {
+	var requested uint
+	if raw := c.Query("business_id"); raw == AllBusinessesFilter {
+		if !IsSuperAdmin(c) {
+			return 0, ErrBusinessFilterForbidden
+		}
+		return db.AllBusinesses, nil
+	} else if raw != "" {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return 0, ErrInvalidBusinessFilter
+		}
+		requested = uint(id)
+	}
+	return ScopeBusiness(c, requested)
+}
+
+// RequireBusiness acota la operación a un negocio concreto, nunca a todos, como al crear registros
+func RequireBusiness(c *gin.Context, requested uint) (uint, error) {
+	return ScopeBusiness(c, requested)
+}
+
+// BusinessScopeStatus traduce los errores de acotación por negocio a su código HTTP
+func BusinessScopeStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrBusinessScopeUnavailable):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrBusinessFilterForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
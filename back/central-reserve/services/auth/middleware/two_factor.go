@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	sharedjwt "central_reserve/shared/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TwoFactorEnrollmentAuth autentica la inscripción del segundo factor. Acepta el token intermedio
+// que emite el login cuando el rol exige segundo factor y el usuario aún no lo tiene; en ese caso
+// marca el contexto con two_factor_enrollment. Cualquier otro token se valida como business token.
+func TwoFactorEnrollmentAuth() gin.HandlerFunc {
+	ensureInitialized()
+	return func(c *gin.Context) {
+		token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if token != "" {
+			userID, tokenType, err := defaultJWTService.ValidateTwoFactorToken(token)
+			if err == nil && tokenType == sharedjwt.TwoFactorTokenEnrollment {
+				c.Set("user_id", userID)
+				c.Set("two_factor_enrollment", true)
+				c.Next()
+				return
+			}
+			if err == nil {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"error": "Este token solo sirve para completar el login en /auth/login/2fa",
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		AuthMiddleware(defaultJWTService, defaultLogger)(c)
+	}
+}
+
+// IsTwoFactorEnrollment indica si la request se autenticó con el token intermedio de inscripción
+func IsTwoFactorEnrollment(c *gin.Context) bool {
+	return c.GetBool("two_factor_enrollment")
+}
@@ -2,9 +2,11 @@ package business
 
 import (
 	"central_reserve/services/business/internal/app/usecasebusiness"
+	"central_reserve/services/business/internal/app/usecasebusinessschedule"
 	"central_reserve/services/business/internal/app/usecasebusinesstype"
 	"central_reserve/services/business/internal/domain"
 	"central_reserve/services/business/internal/infra/primary/controllers/businesshandler"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler"
 	"central_reserve/services/business/internal/infra/primary/controllers/businesstypehandler"
 	"central_reserve/services/business/internal/infra/secondary/repository"
 	"central_reserve/shared/db"
@@ -19,10 +21,13 @@ func New(db db.IDatabase, env env.IConfig, logger log.ILogger, s3 domain.IS3Serv
 
 	usecasebusiness := usecasebusiness.New(repository, logger, s3, env)
 	usecasebusinesstype := usecasebusinesstype.New(repository, logger)
+	usecasebusinessschedule := usecasebusinessschedule.New(repository, logger)
 
 	businessHandler := businesshandler.New(usecasebusiness, logger)
 	businesstypeHandler := businesstypehandler.New(usecasebusinesstype, logger)
+	businessscheduleHandler := businessschedulehandler.New(usecasebusinessschedule, logger)
 
 	businessHandler.RegisterRoutes(v1Group, businessHandler)
 	businesstypehandler.RegisterRoutes(v1Group, businesstypeHandler)
+	businessschedulehandler.RegisterRoutes(v1Group, businessscheduleHandler)
 }
@@ -2,6 +2,7 @@ package usecasebusiness
 
 import (
 	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -96,6 +97,14 @@ func (uc *BusinessUseCase) CreateBusiness(ctx context.Context, request domain.Bu
 		return nil, fmt.Errorf("error al obtener negocio creado: %w", err)
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: created.ID,
+		EntityType: "business",
+		EntityID:   created.ID,
+		Action:     audit.ActionCreate,
+		After:      created,
+	})
+
 	// Completar URL de logo si es path relativo
 	fullLogoURL := created.LogoURL
 	if fullLogoURL != "" && !strings.HasPrefix(fullLogoURL, "http") {
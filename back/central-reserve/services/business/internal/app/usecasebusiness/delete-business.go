@@ -1,6 +1,7 @@
 package usecasebusiness
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -28,6 +29,14 @@ func (uc *BusinessUseCase) DeleteBusiness(ctx context.Context, id uint) error {
 		return fmt.Errorf("error al eliminar negocio: %w", err)
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: id,
+		EntityType: "business",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existing,
+	})
+
 	uc.log.Info().Uint("id", id).Msg("Negocio eliminado exitosamente")
 	return nil
 }
@@ -1,6 +1,7 @@
 package usecasebusiness
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"errors"
 )
@@ -50,6 +51,18 @@ func (uc *BusinessUseCase) ToggleBusinessResourceActive(ctx context.Context, bus
 		return err
 	}
 
+	action := audit.ActionDeactivate
+	if active {
+		action = audit.ActionActivate
+	}
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "business_resource",
+		EntityID:   resourceID,
+		Action:     action,
+		After:      map[string]any{"resource": resource.Name, "active": active},
+	})
+
 	uc.log.Info().Uint("business_id", businessID).Uint("resource_id", resourceID).Bool("active", active).Msg("Estado del recurso actualizado exitosamente")
 	return nil
 }
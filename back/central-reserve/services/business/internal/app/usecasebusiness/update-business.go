@@ -2,6 +2,7 @@ package usecasebusiness
 
 import (
 	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -178,6 +179,15 @@ func (uc *BusinessUseCase) UpdateBusiness(ctx context.Context, id uint, request
 		return nil, fmt.Errorf("error al obtener negocio actualizado: %w", err)
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: id,
+		EntityType: "business",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existing,
+		After:      updated,
+	})
+
 	// Completar URL de logo si es path relativo
 	fullLogoURL := updated.LogoURL
 	if fullLogoURL != "" && !strings.HasPrefix(fullLogoURL, "http") {
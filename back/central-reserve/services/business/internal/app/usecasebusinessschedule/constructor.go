@@ -0,0 +1,26 @@
+package usecasebusinessschedule
+
+import (
+	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/log"
+	"context"
+)
+
+type IUseCaseBusinessSchedule interface {
+	GetBusinessSchedule(ctx context.Context, businessID uint) (*domain.BusinessScheduleResponse, error)
+	UpdateBusinessSchedule(ctx context.Context, businessID uint, request domain.BusinessScheduleRequest) (*domain.BusinessScheduleResponse, error)
+	CreateBlackoutDate(ctx context.Context, businessID uint, request domain.BlackoutDateRequest) (*domain.BlackoutDateDTO, error)
+	DeleteBlackoutDate(ctx context.Context, businessID, id uint) error
+}
+
+type BusinessScheduleUseCase struct {
+	repository domain.IBusinessRepository
+	log        log.ILogger
+}
+
+func New(repository domain.IBusinessRepository, log log.ILogger) IUseCaseBusinessSchedule {
+	return &BusinessScheduleUseCase{
+		repository: repository,
+		log:        log,
+	}
+}
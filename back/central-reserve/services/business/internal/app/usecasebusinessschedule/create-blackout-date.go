@@ -0,0 +1,64 @@
+package usecasebusinessschedule
+
+import (
+	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CreateBlackoutDate registra un día cerrado (festivo) o con horario especial para un negocio
+func (uc *BusinessScheduleUseCase) CreateBlackoutDate(ctx context.Context, businessID uint, request domain.BlackoutDateRequest) (*domain.BlackoutDateDTO, error) {
+	uc.log.Info().Uint("business_id", businessID).Time("date", request.Date).Bool("is_closed", request.IsClosed).Msg("Creando excepción de horario")
+
+	if _, err := uc.getBusiness(ctx, businessID); err != nil {
+		return nil, err
+	}
+
+	blackout := domain.BusinessBlackoutDate{
+		BusinessID: businessID,
+		Date:       time.Date(request.Date.Year(), request.Date.Month(), request.Date.Day(), 0, 0, 0, 0, time.UTC),
+		IsClosed:   request.IsClosed,
+		Reason:     request.Reason,
+	}
+
+	// Un día con horario especial requiere apertura y cierre válidos
+	if !request.IsClosed {
+		if request.OpenTime == nil || request.CloseTime == nil {
+			return nil, fmt.Errorf("%w: open_time y close_time son requeridos si el día no está cerrado", domain.ErrInvalidOpeningHour)
+		}
+		if _, err := domain.ParseClock(*request.OpenTime); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidOpeningHour, err)
+		}
+		if _, err := domain.ParseClock(*request.CloseTime); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidOpeningHour, err)
+		}
+		blackout.OpenTime = request.OpenTime
+		blackout.CloseTime = request.CloseTime
+	}
+
+	id, err := uc.repository.CreateBlackoutDate(ctx, blackout)
+	if err != nil {
+		if errors.Is(err, domain.ErrBlackoutDateAlreadyExists) {
+			return nil, err
+		}
+		uc.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al crear excepción de horario")
+		return nil, fmt.Errorf("error al crear excepción de horario: %w", err)
+	}
+
+	blackout.ID = id
+	dto := blackoutToDTO(blackout)
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "blackout_date",
+		EntityID:   id,
+		Action:     audit.ActionCreate,
+		After:      dto,
+	})
+
+	uc.log.Info().Uint("business_id", businessID).Uint("id", id).Msg("Excepción de horario creada exitosamente")
+	return &dto, nil
+}
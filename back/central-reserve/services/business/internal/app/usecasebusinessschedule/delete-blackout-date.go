@@ -0,0 +1,32 @@
+package usecasebusinessschedule
+
+import (
+	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DeleteBlackoutDate elimina una excepción de horario de un negocio
+func (uc *BusinessScheduleUseCase) DeleteBlackoutDate(ctx context.Context, businessID, id uint) error {
+	uc.log.Info().Uint("business_id", businessID).Uint("id", id).Msg("Eliminando excepción de horario")
+
+	if err := uc.repository.DeleteBlackoutDate(ctx, businessID, id); err != nil {
+		if errors.Is(err, domain.ErrBlackoutDateNotFound) {
+			return err
+		}
+		uc.log.Error().Err(err).Uint("business_id", businessID).Uint("id", id).Msg("Error al eliminar excepción de horario")
+		return fmt.Errorf("error al eliminar excepción de horario: %w", err)
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "blackout_date",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+	})
+
+	uc.log.Info().Uint("business_id", businessID).Uint("id", id).Msg("Excepción de horario eliminada exitosamente")
+	return nil
+}
@@ -0,0 +1,105 @@
+package usecasebusinessschedule
+
+import (
+	"central_reserve/services/business/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GetBusinessSchedule obtiene las reglas de reserva, el horario semanal y las excepciones vigentes de un negocio
+func (uc *BusinessScheduleUseCase) GetBusinessSchedule(ctx context.Context, businessID uint) (*domain.BusinessScheduleResponse, error) {
+	business, err := uc.getBusiness(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := uc.repository.GetReservationSettings(ctx, businessID)
+	if err != nil {
+		uc.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener reglas de reserva")
+		return nil, fmt.Errorf("error al obtener reglas de reserva: %w", err)
+	}
+	if settings == nil {
+		settings = &domain.BusinessReservationSettings{
+			BusinessID:             businessID,
+			SlotMinutes:            domain.DefaultSlotMinutes,
+			DefaultDurationMinutes: domain.DefaultReservationMinutes,
+			EmailLocale:            domain.DefaultEmailLocale,
+			ReminderHoursBefore:    domain.DefaultReminderHoursBefore,
+			NoShowGraceMinutes:     domain.DefaultNoShowGraceMinutes,
+		}
+	}
+
+	hours, err := uc.repository.GetOpeningHours(ctx, businessID)
+	if err != nil {
+		uc.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener horario de atención")
+		return nil, fmt.Errorf("error al obtener horario de atención: %w", err)
+	}
+
+	// Solo se listan las excepciones desde hoy (en la zona horaria del negocio) en adelante
+	loc, err := time.LoadLocation(business.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	year, month, day := time.Now().In(loc).Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	blackouts, err := uc.repository.GetBlackoutDates(ctx, businessID, &today)
+	if err != nil {
+		uc.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener excepciones de horario")
+		return nil, fmt.Errorf("error al obtener excepciones de horario: %w", err)
+	}
+
+	response := &domain.BusinessScheduleResponse{
+		BusinessID:             businessID,
+		Timezone:               business.Timezone,
+		SlotMinutes:            settings.SlotMinutes,
+		DefaultDurationMinutes: settings.DefaultDurationMinutes,
+		MinLeadTimeMinutes:     settings.MinLeadTimeMinutes,
+		MaxAdvanceDays:         settings.MaxAdvanceDays,
+		EmailLocale:            settings.EmailLocale,
+		ReminderHoursBefore:    settings.ReminderHoursBefore,
+		NoShowGraceMinutes:     settings.NoShowGraceMinutes,
+		OpeningHours:           make([]domain.OpeningHourDTO, len(hours)),
+		BlackoutDates:          make([]domain.BlackoutDateDTO, len(blackouts)),
+	}
+	for i, hour := range hours {
+		response.OpeningHours[i] = domain.OpeningHourDTO{
+			DayOfWeek: hour.DayOfWeek,
+			OpenTime:  hour.OpenTime,
+			CloseTime: hour.CloseTime,
+		}
+	}
+	for i, blackout := range blackouts {
+		response.BlackoutDates[i] = blackoutToDTO(blackout)
+	}
+
+	return response, nil
+}
+
+// getBusiness verifica que el negocio exista
+func (uc *BusinessScheduleUseCase) getBusiness(ctx context.Context, businessID uint) (*domain.Business, error) {
+	business, err := uc.repository.GetBusinessByID(ctx, businessID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			uc.log.Warn().Uint("business_id", businessID).Msg("Negocio no encontrado")
+			return nil, domain.ErrBusinessNotFound
+		}
+		uc.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener negocio")
+		return nil, fmt.Errorf("error al obtener negocio: %w", err)
+	}
+	return business, nil
+}
+
+func blackoutToDTO(blackout domain.BusinessBlackoutDate) domain.BlackoutDateDTO {
+	return domain.BlackoutDateDTO{
+		ID:        blackout.ID,
+		Date:      blackout.Date,
+		IsClosed:  blackout.IsClosed,
+		OpenTime:  blackout.OpenTime,
+		CloseTime: blackout.CloseTime,
+		Reason:    blackout.Reason,
+	}
+}
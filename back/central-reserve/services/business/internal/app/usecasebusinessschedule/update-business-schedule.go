@@ -0,0 +1,149 @@
+package usecasebusinessschedule
+
+import (
+	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+	"sort"
+)
+
+const minutesPerWeek = 7 * domain.MinutesPerDay
+
+// UpdateBusinessSchedule reemplaza las reglas de reserva y el horario semanal de un negocio
+func (uc *BusinessScheduleUseCase) UpdateBusinessSchedule(ctx context.Context, businessID uint, request domain.BusinessScheduleRequest) (*domain.BusinessScheduleResponse, error) {
+	uc.log.Info().Uint("business_id", businessID).Int("opening_hours", len(request.OpeningHours)).Msg("Actualizando horario de reservas")
+
+	if _, err := uc.getBusiness(ctx, businessID); err != nil {
+		return nil, err
+	}
+
+	if request.EmailLocale == "" {
+		request.EmailLocale = domain.DefaultEmailLocale
+	}
+	if request.ReminderHoursBefore == nil {
+		hours := domain.DefaultReminderHoursBefore
+		request.ReminderHoursBefore = &hours
+	}
+	if request.NoShowGraceMinutes == nil {
+		minutes := domain.DefaultNoShowGraceMinutes
+		request.NoShowGraceMinutes = &minutes
+	}
+	if err := validateSlotRules(request); err != nil {
+		return nil, err
+	}
+	if err := validateOpeningHours(request.OpeningHours); err != nil {
+		return nil, err
+	}
+
+	settings := domain.BusinessReservationSettings{
+		BusinessID:             businessID,
+		SlotMinutes:            request.SlotMinutes,
+		DefaultDurationMinutes: request.DefaultDurationMinutes,
+		MinLeadTimeMinutes:     request.MinLeadTimeMinutes,
+		MaxAdvanceDays:         request.MaxAdvanceDays,
+		EmailLocale:            request.EmailLocale,
+		ReminderHoursBefore:    *request.ReminderHoursBefore,
+		NoShowGraceMinutes:     *request.NoShowGraceMinutes,
+	}
+	hours := make([]domain.BusinessOpeningHour, len(request.OpeningHours))
+	for i, hour := range request.OpeningHours {
+		hours[i] = domain.BusinessOpeningHour{
+			BusinessID: businessID,
+			DayOfWeek:  hour.DayOfWeek,
+			OpenTime:   hour.OpenTime,
+			CloseTime:  hour.CloseTime,
+		}
+	}
+
+	// Horario anterior para la auditoría; un negocio sin horario guardado no lo tiene
+	before, _ := uc.GetBusinessSchedule(ctx, businessID)
+
+	if err := uc.repository.SaveBusinessSchedule(ctx, settings, hours); err != nil {
+		uc.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al guardar horario de reservas")
+		return nil, fmt.Errorf("error al guardar horario de reservas: %w", err)
+	}
+
+	after, err := uc.GetBusinessSchedule(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "business_schedule",
+		EntityID:   businessID,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      after,
+	})
+
+	uc.log.Info().Uint("business_id", businessID).Msg("Horario de reservas actualizado exitosamente")
+	return after, nil
+}
+
+// validateSlotRules valida la granularidad, duración y ventanas de anticipación
+func validateSlotRules(request domain.BusinessScheduleRequest) error {
+	if request.SlotMinutes <= 0 || domain.MinutesPerDay%request.SlotMinutes != 0 {
+		return fmt.Errorf("%w: slot_minutes debe dividir el día en partes exactas", domain.ErrInvalidSlotRules)
+	}
+	if request.DefaultDurationMinutes <= 0 || request.DefaultDurationMinutes > domain.MinutesPerDay {
+		return fmt.Errorf("%w: default_duration_minutes debe estar entre 1 y %d", domain.ErrInvalidSlotRules, domain.MinutesPerDay)
+	}
+	if request.MinLeadTimeMinutes < 0 {
+		return fmt.Errorf("%w: min_lead_time_minutes no puede ser negativo", domain.ErrInvalidSlotRules)
+	}
+	if request.MaxAdvanceDays < 0 {
+		return fmt.Errorf("%w: max_advance_days no puede ser negativo", domain.ErrInvalidSlotRules)
+	}
+	if hours := *request.ReminderHoursBefore; hours < 0 || hours > domain.MaxReminderHoursBefore {
+		return fmt.Errorf("%w: reminder_hours_before debe estar entre 0 y %d", domain.ErrInvalidSlotRules, domain.MaxReminderHoursBefore)
+	}
+	if minutes := *request.NoShowGraceMinutes; minutes < 0 || minutes > domain.MinutesPerDay {
+		return fmt.Errorf("%w: no_show_grace_minutes debe estar entre 0 y %d", domain.ErrInvalidSlotRules, domain.MinutesPerDay)
+	}
+	for _, locale := range domain.SupportedEmailLocales {
+		if request.EmailLocale == locale {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: email_locale debe ser uno de %v", domain.ErrInvalidSlotRules, domain.SupportedEmailLocales)
+}
+
+// validateOpeningHours valida el formato de cada franja y que no se solapen dentro de la semana
+func validateOpeningHours(hours []domain.OpeningHourDTO) error {
+	type window struct{ start, end int }
+	windows := make([]window, 0, len(hours))
+
+	for _, hour := range hours {
+		if hour.DayOfWeek < 0 || hour.DayOfWeek > 6 {
+			return fmt.Errorf("%w: day_of_week debe estar entre 0 (domingo) y 6 (sábado)", domain.ErrInvalidOpeningHour)
+		}
+		open, err := domain.ParseClock(hour.OpenTime)
+		if err != nil {
+			return fmt.Errorf("%w: %v", domain.ErrInvalidOpeningHour, err)
+		}
+		closeAt, err := domain.ParseClock(hour.CloseTime)
+		if err != nil {
+			return fmt.Errorf("%w: %v", domain.ErrInvalidOpeningHour, err)
+		}
+		// Una hora de cierre menor o igual a la de apertura indica que cierra al día siguiente
+		if closeAt <= open {
+			closeAt += domain.MinutesPerDay
+		}
+		start := hour.DayOfWeek*domain.MinutesPerDay + open
+		windows = append(windows, window{start: start, end: start + closeAt - open})
+	}
+
+	sort.Slice(windows, func(i, j int) bool { return windows[i].start < windows[j].start })
+	for i := 1; i < len(windows); i++ {
+		if windows[i].start < windows[i-1].end {
+			return fmt.Errorf("%w: las franjas horarias se solapan", domain.ErrInvalidOpeningHour)
+		}
+	}
+	// La última franja de la semana puede extenderse hasta el domingo siguiente
+	if n := len(windows); n > 1 && windows[n-1].end > minutesPerWeek && windows[n-1].end-minutesPerWeek > windows[0].start {
+		return fmt.Errorf("%w: las franjas horarias se solapan", domain.ErrInvalidOpeningHour)
+	}
+	return nil
+}
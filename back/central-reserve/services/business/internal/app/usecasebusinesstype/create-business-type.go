@@ -2,6 +2,7 @@ package usecasebusinesstype
 
 import (
 	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -45,6 +46,13 @@ func (uc *BusinessTypeUseCase) CreateBusinessType(ctx context.Context, request d
 		return nil, fmt.Errorf("error al obtener tipo de negocio creado: %w", err)
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "business_type",
+		EntityID:   created.ID,
+		Action:     audit.ActionCreate,
+		After:      created,
+	})
+
 	response := &domain.BusinessTypeResponse{
 		ID:          created.ID,
 		Name:        created.Name,
@@ -1,6 +1,7 @@
 package usecasebusinesstype
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -28,6 +29,13 @@ func (uc *BusinessTypeUseCase) DeleteBusinessType(ctx context.Context, id uint)
 		return fmt.Errorf("error al eliminar tipo de negocio: %w", err)
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "business_type",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existing,
+	})
+
 	uc.log.Info().Uint("id", id).Msg("Tipo de negocio eliminado exitosamente")
 	return nil
 }
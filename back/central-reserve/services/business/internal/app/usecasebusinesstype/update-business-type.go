@@ -2,6 +2,7 @@ package usecasebusinesstype
 
 import (
 	"central_reserve/services/business/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -59,6 +60,14 @@ func (uc *BusinessTypeUseCase) UpdateBusinessType(ctx context.Context, id uint,
 		return nil, fmt.Errorf("error al obtener tipo de negocio actualizado: %w", err)
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "business_type",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existing,
+		After:      updated,
+	})
+
 	response := &domain.BusinessTypeResponse{
 		ID:          updated.ID,
 		Name:        updated.Name,
@@ -195,3 +195,56 @@ type BusinessesWithConfiguredResourcesPaginatedResponse struct {
 	Businesses []BusinessWithConfiguredResourcesResponse
 	Pagination PaginationResponse
 }
+
+// BusinessScheduleRequest representa la solicitud para reemplazar las reglas y el horario semanal de un negocio
+type BusinessScheduleRequest struct {
+	SlotMinutes            int
+	DefaultDurationMinutes int
+	MinLeadTimeMinutes     int
+	MaxAdvanceDays         int
+	EmailLocale            string
+	ReminderHoursBefore    *int // nil = DefaultReminderHoursBefore
+	NoShowGraceMinutes     *int // nil = DefaultNoShowGraceMinutes
+	OpeningHours           []OpeningHourDTO
+}
+
+// OpeningHourDTO representa una franja del horario semanal
+type OpeningHourDTO struct {
+	DayOfWeek int
+	OpenTime  string
+	CloseTime string
+}
+
+// BusinessScheduleResponse representa las reglas, horario y excepciones de un negocio
+type BusinessScheduleResponse struct {
+	BusinessID             uint
+	Timezone               string
+	SlotMinutes            int
+	DefaultDurationMinutes int
+	MinLeadTimeMinutes     int
+	MaxAdvanceDays         int
+	EmailLocale            string
+	ReminderHoursBefore    int
+	NoShowGraceMinutes     int
+	OpeningHours           []OpeningHourDTO
+	BlackoutDates          []BlackoutDateDTO
+}
+
+// BlackoutDateRequest representa la solicitud para registrar un día cerrado o con horario especial
+type BlackoutDateRequest struct {
+	Date      time.Time
+	IsClosed  bool
+	OpenTime  *string
+	CloseTime *string
+	Reason    string
+}
+
+// BlackoutDateDTO representa un día cerrado o con horario especial
+type BlackoutDateDTO struct {
+	ID        uint
+	Date      time.Time
+	IsClosed  bool
+	OpenTime  *string
+	CloseTime *string
+	Reason    string
+}
@@ -69,3 +69,36 @@ type Resource struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// BusinessReservationSettings representa las reglas de reserva de un negocio
+type BusinessReservationSettings struct {
+	BusinessID             uint
+	SlotMinutes            int
+	DefaultDurationMinutes int
+	MinLeadTimeMinutes     int
+	MaxAdvanceDays         int
+	EmailLocale            string
+	ReminderHoursBefore    int
+	NoShowGraceMinutes     int
+}
+
+// BusinessOpeningHour representa una franja del horario semanal de un negocio
+type BusinessOpeningHour struct {
+	ID         uint
+	BusinessID uint
+	DayOfWeek  int    // 0=domingo ... 6=sábado
+	OpenTime   string // HH:MM hora local del negocio
+	CloseTime  string // HH:MM; si es <= OpenTime cierra al día siguiente
+}
+
+// BusinessBlackoutDate representa un día cerrado o con horario especial
+type BusinessBlackoutDate struct {
+	ID         uint
+	BusinessID uint
+	Date       time.Time
+	IsClosed   bool
+	OpenTime   *string
+	CloseTime  *string
+	Reason     string
+	CreatedAt  time.Time
+}
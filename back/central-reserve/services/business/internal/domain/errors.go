@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrBusinessCodeAlreadyExists   = errors.New("el código del negocio ya está en uso")
 	ErrBusinessDomainAlreadyExists = errors.New("el dominio personalizado ya está en uso")
+	ErrBusinessNotFound            = errors.New("negocio no encontrado")
+	ErrInvalidSlotRules            = errors.New("las reglas de reserva son inválidas")
+	ErrInvalidOpeningHour          = errors.New("el horario de atención es inválido")
+	ErrBlackoutDateAlreadyExists   = errors.New("ya existe una excepción de horario para esa fecha")
+	ErrBlackoutDateNotFound        = errors.New("excepción de horario no encontrada")
 )
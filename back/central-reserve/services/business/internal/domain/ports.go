@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"mime/multipart"
+	"time"
 )
 
 type IBusinessRepository interface {
@@ -32,6 +33,14 @@ type IBusinessRepository interface {
 	GetBusinessConfiguredResourcesIDs(ctx context.Context, businessID uint) ([]uint, error)
 	UpdateBusinessConfiguredResources(ctx context.Context, businessID uint, resourcesIDs []uint) error
 	ToggleBusinessResourceActive(ctx context.Context, businessID uint, resourceID uint, active bool) error
+
+	// Métodos para horario de atención y reglas de reserva
+	GetReservationSettings(ctx context.Context, businessID uint) (*BusinessReservationSettings, error)
+	GetOpeningHours(ctx context.Context, businessID uint) ([]BusinessOpeningHour, error)
+	SaveBusinessSchedule(ctx context.Context, settings BusinessReservationSettings, hours []BusinessOpeningHour) error
+	GetBlackoutDates(ctx context.Context, businessID uint, from *time.Time) ([]BusinessBlackoutDate, error)
+	CreateBlackoutDate(ctx context.Context, blackout BusinessBlackoutDate) (uint, error)
+	DeleteBlackoutDate(ctx context.Context, businessID, id uint) error
 }
 
 // IS3Service define las operaciones de almacenamiento en S3
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultSlotMinutes es la granularidad de reserva cuando el negocio no ha configurado reglas
+	DefaultSlotMinutes = 30
+	// DefaultReservationMinutes es la duración de una reserva sin hora de fin explícita
+	DefaultReservationMinutes = 120
+	// MinutesPerDay se usa para validar que la granularidad divida el día en partes exactas
+	MinutesPerDay = 24 * 60
+	// DefaultEmailLocale es el idioma de los emails a clientes si el negocio no lo configura
+	DefaultEmailLocale = "es"
+	// DefaultReminderHoursBefore es la anticipación del recordatorio por email (0 = sin recordatorio)
+	DefaultReminderHoursBefore = 24
+	// MaxReminderHoursBefore acota el recordatorio a una semana antes de la reserva
+	MaxReminderHoursBefore = 7 * 24
+	// DefaultNoShowGraceMinutes es la tolerancia para marcar no-show automático (0 = desactivado)
+	DefaultNoShowGraceMinutes = 0
+)
+
+// SupportedEmailLocales son los idiomas con plantillas de email disponibles
+var SupportedEmailLocales = []string{"es", "en"}
+
+// ParseClock convierte una hora "HH:MM" en minutos desde la medianoche
+func ParseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("hora inválida %q: se espera formato HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"central_reserve/services/auth/middleware"
 	"central_reserve/services/business/internal/infra/primary/controllers/businesshandler/mapper"
 
 	"github.com/gin-gonic/gin"
@@ -44,6 +45,8 @@ func (h *BusinessHandler) DeleteBusinessHandler(c *gin.Context) {
 		return
 	}
 
+	middleware.InvalidateBusinessFeatures(uint(id))
+
 	// Construir respuesta exitosa
 	response := mapper.BuildDeleteBusinessResponse("Negocio eliminado exitosamente")
 	c.JSON(http.StatusOK, response)
@@ -8,18 +8,24 @@ import (
 
 // RegisterRoutes registra las rutas del handler de Business
 func (h *BusinessHandler) RegisterRoutes(router *gin.RouterGroup, handler IBusinessHandler) {
+	read := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionDelete)
+	configure := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionConfigure)
+
 	businesses := router.Group("/businesses")
 
 	// Rutas de Business
-	businesses.GET("", middleware.JWT(), handler.GetBusinesses)
-	businesses.GET("/configured-resources", middleware.JWT(), handler.GetBusinessesConfiguredResourcesHandler)
-	businesses.GET("/:id/configured-resources", middleware.JWT(), handler.GetBusinessConfiguredResourcesByIDHandler)
-	businesses.GET("/:id", middleware.JWT(), handler.GetBusinessByIDHandler)
-	businesses.POST("", middleware.JWT(), handler.CreateBusinessHandler)
-	businesses.PUT("/:id", middleware.JWT(), handler.UpdateBusinessHandler)
-	businesses.DELETE("/:id", middleware.JWT(), handler.DeleteBusinessHandler)
+	businesses.GET("", middleware.JWT(), read, handler.GetBusinesses)
+	businesses.GET("/configured-resources", middleware.JWT(), read, handler.GetBusinessesConfiguredResourcesHandler)
+	businesses.GET("/:id/configured-resources", middleware.JWT(), read, handler.GetBusinessConfiguredResourcesByIDHandler)
+	businesses.GET("/:id", middleware.JWT(), read, handler.GetBusinessByIDHandler)
+	businesses.POST("", middleware.JWT(), create, handler.CreateBusinessHandler)
+	businesses.PUT("/:id", middleware.JWT(), update, handler.UpdateBusinessHandler)
+	businesses.DELETE("/:id", middleware.JWT(), remove, handler.DeleteBusinessHandler)
 
 	// Rutas para activar/desactivar recursos de business
-	businesses.PUT("/configured-resources/:resource_id/activate", middleware.JWT(), handler.ActivateBusinessResourceHandler)
-	businesses.PUT("/configured-resources/:resource_id/deactivate", middleware.JWT(), handler.DeactivateBusinessResourceHandler)
+	businesses.PUT("/configured-resources/:resource_id/activate", middleware.JWT(), configure, handler.ActivateBusinessResourceHandler)
+	businesses.PUT("/configured-resources/:resource_id/deactivate", middleware.JWT(), configure, handler.DeactivateBusinessResourceHandler)
 }
@@ -113,6 +113,9 @@ func (h *BusinessHandler) ActivateBusinessResourceHandler(c *gin.Context) {
 		return
 	}
 
+	// Los módulos y permisos del negocio cambian: descartar su caché
+	middleware.InvalidateBusinessFeatures(businessID)
+
 	h.logger.Info(ctx).Uint("business_id", businessID).Uint("resource_id", resourceID).Msg("Recurso activado exitosamente")
 
 	c.JSON(http.StatusOK, gin.H{
@@ -225,6 +228,9 @@ func (h *BusinessHandler) DeactivateBusinessResourceHandler(c *gin.Context) {
 		return
 	}
 
+	// Los módulos y permisos del negocio cambian: descartar su caché
+	middleware.InvalidateBusinessFeatures(businessID)
+
 	h.logger.Info(ctx).Uint("business_id", businessID).Uint("resource_id", resourceID).Msg("Recurso desactivado exitosamente")
 
 	c.JSON(http.StatusOK, gin.H{
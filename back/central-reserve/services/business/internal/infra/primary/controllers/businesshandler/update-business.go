@@ -1,6 +1,7 @@
 package businesshandler
 
 import (
+	"central_reserve/services/auth/middleware"
 	"central_reserve/services/business/internal/infra/primary/controllers/businesshandler/mapper"
 	"central_reserve/services/business/internal/infra/primary/controllers/businesshandler/request"
 	"net/http"
@@ -73,6 +74,9 @@ func (h *BusinessHandler) UpdateBusinessHandler(c *gin.Context) {
 		return
 	}
 
+	// Los flags de funcionalidades y el estado del negocio definen sus módulos habilitados
+	middleware.InvalidateBusinessFeatures(uint(id))
+
 	// Construir respuesta exitosa
 	response := mapper.BuildUpdateBusinessResponseFromDTO(business, "Negocio actualizado exitosamente")
 	c.JSON(http.StatusOK, response)
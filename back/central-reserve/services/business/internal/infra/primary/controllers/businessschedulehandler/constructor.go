@@ -0,0 +1,29 @@
+package businessschedulehandler
+
+import (
+	"central_reserve/services/business/internal/app/usecasebusinessschedule"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IBusinessScheduleHandler define la interfaz para el handler de horario de reservas
+type IBusinessScheduleHandler interface {
+	GetBusinessScheduleHandler(c *gin.Context)
+	UpdateBusinessScheduleHandler(c *gin.Context)
+	CreateBlackoutDateHandler(c *gin.Context)
+	DeleteBlackoutDateHandler(c *gin.Context)
+}
+
+type BusinessScheduleHandler struct {
+	usecase usecasebusinessschedule.IUseCaseBusinessSchedule
+	logger  log.ILogger
+}
+
+// New crea una nueva instancia del handler de horario de reservas
+func New(usecase usecasebusinessschedule.IUseCaseBusinessSchedule, logger log.ILogger) IBusinessScheduleHandler {
+	return &BusinessScheduleHandler{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,63 @@
+package businessschedulehandler
+
+import (
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/mapper"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/request"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/response"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ response.CreateBlackoutDateResponse
+
+// CreateBlackoutDateHandler godoc
+//
+//	@Summary		Registrar festivo u horario especial
+//	@Description	Marca una fecha como cerrada o con un horario especial que reemplaza al semanal ese día
+//	@Tags			businesses
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int									true	"ID del negocio"
+//	@Param			blackout	body		request.BlackoutDateRequest			true	"Fecha (YYYY-MM-DD) y horario especial"
+//	@Success		201		{object}	response.CreateBlackoutDateResponse	"Excepción creada exitosamente"
+//	@Failure		400		{object}	map[string]interface{}				"Solicitud inválida"
+//	@Failure		401		{object}	map[string]interface{}				"Token de acceso requerido"
+//	@Failure		403		{object}	map[string]interface{}				"Sin permisos sobre el negocio"
+//	@Failure		404		{object}	map[string]interface{}				"Negocio no encontrado"
+//	@Failure		409		{object}	map[string]interface{}				"Ya existe una excepción para esa fecha"
+//	@Failure		500		{object}	map[string]interface{}				"Error interno del servidor"
+//	@Router			/businesses/{id}/blackout-dates [post]
+func (h *BusinessScheduleHandler) CreateBlackoutDateHandler(c *gin.Context) {
+	businessID, ok := resolveBusinessID(c)
+	if !ok {
+		return
+	}
+
+	var blackoutRequest request.BlackoutDateRequest
+	if err := c.ShouldBindJSON(&blackoutRequest); err != nil {
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_request", fmt.Sprintf("Datos de entrada inválidos: %s", err.Error())))
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", blackoutRequest.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_date", "La fecha debe tener formato YYYY-MM-DD"))
+		return
+	}
+
+	blackout, err := h.usecase.CreateBlackoutDate(c.Request.Context(), businessID, mapper.RequestToBlackoutDTO(blackoutRequest, date))
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al crear excepción de horario")
+		c.JSON(http.StatusInternalServerError, mapper.BuildErrorResponse("internal_error", "Error interno del servidor"))
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.BuildCreateBlackoutDateResponse(blackout, "Excepción de horario creada exitosamente"))
+}
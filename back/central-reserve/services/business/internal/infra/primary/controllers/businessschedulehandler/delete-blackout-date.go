@@ -0,0 +1,53 @@
+package businessschedulehandler
+
+import (
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/mapper"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteBlackoutDateHandler godoc
+//
+//	@Summary		Eliminar festivo u horario especial
+//	@Description	Elimina una excepción de horario del negocio
+//	@Tags			businesses
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		int									true	"ID del negocio"
+//	@Param			blackout_id	path		int									true	"ID de la excepción"
+//	@Success		200			{object}	response.DeleteBlackoutDateResponse	"Excepción eliminada exitosamente"
+//	@Failure		400			{object}	map[string]interface{}				"Solicitud inválida"
+//	@Failure		401			{object}	map[string]interface{}				"Token de acceso requerido"
+//	@Failure		403			{object}	map[string]interface{}				"Sin permisos sobre el negocio"
+//	@Failure		404			{object}	map[string]interface{}				"Excepción no encontrada"
+//	@Failure		500			{object}	map[string]interface{}				"Error interno del servidor"
+//	@Router			/businesses/{id}/blackout-dates/{blackout_id} [delete]
+func (h *BusinessScheduleHandler) DeleteBlackoutDateHandler(c *gin.Context) {
+	businessID, ok := resolveBusinessID(c)
+	if !ok {
+		return
+	}
+
+	blackoutID, err := strconv.ParseUint(c.Param("blackout_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_id", "ID de excepción inválido"))
+		return
+	}
+
+	if err := h.usecase.DeleteBlackoutDate(c.Request.Context(), businessID, uint(blackoutID)); err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Uint("id", uint(blackoutID)).Msg("Error al eliminar excepción de horario")
+		c.JSON(http.StatusInternalServerError, mapper.BuildErrorResponse("internal_error", "Error interno del servidor"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.DeleteBlackoutDateResponse{
+		Success: true,
+		Message: "Excepción de horario eliminada exitosamente",
+	})
+}
@@ -0,0 +1,51 @@
+package businessschedulehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/business/internal/domain"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/mapper"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// resolveBusinessID obtiene el negocio del path y verifica que el usuario pueda gestionarlo.
+// El super admin gestiona cualquier negocio; el resto solo el negocio de su token.
+func resolveBusinessID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_id", "ID de negocio inválido"))
+		return 0, false
+	}
+
+	if !middleware.IsSuperAdmin(c) {
+		tokenBusinessID, ok := middleware.GetBusinessID(c)
+		if !ok || tokenBusinessID != uint(id) {
+			c.JSON(http.StatusForbidden, mapper.BuildErrorResponse("forbidden", "Sin permisos para gestionar el horario de este negocio"))
+			return 0, false
+		}
+	}
+
+	return uint(id), true
+}
+
+// respondDomainError responde con el código HTTP adecuado si el error es conocido
+func respondDomainError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, domain.ErrBusinessNotFound):
+		c.JSON(http.StatusNotFound, mapper.BuildErrorResponse("not_found", "Negocio no encontrado"))
+	case errors.Is(err, domain.ErrBlackoutDateNotFound):
+		c.JSON(http.StatusNotFound, mapper.BuildErrorResponse("blackout_date_not_found", "Excepción de horario no encontrada"))
+	case errors.Is(err, domain.ErrBlackoutDateAlreadyExists):
+		c.JSON(http.StatusConflict, mapper.BuildErrorResponse("blackout_date_already_exists", "Ya existe una excepción de horario para esa fecha"))
+	case errors.Is(err, domain.ErrInvalidSlotRules):
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_slot_rules", err.Error()))
+	case errors.Is(err, domain.ErrInvalidOpeningHour):
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_opening_hour", err.Error()))
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package businessschedulehandler
+
+import (
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/mapper"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ response.GetBusinessScheduleResponse
+
+// GetBusinessScheduleHandler godoc
+//
+//	@Summary		Obtener horario de reservas
+//	@Description	Obtiene las reglas de reserva (granularidad, duración, anticipación), el horario semanal y los festivos vigentes de un negocio
+//	@Tags			businesses
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int									true	"ID del negocio"
+//	@Success		200	{object}	response.GetBusinessScheduleResponse	"Horario obtenido exitosamente"
+//	@Failure		400	{object}	map[string]interface{}				"Solicitud inválida"
+//	@Failure		401	{object}	map[string]interface{}				"Token de acceso requerido"
+//	@Failure		403	{object}	map[string]interface{}				"Sin permisos sobre el negocio"
+//	@Failure		404	{object}	map[string]interface{}				"Negocio no encontrado"
+//	@Failure		500	{object}	map[string]interface{}				"Error interno del servidor"
+//	@Router			/businesses/{id}/reservation-schedule [get]
+func (h *BusinessScheduleHandler) GetBusinessScheduleHandler(c *gin.Context) {
+	businessID, ok := resolveBusinessID(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.usecase.GetBusinessSchedule(c.Request.Context(), businessID)
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener horario de reservas")
+		c.JSON(http.StatusInternalServerError, mapper.BuildErrorResponse("internal_error", "Error interno del servidor"))
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BuildGetBusinessScheduleResponse(schedule, "Horario de reservas obtenido exitosamente"))
+}
@@ -0,0 +1,109 @@
+package mapper
+
+import (
+	"central_reserve/services/business/internal/domain"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/request"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/response"
+	"time"
+)
+
+// RequestToScheduleDTO convierte request.BusinessScheduleRequest a domain.BusinessScheduleRequest
+func RequestToScheduleDTO(req request.BusinessScheduleRequest) domain.BusinessScheduleRequest {
+	hours := make([]domain.OpeningHourDTO, len(req.OpeningHours))
+	for i, hour := range req.OpeningHours {
+		hours[i] = domain.OpeningHourDTO{
+			DayOfWeek: hour.DayOfWeek,
+			OpenTime:  hour.OpenTime,
+			CloseTime: hour.CloseTime,
+		}
+	}
+	return domain.BusinessScheduleRequest{
+		SlotMinutes:            req.SlotMinutes,
+		DefaultDurationMinutes: req.DefaultDurationMinutes,
+		MinLeadTimeMinutes:     req.MinLeadTimeMinutes,
+		MaxAdvanceDays:         req.MaxAdvanceDays,
+		EmailLocale:            req.EmailLocale,
+		ReminderHoursBefore:    req.ReminderHoursBefore,
+		NoShowGraceMinutes:     req.NoShowGraceMinutes,
+		OpeningHours:           hours,
+	}
+}
+
+// RequestToBlackoutDTO convierte request.BlackoutDateRequest a domain.BlackoutDateRequest
+func RequestToBlackoutDTO(req request.BlackoutDateRequest, date time.Time) domain.BlackoutDateRequest {
+	return domain.BlackoutDateRequest{
+		Date:      date,
+		IsClosed:  req.IsClosed,
+		OpenTime:  req.OpenTime,
+		CloseTime: req.CloseTime,
+		Reason:    req.Reason,
+	}
+}
+
+// BlackoutDTOToResponse convierte domain.BlackoutDateDTO a response.BlackoutDateResponse
+func BlackoutDTOToResponse(dto domain.BlackoutDateDTO) response.BlackoutDateResponse {
+	return response.BlackoutDateResponse{
+		ID:        dto.ID,
+		Date:      dto.Date.Format("2006-01-02"),
+		IsClosed:  dto.IsClosed,
+		OpenTime:  dto.OpenTime,
+		CloseTime: dto.CloseTime,
+		Reason:    dto.Reason,
+	}
+}
+
+// ScheduleDTOToResponse convierte domain.BusinessScheduleResponse a response.BusinessScheduleResponse
+func ScheduleDTOToResponse(dto domain.BusinessScheduleResponse) response.BusinessScheduleResponse {
+	hours := make([]response.OpeningHourResponse, len(dto.OpeningHours))
+	for i, hour := range dto.OpeningHours {
+		hours[i] = response.OpeningHourResponse{
+			DayOfWeek: hour.DayOfWeek,
+			OpenTime:  hour.OpenTime,
+			CloseTime: hour.CloseTime,
+		}
+	}
+	blackouts := make([]response.BlackoutDateResponse, len(dto.BlackoutDates))
+	for i, blackout := range dto.BlackoutDates {
+		blackouts[i] = BlackoutDTOToResponse(blackout)
+	}
+	return response.BusinessScheduleResponse{
+		BusinessID:             dto.BusinessID,
+		Timezone:               dto.Timezone,
+		SlotMinutes:            dto.SlotMinutes,
+		DefaultDurationMinutes: dto.DefaultDurationMinutes,
+		MinLeadTimeMinutes:     dto.MinLeadTimeMinutes,
+		MaxAdvanceDays:         dto.MaxAdvanceDays,
+		EmailLocale:            dto.EmailLocale,
+		ReminderHoursBefore:    dto.ReminderHoursBefore,
+		NoShowGraceMinutes:     dto.NoShowGraceMinutes,
+		OpeningHours:           hours,
+		BlackoutDates:          blackouts,
+	}
+}
+
+// BuildGetBusinessScheduleResponse construye la respuesta completa del horario de reservas
+func BuildGetBusinessScheduleResponse(dto *domain.BusinessScheduleResponse, message string) response.GetBusinessScheduleResponse {
+	return response.GetBusinessScheduleResponse{
+		Success: true,
+		Message: message,
+		Data:    ScheduleDTOToResponse(*dto),
+	}
+}
+
+// BuildCreateBlackoutDateResponse construye la respuesta completa para crear una excepción de horario
+func BuildCreateBlackoutDateResponse(dto *domain.BlackoutDateDTO, message string) response.CreateBlackoutDateResponse {
+	return response.CreateBlackoutDateResponse{
+		Success: true,
+		Message: message,
+		Data:    BlackoutDTOToResponse(*dto),
+	}
+}
+
+// BuildErrorResponse construye una respuesta de error
+func BuildErrorResponse(errorType, message string) response.ErrorResponse {
+	return response.ErrorResponse{
+		Success: false,
+		Error:   errorType,
+		Message: message,
+	}
+}
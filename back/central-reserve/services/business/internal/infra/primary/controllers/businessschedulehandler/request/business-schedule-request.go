@@ -0,0 +1,29 @@
+package request
+
+// BusinessScheduleRequest representa la solicitud para reemplazar las reglas de reserva y el horario semanal
+type BusinessScheduleRequest struct {
+	SlotMinutes            int                  `json:"slot_minutes" binding:"required,min=1"`
+	DefaultDurationMinutes int                  `json:"default_duration_minutes" binding:"required,min=1"`
+	MinLeadTimeMinutes     int                  `json:"min_lead_time_minutes" binding:"min=0"`
+	MaxAdvanceDays         int                  `json:"max_advance_days" binding:"min=0"`
+	EmailLocale            string               `json:"email_locale"`                                    // Idioma de los emails a clientes (es, en). Por defecto es
+	ReminderHoursBefore    *int                 `json:"reminder_hours_before" binding:"omitempty,min=0"` // Horas antes del recordatorio (0 = sin recordatorio). Por defecto 24
+	NoShowGraceMinutes     *int                 `json:"no_show_grace_minutes" binding:"omitempty,min=0"` // Tolerancia para no-show automático (0 = desactivado). Por defecto 0
+	OpeningHours           []OpeningHourRequest `json:"opening_hours" binding:"dive"`
+}
+
+// OpeningHourRequest representa una franja del horario semanal (0=domingo ... 6=sábado)
+type OpeningHourRequest struct {
+	DayOfWeek int    `json:"day_of_week" binding:"min=0,max=6"`
+	OpenTime  string `json:"open_time" binding:"required"`
+	CloseTime string `json:"close_time" binding:"required"`
+}
+
+// BlackoutDateRequest representa la solicitud para registrar un festivo o un horario especial
+type BlackoutDateRequest struct {
+	Date      string  `json:"date" binding:"required"` // YYYY-MM-DD
+	IsClosed  bool    `json:"is_closed"`
+	OpenTime  *string `json:"open_time"`
+	CloseTime *string `json:"close_time"`
+	Reason    string  `json:"reason"`
+}
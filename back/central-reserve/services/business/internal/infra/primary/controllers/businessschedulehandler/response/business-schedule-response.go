@@ -0,0 +1,60 @@
+package response
+
+// BusinessScheduleResponse representa las reglas de reserva, horario y excepciones de un negocio
+type BusinessScheduleResponse struct {
+	BusinessID             uint                   `json:"business_id"`
+	Timezone               string                 `json:"timezone"`
+	SlotMinutes            int                    `json:"slot_minutes"`
+	DefaultDurationMinutes int                    `json:"default_duration_minutes"`
+	MinLeadTimeMinutes     int                    `json:"min_lead_time_minutes"`
+	MaxAdvanceDays         int                    `json:"max_advance_days"`
+	EmailLocale            string                 `json:"email_locale"`
+	ReminderHoursBefore    int                    `json:"reminder_hours_before"`
+	NoShowGraceMinutes     int                    `json:"no_show_grace_minutes"`
+	OpeningHours           []OpeningHourResponse  `json:"opening_hours"`
+	BlackoutDates          []BlackoutDateResponse `json:"blackout_dates"`
+}
+
+// OpeningHourResponse representa una franja del horario semanal
+type OpeningHourResponse struct {
+	DayOfWeek int    `json:"day_of_week"`
+	OpenTime  string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+}
+
+// BlackoutDateResponse representa un festivo o día con horario especial
+type BlackoutDateResponse struct {
+	ID        uint    `json:"id"`
+	Date      string  `json:"date"`
+	IsClosed  bool    `json:"is_closed"`
+	OpenTime  *string `json:"open_time,omitempty"`
+	CloseTime *string `json:"close_time,omitempty"`
+	Reason    string  `json:"reason"`
+}
+
+// GetBusinessScheduleResponse representa la respuesta para obtener/actualizar el horario de reservas
+type GetBusinessScheduleResponse struct {
+	Success bool                     `json:"success"`
+	Message string                   `json:"message"`
+	Data    BusinessScheduleResponse `json:"data"`
+}
+
+// CreateBlackoutDateResponse representa la respuesta para crear una excepción de horario
+type CreateBlackoutDateResponse struct {
+	Success bool                 `json:"success"`
+	Message string               `json:"message"`
+	Data    BlackoutDateResponse `json:"data"`
+}
+
+// DeleteBlackoutDateResponse representa la respuesta para eliminar una excepción de horario
+type DeleteBlackoutDateResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse representa una respuesta de error
+type ErrorResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,23 @@
+package businessschedulehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registra las rutas del handler de horario de reservas
+func RegisterRoutes(router *gin.RouterGroup, handler IBusinessScheduleHandler) {
+	read := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionRead)
+	configure := middleware.RequirePermission(middleware.ResourceBusinesses, middleware.ActionConfigure)
+
+	businesses := router.Group("/businesses")
+
+	// Reglas de reserva y horario semanal
+	businesses.GET("/:id/reservation-schedule", middleware.JWT(), read, handler.GetBusinessScheduleHandler)
+	businesses.PUT("/:id/reservation-schedule", middleware.JWT(), configure, handler.UpdateBusinessScheduleHandler)
+
+	// Festivos y horarios especiales
+	businesses.POST("/:id/blackout-dates", middleware.JWT(), configure, handler.CreateBlackoutDateHandler)
+	businesses.DELETE("/:id/blackout-dates/:blackout_id", middleware.JWT(), configure, handler.DeleteBlackoutDateHandler)
+}
@@ -0,0 +1,52 @@
+package businessschedulehandler
+
+import (
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/mapper"
+	"central_reserve/services/business/internal/infra/primary/controllers/businessschedulehandler/request"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateBusinessScheduleHandler godoc
+//
+//	@Summary		Actualizar horario de reservas
+//	@Description	Reemplaza las reglas de reserva y el horario semanal del negocio. Una hora de cierre menor o igual a la de apertura indica que la franja termina al día siguiente. Sin franjas configuradas no se restringe la hora.
+//	@Tags			businesses
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		int									true	"ID del negocio"
+//	@Param			schedule	body		request.BusinessScheduleRequest		true	"Reglas y horario semanal"
+//	@Success		200			{object}	response.GetBusinessScheduleResponse	"Horario actualizado exitosamente"
+//	@Failure		400			{object}	map[string]interface{}				"Solicitud inválida"
+//	@Failure		401			{object}	map[string]interface{}				"Token de acceso requerido"
+//	@Failure		403			{object}	map[string]interface{}				"Sin permisos sobre el negocio"
+//	@Failure		404			{object}	map[string]interface{}				"Negocio no encontrado"
+//	@Failure		500			{object}	map[string]interface{}				"Error interno del servidor"
+//	@Router			/businesses/{id}/reservation-schedule [put]
+func (h *BusinessScheduleHandler) UpdateBusinessScheduleHandler(c *gin.Context) {
+	businessID, ok := resolveBusinessID(c)
+	if !ok {
+		return
+	}
+
+	var scheduleRequest request.BusinessScheduleRequest
+	if err := c.ShouldBindJSON(&scheduleRequest); err != nil {
+		c.JSON(http.StatusBadRequest, mapper.BuildErrorResponse("invalid_request", fmt.Sprintf("Datos de entrada inválidos: %s", err.Error())))
+		return
+	}
+
+	schedule, err := h.usecase.UpdateBusinessSchedule(c.Request.Context(), businessID, mapper.RequestToScheduleDTO(scheduleRequest))
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al actualizar horario de reservas")
+		c.JSON(http.StatusInternalServerError, mapper.BuildErrorResponse("internal_error", "Error interno del servidor"))
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.BuildGetBusinessScheduleResponse(schedule, "Horario de reservas actualizado exitosamente"))
+}
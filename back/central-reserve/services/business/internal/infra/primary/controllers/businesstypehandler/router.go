@@ -8,12 +8,17 @@ import (
 
 // RegisterRoutes registra las rutas del handler de BusinessType
 func RegisterRoutes(router *gin.RouterGroup, handler IBusinessTypeHandler) {
+	read := middleware.RequirePermission(middleware.ResourceBusinessTypes, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceBusinessTypes, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceBusinessTypes, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceBusinessTypes, middleware.ActionDelete)
+
 	businessTypes := router.Group("/business-types")
 
 	// Rutas de BusinessType
-	businessTypes.GET("", middleware.JWT(), handler.GetBusinessTypesHandler)
-	businessTypes.GET("/:id", middleware.JWT(), handler.GetBusinessTypeByIDHandler)
-	businessTypes.POST("", middleware.JWT(), handler.CreateBusinessTypeHandler)
-	businessTypes.PUT("/:id", middleware.JWT(), handler.UpdateBusinessTypeHandler)
-	businessTypes.DELETE("/:id", middleware.JWT(), handler.DeleteBusinessTypeHandler)
+	businessTypes.GET("", middleware.JWT(), read, handler.GetBusinessTypesHandler)
+	businessTypes.GET("/:id", middleware.JWT(), read, handler.GetBusinessTypeByIDHandler)
+	businessTypes.POST("", middleware.JWT(), create, handler.CreateBusinessTypeHandler)
+	businessTypes.PUT("/:id", middleware.JWT(), update, handler.UpdateBusinessTypeHandler)
+	businessTypes.DELETE("/:id", middleware.JWT(), remove, handler.DeleteBusinessTypeHandler)
 }
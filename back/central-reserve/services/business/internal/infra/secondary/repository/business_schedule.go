@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"central_reserve/services/business/internal/domain"
+	"dbpostgres/app/infra/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetReservationSettings obtiene las reglas de reserva de un negocio (nil si no se han configurado)
+func (r *Repository) GetReservationSettings(ctx context.Context, businessID uint) (*domain.BusinessReservationSettings, error) {
+	var model models.BusinessReservationSettings
+	if err := r.database.Conn(ctx).
+		Where("business_id = ?", businessID).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("[business_schedule_repository] Error al obtener reglas de reserva")
+		return nil, err
+	}
+
+	return &domain.BusinessReservationSettings{
+		BusinessID:             model.BusinessID,
+		SlotMinutes:            model.SlotMinutes,
+		DefaultDurationMinutes: model.DefaultDurationMinutes,
+		MinLeadTimeMinutes:     model.MinLeadTimeMinutes,
+		MaxAdvanceDays:         model.MaxAdvanceDays,
+		EmailLocale:            model.EmailLocale,
+		ReminderHoursBefore:    model.ReminderHoursBefore,
+		NoShowGraceMinutes:     model.NoShowGraceMinutes,
+	}, nil
+}
+
+// GetOpeningHours obtiene el horario semanal de un negocio ordenado por día y hora de apertura
+func (r *Repository) GetOpeningHours(ctx context.Context, businessID uint) ([]domain.BusinessOpeningHour, error) {
+	var hoursModel []models.BusinessOpeningHour
+	if err := r.database.Conn(ctx).
+		Where("business_id = ?", businessID).
+		Order("day_of_week ASC, open_time ASC").
+		Find(&hoursModel).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("[business_schedule_repository] Error al obtener horario de atención")
+		return nil, err
+	}
+
+	hours := make([]domain.BusinessOpeningHour, len(hoursModel))
+	for i, model := range hoursModel {
+		hours[i] = domain.BusinessOpeningHour{
+			ID:         model.ID,
+			BusinessID: model.BusinessID,
+			DayOfWeek:  model.DayOfWeek,
+			OpenTime:   model.OpenTime,
+			CloseTime:  model.CloseTime,
+		}
+	}
+	return hours, nil
+}
+
+// SaveBusinessSchedule guarda las reglas de reserva y reemplaza el horario semanal en una sola transacción
+func (r *Repository) SaveBusinessSchedule(ctx context.Context, settings domain.BusinessReservationSettings, hours []domain.BusinessOpeningHour) error {
+	return r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		model := models.BusinessReservationSettings{
+			BusinessID:             settings.BusinessID,
+			SlotMinutes:            settings.SlotMinutes,
+			DefaultDurationMinutes: settings.DefaultDurationMinutes,
+			MinLeadTimeMinutes:     settings.MinLeadTimeMinutes,
+			MaxAdvanceDays:         settings.MaxAdvanceDays,
+			EmailLocale:            settings.EmailLocale,
+			ReminderHoursBefore:    settings.ReminderHoursBefore,
+			NoShowGraceMinutes:     settings.NoShowGraceMinutes,
+		}
+		columns := []string{
+			"slot_minutes", "default_duration_minutes", "min_lead_time_minutes", "max_advance_days", "email_locale",
+			"reminder_hours_before", "no_show_grace_minutes", "updated_at",
+		}
+		// Las columnas se seleccionan explícitamente para que un 0 (recordatorio desactivado) no se
+		// reemplace por el valor por defecto de la columna al insertar
+		if err := tx.Select(append([]string{"business_id", "created_at"}, columns...)).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "business_id"}},
+			DoUpdates: clause.AssignmentColumns(columns),
+		}).Create(&model).Error; err != nil {
+			r.logger.Error().Err(err).Uint("business_id", settings.BusinessID).Msg("[business_schedule_repository] Error al guardar reglas de reserva")
+			return err
+		}
+
+		if err := tx.Unscoped().
+			Where("business_id = ?", settings.BusinessID).
+			Delete(&models.BusinessOpeningHour{}).Error; err != nil {
+			r.logger.Error().Err(err).Uint("business_id", settings.BusinessID).Msg("[business_schedule_repository] Error al limpiar horario de atención")
+			return err
+		}
+
+		if len(hours) == 0 {
+			return nil
+		}
+
+		hoursModel := make([]models.BusinessOpeningHour, len(hours))
+		for i, hour := range hours {
+			hoursModel[i] = models.BusinessOpeningHour{
+				BusinessID: settings.BusinessID,
+				DayOfWeek:  hour.DayOfWeek,
+				OpenTime:   hour.OpenTime,
+				CloseTime:  hour.CloseTime,
+			}
+		}
+		if err := tx.Create(&hoursModel).Error; err != nil {
+			r.logger.Error().Err(err).Uint("business_id", settings.BusinessID).Msg("[business_schedule_repository] Error al guardar horario de atención")
+			return err
+		}
+		return nil
+	})
+}
+
+// GetBlackoutDates obtiene las excepciones de horario de un negocio, opcionalmente desde una fecha
+func (r *Repository) GetBlackoutDates(ctx context.Context, businessID uint, from *time.Time) ([]domain.BusinessBlackoutDate, error) {
+	query := r.database.Conn(ctx).Where("business_id = ?", businessID)
+	if from != nil {
+		query = query.Where("date >= ?", from.Format("2006-01-02"))
+	}
+
+	var blackoutsModel []models.BusinessBlackoutDate
+	if err := query.Order("date ASC").Find(&blackoutsModel).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("[business_schedule_repository] Error al obtener excepciones de horario")
+		return nil, err
+	}
+
+	blackouts := make([]domain.BusinessBlackoutDate, len(blackoutsModel))
+	for i, model := range blackoutsModel {
+		blackouts[i] = domain.BusinessBlackoutDate{
+			ID:         model.ID,
+			BusinessID: model.BusinessID,
+			Date:       model.Date,
+			IsClosed:   model.IsClosed,
+			OpenTime:   model.OpenTime,
+			CloseTime:  model.CloseTime,
+			Reason:     model.Reason,
+			CreatedAt:  model.CreatedAt,
+		}
+	}
+	return blackouts, nil
+}
+
+// CreateBlackoutDate registra un día cerrado o con horario especial
+func (r *Repository) CreateBlackoutDate(ctx context.Context, blackout domain.BusinessBlackoutDate) (uint, error) {
+	var count int64
+	if err := r.database.Conn(ctx).
+		Model(&models.BusinessBlackoutDate{}).
+		Where("business_id = ? AND date = ?", blackout.BusinessID, blackout.Date.Format("2006-01-02")).
+		Count(&count).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", blackout.BusinessID).Msg("[business_schedule_repository] Error al verificar excepción de horario")
+		return 0, err
+	}
+	if count > 0 {
+		return 0, domain.ErrBlackoutDateAlreadyExists
+	}
+
+	model := models.BusinessBlackoutDate{
+		BusinessID: blackout.BusinessID,
+		Date:       blackout.Date,
+		IsClosed:   blackout.IsClosed,
+		OpenTime:   blackout.OpenTime,
+		CloseTime:  blackout.CloseTime,
+		Reason:     blackout.Reason,
+	}
+	// Select explícito para que IsClosed=false no sea reemplazado por el default de la columna
+	if err := r.database.Conn(ctx).
+		Select("BusinessID", "Date", "IsClosed", "OpenTime", "CloseTime", "Reason", "CreatedAt", "UpdatedAt").
+		Create(&model).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", blackout.BusinessID).Msg("[business_schedule_repository] Error al crear excepción de horario")
+		return 0, err
+	}
+	return model.ID, nil
+}
+
+// DeleteBlackoutDate elimina una excepción de horario del negocio
+func (r *Repository) DeleteBlackoutDate(ctx context.Context, businessID, id uint) error {
+	result := r.database.Conn(ctx).
+		Unscoped().
+		Where("id = ? AND business_id = ?", id, businessID).
+		Delete(&models.BusinessBlackoutDate{})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("id", id).Msg("[business_schedule_repository] Error al eliminar excepción de horario")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrBlackoutDateNotFound
+	}
+	return nil
+}
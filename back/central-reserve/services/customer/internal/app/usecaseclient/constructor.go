@@ -6,11 +6,11 @@ import (
 )
 
 type IUseCaseClient interface {
-	GetClients(ctx context.Context) ([]domain.Client, error)
-	GetClientByID(ctx context.Context, id uint) (*domain.Client, error)
+	GetClients(ctx context.Context, businessID uint) ([]domain.Client, error)
+	GetClientByID(ctx context.Context, businessID, id uint) (*domain.Client, error)
 	CreateClient(ctx context.Context, client domain.Client) (string, error)
-	UpdateClient(ctx context.Context, id uint, client domain.Client) (string, error)
-	DeleteClient(ctx context.Context, id uint) (string, error)
+	UpdateClient(ctx context.Context, businessID, id uint, client domain.Client) (string, error)
+	DeleteClient(ctx context.Context, businessID, id uint) (string, error)
 }
 
 type ClientUseCase struct {
@@ -2,6 +2,7 @@ package usecaseclient
 
 import (
 	"central_reserve/services/customer/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -9,6 +10,10 @@ import (
 // CreateClient crea un nuevo cliente
 func (u *ClientUseCase) CreateClient(ctx context.Context, client domain.Client) (string, error) {
 	// Validar que el cliente tenga los campos requeridos
+	if client.BusinessID == 0 {
+		return "", fmt.Errorf("el ID del negocio es requerido")
+	}
+
 	if client.Name == "" {
 		return "", fmt.Errorf("el nombre del cliente es requerido")
 	}
@@ -17,8 +22,8 @@ func (u *ClientUseCase) CreateClient(ctx context.Context, client domain.Client)
 		return "", fmt.Errorf("el email del cliente es requerido")
 	}
 
-	// Verificar si ya existe un cliente con el mismo email
-	existingClient, err := u.repository.GetClientByEmail(ctx, client.Email)
+	// Verificar si ya existe un cliente con el mismo email en el negocio
+	existingClient, err := u.repository.GetClientByEmailAndBusiness(ctx, client.Email, client.BusinessID)
 	if err == nil && existingClient != nil {
 		return "", fmt.Errorf("ya existe un cliente con el email %s", client.Email)
 	}
@@ -29,5 +34,15 @@ func (u *ClientUseCase) CreateClient(ctx context.Context, client domain.Client)
 		return "", fmt.Errorf("error al crear cliente: %w", err)
 	}
 
+	if created, err := u.repository.GetClientByEmailAndBusiness(ctx, client.Email, client.BusinessID); err == nil && created != nil {
+		audit.Log(ctx, audit.Entry{
+			BusinessID: created.BusinessID,
+			EntityType: "client",
+			EntityID:   created.ID,
+			Action:     audit.ActionCreate,
+			After:      created,
+		})
+	}
+
 	return result, nil
 }
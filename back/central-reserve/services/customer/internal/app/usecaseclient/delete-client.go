@@ -1,13 +1,27 @@
 package usecaseclient
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 )
 
-func (u *ClientUseCase) DeleteClient(ctx context.Context, id uint) (string, error) {
-	response, err := u.repository.DeleteClient(ctx, id)
+func (u *ClientUseCase) DeleteClient(ctx context.Context, businessID, id uint) (string, error) {
+	before, err := u.repository.GetClientByID(ctx, businessID, id)
 	if err != nil {
 		return "", err
 	}
+
+	response, err := u.repository.DeleteClient(ctx, businessID, id)
+	if err != nil {
+		return "", err
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: before.BusinessID,
+		EntityType: "client",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     before,
+	})
 	return response, nil
 }
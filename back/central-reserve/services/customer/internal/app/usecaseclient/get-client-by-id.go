@@ -6,20 +6,11 @@ import (
 	"fmt"
 )
 
-// GetClientByID obtiene un cliente por su ID
-func (u *ClientUseCase) GetClientByID(ctx context.Context, id uint) (*domain.Client, error) {
-	client, err := u.repository.GetClientByID(ctx, id)
+// GetClientByID obtiene un cliente por su ID dentro del negocio
+func (u *ClientUseCase) GetClientByID(ctx context.Context, businessID, id uint) (*domain.Client, error) {
+	client, err := u.repository.GetClientByID(ctx, businessID, id)
 	if err != nil {
 		return nil, fmt.Errorf("error al obtener cliente: %w", err)
 	}
 	return client, nil
 }
-
-// GetClientByEmail obtiene un cliente por su email
-func (u *ClientUseCase) GetClientByEmail(ctx context.Context, email string) (*domain.Client, error) {
-	client, err := u.repository.GetClientByEmail(ctx, email)
-	if err != nil {
-		return nil, fmt.Errorf("error al obtener cliente por email: %w", err)
-	}
-	return client, nil
-}
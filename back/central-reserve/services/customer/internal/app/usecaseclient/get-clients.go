@@ -5,9 +5,9 @@ import (
 	"context"
 )
 
-// GetClients obtiene todos los clientes
-func (u *ClientUseCase) GetClients(ctx context.Context) ([]domain.Client, error) {
-	clients, err := u.repository.GetClients(ctx)
+// GetClients obtiene los clientes del negocio
+func (u *ClientUseCase) GetClients(ctx context.Context, businessID uint) ([]domain.Client, error) {
+	clients, err := u.repository.GetClients(ctx, businessID)
 	if err != nil {
 		return nil, err
 	}
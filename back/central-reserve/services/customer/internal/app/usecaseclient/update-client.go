@@ -2,27 +2,40 @@ package usecaseclient
 
 import (
 	"central_reserve/services/customer/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
 
 // UpdateClient actualiza un cliente existente
-func (u *ClientUseCase) UpdateClient(ctx context.Context, id uint, client domain.Client) (string, error) {
-	// Verificar que el cliente existe
-	existingClient, err := u.repository.GetClientByID(ctx, id)
+func (u *ClientUseCase) UpdateClient(ctx context.Context, businessID, id uint, client domain.Client) (string, error) {
+	// Verificar que el cliente existe en el negocio
+	before, err := u.repository.GetClientByID(ctx, businessID, id)
 	if err != nil {
-		return "", fmt.Errorf("cliente no encontrado: %w", err)
+		return "", fmt.Errorf("error al verificar cliente: %w", err)
 	}
 
-	if existingClient == nil {
-		return "", fmt.Errorf("cliente no encontrado")
-	}
+	// Un cliente no puede cambiar de negocio: con valor cero Updates no modifica business_id
+	client.BusinessID = 0
 
 	// Actualizar el cliente
-	result, err := u.repository.UpdateClient(ctx, id, client)
+	result, err := u.repository.UpdateClient(ctx, businessID, id, client)
 	if err != nil {
 		return "", fmt.Errorf("error al actualizar cliente: %w", err)
 	}
 
+	after, err := u.repository.GetClientByID(ctx, businessID, id)
+	if err != nil {
+		return "", fmt.Errorf("error al obtener cliente actualizado: %w", err)
+	}
+	audit.Log(ctx, audit.Entry{
+		BusinessID: before.BusinessID,
+		EntityType: "client",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      after,
+	})
+
 	return result, nil
 }
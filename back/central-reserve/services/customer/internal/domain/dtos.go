@@ -10,7 +10,10 @@ type Client struct {
 	Email      string
 	Phone      string
 	Dni        *string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  *time.Time
+	// Historial de asistencia (lo actualiza el servicio de reservas al marcar un no-show)
+	NoShowCount  int
+	LastNoShowAt *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
 }
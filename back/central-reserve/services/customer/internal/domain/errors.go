@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+var (
+	ErrClientNotFound = errors.New("cliente no encontrado")
+)
@@ -2,13 +2,13 @@ package domain
 
 import "context"
 
-// IClientRepository define las operaciones para clientes
+// IClientRepository define las operaciones para clientes. Las consultas se acotan al negocio indicado;
+// db.AllBusinesses solo lo usa el super admin que pide explícitamente todos los negocios.
 type IClientRepository interface {
-	GetClients(ctx context.Context) ([]Client, error)
-	GetClientByID(ctx context.Context, id uint) (*Client, error)
-	GetClientByEmail(ctx context.Context, email string) (*Client, error)
+	GetClients(ctx context.Context, businessID uint) ([]Client, error)
+	GetClientByID(ctx context.Context, businessID, id uint) (*Client, error)
 	GetClientByEmailAndBusiness(ctx context.Context, email string, businessID uint) (*Client, error)
 	CreateClient(ctx context.Context, client Client) (string, error)
-	UpdateClient(ctx context.Context, id uint, client Client) (string, error)
-	DeleteClient(ctx context.Context, id uint) (string, error)
+	UpdateClient(ctx context.Context, businessID, id uint, client Client) (string, error)
+	DeleteClient(ctx context.Context, businessID, id uint) (string, error)
 }
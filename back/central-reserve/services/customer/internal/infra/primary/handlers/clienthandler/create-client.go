@@ -1,6 +1,7 @@
 package clienthandler
 
 import (
+	"central_reserve/services/auth/middleware"
 	"central_reserve/services/customer/internal/infra/primary/handlers/clienthandler/mapper"
 	"central_reserve/services/customer/internal/infra/primary/handlers/clienthandler/request"
 	"net/http"
@@ -18,6 +19,7 @@ import (
 // @Success		201		{object}	map[string]interface{}	"Cliente creado exitosamente"
 // @Failure		400		{object}	map[string]interface{}	"Solicitud inválida"
 // @Failure		401		{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403		{object}	map[string]interface{}	"Sin acceso al negocio indicado"
 // @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
 // @Router			/clients [post]
 func (h *ClientHandler) CreateClientHandler(c *gin.Context) {
@@ -35,8 +37,15 @@ func (h *ClientHandler) CreateClientHandler(c *gin.Context) {
 		return
 	}
 
+	businessID, err := middleware.RequireBusiness(c, req.BusinessID)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
 	// 2. DTO → Dominio ───────────────────────────────────────
 	client := mapper.ClientToDomain(req)
+	client.BusinessID = businessID
 
 	// 3. Caso de uso ─────────────────────────────────────────
 	response, err := h.usecase.CreateClient(ctx, client)
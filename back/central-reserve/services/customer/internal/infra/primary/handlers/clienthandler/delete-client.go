@@ -1,6 +1,9 @@
 package clienthandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/customer/internal/domain"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -36,9 +39,26 @@ func (h *ClientHandler) DeleteClientHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Caso de uso ─────────────────────────────────────────
-	response, err := h.usecase.DeleteClient(ctx, uint(clientID))
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
 	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	response, err := h.usecase.DeleteClient(ctx, businessID, uint(clientID))
+	if err != nil {
+		// El cliente no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrClientNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "not_found",
+				"message": "Cliente no encontrado",
+			})
+			return
+		}
+
 		h.logger.Error().Err(err).Msg("error interno al eliminar cliente")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -48,16 +68,6 @@ func (h *ClientHandler) DeleteClientHandler(c *gin.Context) {
 		return
 	}
 
-	// 3. Verificar si el cliente existía ────────────────────
-	if response == "" {
-		c.JSON(http.StatusNotFound, gin.H{
-			"success": false,
-			"error":   "not_found",
-			"message": "Cliente no encontrado",
-		})
-		return
-	}
-
 	// 4. Salida ──────────────────────────────────────────────
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
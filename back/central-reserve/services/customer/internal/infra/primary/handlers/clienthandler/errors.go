@@ -0,0 +1,16 @@
+package clienthandler
+
+import (
+	"central_reserve/services/auth/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondBusinessScopeError responde el error al acotar la request al negocio del token
+func respondBusinessScopeError(c *gin.Context, err error) {
+	c.JSON(middleware.BusinessScopeStatus(err), gin.H{
+		"success": false,
+		"error":   "invalid_business_scope",
+		"message": err.Error(),
+	})
+}
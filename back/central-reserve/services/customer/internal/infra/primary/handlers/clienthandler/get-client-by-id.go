@@ -1,6 +1,9 @@
 package clienthandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/customer/internal/domain"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -36,11 +39,18 @@ func (h *ClientHandler) GetClientByIDHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Caso de uso ─────────────────────────────────────────
-	client, err := h.usecase.GetClientByID(ctx, uint(clientID))
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
 	if err != nil {
-		// Si es error de "record not found", retornar 404
-		if err.Error() == "record not found" {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	client, err := h.usecase.GetClientByID(ctx, businessID, uint(clientID))
+	if err != nil {
+		// El cliente no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrClientNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
 				"success": false,
 				"error":   "not_found",
@@ -58,7 +68,7 @@ func (h *ClientHandler) GetClientByIDHandler(c *gin.Context) {
 		return
 	}
 
-	// 3. Salida ──────────────────────────────────────────────
+	// 4. Salida ──────────────────────────────────────────────
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"message": "Cliente obtenido exitosamente",
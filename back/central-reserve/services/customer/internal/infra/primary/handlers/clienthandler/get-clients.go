@@ -1,26 +1,37 @@
 package clienthandler
 
 import (
+	"central_reserve/services/auth/middleware"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
-// @Summary		Obtiene todos los clientes
-// @Description	Este endpoint permite obtener la lista de todos los clientes registrados.
+// @Summary		Obtiene los clientes del negocio
+// @Description	Este endpoint permite obtener los clientes del negocio del token. El super admin debe indicar el negocio con business_id, o business_id=all para todos.
 // @Tags			Clientes
 // @Accept			json
 // @Produce		json
 // @Security		BearerAuth
+// @Param			business_id	query		string						false	"ID del negocio o all para todos (solo super admin, obligatorio para él)"
 // @Success		200	{object}	map[string]interface{}	"Lista de clientes obtenida exitosamente"
+// @Failure		400	{object}	map[string]interface{}	"Filtro de negocio inválido"
 // @Failure		401	{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403	{object}	map[string]interface{}	"Sin acceso al negocio indicado"
 // @Failure		500	{object}	map[string]interface{}	"Error interno del servidor"
 // @Router			/clients [get]
 func (h *ClientHandler) GetClientsHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	// 1. Caso de uso ─────────────────────────────────────────
-	clients, err := h.usecase.GetClients(ctx)
+	// 1. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	clients, err := h.usecase.GetClients(ctx, businessID)
 	if err != nil {
 		h.logger.Error().Err(err).Msg("error interno al obtener clientes")
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -31,7 +42,7 @@ func (h *ClientHandler) GetClientsHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Salida ──────────────────────────────────────────────
+	// 3. Salida ──────────────────────────────────────────────
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"message": "Clientes obtenidos exitosamente",
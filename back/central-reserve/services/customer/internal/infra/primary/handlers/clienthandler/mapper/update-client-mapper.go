@@ -9,9 +9,6 @@ import (
 func UpdateClientToDomain(u request.UpdateClient) domain.Client {
 	client := domain.Client{}
 
-	if u.BusinessID != nil {
-		client.BusinessID = *u.BusinessID
-	}
 	if u.Name != nil {
 		client.Name = *u.Name
 	}
@@ -1,7 +1,8 @@
 package request
 
 type Client struct {
-	BusinessID uint   `json:"business_id" binding:"required"`
+	// Solo lo indica el super admin; para el resto se usa el negocio del business token
+	BusinessID uint   `json:"business_id"`
 	Name       string `json:"name" binding:"required"`
 	Email      string `json:"email" binding:"required"`
 	Phone      string `json:"phone" binding:"required"`
@@ -7,12 +7,20 @@ import (
 )
 
 func RegisterRoutes(v1Group *gin.RouterGroup, handler IClientHandler) {
+	read := middleware.RequirePermission(middleware.ResourceClients, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceClients, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceClients, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceClients, middleware.ActionDelete)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourceClients)
+
 	clients := v1Group.Group("/clients")
 	{
-		clients.GET("", middleware.JWT(), handler.GetClientsHandler)
-		clients.GET("/:id", middleware.JWT(), handler.GetClientByIDHandler)
-		clients.POST("", middleware.JWT(), handler.CreateClientHandler)
-		clients.PUT("/:id", middleware.JWT(), handler.UpdateClientHandler)
-		clients.DELETE("/:id", middleware.JWT(), handler.DeleteClientHandler)
+		clients.GET("", middleware.JWT(), feature, read, handler.GetClientsHandler)
+		clients.GET("/:id", middleware.JWT(), feature, read, handler.GetClientByIDHandler)
+		clients.POST("", middleware.JWT(), feature, create, handler.CreateClientHandler)
+		clients.PUT("/:id", middleware.JWT(), feature, update, handler.UpdateClientHandler)
+		clients.DELETE("/:id", middleware.JWT(), feature, remove, handler.DeleteClientHandler)
 	}
 }
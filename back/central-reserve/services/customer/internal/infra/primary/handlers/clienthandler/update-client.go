@@ -1,8 +1,11 @@
 package clienthandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/customer/internal/domain"
 	"central_reserve/services/customer/internal/infra/primary/handlers/clienthandler/mapper"
 	"central_reserve/services/customer/internal/infra/primary/handlers/clienthandler/request"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -39,7 +42,14 @@ func (h *ClientHandler) UpdateClientHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Entrada ──────────────────────────────────────────────
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Entrada ──────────────────────────────────────────────
 	var req request.UpdateClient
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error().Err(err).Msg("error al bindear JSON de actualización de cliente")
@@ -51,12 +61,22 @@ func (h *ClientHandler) UpdateClientHandler(c *gin.Context) {
 		return
 	}
 
-	// 3. DTO → Dominio ───────────────────────────────────────
+	// 4. DTO → Dominio ───────────────────────────────────────
 	client := mapper.UpdateClientToDomain(req)
 
-	// 4. Caso de uso ─────────────────────────────────────────
-	response, err := h.usecase.UpdateClient(ctx, uint(clientID), client)
+	// 5. Caso de uso ─────────────────────────────────────────
+	response, err := h.usecase.UpdateClient(ctx, businessID, uint(clientID), client)
 	if err != nil {
+		// El cliente no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrClientNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "not_found",
+				"message": "Cliente no encontrado",
+			})
+			return
+		}
+
 		h.logger.Error().Err(err).Msg("error interno al actualizar cliente")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -66,16 +86,6 @@ func (h *ClientHandler) UpdateClientHandler(c *gin.Context) {
 		return
 	}
 
-	// 5. Verificar si el cliente existía ───────────────────────
-	if response == "" {
-		c.JSON(http.StatusNotFound, gin.H{
-			"success": false,
-			"error":   "not_found",
-			"message": "Cliente no encontrado",
-		})
-		return
-	}
-
 	// 6. Salida ──────────────────────────────────────────────
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -7,7 +7,10 @@ import (
 	"central_reserve/shared/log"
 	"context"
 	"dbpostgres/app/infra/models"
+	"errors"
 	"fmt"
+
+	"gorm.io/gorm"
 )
 
 type Repository struct {
@@ -22,11 +25,11 @@ func New(db db.IDatabase, logger log.ILogger) domain.IClientRepository {
 	}
 }
 
-// GetClients obtiene todos los clientes
-func (r *Repository) GetClients(ctx context.Context) ([]domain.Client, error) {
+// GetClients obtiene los clientes del negocio
+func (r *Repository) GetClients(ctx context.Context, businessID uint) ([]domain.Client, error) {
 	var dbClients []models.Client
-	if err := r.database.Conn(ctx).Model(&models.Client{}).Find(&dbClients).Error; err != nil {
-		r.logger.Error().Msg("Error al obtener clientes")
+	if err := r.database.Conn(ctx).Model(&models.Client{}).Scopes(db.ByBusiness(businessID)).Find(&dbClients).Error; err != nil {
+		r.logger.Error().Uint("business_id", businessID).Msg("Error al obtener clientes")
 		return nil, err
 	}
 
@@ -34,23 +37,14 @@ func (r *Repository) GetClients(ctx context.Context) ([]domain.Client, error) {
 	return clients, nil
 }
 
-// GetClientByID obtiene un cliente por su ID
-func (r *Repository) GetClientByID(ctx context.Context, id uint) (*domain.Client, error) {
+// GetClientByID obtiene un cliente por su ID dentro del negocio
+func (r *Repository) GetClientByID(ctx context.Context, businessID, id uint) (*domain.Client, error) {
 	var dbClient models.Client
-	if err := r.database.Conn(ctx).Model(&models.Client{}).Where("id = ?", id).First(&dbClient).Error; err != nil {
-		r.logger.Error().Uint("id", id).Msg("Error al obtener cliente por ID")
-		return nil, err
-	}
-
-	client := mappers.ToClientEntity(dbClient)
-	return &client, nil
-}
-
-// GetClientByEmail obtiene un cliente por su email
-func (r *Repository) GetClientByEmail(ctx context.Context, email string) (*domain.Client, error) {
-	var dbClient models.Client
-	if err := r.database.Conn(ctx).Model(&models.Client{}).Where("email = ?", email).First(&dbClient).Error; err != nil {
-		r.logger.Error().Str("email", email).Msg("Error al obtener cliente por email")
+	if err := r.database.Conn(ctx).Model(&models.Client{}).Scopes(db.ByBusiness(businessID)).Where("id = ?", id).First(&dbClient).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrClientNotFound
+		}
+		r.logger.Error().Uint("id", id).Uint("business_id", businessID).Msg("Error al obtener cliente por ID")
 		return nil, err
 	}
 
@@ -82,23 +76,31 @@ func (r *Repository) CreateClient(ctx context.Context, client domain.Client) (st
 	return fmt.Sprintf("Cliente creado con ID: %d", clientModel.ID), nil
 }
 
-// UpdateClient actualiza un cliente existente
-func (r *Repository) UpdateClient(ctx context.Context, id uint, client domain.Client) (string, error) {
+// UpdateClient actualiza un cliente existente del negocio
+func (r *Repository) UpdateClient(ctx context.Context, businessID, id uint, client domain.Client) (string, error) {
 	clientModel := mappers.CreateClientModel(client)
 
-	if err := r.database.Conn(ctx).Model(&models.Client{}).Where("id = ?", id).Updates(&clientModel).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al actualizar cliente")
-		return "", err
+	result := r.database.Conn(ctx).Model(&models.Client{}).Scopes(db.ByBusiness(businessID)).Where("id = ?", id).Updates(&clientModel)
+	if result.Error != nil {
+		r.logger.Error().Uint("id", id).Err(result.Error).Msg("Error al actualizar cliente")
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", domain.ErrClientNotFound
 	}
 
 	return fmt.Sprintf("Cliente actualizado con ID: %d", id), nil
 }
 
-// DeleteClient elimina un cliente
-func (r *Repository) DeleteClient(ctx context.Context, id uint) (string, error) {
-	if err := r.database.Conn(ctx).Model(&models.Client{}).Where("id = ?", id).Delete(&models.Client{}).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al eliminar cliente")
-		return "", err
+// DeleteClient elimina un cliente del negocio
+func (r *Repository) DeleteClient(ctx context.Context, businessID, id uint) (string, error) {
+	result := r.database.Conn(ctx).Scopes(db.ByBusiness(businessID)).Where("id = ?", id).Delete(&models.Client{})
+	if result.Error != nil {
+		r.logger.Error().Uint("id", id).Err(result.Error).Msg("Error al eliminar cliente")
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", domain.ErrClientNotFound
 	}
 
 	return fmt.Sprintf("Cliente eliminado con ID: %d", id), nil
@@ -26,14 +26,16 @@ func CreateClientModel(client domain.Client) models.Client {
 // ToClientEntity convierte models.Client a entities.Client
 func ToClientEntity(client models.Client) domain.Client {
 	return domain.Client{
-		ID:         client.ID,
-		Name:       client.Name,
-		Email:      client.Email,
-		Phone:      client.Phone,
-		Dni:        client.Dni,
-		BusinessID: client.BusinessID,
-		CreatedAt:  client.CreatedAt,
-		UpdatedAt:  client.UpdatedAt,
+		ID:           client.ID,
+		Name:         client.Name,
+		Email:        client.Email,
+		Phone:        client.Phone,
+		Dni:          client.Dni,
+		BusinessID:   client.BusinessID,
+		NoShowCount:  client.NoShowCount,
+		LastNoShowAt: client.LastNoShowAt,
+		CreatedAt:    client.CreatedAt,
+		UpdatedAt:    client.UpdatedAt,
 	}
 }
 
@@ -1,6 +1,8 @@
 package horizontalproperty
 
 import (
+	"context"
+
 	"central_reserve/services/horizontalproperty/internal/app/usecaseattendance"
 	"central_reserve/services/horizontalproperty/internal/app/usecasehorizontalproperty"
 	"central_reserve/services/horizontalproperty/internal/app/usecasepropertyunit"
@@ -42,14 +44,20 @@ func New(db db.IDatabase, logger log.ILogger, s3 storage.IS3Service, envConfig e
 	// Voting use case (necesita acceso a voting y resident repos)
 	votingUseCase := usecasevote.NewVotingUseCase(repoConcrete, repoConcrete, serviceLogger)
 
+	// Apertura y cierre de votaciones programadas
+	go votingUseCase.RunVotingScheduler(context.Background())
+
 	// Property Unit use case
 	propertyUnitUseCase := usecasepropertyunit.New(repoConcrete, serviceLogger)
 
 	// Resident use case
 	residentUseCase := usecaseresident.New(repoConcrete, serviceLogger)
 
+	// Avisos de cambios de asistencia para el stream de quórum
+	quorumNotifier := domain.NewQuorumNotifier()
+
 	// Attendance use case
-	attendanceUseCase := usecaseattendance.NewAttendanceUseCase(repoConcrete, serviceLogger)
+	attendanceUseCase := usecaseattendance.NewAttendanceUseCase(repoConcrete, quorumNotifier, serviceLogger)
 
 	// Crear cache de votaciones para SSE en tiempo real
 	votingCache := domain.NewVotingCache()
@@ -68,6 +76,7 @@ func New(db db.IDatabase, logger log.ILogger, s3 storage.IS3Service, envConfig e
 		propertyUnitUseCase,
 		horizontalPropertyUseCase,
 		votingCache,
+		quorumNotifier,
 		jwtSecret,
 		serviceLogger,
 	)
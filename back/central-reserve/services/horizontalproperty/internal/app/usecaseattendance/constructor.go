@@ -1,6 +1,8 @@
 package usecaseattendance
 
 import (
+	"context"
+
 	"central_reserve/services/horizontalproperty/internal/domain"
 	"central_reserve/shared/log"
 )
@@ -8,17 +10,33 @@ import (
 // AttendanceUseCase - Caso de uso para gestión de asistencia
 type AttendanceUseCase struct {
 	attendanceRepo domain.AttendanceRepository
+	quorum         domain.QuorumNotifier
 	logger         log.ILogger
 }
 
 // NewAttendanceUseCase - Constructor del caso de uso de asistencia
 func NewAttendanceUseCase(
 	attendanceRepo domain.AttendanceRepository,
+	quorum domain.QuorumNotifier,
 	logger log.ILogger,
 ) *AttendanceUseCase {
 	contextualLogger := logger.WithModule("asistencia")
 	return &AttendanceUseCase{
 		attendanceRepo: attendanceRepo,
+		quorum:         quorum,
 		logger:         contextualLogger,
 	}
 }
+
+// notifyQuorum avisa a los streams de quórum del grupo de votación de la lista que la asistencia cambió
+func (uc *AttendanceUseCase) notifyQuorum(ctx context.Context, attendanceListID uint) {
+	if uc.quorum == nil {
+		return
+	}
+	list, err := uc.attendanceRepo.GetAttendanceListByID(ctx, attendanceListID)
+	if err != nil || list == nil {
+		uc.logger.Warn().Err(err).Uint("attendance_list_id", attendanceListID).Msg("No se pudo notificar el cambio de quórum")
+		return
+	}
+	uc.quorum.Notify(list.VotingGroupID)
+}
@@ -69,6 +69,7 @@ func (uc *AttendanceUseCase) MarkAttendance(ctx context.Context, attendanceListI
 			Uint("property_unit_id", propertyUnitID).
 			Msg("Asistencia actualizada exitosamente")
 
+		uc.notifyQuorum(ctx, attendanceListID)
 		return response, nil
 	}
 
@@ -124,5 +125,6 @@ func (uc *AttendanceUseCase) MarkAttendance(ctx context.Context, attendanceListI
 		Uint("property_unit_id", propertyUnitID).
 		Msg("Asistencia marcada exitosamente")
 
+	uc.notifyQuorum(ctx, attendanceListID)
 	return response, nil
 }
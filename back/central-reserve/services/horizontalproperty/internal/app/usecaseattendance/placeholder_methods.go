@@ -443,6 +443,8 @@ func (uc *AttendanceUseCase) MarkAttendanceSimple(ctx context.Context, recordID
 		Bool("attended_as_owner", updated.AttendedAsOwner).
 		Msg("UpdateAttendanceRecordSimple exitoso")
 
+	uc.notifyQuorum(ctx, updated.AttendanceListID)
+
 	// Convertir a DTO
 	dto := &domain.AttendanceRecordDTO{
 		ID:                updated.ID,
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 )
 
 // CreateHorizontalProperty crea una nueva propiedad horizontal
@@ -135,6 +136,14 @@ func (uc *HorizontalPropertyUseCase) CreateHorizontalProperty(ctx context.Contex
 
 	uc.logger.Info().Uint("id", createdProperty.ID).Str("name", createdProperty.Name).Msg("Propiedad horizontal creada exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: createdProperty.ID,
+		EntityType: "horizontal_property",
+		EntityID:   createdProperty.ID,
+		Action:     audit.ActionCreate,
+		After:      createdProperty,
+	})
+
 	// ═══════════════════════════════════════════════════════════════════
 	// CONFIGURACIÓN INICIAL AUTOMÁTICA (SETUP)
 	// ═══════════════════════════════════════════════════════════════════
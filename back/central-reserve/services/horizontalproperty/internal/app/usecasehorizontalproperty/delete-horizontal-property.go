@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 )
 
 // DeleteHorizontalProperty elimina una propiedad horizontal
@@ -46,5 +47,13 @@ func (uc *HorizontalPropertyUseCase) DeleteHorizontalProperty(ctx context.Contex
 
 	uc.logger.Info().Uint("id", id).Str("name", property.Name).Msg("Propiedad horizontal eliminada exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: id,
+		EntityType: "horizontal_property",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     property,
+	})
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 )
 
 // UpdateHorizontalProperty actualiza una propiedad horizontal existente
@@ -19,6 +20,7 @@ func (uc *HorizontalPropertyUseCase) UpdateHorizontalProperty(ctx context.Contex
 		uc.logger.Error().Err(err).Uint("id", id).Msg("Error obteniendo propiedad horizontal para actualizar")
 		return nil, domain.ErrHorizontalPropertyNotFound
 	}
+	before := *existingProperty
 
 	// ═══════════════════════════════════════════════════════════════════
 	// VALIDACIONES PREVIAS
@@ -179,5 +181,14 @@ func (uc *HorizontalPropertyUseCase) UpdateHorizontalProperty(ctx context.Contex
 
 	uc.logger.Info().Uint("id", id).Str("name", updatedProperty.Name).Msg("Propiedad horizontal actualizada exitosamente")
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: id,
+		EntityType: "horizontal_property",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      updatedProperty,
+	})
+
 	return uc.mapToDTO(updatedProperty, businessType), nil
 }
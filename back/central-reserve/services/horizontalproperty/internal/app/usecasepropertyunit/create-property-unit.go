@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -50,6 +51,14 @@ func (uc *propertyUnitUseCase) CreatePropertyUnit(ctx context.Context, dto domai
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: created.BusinessID,
+		EntityType: "property_unit",
+		EntityID:   created.ID,
+		Action:     audit.ActionCreate,
+		After:      created,
+	})
+
 	// Convertir a DTO de respuesta
 	return &domain.PropertyUnitDetailDTO{
 		ID:                       created.ID,
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -28,5 +29,13 @@ func (uc *propertyUnitUseCase) DeletePropertyUnit(ctx context.Context, id uint)
 		return err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: existing.BusinessID,
+		EntityType: "property_unit",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existing,
+	})
+
 	return nil
 }
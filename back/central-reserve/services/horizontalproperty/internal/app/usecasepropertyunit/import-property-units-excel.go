@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 
 	"github.com/xuri/excelize/v2"
@@ -226,5 +227,12 @@ func (uc *propertyUnitUseCase) ImportPropertyUnitsFromExcel(ctx context.Context,
 		Int("errors", len(result.Errors)).
 		Msg("✅ Importación de unidades completada")
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "property_unit",
+		Action:     audit.ActionImport,
+		After:      map[string]int{"total": result.Total, "created": result.Created, "skipped": result.Skipped},
+	})
+
 	return result, nil
 }
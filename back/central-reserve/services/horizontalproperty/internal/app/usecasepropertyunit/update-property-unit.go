@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -21,6 +22,7 @@ func (uc *propertyUnitUseCase) UpdatePropertyUnit(ctx context.Context, id uint,
 		uc.logger.Warn(ctx).Uint("unit_id", id).Msg("Unidad de propiedad no encontrada para actualizar")
 		return nil, domain.ErrPropertyUnitNotFound
 	}
+	before := *existing
 
 	// Si se está cambiando el número, verificar que no exista
 	if dto.Number != nil && *dto.Number != existing.Number {
@@ -74,6 +76,15 @@ func (uc *propertyUnitUseCase) UpdatePropertyUnit(ctx context.Context, id uint,
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: updated.BusinessID,
+		EntityType: "property_unit",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      updated,
+	})
+
 	// Convertir a DTO de respuesta
 	return &domain.PropertyUnitDetailDTO{
 		ID:                       updated.ID,
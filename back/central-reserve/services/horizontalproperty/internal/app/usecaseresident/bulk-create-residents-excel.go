@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 
 	"github.com/xuri/excelize/v2"
@@ -339,5 +340,12 @@ func (uc *residentUseCase) ImportResidentsFromExcel(ctx context.Context, busines
 
 	uc.logger.Info().Uint("business_id", businessID).Int("total", result.Total).Int("created", result.Created).Int("errors", len(result.Errors)).Msg("✅ Importación de residentes completada")
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "resident",
+		Action:     audit.ActionImport,
+		After:      map[string]int{"total": result.Total, "created": result.Created},
+	})
+
 	return result, nil
 }
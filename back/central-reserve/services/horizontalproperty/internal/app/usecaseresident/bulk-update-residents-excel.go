@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 
 	"github.com/xuri/excelize/v2"
@@ -243,5 +244,12 @@ func (uc *residentUseCase) BulkUpdateResidentsFromExcel(ctx context.Context, bus
 		Int("errors", result.Errors).
 		Msg("Edición masiva de residentes desde Excel completada")
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "resident",
+		Action:     audit.ActionImport,
+		After:      map[string]int{"total_processed": result.TotalProcessed, "updated": result.Updated},
+	})
+
 	return result, nil
 }
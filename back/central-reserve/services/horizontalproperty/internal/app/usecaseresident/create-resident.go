@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -79,5 +80,13 @@ func (uc *residentUseCase) CreateResident(ctx context.Context, dto domain.Create
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: detail.BusinessID,
+		EntityType: "resident",
+		EntityID:   detail.ID,
+		Action:     audit.ActionCreate,
+		After:      detail,
+	})
+
 	return detail, nil
 }
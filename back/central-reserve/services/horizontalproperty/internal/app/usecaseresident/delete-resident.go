@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -28,5 +29,13 @@ func (uc *residentUseCase) DeleteResident(ctx context.Context, id uint) error {
 		return err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: existing.BusinessID,
+		EntityType: "resident",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existing,
+	})
+
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -126,5 +127,14 @@ func (uc *residentUseCase) UpdateResident(ctx context.Context, id uint, dto doma
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: existing.BusinessID,
+		EntityType: "resident",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existing,
+		After:      updated,
+	})
+
 	return updated, nil
 }
@@ -0,0 +1,133 @@
+package usecasevote
+
+import (
+	"context"
+	"fmt"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+)
+
+// ballotSettings - Configuración de la papeleta según el tipo de votación
+type ballotSettings struct {
+	minSelections int
+	maxSelections int // 0 sin límite
+	seats         int
+}
+
+// buildBallotSettings valida la configuración de la papeleta y completa sus valores por defecto.
+// Las votaciones de opción única quedan en una opción por papeleta.
+func buildBallotSettings(dto domain.CreateVotingDTO) (*ballotSettings, error) {
+	if dto.MinSelections < 0 || dto.MaxSelections < 0 || dto.Seats < 0 {
+		return nil, fmt.Errorf("%w: min_selections, max_selections y seats no pueden ser negativos", domain.ErrVotingConfigInvalid)
+	}
+
+	settings := &ballotSettings{minSelections: 1, seats: 1}
+	switch dto.VotingType {
+	case domain.VotingTypeMultipleChoice:
+		if dto.MinSelections > 0 {
+			settings.minSelections = dto.MinSelections
+		}
+		settings.maxSelections = dto.MaxSelections
+		if settings.maxSelections > 0 && settings.maxSelections < settings.minSelections {
+			return nil, fmt.Errorf("%w: max_selections debe ser mayor o igual que min_selections", domain.ErrVotingConfigInvalid)
+		}
+	case domain.VotingTypeRankedChoice:
+		settings.maxSelections = dto.MaxSelections
+	case domain.VotingTypeElection:
+		if dto.Seats > 0 {
+			settings.seats = dto.Seats
+		}
+		settings.maxSelections = settings.seats
+	}
+	return settings, nil
+}
+
+// ensureVotingTypeUnlocked impide cambiar el tipo de votación, su papeleta o si es secreta cuando ya
+// hay votos, porque cambiaría cómo se guardan y se cuentan las papeletas emitidas
+func (u *votingUseCase) ensureVotingTypeUnlocked(ctx context.Context, before *domain.Voting, dto domain.CreateVotingDTO, settings *ballotSettings) error {
+	if before.VotingType == dto.VotingType &&
+		before.IsSecret == dto.IsSecret &&
+		before.MinSelections == settings.minSelections &&
+		before.MaxSelections == settings.maxSelections &&
+		before.Seats == settings.seats {
+		return nil
+	}
+	votes, err := u.repo.CountVotesByVoting(ctx, before.ID)
+	if err != nil {
+		return err
+	}
+	if votes > 0 {
+		return domain.ErrVotingTypeLocked
+	}
+	return nil
+}
+
+// ballotOptionIDs devuelve las opciones de la papeleta en orden de preferencia: la lista si viene o
+// la opción única
+func ballotOptionIDs(dto domain.CreateVoteDTO) []uint {
+	if len(dto.VotingOptionIDs) > 0 {
+		return dto.VotingOptionIDs
+	}
+	if dto.VotingOptionID != 0 {
+		return []uint{dto.VotingOptionID}
+	}
+	return nil
+}
+
+// validateBallot verifica que las opciones sean activas de la votación, sin repetir, y que su número
+// respete el tipo de votación. La abstención no se combina con otras opciones.
+func validateBallot(voting *domain.Voting, options []domain.VotingOption, optionIDs []uint) error {
+	if len(optionIDs) == 0 {
+		return fmt.Errorf("%w: debe elegir al menos una opción", domain.ErrInvalidBallot)
+	}
+
+	active := make(map[uint]domain.VotingOption)
+	for _, option := range options {
+		if option.IsActive {
+			active[option.ID] = option
+		}
+	}
+	seen := make(map[uint]bool)
+	abstains := false
+	for _, id := range optionIDs {
+		option, ok := active[id]
+		if !ok {
+			return fmt.Errorf("%w: la opción %d no pertenece a la votación o está inactiva", domain.ErrInvalidBallot, id)
+		}
+		if seen[id] {
+			return fmt.Errorf("%w: la opción %d está repetida", domain.ErrInvalidBallot, id)
+		}
+		seen[id] = true
+		abstains = abstains || isAbstentionOption(option.OptionCode)
+	}
+	if abstains {
+		if len(optionIDs) > 1 {
+			return fmt.Errorf("%w: la abstención no se combina con otras opciones", domain.ErrInvalidBallot)
+		}
+		return nil
+	}
+
+	picked := len(optionIDs)
+	switch voting.VotingType {
+	case domain.VotingTypeMultipleChoice:
+		if picked < voting.MinSelections {
+			return fmt.Errorf("%w: debe elegir al menos %d opciones", domain.ErrInvalidBallot, voting.MinSelections)
+		}
+		if voting.MaxSelections > 0 && picked > voting.MaxSelections {
+			return fmt.Errorf("%w: puede elegir máximo %d opciones", domain.ErrInvalidBallot, voting.MaxSelections)
+		}
+	case domain.VotingTypeRankedChoice:
+		if voting.MaxSelections > 0 && picked > voting.MaxSelections {
+			return fmt.Errorf("%w: puede ordenar máximo %d opciones", domain.ErrInvalidBallot, voting.MaxSelections)
+		}
+	case domain.VotingTypeElection:
+		if picked > voting.Seats {
+			return fmt.Errorf("%w: puede elegir máximo %d candidatos", domain.ErrInvalidBallot, voting.Seats)
+		}
+	default:
+		if picked != 1 {
+			return fmt.Errorf("%w: debe elegir una sola opción", domain.ErrInvalidBallot)
+		}
+	}
+	return nil
+}
@@ -20,6 +20,34 @@ func (u *votingUseCase) CreateVote(ctx context.Context, dto domain.CreateVoteDTO
 		return nil, fmt.Errorf("errores de validación: %w", err)
 	}
 
+	// Solo se vota con la votación abierta y, sin quórum, si el grupo lo permite
+	voting, err := u.repo.GetVotingByID(ctx, dto.VotingID)
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_id", dto.VotingID).Msg("Error obteniendo votación")
+		return nil, err
+	}
+	if err := ensureVotingEditable(voting); err != nil {
+		return nil, err
+	}
+	if voting.Status != domain.VotingStatusOpen {
+		return nil, domain.ErrVotingNotOpen
+	}
+	if _, err := u.ensureQuorum(ctx, voting.VotingGroupID); err != nil {
+		return nil, err
+	}
+
+	// La papeleta debe respetar el tipo de votación
+	options, err := u.repo.ListVotingOptionsByVoting(ctx, dto.VotingID)
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_id", dto.VotingID).Msg("Error obteniendo opciones de votación")
+		return nil, err
+	}
+	optionIDs := ballotOptionIDs(dto)
+	if err := validateBallot(voting, options, optionIDs); err != nil {
+		u.logger.Warn(ctx).Err(err).Uint("voting_id", dto.VotingID).Uint("property_unit_id", dto.PropertyUnitID).Str("voting_type", voting.VotingType).Msg("Papeleta inválida")
+		return nil, err
+	}
+
 	// Verificar que la unidad tenga asistencia marcada para esta votación
 	hasAttendance, err := u.repo.CheckUnitAttendanceForVoting(ctx, dto.VotingID, dto.PropertyUnitID)
 	if err != nil {
@@ -45,30 +73,76 @@ func (u *votingUseCase) CreateVote(ctx context.Context, dto domain.CreateVoteDTO
 	entity := domain.Vote{
 		VotingID:       dto.VotingID,
 		PropertyUnitID: dto.PropertyUnitID,
-		VotingOptionID: dto.VotingOptionID,
+		VotingOptionID: optionIDs[0],
+		OptionIDs:      optionIDs,
 		IPAddress:      dto.IPAddress,
 		UserAgent:      dto.UserAgent,
 		Notes:          dto.Notes,
 	}
 
+	// En una votación secreta la unidad solo queda registrada como participante y la opción se
+	// guarda en una papeleta anónima
+	if voting.IsSecret {
+		return u.createSecretVote(ctx, entity, options)
+	}
+
 	created, err := u.repo.CreateVote(ctx, entity)
 	if err != nil {
-		u.logger.Error(ctx).Err(err).Uint("voting_id", dto.VotingID).Uint("property_unit_id", dto.PropertyUnitID).Uint("voting_option_id", dto.VotingOptionID).Msg("Error creando voto en repositorio")
+		u.logger.Error(ctx).Err(err).Uint("voting_id", dto.VotingID).Uint("property_unit_id", dto.PropertyUnitID).Uint("voting_option_id", optionIDs[0]).Msg("Error creando voto en repositorio")
 		return nil, err
 	}
 
 	// Mapear el voto creado a DTO con todos los datos reales de la BD
 	return &domain.VoteDTO{
-		ID:             created.ID,
-		VotingID:       created.VotingID,
-		PropertyUnitID: created.PropertyUnitID,
-		VotingOptionID: created.VotingOptionID,
-		OptionText:     created.OptionText,
-		OptionCode:     created.OptionCode,
-		OptionColor:    created.OptionColor,
-		VotedAt:        created.VotedAt,
-		IPAddress:      created.IPAddress,
-		UserAgent:      created.UserAgent,
-		Notes:          created.Notes,
+		ID:              created.ID,
+		VotingID:        created.VotingID,
+		PropertyUnitID:  created.PropertyUnitID,
+		VotingOptionID:  created.VotingOptionID,
+		VotingOptionIDs: created.OptionIDs,
+		OptionText:      created.OptionText,
+		OptionCode:      created.OptionCode,
+		OptionColor:     created.OptionColor,
+		VotedAt:         created.VotedAt,
+		IPAddress:       created.IPAddress,
+		UserAgent:       created.UserAgent,
+		Notes:           created.Notes,
 	}, nil
 }
+
+// createSecretVote registra la participación de la unidad y su papeleta anónima. La respuesta
+// confirma las opciones elegidas pero no queda ningún registro que las relacione con la unidad.
+func (u *votingUseCase) createSecretVote(ctx context.Context, entity domain.Vote, options []domain.VotingOption) (*domain.VoteDTO, error) {
+	ballotID, err := newBallotID()
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_id", entity.VotingID).Msg("Error generando papeleta secreta")
+		return nil, err
+	}
+
+	created, err := u.repo.CreateSecretVote(ctx, entity, domain.VotingBallot{BallotID: ballotID, OptionIDs: entity.OptionIDs})
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_id", entity.VotingID).Uint("property_unit_id", entity.PropertyUnitID).Msg("Error creando voto secreto en repositorio")
+		return nil, err
+	}
+
+	dto := &domain.VoteDTO{
+		ID:              created.ID,
+		VotingID:        created.VotingID,
+		PropertyUnitID:  created.PropertyUnitID,
+		VotingOptionID:  entity.VotingOptionID,
+		VotingOptionIDs: entity.OptionIDs,
+		VotedAt:         created.VotedAt,
+		IPAddress:       created.IPAddress,
+		UserAgent:       created.UserAgent,
+		Notes:           created.Notes,
+		Secret:          true,
+	}
+	for _, option := range options {
+		if option.ID == entity.VotingOptionID {
+			dto.OptionText = option.OptionText
+			dto.OptionCode = option.OptionCode
+			dto.OptionColor = option.Color
+			break
+		}
+	}
+	return dto, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 
 	"github.com/go-playground/validator/v10"
@@ -27,15 +28,16 @@ func (u *votingUseCase) CreateVotingGroup(ctx context.Context, dto domain.Create
 	}
 
 	entity := &domain.VotingGroup{
-		BusinessID:       dto.BusinessID,
-		Name:             dto.Name,
-		Description:      dto.Description,
-		VotingStartDate:  dto.VotingStartDate,
-		VotingEndDate:    dto.VotingEndDate,
-		RequiresQuorum:   dto.RequiresQuorum,
-		QuorumPercentage: dto.QuorumPercentage,
-		CreatedByUserID:  dto.CreatedByUserID,
-		Notes:            dto.Notes,
+		BusinessID:               dto.BusinessID,
+		Name:                     dto.Name,
+		Description:              dto.Description,
+		VotingStartDate:          dto.VotingStartDate,
+		VotingEndDate:            dto.VotingEndDate,
+		RequiresQuorum:           dto.RequiresQuorum,
+		QuorumPercentage:         dto.QuorumPercentage,
+		AllowVotingWithoutQuorum: dto.AllowVotingWithoutQuorum,
+		CreatedByUserID:          dto.CreatedByUserID,
+		Notes:                    dto.Notes,
 	}
 
 	created, err := u.repo.CreateVotingGroup(ctx, entity)
@@ -44,19 +46,28 @@ func (u *votingUseCase) CreateVotingGroup(ctx context.Context, dto domain.Create
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: created.BusinessID,
+		EntityType: "voting_group",
+		EntityID:   created.ID,
+		Action:     audit.ActionCreate,
+		After:      created,
+	})
+
 	return &domain.VotingGroupDTO{
-		ID:               created.ID,
-		BusinessID:       created.BusinessID,
-		Name:             created.Name,
-		Description:      created.Description,
-		VotingStartDate:  created.VotingStartDate,
-		VotingEndDate:    created.VotingEndDate,
-		IsActive:         created.IsActive,
-		RequiresQuorum:   created.RequiresQuorum,
-		QuorumPercentage: created.QuorumPercentage,
-		CreatedByUserID:  created.CreatedByUserID,
-		Notes:            created.Notes,
-		CreatedAt:        created.CreatedAt,
-		UpdatedAt:        created.UpdatedAt,
+		ID:                       created.ID,
+		BusinessID:               created.BusinessID,
+		Name:                     created.Name,
+		Description:              created.Description,
+		VotingStartDate:          created.VotingStartDate,
+		VotingEndDate:            created.VotingEndDate,
+		IsActive:                 created.IsActive,
+		RequiresQuorum:           created.RequiresQuorum,
+		QuorumPercentage:         created.QuorumPercentage,
+		AllowVotingWithoutQuorum: created.AllowVotingWithoutQuorum,
+		CreatedByUserID:          created.CreatedByUserID,
+		Notes:                    created.Notes,
+		CreatedAt:                created.CreatedAt,
+		UpdatedAt:                created.UpdatedAt,
 	}, nil
 }
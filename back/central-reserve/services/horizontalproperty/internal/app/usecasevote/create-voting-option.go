@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -15,6 +16,15 @@ func (u *votingUseCase) CreateVotingOption(ctx context.Context, dto domain.Creat
 		return nil, fmt.Errorf("errores de validación: %w", err)
 	}
 
+	// Las opciones de una votación cerrada no cambian
+	voting, err := u.repo.GetVotingByID(ctx, dto.VotingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureVotingEditable(voting); err != nil {
+		return nil, err
+	}
+
 	entity := &domain.VotingOption{
 		VotingID:     dto.VotingID,
 		OptionText:   dto.OptionText,
@@ -28,6 +38,13 @@ func (u *votingUseCase) CreateVotingOption(ctx context.Context, dto domain.Creat
 		return nil, err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "voting_option",
+		EntityID:   created.ID,
+		Action:     audit.ActionCreate,
+		After:      created,
+	})
+
 	return &domain.VotingOptionDTO{
 		ID:           created.ID,
 		VotingID:     created.VotingID,
@@ -3,8 +3,10 @@ package usecasevote
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 
 	"github.com/go-playground/validator/v10"
@@ -20,6 +22,23 @@ func (u *votingUseCase) CreateVoting(ctx context.Context, dto domain.CreateVotin
 		return nil, fmt.Errorf("errores de validación: %w", err)
 	}
 
+	group, loc, err := u.groupLocation(ctx, dto.VotingGroupID)
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_group_id", dto.VotingGroupID).Msg("Error obteniendo grupo de votación")
+		return nil, err
+	}
+	now := time.Now()
+	schedule, err := buildVotingSchedule(dto, group, loc, now)
+	if err != nil {
+		u.logger.Warn(ctx).Err(err).Uint("voting_group_id", dto.VotingGroupID).Msg("Programación de votación inválida")
+		return nil, err
+	}
+	ballot, err := buildBallotSettings(dto)
+	if err != nil {
+		u.logger.Warn(ctx).Err(err).Uint("voting_group_id", dto.VotingGroupID).Str("voting_type", dto.VotingType).Msg("Configuración de papeleta inválida")
+		return nil, err
+	}
+
 	entity := &domain.Voting{
 		VotingGroupID:      dto.VotingGroupID,
 		Title:              dto.Title,
@@ -29,6 +48,16 @@ func (u *votingUseCase) CreateVoting(ctx context.Context, dto domain.CreateVotin
 		AllowAbstention:    dto.AllowAbstention,
 		DisplayOrder:       dto.DisplayOrder,
 		RequiredPercentage: dto.RequiredPercentage,
+		MajorityBase:       effectiveMajorityBase(dto.MajorityBase),
+		MinSelections:      ballot.minSelections,
+		MaxSelections:      ballot.maxSelections,
+		Seats:              ballot.seats,
+		Status:             schedule.status,
+		ScheduledOpenAt:    schedule.openAt,
+		ScheduledCloseAt:   schedule.closeAt,
+	}
+	if entity.Status == domain.VotingStatusOpen {
+		entity.OpenedAt = &now
 	}
 
 	created, err := u.repo.CreateVoting(ctx, entity)
@@ -37,18 +66,19 @@ func (u *votingUseCase) CreateVoting(ctx context.Context, dto domain.CreateVotin
 		return nil, err
 	}
 
-	return &domain.VotingDTO{
-		ID:                 created.ID,
-		VotingGroupID:      created.VotingGroupID,
-		Title:              created.Title,
-		Description:        created.Description,
-		VotingType:         created.VotingType,
-		IsSecret:           created.IsSecret,
-		AllowAbstention:    created.AllowAbstention,
-		IsActive:           created.IsActive,
-		DisplayOrder:       created.DisplayOrder,
-		RequiredPercentage: created.RequiredPercentage,
-		CreatedAt:          created.CreatedAt,
-		UpdatedAt:          created.UpdatedAt,
-	}, nil
+	audit.Log(ctx, audit.Entry{
+		EntityType: "voting",
+		EntityID:   created.ID,
+		Action:     audit.ActionCreate,
+		After:      created,
+	})
+
+	// Si la votación se crea abierta queda registrado el quórum con que se abrió; los borradores lo
+	// registran al abrirse
+	if created.Status == domain.VotingStatusOpen {
+		u.snapshotQuorum(ctx, created.ID, created.VotingGroupID, domain.QuorumSnapshotOpen)
+	}
+
+	res := mapVotingToDTO(created, loc)
+	return &res, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -18,11 +19,30 @@ func (uc *votingUseCase) DeleteVote(ctx context.Context, voteID uint) error {
 		return fmt.Errorf("el ID del voto es requerido")
 	}
 
+	// Obtener el voto para la auditoría
+	existing, err := uc.repo.GetVoteByID(ctx, voteID)
+	if err != nil {
+		uc.logger.Error(ctx).Err(err).Uint("vote_id", voteID).Msg("Error obteniendo voto a eliminar")
+		return err
+	}
+
+	// En una votación secreta no se puede saber qué papeleta corresponde al voto
+	if err := uc.ensureVotingNotSecret(ctx, existing.VotingID); err != nil {
+		return err
+	}
+
 	// Eliminar el voto
 	if err := uc.repo.DeleteVote(ctx, voteID); err != nil {
 		uc.logger.Error(ctx).Err(err).Uint("vote_id", voteID).Msg("Error eliminando voto en repositorio")
 		return err
 	}
 
+	audit.Log(ctx, audit.Entry{
+		EntityType: "vote",
+		EntityID:   voteID,
+		Action:     audit.ActionDelete,
+		Before:     existing,
+	})
+
 	return nil
 }
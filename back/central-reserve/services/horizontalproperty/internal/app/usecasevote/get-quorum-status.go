@@ -0,0 +1,130 @@
+package usecasevote
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/log"
+)
+
+// GetQuorumStatus calcula en el momento el quórum del grupo: coeficiente de los propietarios
+// presentes más el de las unidades representadas por un apoderado con poder vigente, sobre el
+// coeficiente total de la copropiedad
+func (u *votingUseCase) GetQuorumStatus(ctx context.Context, votingGroupID uint) (*domain.QuorumStatusDTO, error) {
+	ctx = log.WithFunctionCtx(ctx, "GetQuorumStatus")
+
+	group, err := u.repo.GetVotingGroupByID(ctx, votingGroupID)
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_group_id", votingGroupID).Msg("Error obteniendo grupo de votación")
+		return nil, err
+	}
+	participation, err := u.repo.GetQuorumParticipation(ctx, votingGroupID)
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_group_id", votingGroupID).Msg("Error calculando participación para el quórum")
+		return nil, err
+	}
+	return evaluateQuorum(group, participation, time.Now()), nil
+}
+
+// ListQuorumSnapshots lista el quórum registrado al abrir y cerrar la votación
+func (u *votingUseCase) ListQuorumSnapshots(ctx context.Context, votingID uint) ([]domain.VotingQuorumSnapshot, error) {
+	return u.repo.ListQuorumSnapshotsByVoting(ctx, votingID)
+}
+
+// evaluateQuorum compara la participación con el quórum del grupo. Como en los resultados, se mide
+// por coeficiente salvo que la copropiedad no tenga coeficientes cargados.
+func evaluateQuorum(group *domain.VotingGroup, participation *domain.QuorumParticipation, now time.Time) *domain.QuorumStatusDTO {
+	status := &domain.QuorumStatusDTO{
+		VotingGroupID:            group.ID,
+		BusinessID:               group.BusinessID,
+		RequiresQuorum:           group.RequiresQuorum,
+		AllowVotingWithoutQuorum: group.AllowVotingWithoutQuorum,
+		QuorumPercentage:         effectiveQuorumPercentage(group),
+		TotalUnits:               participation.TotalUnits,
+		TotalCoefficient:         participation.TotalCoefficient,
+		PresentUnits:             participation.OwnerUnits + participation.ProxyUnits,
+		PresentCoefficient:       participation.OwnerCoefficient + participation.ProxyCoefficient,
+		OwnerUnits:               participation.OwnerUnits,
+		ProxyUnits:               participation.ProxyUnits,
+		Enforced:                 group.RequiresQuorum && !group.AllowVotingWithoutQuorum,
+		CalculatedAt:             now,
+	}
+
+	present, total := status.PresentCoefficient, status.TotalCoefficient
+	status.WeightedBy = domain.VotingWeightCoefficient
+	if total <= 0 {
+		present, total = float64(status.PresentUnits), float64(status.TotalUnits)
+		status.WeightedBy = domain.VotingWeightUnits
+	}
+	if total > 0 {
+		status.PresentPercentage = present / total * 100
+	}
+	status.QuorumMet = status.PresentPercentage+percentageTolerance >= status.QuorumPercentage
+	return status
+}
+
+// effectiveQuorumPercentage devuelve el quórum del grupo o el de por defecto si no lo indica
+func effectiveQuorumPercentage(group *domain.VotingGroup) float64 {
+	if group.QuorumPercentage != nil && *group.QuorumPercentage > 0 {
+		return *group.QuorumPercentage
+	}
+	return domain.DefaultQuorumPercentage
+}
+
+// ensureQuorum calcula el quórum del grupo y devuelve ErrQuorumNotMet si el grupo lo exige y no
+// se alcanza. Si el grupo solo advierte, deja constancia en el log y permite continuar.
+func (u *votingUseCase) ensureQuorum(ctx context.Context, votingGroupID uint) (*domain.QuorumStatusDTO, error) {
+	status, err := u.GetQuorumStatus(ctx, votingGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if status.Blocking() {
+		u.logger.Warn(ctx).
+			Uint("voting_group_id", votingGroupID).
+			Float64("present_percentage", status.PresentPercentage).
+			Float64("quorum_percentage", status.QuorumPercentage).
+			Msg("Quórum no alcanzado")
+		return status, fmt.Errorf("%w: presente %.2f%% de %.2f%% requerido", domain.ErrQuorumNotMet, status.PresentPercentage, status.QuorumPercentage)
+	}
+	if status.RequiresQuorum && !status.QuorumMet {
+		u.logger.Warn(ctx).
+			Uint("voting_group_id", votingGroupID).
+			Float64("present_percentage", status.PresentPercentage).
+			Float64("quorum_percentage", status.QuorumPercentage).
+			Msg("Se continúa sin quórum: el grupo permite votar sin alcanzarlo")
+	}
+	return status, nil
+}
+
+// recordQuorumSnapshot registra el quórum al abrir o cerrar una votación. Un fallo no revierte la
+// apertura o el cierre, solo queda en el log.
+func (u *votingUseCase) recordQuorumSnapshot(ctx context.Context, votingID uint, status *domain.QuorumStatusDTO, event string) {
+	_, err := u.repo.CreateQuorumSnapshot(ctx, domain.VotingQuorumSnapshot{
+		VotingID:           votingID,
+		Event:              event,
+		PresentUnits:       status.PresentUnits,
+		PresentCoefficient: status.PresentCoefficient,
+		TotalUnits:         status.TotalUnits,
+		TotalCoefficient:   status.TotalCoefficient,
+		PresentPercentage:  status.PresentPercentage,
+		WeightedBy:         status.WeightedBy,
+		QuorumPercentage:   status.QuorumPercentage,
+		QuorumMet:          status.QuorumMet,
+		TakenAt:            status.CalculatedAt,
+	})
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_id", votingID).Str("event", event).Msg("Error registrando quórum de la votación")
+	}
+}
+
+// snapshotQuorum calcula el quórum del grupo de la votación y lo registra
+func (u *votingUseCase) snapshotQuorum(ctx context.Context, votingID, votingGroupID uint, event string) {
+	status, err := u.GetQuorumStatus(ctx, votingGroupID)
+	if err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_id", votingID).Str("event", event).Msg("No se pudo calcular el quórum a registrar")
+		return
+	}
+	u.recordQuorumSnapshot(ctx, votingID, status, event)
+}
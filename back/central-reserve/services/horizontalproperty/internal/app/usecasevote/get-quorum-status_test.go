@@ -0,0 +1,140 @@
+package usecasevote
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+)
+
+func percentage(value float64) *float64 {
+	return &value
+}
+
+func TestEvaluateQuorum(t *testing.T) {
+	now := time.Date(2025, 3, 14, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		group          domain.VotingGroup
+		participation  domain.QuorumParticipation
+		wantWeightedBy string
+		wantPercentage float64
+		wantQuorum     float64
+		wantMet        bool
+		wantBlocking   bool
+	}{
+		{
+			name:  "por coeficiente, quórum alcanzado",
+			group: domain.VotingGroup{RequiresQuorum: true, QuorumPercentage: percentage(50)},
+			participation: domain.QuorumParticipation{
+				TotalUnits: 10, TotalCoefficient: 100,
+				OwnerUnits: 3, OwnerCoefficient: 40,
+				ProxyUnits: 1, ProxyCoefficient: 15,
+			},
+			wantWeightedBy: domain.VotingWeightCoefficient,
+			wantPercentage: 55,
+			wantQuorum:     50,
+			wantMet:        true,
+		},
+		{
+			name:  "el coeficiente manda aunque la mayoría de unidades esté presente",
+			group: domain.VotingGroup{RequiresQuorum: true, QuorumPercentage: percentage(50)},
+			participation: domain.QuorumParticipation{
+				TotalUnits: 10, TotalCoefficient: 100,
+				OwnerUnits: 6, OwnerCoefficient: 30,
+			},
+			wantWeightedBy: domain.VotingWeightCoefficient,
+			wantPercentage: 30,
+			wantQuorum:     50,
+			wantMet:        false,
+			wantBlocking:   true,
+		},
+		{
+			name:  "exactamente el quórum lo alcanza pese al redondeo",
+			group: domain.VotingGroup{RequiresQuorum: true, QuorumPercentage: percentage(90)},
+			participation: domain.QuorumParticipation{
+				TotalUnits: 10, TotalCoefficient: 0.1,
+				OwnerUnits: 9, OwnerCoefficient: 0.09, // 0.09 / 0.1 * 100 = 89.99999999999999
+			},
+			wantWeightedBy: domain.VotingWeightCoefficient,
+			wantPercentage: 90,
+			wantQuorum:     90,
+			wantMet:        true,
+		},
+		{
+			name:  "sin coeficientes cargados se mide por unidades",
+			group: domain.VotingGroup{RequiresQuorum: true, QuorumPercentage: percentage(60)},
+			participation: domain.QuorumParticipation{
+				TotalUnits: 5,
+				OwnerUnits: 2, ProxyUnits: 1,
+			},
+			wantWeightedBy: domain.VotingWeightUnits,
+			wantPercentage: 60,
+			wantQuorum:     60,
+			wantMet:        true,
+		},
+		{
+			name:  "sin porcentaje configurado usa el de por defecto",
+			group: domain.VotingGroup{RequiresQuorum: true},
+			participation: domain.QuorumParticipation{
+				TotalUnits: 4, TotalCoefficient: 100,
+				OwnerUnits: 1, OwnerCoefficient: 25,
+			},
+			wantWeightedBy: domain.VotingWeightCoefficient,
+			wantPercentage: 25,
+			wantQuorum:     domain.DefaultQuorumPercentage,
+			wantMet:        false,
+			wantBlocking:   true,
+		},
+		{
+			name:  "si el grupo permite votar sin quórum solo advierte",
+			group: domain.VotingGroup{RequiresQuorum: true, AllowVotingWithoutQuorum: true, QuorumPercentage: percentage(50)},
+			participation: domain.QuorumParticipation{
+				TotalUnits: 4, TotalCoefficient: 100,
+				OwnerUnits: 1, OwnerCoefficient: 25,
+			},
+			wantWeightedBy: domain.VotingWeightCoefficient,
+			wantPercentage: 25,
+			wantQuorum:     50,
+			wantMet:        false,
+		},
+		{
+			name:           "copropiedad sin unidades",
+			group:          domain.VotingGroup{RequiresQuorum: true, QuorumPercentage: percentage(50)},
+			participation:  domain.QuorumParticipation{},
+			wantWeightedBy: domain.VotingWeightUnits,
+			wantPercentage: 0,
+			wantQuorum:     50,
+			wantMet:        false,
+			wantBlocking:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := evaluateQuorum(&tt.group, &tt.participation, now)
+
+			if status.WeightedBy != tt.wantWeightedBy {
+				t.Errorf("WeightedBy = %s, se esperaba %s", status.WeightedBy, tt.wantWeightedBy)
+			}
+			if math.Abs(status.PresentPercentage-tt.wantPercentage) > 1e-9 {
+				t.Errorf("PresentPercentage = %v, se esperaba %v", status.PresentPercentage, tt.wantPercentage)
+			}
+			if status.QuorumPercentage != tt.wantQuorum {
+				t.Errorf("QuorumPercentage = %v, se esperaba %v", status.QuorumPercentage, tt.wantQuorum)
+			}
+			if status.QuorumMet != tt.wantMet {
+				t.Errorf("QuorumMet = %v, se esperaba %v", status.QuorumMet, tt.wantMet)
+			}
+			if status.Blocking() != tt.wantBlocking {
+				t.Errorf("Blocking() = %v, se esperaba %v", status.Blocking(), tt.wantBlocking)
+			}
+			wantPresent := tt.participation.OwnerUnits + tt.participation.ProxyUnits
+			if status.PresentUnits != wantPresent {
+				t.Errorf("PresentUnits = %d, se esperaba %d", status.PresentUnits, wantPresent)
+			}
+		})
+	}
+}
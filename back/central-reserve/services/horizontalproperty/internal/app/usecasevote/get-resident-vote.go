@@ -14,15 +14,16 @@ func (uc *votingUseCase) GetUnitVote(ctx context.Context, votingID, propertyUnit
 	}
 
 	return &domain.VoteDTO{
-		ID:             vote.ID,
-		VotingID:       vote.VotingID,
-		PropertyUnitID: vote.PropertyUnitID,
-		VotingOptionID: vote.VotingOptionID,
-		OptionText:     vote.OptionText,
-		OptionCode:     vote.OptionCode,
-		VotedAt:        vote.VotedAt,
-		IPAddress:      vote.IPAddress,
-		UserAgent:      vote.UserAgent,
-		Notes:          vote.Notes,
+		ID:              vote.ID,
+		VotingID:        vote.VotingID,
+		PropertyUnitID:  vote.PropertyUnitID,
+		VotingOptionID:  vote.VotingOptionID,
+		VotingOptionIDs: vote.OptionIDs,
+		OptionText:      vote.OptionText,
+		OptionCode:      vote.OptionCode,
+		VotedAt:         vote.VotedAt,
+		IPAddress:       vote.IPAddress,
+		UserAgent:       vote.UserAgent,
+		Notes:           vote.Notes,
 	}, nil
 }
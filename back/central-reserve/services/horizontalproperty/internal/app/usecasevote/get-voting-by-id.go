@@ -35,23 +35,16 @@ func (uc *votingUseCase) GetVotingByID(ctx context.Context, hpID, groupID, votin
 		return nil, fmt.Errorf("error obteniendo opciones de votación")
 	}
 
-	// Construir DTO con opciones
-	dto := &domain.VotingDTO{
-		ID:                 voting.ID,
-		VotingGroupID:      voting.VotingGroupID,
-		Title:              voting.Title,
-		Description:        voting.Description,
-		VotingType:         voting.VotingType,
-		IsSecret:           voting.IsSecret,
-		AllowAbstention:    voting.AllowAbstention,
-		IsActive:           voting.IsActive,
-		DisplayOrder:       voting.DisplayOrder,
-		RequiredPercentage: voting.RequiredPercentage,
-		CreatedAt:          voting.CreatedAt,
-		UpdatedAt:          voting.UpdatedAt,
-		Options:            make([]domain.VotingOptionDTO, 0, len(options)),
+	_, loc, err := uc.groupLocation(ctx, voting.VotingGroupID)
+	if err != nil {
+		uc.logger.Error(ctx).Err(err).Uint("voting_id", votingID).Msg("Error obteniendo zona horaria de la votación")
+		return nil, fmt.Errorf("error obteniendo grupo de votación")
 	}
 
+	// Construir DTO con opciones
+	dto := mapVotingToDTO(voting, loc)
+	dto.Options = make([]domain.VotingOptionDTO, 0, len(options))
+
 	// Mapear opciones
 	for _, opt := range options {
 		dto.Options = append(dto.Options, domain.VotingOptionDTO{
@@ -65,5 +58,5 @@ func (uc *votingUseCase) GetVotingByID(ctx context.Context, hpID, groupID, votin
 		})
 	}
 
-	return dto, nil
+	return &dto, nil
 }
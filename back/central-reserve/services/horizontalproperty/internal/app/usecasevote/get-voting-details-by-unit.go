@@ -8,5 +8,8 @@ import (
 
 // GetVotingDetailsByUnit obtiene el detalle completo de la votación por cada unidad
 func (uc *votingUseCase) GetVotingDetailsByUnit(ctx context.Context, votingID, hpID uint) ([]domain.VotingDetailByUnitDTO, error) {
+	if err := uc.ensureVotingNotSecret(ctx, votingID); err != nil {
+		return nil, err
+	}
 	return uc.repo.GetVotingDetailsByUnit(ctx, votingID, hpID)
 }
@@ -2,11 +2,223 @@ package usecasevote
 
 import (
 	"context"
+	"strings"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
 )
 
 // GetVotingResults obtiene los resultados (conteo y porcentajes) de una votación
 func (uc *votingUseCase) GetVotingResults(ctx context.Context, votingID uint) ([]domain.VotingResultDTO, error) {
-	return uc.repo.GetVotingResults(ctx, votingID)
+	tally, err := uc.GetVotingTally(ctx, votingID)
+	if err != nil {
+		return nil, err
+	}
+	return tally.Options, nil
+}
+
+// GetVotingTally obtiene los resultados por unidades y por coeficiente y decide si la votación se
+// aprueba con la mayoría requerida sobre la base configurada (coeficientes presentes o totales).
+// Una votación cerrada devuelve los resultados registrados al cierre.
+func (uc *votingUseCase) GetVotingTally(ctx context.Context, votingID uint) (*domain.VotingTallyDTO, error) {
+	voting, err := uc.repo.GetVotingByID(ctx, votingID)
+	if err != nil {
+		return nil, err
+	}
+	if voting.IsClosed() {
+		snapshot, err := uc.repo.GetVotingResultSnapshot(ctx, votingID)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			return &snapshot.Tally, nil
+		}
+	}
+	return uc.computeTally(ctx, voting)
+}
+
+// computeTally cuenta los votos según el tipo de votación: las de opción única por opción elegida y
+// las de varias opciones o secretas papeleta por papeleta
+func (uc *votingUseCase) computeTally(ctx context.Context, voting *domain.Voting) (*domain.VotingTallyDTO, error) {
+	participation, err := uc.repo.GetVotingParticipation(ctx, voting.ID)
+	if err != nil {
+		return nil, err
+	}
+	if voting.IsSingleChoice() && !voting.IsSecret {
+		results, err := uc.repo.GetVotingResults(ctx, voting.ID)
+		if err != nil {
+			return nil, err
+		}
+		return tallyVoting(voting, results, participation), nil
+	}
+
+	options, err := uc.repo.ListVotingOptionsByVoting(ctx, voting.ID)
+	if err != nil {
+		return nil, err
+	}
+	ballots, err := uc.listBallots(ctx, voting)
+	if err != nil {
+		return nil, err
+	}
+	return tallyBallots(voting, options, ballots, participation), nil
+}
+
+// percentageTolerance absorbe el redondeo de sumar coeficientes decimales (p. ej. 99.9999% en una unanimidad)
+const percentageTolerance = 1e-6
+
+// meetsRequiredPercentage indica si el porcentaje alcanza el requerido. La mayoría simple (50%)
+// exige más de la mitad, así que un empate a la mitad no aprueba; una mayoría calificada (p. ej.
+// 70%) se aprueba al alcanzarla.
+func meetsRequiredPercentage(percentage, required float64) bool {
+	if required == domain.DefaultRequiredPercentage {
+		return percentage > required+percentageTolerance
+	}
+	return percentage+percentageTolerance >= required
+}
+
+// tallyVoting calcula los porcentajes sobre la base de la decisión y el resultado. La opción que
+// decide es la afirmativa (código yes/si) o, si la votación no tiene, la más votada sin contar la
+// abstención. Se aprueba si alcanza el porcentaje requerido (más de la mitad en mayoría simple) y
+// supera a las demás opciones.
+func tallyVoting(voting *domain.Voting, results []domain.VotingResultDTO, participation *domain.VotingParticipation) *domain.VotingTallyDTO {
+	tally := newVotingTally(voting, participation)
+	tally.Options = results
+	for _, result := range results {
+		tally.VotedUnits += result.VoteCount
+		tally.VotedCoefficient += result.Coefficient
+	}
+	weight := resultWeight(tally)
+
+	if tally.BaseWeight > 0 {
+		for i := range tally.Options {
+			tally.Options[i].BasePercentage = weight(tally.Options[i]) / tally.BaseWeight * 100
+		}
+	}
+
+	deciding := -1
+	for i, result := range tally.Options {
+		if isAffirmativeOption(result.OptionCode) {
+			deciding = i
+			break
+		}
+	}
+	if deciding < 0 {
+		deciding = leadingOption(tally.Options, weight)
+	}
+	if deciding < 0 {
+		return tally
+	}
+
+	decidingOption := tally.Options[deciding]
+	decidingID := decidingOption.VotingOptionID
+	tally.DecidingOptionID = &decidingID
+	tally.ApprovalPercentage = decidingOption.BasePercentage
+
+	// Un empate con otra opción no aprueba, aunque se alcance el porcentaje
+	for i, result := range tally.Options {
+		if i != deciding && !isAbstentionOption(result.OptionCode) && weight(result) >= weight(decidingOption) {
+			return tally
+		}
+	}
+	if weight(decidingOption) > 0 && meetsRequiredPercentage(tally.ApprovalPercentage, tally.RequiredPercentage) {
+		tally.Outcome = domain.VotingOutcomeApproved
+	}
+	return tally
+}
+
+// newVotingTally arma los totales de participación y la base de la decisión, sin votos contados
+func newVotingTally(voting *domain.Voting, participation *domain.VotingParticipation) *domain.VotingTallyDTO {
+	tally := &domain.VotingTallyDTO{
+		VotingID:           voting.ID,
+		VotingType:         voting.VotingType,
+		PresentUnits:       participation.PresentUnits,
+		PresentCoefficient: participation.PresentCoefficient,
+		TotalUnits:         participation.TotalUnits,
+		TotalCoefficient:   participation.TotalCoefficient,
+		MajorityBase:       effectiveMajorityBase(voting.MajorityBase),
+		RequiredPercentage: effectiveRequiredPercentage(voting),
+		Outcome:            domain.VotingOutcomeRejected,
+	}
+
+	// Sin coeficientes cargados se decide por unidades
+	tally.WeightedBy = domain.VotingWeightCoefficient
+	if participation.TotalCoefficient <= 0 {
+		tally.WeightedBy = domain.VotingWeightUnits
+	}
+
+	switch {
+	case tally.MajorityBase == domain.VotingMajorityBaseTotal && tally.WeightedBy == domain.VotingWeightUnits:
+		tally.BaseWeight = float64(participation.TotalUnits)
+	case tally.MajorityBase == domain.VotingMajorityBaseTotal:
+		tally.BaseWeight = participation.TotalCoefficient
+	case tally.WeightedBy == domain.VotingWeightUnits:
+		tally.BaseWeight = float64(participation.PresentUnits)
+	default:
+		tally.BaseWeight = participation.PresentCoefficient
+	}
+	return tally
+}
+
+// resultWeight devuelve el peso de los votos de una opción: su coeficiente o su número de unidades
+func resultWeight(tally *domain.VotingTallyDTO) func(domain.VotingResultDTO) float64 {
+	return func(result domain.VotingResultDTO) float64 {
+		if tally.WeightedBy == domain.VotingWeightUnits {
+			return float64(result.VoteCount)
+		}
+		return result.Coefficient
+	}
+}
+
+// leadingOption retorna la opción con más peso sin contar la abstención; -1 si hay empate o no hay votos
+func leadingOption(results []domain.VotingResultDTO, weight func(domain.VotingResultDTO) float64) int {
+	leader := -1
+	tied := false
+	for i, result := range results {
+		if isAbstentionOption(result.OptionCode) || weight(result) <= 0 {
+			continue
+		}
+		switch {
+		case leader < 0 || weight(result) > weight(results[leader]):
+			leader, tied = i, false
+		case weight(result) == weight(results[leader]):
+			tied = true
+		}
+	}
+	if tied {
+		return -1
+	}
+	return leader
+}
+
+func effectiveMajorityBase(base string) string {
+	if base == domain.VotingMajorityBaseTotal {
+		return domain.VotingMajorityBaseTotal
+	}
+	return domain.VotingMajorityBasePresent
+}
+
+// effectiveRequiredPercentage aplica la mayoría simple por defecto; la unanimidad exige el 100%
+func effectiveRequiredPercentage(voting *domain.Voting) float64 {
+	if voting.VotingType == domain.VotingTypeUnanimity {
+		return 100
+	}
+	if voting.RequiredPercentage != nil && *voting.RequiredPercentage > 0 {
+		return *voting.RequiredPercentage
+	}
+	return domain.DefaultRequiredPercentage
+}
+
+func isAffirmativeOption(code string) bool {
+	switch strings.ToLower(strings.TrimSpace(code)) {
+	case "yes", "si", "sí":
+		return true
+	}
+	return false
+}
+
+func isAbstentionOption(code string) bool {
+	switch strings.ToLower(strings.TrimSpace(code)) {
+	case "abstention", "abstencion", "abstención", "blank", "blanco":
+		return true
+	}
+	return false
 }
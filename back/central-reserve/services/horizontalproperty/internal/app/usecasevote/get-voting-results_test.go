@@ -0,0 +1,152 @@
+package usecasevote
+
+import (
+	"math"
+	"testing"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+)
+
+func votingResult(id uint, code string, voteCount int, coefficient float64) domain.VotingResultDTO {
+	return domain.VotingResultDTO{VotingOptionID: id, OptionCode: code, VoteCount: voteCount, Coefficient: coefficient}
+}
+
+func TestTallyVoting(t *testing.T) {
+	present := domain.VotingParticipation{TotalUnits: 10, TotalCoefficient: 100, PresentUnits: 10, PresentCoefficient: 100}
+
+	tests := []struct {
+		name          string
+		voting        domain.Voting
+		results       []domain.VotingResultDTO
+		participation domain.VotingParticipation
+		wantOutcome   string
+		wantDeciding  uint
+		wantApproval  float64
+	}{
+		{
+			name:   "mayoría simple con exactamente el 50% no aprueba",
+			voting: domain.Voting{VotingType: domain.VotingTypeSimple},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "yes", 5, 50), votingResult(2, "no", 3, 30), votingResult(3, "abstention", 2, 20),
+			},
+			participation: present,
+			wantOutcome:   domain.VotingOutcomeRejected,
+			wantDeciding:  1,
+			wantApproval:  50,
+		},
+		{
+			name:   "mayoría simple indicada explícitamente con el 50% tampoco aprueba",
+			voting: domain.Voting{VotingType: domain.VotingTypeSimple, RequiredPercentage: percentage(50)},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "yes", 5, 50), votingResult(2, "no", 5, 50),
+			},
+			participation: present,
+			wantOutcome:   domain.VotingOutcomeRejected,
+			wantDeciding:  1,
+			wantApproval:  50,
+		},
+		{
+			name:   "mayoría simple con más del 50% aprueba",
+			voting: domain.Voting{VotingType: domain.VotingTypeSimple},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "yes", 5, 50.5), votingResult(2, "no", 5, 49.5),
+			},
+			participation: present,
+			wantOutcome:   domain.VotingOutcomeApproved,
+			wantDeciding:  1,
+			wantApproval:  50.5,
+		},
+		{
+			name:   "mayoría calificada se aprueba al alcanzarla",
+			voting: domain.Voting{VotingType: domain.VotingTypeMajority, RequiredPercentage: percentage(70)},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "si", 7, 70), votingResult(2, "no", 3, 30),
+			},
+			participation: present,
+			wantOutcome:   domain.VotingOutcomeApproved,
+			wantDeciding:  1,
+			wantApproval:  70,
+		},
+		{
+			name:   "mayoría calificada no alcanzada",
+			voting: domain.Voting{VotingType: domain.VotingTypeMajority, RequiredPercentage: percentage(70)},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "si", 7, 69), votingResult(2, "no", 3, 31),
+			},
+			participation: present,
+			wantOutcome:   domain.VotingOutcomeRejected,
+			wantDeciding:  1,
+			wantApproval:  69,
+		},
+		{
+			name:   "unanimidad con redondeo de coeficientes aprueba",
+			voting: domain.Voting{VotingType: domain.VotingTypeUnanimity},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "yes", 3, sumCoefficients(0.1, 0.2)),
+			},
+			participation: domain.VotingParticipation{TotalUnits: 3, TotalCoefficient: 0.3, PresentUnits: 3, PresentCoefficient: 0.3},
+			wantOutcome:   domain.VotingOutcomeApproved,
+			wantDeciding:  1,
+			wantApproval:  100,
+		},
+		{
+			name:   "sin opción afirmativa decide la más votada",
+			voting: domain.Voting{VotingType: domain.VotingTypeSingleChoice},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "A", 2, 20), votingResult(2, "B", 6, 60), votingResult(3, "blank", 2, 20),
+			},
+			participation: present,
+			wantOutcome:   domain.VotingOutcomeApproved,
+			wantDeciding:  2,
+			wantApproval:  60,
+		},
+		{
+			name:   "sin coeficientes cargados se decide por unidades",
+			voting: domain.Voting{VotingType: domain.VotingTypeSimple},
+			results: []domain.VotingResultDTO{
+				votingResult(1, "yes", 3, 0), votingResult(2, "no", 2, 0),
+			},
+			participation: domain.VotingParticipation{TotalUnits: 6, PresentUnits: 6},
+			wantOutcome:   domain.VotingOutcomeRejected,
+			wantDeciding:  1,
+			wantApproval:  50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tally := tallyVoting(&tt.voting, tt.results, &tt.participation)
+
+			if tally.Outcome != tt.wantOutcome {
+				t.Errorf("Outcome = %q, se esperaba %q", tally.Outcome, tt.wantOutcome)
+			}
+			if tally.DecidingOptionID == nil || *tally.DecidingOptionID != tt.wantDeciding {
+				t.Fatalf("DecidingOptionID = %v, se esperaba %d", tally.DecidingOptionID, tt.wantDeciding)
+			}
+			if math.Abs(tally.ApprovalPercentage-tt.wantApproval) > percentageTolerance {
+				t.Errorf("ApprovalPercentage = %v, se esperaba %v", tally.ApprovalPercentage, tt.wantApproval)
+			}
+		})
+	}
+}
+
+func TestDecideMultipleChoiceSimpleMajority(t *testing.T) {
+	tally := &domain.VotingTallyDTO{
+		WeightedBy:         domain.VotingWeightCoefficient,
+		RequiredPercentage: domain.DefaultRequiredPercentage,
+		Outcome:            domain.VotingOutcomeRejected,
+		Options: []domain.VotingResultDTO{
+			{VotingOptionID: optionA, Coefficient: 50, BasePercentage: 50},
+			{VotingOptionID: optionB, Coefficient: 60, BasePercentage: 60},
+		},
+	}
+
+	decideMultipleChoice(tally)
+
+	if len(tally.WinnerOptionIDs) != 1 || tally.WinnerOptionIDs[0] != optionB {
+		t.Errorf("WinnerOptionIDs = %v, se esperaba [%d]: el 50%% exacto no es mayoría simple", tally.WinnerOptionIDs, optionB)
+	}
+	if tally.Outcome != domain.VotingOutcomeApproved {
+		t.Errorf("Outcome = %q, se esperaba %q", tally.Outcome, domain.VotingOutcomeApproved)
+	}
+}
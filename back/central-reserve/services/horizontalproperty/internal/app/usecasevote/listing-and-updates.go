@@ -3,8 +3,10 @@ package usecasevote
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
 	"central_reserve/shared/log"
 )
 
@@ -22,19 +24,20 @@ func (u *votingUseCase) ListVotingGroupsByBusiness(ctx context.Context, business
 	for i := range groups {
 		g := groups[i]
 		res[i] = domain.VotingGroupDTO{
-			ID:               g.ID,
-			BusinessID:       g.BusinessID,
-			Name:             g.Name,
-			Description:      g.Description,
-			VotingStartDate:  g.VotingStartDate,
-			VotingEndDate:    g.VotingEndDate,
-			IsActive:         g.IsActive,
-			RequiresQuorum:   g.RequiresQuorum,
-			QuorumPercentage: g.QuorumPercentage,
-			CreatedByUserID:  g.CreatedByUserID,
-			Notes:            g.Notes,
-			CreatedAt:        g.CreatedAt,
-			UpdatedAt:        g.UpdatedAt,
+			ID:                       g.ID,
+			BusinessID:               g.BusinessID,
+			Name:                     g.Name,
+			Description:              g.Description,
+			VotingStartDate:          g.VotingStartDate,
+			VotingEndDate:            g.VotingEndDate,
+			IsActive:                 g.IsActive,
+			RequiresQuorum:           g.RequiresQuorum,
+			QuorumPercentage:         g.QuorumPercentage,
+			AllowVotingWithoutQuorum: g.AllowVotingWithoutQuorum,
+			CreatedByUserID:          g.CreatedByUserID,
+			Notes:                    g.Notes,
+			CreatedAt:                g.CreatedAt,
+			UpdatedAt:                g.UpdatedAt,
 		}
 	}
 	return res, nil
@@ -49,66 +52,100 @@ func (u *votingUseCase) UpdateVotingGroup(ctx context.Context, id uint, dto doma
 		return nil, fmt.Errorf("debe especificar quorum_percentage")
 	}
 	entity := &domain.VotingGroup{
-		Name:             dto.Name,
-		Description:      dto.Description,
-		VotingStartDate:  dto.VotingStartDate,
-		VotingEndDate:    dto.VotingEndDate,
-		RequiresQuorum:   dto.RequiresQuorum,
-		QuorumPercentage: dto.QuorumPercentage,
-		Notes:            dto.Notes,
+		Name:                     dto.Name,
+		Description:              dto.Description,
+		VotingStartDate:          dto.VotingStartDate,
+		VotingEndDate:            dto.VotingEndDate,
+		RequiresQuorum:           dto.RequiresQuorum,
+		QuorumPercentage:         dto.QuorumPercentage,
+		AllowVotingWithoutQuorum: dto.AllowVotingWithoutQuorum,
+		Notes:                    dto.Notes,
+	}
+	before, err := u.repo.GetVotingGroupByID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 	updated, err := u.repo.UpdateVotingGroup(ctx, id, entity)
 	if err != nil {
 		return nil, err
 	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: updated.BusinessID,
+		EntityType: "voting_group",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      updated,
+	})
+
 	return &domain.VotingGroupDTO{
-		ID:               updated.ID,
-		BusinessID:       updated.BusinessID,
-		Name:             updated.Name,
-		Description:      updated.Description,
-		VotingStartDate:  updated.VotingStartDate,
-		VotingEndDate:    updated.VotingEndDate,
-		IsActive:         updated.IsActive,
-		RequiresQuorum:   updated.RequiresQuorum,
-		QuorumPercentage: updated.QuorumPercentage,
-		CreatedByUserID:  updated.CreatedByUserID,
-		Notes:            updated.Notes,
-		CreatedAt:        updated.CreatedAt,
-		UpdatedAt:        updated.UpdatedAt,
+		ID:                       updated.ID,
+		BusinessID:               updated.BusinessID,
+		Name:                     updated.Name,
+		Description:              updated.Description,
+		VotingStartDate:          updated.VotingStartDate,
+		VotingEndDate:            updated.VotingEndDate,
+		IsActive:                 updated.IsActive,
+		RequiresQuorum:           updated.RequiresQuorum,
+		QuorumPercentage:         updated.QuorumPercentage,
+		AllowVotingWithoutQuorum: updated.AllowVotingWithoutQuorum,
+		CreatedByUserID:          updated.CreatedByUserID,
+		Notes:                    updated.Notes,
+		CreatedAt:                updated.CreatedAt,
+		UpdatedAt:                updated.UpdatedAt,
 	}, nil
 }
 
 func (u *votingUseCase) DeactivateVotingGroup(ctx context.Context, id uint) error {
-	return u.repo.DeactivateVotingGroup(ctx, id)
+	if err := u.repo.DeactivateVotingGroup(ctx, id); err != nil {
+		return err
+	}
+	logVotingState(ctx, "voting_group", id, audit.ActionDeactivate)
+	return nil
 }
 
 func (u *votingUseCase) ListVotingsByGroup(ctx context.Context, groupID uint) ([]domain.VotingDTO, error) {
+	_, loc, err := u.groupLocation(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
 	votings, err := u.repo.ListVotingsByGroup(ctx, groupID)
 	if err != nil {
 		return nil, err
 	}
 	res := make([]domain.VotingDTO, len(votings))
 	for i := range votings {
-		v := votings[i]
-		res[i] = domain.VotingDTO{
-			ID:                 v.ID,
-			VotingGroupID:      v.VotingGroupID,
-			Title:              v.Title,
-			Description:        v.Description,
-			VotingType:         v.VotingType,
-			IsSecret:           v.IsSecret,
-			AllowAbstention:    v.AllowAbstention,
-			IsActive:           v.IsActive,
-			DisplayOrder:       v.DisplayOrder,
-			RequiredPercentage: v.RequiredPercentage,
-			CreatedAt:          v.CreatedAt,
-			UpdatedAt:          v.UpdatedAt,
-		}
+		res[i] = mapVotingToDTO(&votings[i], loc)
 	}
 	return res, nil
 }
 
+// UpdateVoting actualiza la votación y su horario mientras no esté cerrada
 func (u *votingUseCase) UpdateVoting(ctx context.Context, id uint, dto domain.CreateVotingDTO) (*domain.VotingDTO, error) {
+	before, err := u.repo.GetVotingByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureVotingEditable(before); err != nil {
+		return nil, err
+	}
+	group, loc, err := u.groupLocation(ctx, before.VotingGroupID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := buildVotingSchedule(dto, group, loc, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	ballot, err := buildBallotSettings(dto)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.ensureVotingTypeUnlocked(ctx, before, dto, ballot); err != nil {
+		return nil, err
+	}
+
 	entity := &domain.Voting{
 		Title:              dto.Title,
 		Description:        dto.Description,
@@ -117,37 +154,50 @@ func (u *votingUseCase) UpdateVoting(ctx context.Context, id uint, dto domain.Cr
 		AllowAbstention:    dto.AllowAbstention,
 		DisplayOrder:       dto.DisplayOrder,
 		RequiredPercentage: dto.RequiredPercentage,
+		MajorityBase:       effectiveMajorityBase(dto.MajorityBase),
+		MinSelections:      ballot.minSelections,
+		MaxSelections:      ballot.maxSelections,
+		Seats:              ballot.seats,
+		ScheduledOpenAt:    schedule.openAt,
+		ScheduledCloseAt:   schedule.closeAt,
 	}
 	updated, err := u.repo.UpdateVoting(ctx, id, entity)
 	if err != nil {
 		return nil, err
 	}
-	return &domain.VotingDTO{
-		ID:                 updated.ID,
-		VotingGroupID:      updated.VotingGroupID,
-		Title:              updated.Title,
-		Description:        updated.Description,
-		VotingType:         updated.VotingType,
-		IsSecret:           updated.IsSecret,
-		AllowAbstention:    updated.AllowAbstention,
-		IsActive:           updated.IsActive,
-		DisplayOrder:       updated.DisplayOrder,
-		RequiredPercentage: updated.RequiredPercentage,
-		CreatedAt:          updated.CreatedAt,
-		UpdatedAt:          updated.UpdatedAt,
-	}, nil
-}
 
-func (u *votingUseCase) ActivateVoting(ctx context.Context, id uint) error {
-	return u.repo.ActivateVoting(ctx, id)
-}
+	audit.Log(ctx, audit.Entry{
+		EntityType: "voting",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      updated,
+	})
 
-func (u *votingUseCase) DeactivateVoting(ctx context.Context, id uint) error {
-	return u.repo.DeactivateVoting(ctx, id)
+	res := mapVotingToDTO(updated, loc)
+	return &res, nil
 }
 
+// DeleteVoting elimina la votación; una votación certificada es el acta definitiva y no se elimina
 func (u *votingUseCase) DeleteVoting(ctx context.Context, id uint) error {
-	return u.repo.DeleteVoting(ctx, id)
+	before, err := u.repo.GetVotingByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if before.Status == domain.VotingStatusCertified {
+		return domain.ErrVotingClosed
+	}
+	if err := u.repo.DeleteVoting(ctx, id); err != nil {
+		return err
+	}
+
+	audit.Log(ctx, audit.Entry{
+		EntityType: "voting",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     before,
+	})
+	return nil
 }
 
 func (u *votingUseCase) ListVotingOptionsByVoting(ctx context.Context, votingID uint) ([]domain.VotingOptionDTO, error) {
@@ -172,10 +222,28 @@ func (u *votingUseCase) ListVotingOptionsByVoting(ctx context.Context, votingID
 }
 
 func (u *votingUseCase) DeactivateVotingOption(ctx context.Context, id uint) error {
-	return u.repo.DeactivateVotingOption(ctx, id)
+	option, err := u.repo.GetVotingOptionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	voting, err := u.repo.GetVotingByID(ctx, option.VotingID)
+	if err != nil {
+		return err
+	}
+	if err := ensureVotingEditable(voting); err != nil {
+		return err
+	}
+	if err := u.repo.DeactivateVotingOption(ctx, id); err != nil {
+		return err
+	}
+	logVotingState(ctx, "voting_option", id, audit.ActionDeactivate)
+	return nil
 }
 
 func (u *votingUseCase) ListVotesByVoting(ctx context.Context, votingID uint) ([]domain.VoteDTO, error) {
+	if err := u.ensureVotingNotSecret(ctx, votingID); err != nil {
+		return nil, err
+	}
 	votes, err := u.repo.ListVotesByVoting(ctx, votingID)
 	if err != nil {
 		return nil, err
@@ -184,15 +252,26 @@ func (u *votingUseCase) ListVotesByVoting(ctx context.Context, votingID uint) ([
 	for i := range votes {
 		v := votes[i]
 		res[i] = domain.VoteDTO{
-			ID:             v.ID,
-			VotingID:       v.VotingID,
-			PropertyUnitID: v.PropertyUnitID,
-			VotingOptionID: v.VotingOptionID,
-			VotedAt:        v.VotedAt,
-			IPAddress:      v.IPAddress,
-			UserAgent:      v.UserAgent,
-			Notes:          v.Notes,
+			ID:              v.ID,
+			VotingID:        v.VotingID,
+			PropertyUnitID:  v.PropertyUnitID,
+			VotingOptionID:  v.VotingOptionID,
+			VotingOptionIDs: v.OptionIDs,
+			VotedAt:         v.VotedAt,
+			IPAddress:       v.IPAddress,
+			UserAgent:       v.UserAgent,
+			Notes:           v.Notes,
 		}
 	}
 	return res, nil
 }
+
+// logVotingState registra en la auditoría la activación o desactivación de grupos y opciones
+func logVotingState(ctx context.Context, entityType string, id uint, action string) {
+	audit.Log(ctx, audit.Entry{
+		EntityType: entityType,
+		EntityID:   id,
+		Action:     action,
+		After:      map[string]bool{"is_active": action == audit.ActionActivate},
+	})
+}
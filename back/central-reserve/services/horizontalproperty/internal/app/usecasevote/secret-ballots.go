@@ -0,0 +1,64 @@
+package usecasevote
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+)
+
+// ListSecretBallots lista las papeletas anónimas de una votación secreta para que cualquiera pueda
+// repetir el conteo sin saber qué votó cada unidad
+func (u *votingUseCase) ListSecretBallots(ctx context.Context, votingID uint) ([]domain.VotingBallot, error) {
+	voting, err := u.repo.GetVotingByID(ctx, votingID)
+	if err != nil {
+		return nil, err
+	}
+	if !voting.IsSecret {
+		return nil, domain.ErrVotingNotSecret
+	}
+	return u.repo.ListSecretBallots(ctx, votingID)
+}
+
+// ensureVotingNotSecret impide consultar o modificar votos por unidad en una votación secreta
+func (u *votingUseCase) ensureVotingNotSecret(ctx context.Context, votingID uint) error {
+	voting, err := u.repo.GetVotingByID(ctx, votingID)
+	if err != nil {
+		return err
+	}
+	if voting.IsSecret {
+		return domain.ErrVotingIsSecret
+	}
+	return nil
+}
+
+// listBallots obtiene las papeletas de la votación: las anónimas si es secreta o las de cada voto.
+// En una votación secreta debe haber una papeleta por cada unidad que participó.
+func (u *votingUseCase) listBallots(ctx context.Context, voting *domain.Voting) ([]domain.VotingBallot, error) {
+	if !voting.IsSecret {
+		return u.repo.ListVotingBallots(ctx, voting.ID)
+	}
+	ballots, err := u.repo.ListSecretBallots(ctx, voting.ID)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := u.repo.CountVotesByVoting(ctx, voting.ID)
+	if err != nil {
+		return nil, err
+	}
+	if participants != int64(len(ballots)) {
+		u.logger.Error(ctx).Uint("voting_id", voting.ID).Int64("participants", participants).Int("ballots", len(ballots)).Msg("Las papeletas secretas no coinciden con las unidades que votaron")
+	}
+	return ballots, nil
+}
+
+// newBallotID genera el identificador aleatorio de una papeleta secreta
+func newBallotID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("error generando identificador de papeleta: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
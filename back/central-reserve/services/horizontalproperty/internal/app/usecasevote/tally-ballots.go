@@ -0,0 +1,273 @@
+package usecasevote
+
+import (
+	"math"
+	"sort"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+)
+
+// weightTolerance absorbe el redondeo al comparar sumas de coeficientes decimales
+const weightTolerance = 1e-9
+
+// tallyBallots cuenta las votaciones papeleta por papeleta. Cada opción suma las unidades y el
+// coeficiente de las papeletas que la marcaron (en voto preferencial, solo de las que la pusieron
+// primero) y los ganadores se deciden según el tipo de votación. Las de opción única (secretas)
+// se deciden igual que si se contaran por voto.
+func tallyBallots(voting *domain.Voting, options []domain.VotingOption, ballots []domain.VotingBallot, participation *domain.VotingParticipation) *domain.VotingTallyDTO {
+	tally := newVotingTally(voting, participation)
+
+	index := make(map[uint]int)
+	for _, option := range options {
+		if !option.IsActive {
+			continue
+		}
+		index[option.ID] = len(tally.Options)
+		tally.Options = append(tally.Options, domain.VotingResultDTO{
+			VotingOptionID: option.ID,
+			OptionText:     option.OptionText,
+			OptionCode:     option.OptionCode,
+			Color:          option.Color,
+		})
+	}
+
+	for _, ballot := range ballots {
+		tally.VotedUnits++
+		tally.VotedCoefficient += ballot.Coefficient
+		counted := ballot.OptionIDs
+		if voting.VotingType == domain.VotingTypeRankedChoice && len(counted) > 1 {
+			counted = counted[:1]
+		}
+		for _, optionID := range counted {
+			i, ok := index[optionID]
+			if !ok {
+				continue
+			}
+			tally.Options[i].VoteCount++
+			tally.Options[i].Coefficient += ballot.Coefficient
+		}
+	}
+
+	// Una papeleta puede marcar varias opciones, así que los porcentajes no suman 100
+	for i := range tally.Options {
+		result := &tally.Options[i]
+		if tally.VotedUnits > 0 {
+			result.Percentage = float64(result.VoteCount) / float64(tally.VotedUnits) * 100
+		}
+		if tally.VotedCoefficient > 0 {
+			result.CoefficientPercentage = result.Coefficient / tally.VotedCoefficient * 100
+		}
+	}
+	if voting.IsSingleChoice() {
+		return tallyVoting(voting, tally.Options, participation)
+	}
+
+	weight := resultWeight(tally)
+	if tally.BaseWeight > 0 {
+		for i := range tally.Options {
+			tally.Options[i].BasePercentage = weight(tally.Options[i]) / tally.BaseWeight * 100
+		}
+	}
+
+	switch voting.VotingType {
+	case domain.VotingTypeRankedChoice:
+		decideRankedChoice(tally, ballots)
+	case domain.VotingTypeElection:
+		decideElection(tally, voting.Seats)
+	default:
+		decideMultipleChoice(tally)
+	}
+
+	winners := make(map[uint]bool, len(tally.WinnerOptionIDs))
+	for _, id := range tally.WinnerOptionIDs {
+		winners[id] = true
+	}
+	for i := range tally.Options {
+		tally.Options[i].Winner = winners[tally.Options[i].VotingOptionID]
+	}
+	return tally
+}
+
+// decideMultipleChoice aprueba cada opción que alcance por sí sola el porcentaje requerido de la
+// base (más de la mitad en mayoría simple). La opción que decide es la más votada.
+func decideMultipleChoice(tally *domain.VotingTallyDTO) {
+	weight := resultWeight(tally)
+	if leader := leadingOption(tally.Options, weight); leader >= 0 {
+		id := tally.Options[leader].VotingOptionID
+		tally.DecidingOptionID = &id
+		tally.ApprovalPercentage = tally.Options[leader].BasePercentage
+	}
+	for _, result := range tally.Options {
+		if isAbstentionOption(result.OptionCode) || weight(result) <= 0 {
+			continue
+		}
+		if meetsRequiredPercentage(result.BasePercentage, tally.RequiredPercentage) {
+			tally.WinnerOptionIDs = append(tally.WinnerOptionIDs, result.VotingOptionID)
+		}
+	}
+	if len(tally.WinnerOptionIDs) > 0 {
+		tally.Outcome = domain.VotingOutcomeApproved
+	}
+}
+
+// decideElection elige a los candidatos con más peso hasta completar los cargos; no aplica un
+// porcentaje mínimo. Si un empate cae sobre el último cargo solo quedan elegidos los que superan a
+// los empatados y la elección queda empatada.
+func decideElection(tally *domain.VotingTallyDTO, seats int) {
+	if seats < 1 {
+		seats = 1
+	}
+	tally.Seats = seats
+	tally.RequiredPercentage = 0
+
+	weight := resultWeight(tally)
+	var candidates []domain.VotingResultDTO
+	for _, result := range tally.Options {
+		if !isAbstentionOption(result.OptionCode) && weight(result) > 0 {
+			candidates = append(candidates, result)
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return weight(candidates[i]) > weight(candidates[j])
+	})
+
+	if len(candidates) > seats && sameWeight(weight(candidates[seats-1]), weight(candidates[seats])) {
+		cutoff := weight(candidates[seats-1])
+		for _, candidate := range candidates {
+			if weight(candidate) > cutoff && !sameWeight(weight(candidate), cutoff) {
+				tally.WinnerOptionIDs = append(tally.WinnerOptionIDs, candidate.VotingOptionID)
+			}
+		}
+		tally.Outcome = domain.VotingOutcomeTied
+		return
+	}
+
+	for i := 0; i < len(candidates) && i < seats; i++ {
+		tally.WinnerOptionIDs = append(tally.WinnerOptionIDs, candidates[i].VotingOptionID)
+	}
+	tally.Outcome = domain.VotingOutcomeElected
+}
+
+// decideRankedChoice aplica la segunda vuelta instantánea: en cada ronda cada papeleta cuenta para
+// su opción preferida entre las que siguen en competencia y gana la que supere la mitad del peso de
+// las papeletas vigentes. Si ninguna lo logra se elimina la de menor peso y se repite. Un empate en
+// el último lugar se resuelve por primeras preferencias; si persiste salen todas las empatadas, salvo
+// que sean las únicas que quedan, en cuyo caso la votación queda empatada.
+func decideRankedChoice(tally *domain.VotingTallyDTO, ballots []domain.VotingBallot) {
+	tally.RequiredPercentage = domain.DefaultRequiredPercentage
+
+	weight := resultWeight(tally)
+	continuing := make(map[uint]bool)
+	abstention := make(map[uint]bool)
+	firstPreference := make(map[uint]float64)
+	for _, result := range tally.Options {
+		if isAbstentionOption(result.OptionCode) {
+			abstention[result.VotingOptionID] = true
+			continue
+		}
+		continuing[result.VotingOptionID] = true
+		firstPreference[result.VotingOptionID] = weight(result)
+	}
+
+	// Las papeletas que se abstienen no participan en las rondas
+	var ranked []domain.VotingBallot
+	for _, ballot := range ballots {
+		if len(ballot.OptionIDs) > 0 && !abstention[ballot.OptionIDs[0]] {
+			ranked = append(ranked, ballot)
+		}
+	}
+	ballotWeight := func(ballot domain.VotingBallot) float64 {
+		if tally.WeightedBy == domain.VotingWeightUnits {
+			return 1
+		}
+		return ballot.Coefficient
+	}
+
+	for round := 1; len(continuing) > 0; round++ {
+		current := domain.VotingRoundDTO{Round: round}
+		position := make(map[uint]int)
+		for _, result := range tally.Options {
+			if continuing[result.VotingOptionID] {
+				position[result.VotingOptionID] = len(current.Options)
+				current.Options = append(current.Options, domain.VotingRoundOptionDTO{VotingOptionID: result.VotingOptionID})
+			}
+		}
+
+		active := 0.0
+		for _, ballot := range ranked {
+			preferred := -1
+			for _, optionID := range ballot.OptionIDs {
+				if continuing[optionID] {
+					preferred = position[optionID]
+					break
+				}
+			}
+			if preferred < 0 {
+				current.ExhaustedUnits++
+				current.ExhaustedWeight += ballotWeight(ballot)
+				continue
+			}
+			current.Options[preferred].Units++
+			current.Options[preferred].Weight += ballotWeight(ballot)
+			active += ballotWeight(ballot)
+		}
+		if active > 0 {
+			for i := range current.Options {
+				current.Options[i].Percentage = current.Options[i].Weight / active * 100
+			}
+		}
+		tally.Rounds = append(tally.Rounds, current)
+		if active <= 0 {
+			return
+		}
+
+		leader, lowest := current.Options[0], current.Options[0].Weight
+		for _, option := range current.Options {
+			if option.Weight > leader.Weight {
+				leader = option
+			}
+			lowest = math.Min(lowest, option.Weight)
+		}
+		if meetsRequiredPercentage(leader.Percentage, tally.RequiredPercentage) {
+			id := leader.VotingOptionID
+			tally.DecidingOptionID = &id
+			tally.ApprovalPercentage = leader.Percentage
+			tally.WinnerOptionIDs = []uint{id}
+			tally.Outcome = domain.VotingOutcomeElected
+			return
+		}
+
+		var eliminated []uint
+		fewest := math.Inf(1)
+		for _, option := range current.Options {
+			if sameWeight(option.Weight, lowest) {
+				eliminated = append(eliminated, option.VotingOptionID)
+				fewest = math.Min(fewest, firstPreference[option.VotingOptionID])
+			}
+		}
+		if len(eliminated) > 1 {
+			var untied []uint
+			for _, id := range eliminated {
+				if sameWeight(firstPreference[id], fewest) {
+					untied = append(untied, id)
+				}
+			}
+			eliminated = untied
+		}
+		if len(eliminated) == len(continuing) {
+			tally.Outcome = domain.VotingOutcomeTied
+			return
+		}
+		for _, id := range eliminated {
+			delete(continuing, id)
+		}
+		tally.Rounds[len(tally.Rounds)-1].EliminatedOptionIDs = eliminated
+	}
+}
+
+func sameWeight(a, b float64) bool {
+	return math.Abs(a-b) <= weightTolerance
+}
@@ -0,0 +1,305 @@
+package usecasevote
+
+import (
+	"reflect"
+	"testing"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+)
+
+// Opciones de los tests; abstain se reconoce como abstención por su código
+const (
+	optionA uint = iota + 1
+	optionB
+	optionC
+	optionD
+	abstain
+)
+
+func testOption(id uint, voteCount int, coefficient float64) domain.VotingResultDTO {
+	code := ""
+	if id == abstain {
+		code = "abstention"
+	}
+	return domain.VotingResultDTO{VotingOptionID: id, OptionCode: code, VoteCount: voteCount, Coefficient: coefficient}
+}
+
+func ballot(coefficient float64, optionIDs ...uint) domain.VotingBallot {
+	return domain.VotingBallot{Coefficient: coefficient, OptionIDs: optionIDs}
+}
+
+// sumCoefficients suma en tiempo de ejecución, con el redondeo de float64 (0.1 + 0.2 != 0.3)
+func sumCoefficients(values ...float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func repeat(n int, b domain.VotingBallot) []domain.VotingBallot {
+	ballots := make([]domain.VotingBallot, n)
+	for i := range ballots {
+		ballots[i] = b
+	}
+	return ballots
+}
+
+// rankedTally arma el conteo de primeras preferencias como lo hace tallyBallots
+func rankedTally(weightedBy string, optionIDs []uint, ballots []domain.VotingBallot) *domain.VotingTallyDTO {
+	tally := &domain.VotingTallyDTO{WeightedBy: weightedBy}
+	index := make(map[uint]int)
+	for _, id := range optionIDs {
+		index[id] = len(tally.Options)
+		tally.Options = append(tally.Options, testOption(id, 0, 0))
+	}
+	for _, b := range ballots {
+		if len(b.OptionIDs) == 0 {
+			continue
+		}
+		i := index[b.OptionIDs[0]]
+		tally.Options[i].VoteCount++
+		tally.Options[i].Coefficient += b.Coefficient
+	}
+	return tally
+}
+
+func TestDecideRankedChoice(t *testing.T) {
+	tests := []struct {
+		name           string
+		weightedBy     string
+		options        []uint
+		ballots        [][]domain.VotingBallot
+		wantOutcome    string
+		wantWinners    []uint
+		wantRounds     int
+		wantEliminated [][]uint
+	}{
+		{
+			name:        "mayoría en la primera ronda",
+			weightedBy:  domain.VotingWeightUnits,
+			options:     []uint{optionA, optionB},
+			ballots:     [][]domain.VotingBallot{repeat(3, ballot(1, optionA, optionB)), repeat(1, ballot(1, optionB))},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionA},
+			wantRounds:  1,
+		},
+		{
+			name:       "se elimina la última y sus papeletas pasan a la siguiente preferencia",
+			weightedBy: domain.VotingWeightUnits,
+			options:    []uint{optionA, optionB, optionC},
+			ballots: [][]domain.VotingBallot{
+				repeat(2, ballot(1, optionA)),
+				repeat(2, ballot(1, optionB)),
+				repeat(1, ballot(1, optionC, optionB)),
+			},
+			wantOutcome:    domain.VotingOutcomeElected,
+			wantWinners:    []uint{optionB},
+			wantRounds:     2,
+			wantEliminated: [][]uint{{optionC}, nil},
+		},
+		{
+			name:       "empate en el último lugar se resuelve por primeras preferencias",
+			weightedBy: domain.VotingWeightUnits,
+			options:    []uint{optionA, optionB, optionC, optionD},
+			ballots: [][]domain.VotingBallot{
+				repeat(4, ballot(1, optionA)),
+				repeat(3, ballot(1, optionB)),
+				repeat(2, ballot(1, optionC, optionB)),
+				repeat(1, ballot(1, optionD, optionC)),
+			},
+			wantOutcome:    domain.VotingOutcomeElected,
+			wantWinners:    []uint{optionB},
+			wantRounds:     3,
+			wantEliminated: [][]uint{{optionD}, {optionC}, nil},
+		},
+		{
+			name:       "empate entre las dos últimas con papeletas agotadas",
+			weightedBy: domain.VotingWeightUnits,
+			options:    []uint{optionA, optionB, optionC},
+			ballots: [][]domain.VotingBallot{
+				repeat(2, ballot(1, optionA)),
+				repeat(2, ballot(1, optionB)),
+				repeat(1, ballot(1, optionC)),
+			},
+			wantOutcome:    domain.VotingOutcomeTied,
+			wantRounds:     2,
+			wantEliminated: [][]uint{{optionC}, nil},
+		},
+		{
+			name:        "por coeficiente gana la opción con más peso aunque tenga menos unidades",
+			weightedBy:  domain.VotingWeightCoefficient,
+			options:     []uint{optionA, optionB},
+			ballots:     [][]domain.VotingBallot{repeat(1, ballot(0.6, optionA)), repeat(2, ballot(0.2, optionB))},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionA},
+			wantRounds:  1,
+		},
+		{
+			name:       "las papeletas que se abstienen no cuentan",
+			weightedBy: domain.VotingWeightUnits,
+			options:    []uint{optionA, optionB, abstain},
+			ballots: [][]domain.VotingBallot{
+				repeat(3, ballot(1, abstain)),
+				repeat(2, ballot(1, optionA)),
+				repeat(1, ballot(1, optionB)),
+			},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionA},
+			wantRounds:  1,
+		},
+		{
+			name:       "sin votos",
+			weightedBy: domain.VotingWeightUnits,
+			options:    []uint{optionA, optionB},
+			wantRounds: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ballots []domain.VotingBallot
+			for _, group := range tt.ballots {
+				ballots = append(ballots, group...)
+			}
+			tally := rankedTally(tt.weightedBy, tt.options, ballots)
+
+			decideRankedChoice(tally, ballots)
+
+			if tally.Outcome != tt.wantOutcome {
+				t.Errorf("Outcome = %q, se esperaba %q", tally.Outcome, tt.wantOutcome)
+			}
+			if !reflect.DeepEqual(tally.WinnerOptionIDs, tt.wantWinners) {
+				t.Errorf("WinnerOptionIDs = %v, se esperaba %v", tally.WinnerOptionIDs, tt.wantWinners)
+			}
+			if len(tally.Rounds) != tt.wantRounds {
+				t.Fatalf("rondas = %d, se esperaban %d", len(tally.Rounds), tt.wantRounds)
+			}
+			for i, want := range tt.wantEliminated {
+				if got := tally.Rounds[i].EliminatedOptionIDs; !reflect.DeepEqual(got, want) {
+					t.Errorf("ronda %d eliminadas = %v, se esperaba %v", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecideRankedChoiceExhaustedBallots(t *testing.T) {
+	ballots := append(repeat(2, ballot(1, optionA)), append(repeat(2, ballot(1, optionB)), ballot(1, optionC))...)
+	tally := rankedTally(domain.VotingWeightUnits, []uint{optionA, optionB, optionC}, ballots)
+
+	decideRankedChoice(tally, ballots)
+
+	last := tally.Rounds[len(tally.Rounds)-1]
+	if last.ExhaustedUnits != 1 {
+		t.Errorf("ExhaustedUnits = %d, se esperaba 1", last.ExhaustedUnits)
+	}
+	for _, option := range last.Options {
+		if option.Percentage != 50 {
+			t.Errorf("opción %d = %v%%, se esperaba 50%% de las papeletas vigentes", option.VotingOptionID, option.Percentage)
+		}
+	}
+}
+
+func TestDecideElection(t *testing.T) {
+	tests := []struct {
+		name        string
+		weightedBy  string
+		seats       int
+		options     []domain.VotingResultDTO
+		wantOutcome string
+		wantWinners []uint
+		wantSeats   int
+	}{
+		{
+			name:        "se eligen los más votados",
+			weightedBy:  domain.VotingWeightUnits,
+			seats:       2,
+			options:     []domain.VotingResultDTO{testOption(optionA, 5, 0), testOption(optionB, 1, 0), testOption(optionC, 4, 0)},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionA, optionC},
+			wantSeats:   2,
+		},
+		{
+			name:        "empate en el último cargo deja solo a los que superan a los empatados",
+			weightedBy:  domain.VotingWeightUnits,
+			seats:       2,
+			options:     []domain.VotingResultDTO{testOption(optionA, 5, 0), testOption(optionB, 3, 0), testOption(optionC, 3, 0)},
+			wantOutcome: domain.VotingOutcomeTied,
+			wantWinners: []uint{optionA},
+			wantSeats:   2,
+		},
+		{
+			name:        "empate por encima del último cargo no afecta",
+			weightedBy:  domain.VotingWeightUnits,
+			seats:       2,
+			options:     []domain.VotingResultDTO{testOption(optionA, 4, 0), testOption(optionB, 4, 0), testOption(optionC, 1, 0)},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionA, optionB},
+			wantSeats:   2,
+		},
+		{
+			name:        "empate por coeficiente con redondeo decimal",
+			weightedBy:  domain.VotingWeightCoefficient,
+			seats:       1,
+			options:     []domain.VotingResultDTO{testOption(optionA, 2, sumCoefficients(0.1, 0.2)), testOption(optionB, 1, 0.3)},
+			wantOutcome: domain.VotingOutcomeTied,
+			wantSeats:   1,
+		},
+		{
+			name:        "por coeficiente gana el de más peso aunque tenga menos unidades",
+			weightedBy:  domain.VotingWeightCoefficient,
+			seats:       1,
+			options:     []domain.VotingResultDTO{testOption(optionA, 3, 0.3), testOption(optionB, 1, 0.5)},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionB},
+			wantSeats:   1,
+		},
+		{
+			name:        "la abstención y los candidatos sin votos no ocupan cargos",
+			weightedBy:  domain.VotingWeightUnits,
+			seats:       3,
+			options:     []domain.VotingResultDTO{testOption(optionA, 2, 0), testOption(optionB, 0, 0), testOption(abstain, 5, 0)},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionA},
+			wantSeats:   3,
+		},
+		{
+			name:        "sin cargos configurados se elige uno",
+			weightedBy:  domain.VotingWeightUnits,
+			seats:       0,
+			options:     []domain.VotingResultDTO{testOption(optionA, 1, 0), testOption(optionB, 2, 0)},
+			wantOutcome: domain.VotingOutcomeElected,
+			wantWinners: []uint{optionB},
+			wantSeats:   1,
+		},
+		{
+			name:       "sin votos no hay resultado",
+			weightedBy: domain.VotingWeightUnits,
+			seats:      1,
+			options:    []domain.VotingResultDTO{testOption(optionA, 0, 0), testOption(optionB, 0, 0)},
+			wantSeats:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tally := &domain.VotingTallyDTO{WeightedBy: tt.weightedBy, RequiredPercentage: 50, Options: tt.options}
+
+			decideElection(tally, tt.seats)
+
+			if tally.Outcome != tt.wantOutcome {
+				t.Errorf("Outcome = %q, se esperaba %q", tally.Outcome, tt.wantOutcome)
+			}
+			if !reflect.DeepEqual(tally.WinnerOptionIDs, tt.wantWinners) {
+				t.Errorf("WinnerOptionIDs = %v, se esperaba %v", tally.WinnerOptionIDs, tt.wantWinners)
+			}
+			if tally.Seats != tt.wantSeats {
+				t.Errorf("Seats = %d, se esperaba %d", tally.Seats, tt.wantSeats)
+			}
+			if tally.RequiredPercentage != 0 {
+				t.Errorf("RequiredPercentage = %v, una elección no exige porcentaje", tally.RequiredPercentage)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package usecasevote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/shared/audit"
+	"central_reserve/shared/log"
+)
+
+// ActivateVoting abre un borrador si el grupo tiene quórum (o permite votar sin él). Una votación
+// cerrada no se puede reabrir.
+func (u *votingUseCase) ActivateVoting(ctx context.Context, id uint) error {
+	voting, err := u.repo.GetVotingByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch voting.Status {
+	case domain.VotingStatusOpen:
+		return nil
+	case domain.VotingStatusClosed, domain.VotingStatusCertified:
+		return domain.ErrVotingClosed
+	}
+	return u.openVoting(ctx, voting, time.Now())
+}
+
+// DeactivateVoting cierra la votación abierta: desde ese momento no admite votos ni cambios en sus
+// opciones y quedan registrados el quórum y los resultados del cierre
+func (u *votingUseCase) DeactivateVoting(ctx context.Context, id uint) error {
+	voting, err := u.repo.GetVotingByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch voting.Status {
+	case domain.VotingStatusClosed, domain.VotingStatusCertified:
+		return nil
+	case domain.VotingStatusDraft:
+		return domain.ErrVotingNotOpen
+	}
+	return u.closeVoting(ctx, voting, time.Now())
+}
+
+// CertifyVoting marca como definitivos los resultados de una votación cerrada
+func (u *votingUseCase) CertifyVoting(ctx context.Context, id, userID uint) error {
+	ctx = log.WithFunctionCtx(ctx, "CertifyVoting")
+
+	voting, err := u.repo.GetVotingByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch voting.Status {
+	case domain.VotingStatusCertified:
+		return nil
+	case domain.VotingStatusDraft, domain.VotingStatusOpen:
+		return domain.ErrVotingNotClosed
+	}
+
+	// Las votaciones cerradas antes de existir el registro de resultados se certifican con el conteo actual
+	snapshot, err := u.repo.GetVotingResultSnapshot(ctx, id)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		closedAt := time.Now()
+		if voting.ClosedAt != nil {
+			closedAt = *voting.ClosedAt
+		}
+		if err := u.snapshotResults(ctx, voting, closedAt); err != nil {
+			return err
+		}
+	}
+
+	ok, err := u.repo.TransitionVoting(ctx, domain.VotingTransition{
+		VotingID: id,
+		From:     []string{domain.VotingStatusClosed},
+		To:       domain.VotingStatusCertified,
+		At:       time.Now(),
+		UserID:   &userID,
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrVotingNotClosed
+	}
+	logVotingStatus(ctx, id, audit.ActionUpdate, domain.VotingStatusCertified)
+	return nil
+}
+
+// RunVotingScheduler abre y cierra las votaciones programadas hasta que se cancele el contexto
+func (u *votingUseCase) RunVotingScheduler(ctx context.Context) {
+	ticker := time.NewTicker(domain.VotingScheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now()
+			if closed, err := u.closeDueVotings(ctx, now); err != nil {
+				u.logger.Error().Err(err).Msg("Error cerrando votaciones programadas")
+			} else if closed > 0 {
+				u.logger.Info().Int("closed", closed).Msg("Votaciones programadas cerradas")
+			}
+			if opened, err := u.openDueVotings(ctx, now); err != nil {
+				u.logger.Error().Err(err).Msg("Error abriendo votaciones programadas")
+			} else if opened > 0 {
+				u.logger.Info().Int("opened", opened).Msg("Votaciones programadas abiertas")
+			}
+		}
+	}
+}
+
+// openDueVotings abre los borradores cuya apertura programada llegó. Si el grupo exige quórum y
+// aún no se alcanza, la votación sigue en borrador y se intenta de nuevo en el siguiente ciclo.
+func (u *votingUseCase) openDueVotings(ctx context.Context, now time.Time) (int, error) {
+	votings, err := u.repo.ListVotingsDueToOpen(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	opened := 0
+	for i := range votings {
+		if err := u.openVoting(ctx, &votings[i], now); err != nil {
+			if !errors.Is(err, domain.ErrQuorumNotMet) {
+				u.logger.Error().Err(err).Uint("voting_id", votings[i].ID).Msg("Error abriendo votación programada")
+			}
+			continue
+		}
+		opened++
+	}
+	return opened, nil
+}
+
+// closeDueVotings cierra las votaciones abiertas cuyo cierre programado llegó
+func (u *votingUseCase) closeDueVotings(ctx context.Context, now time.Time) (int, error) {
+	votings, err := u.repo.ListVotingsDueToClose(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	closed := 0
+	for i := range votings {
+		if err := u.closeVoting(ctx, &votings[i], now); err != nil {
+			u.logger.Error().Err(err).Uint("voting_id", votings[i].ID).Msg("Error cerrando votación programada")
+			continue
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// openVoting pasa el borrador a abierta y registra el quórum con que se abrió
+func (u *votingUseCase) openVoting(ctx context.Context, voting *domain.Voting, now time.Time) error {
+	status, err := u.ensureQuorum(ctx, voting.VotingGroupID)
+	if err != nil {
+		return err
+	}
+	ok, err := u.repo.TransitionVoting(ctx, domain.VotingTransition{
+		VotingID: voting.ID,
+		From:     []string{domain.VotingStatusDraft},
+		To:       domain.VotingStatusOpen,
+		At:       now,
+	})
+	if err != nil || !ok {
+		return err
+	}
+	logVotingStatus(ctx, voting.ID, audit.ActionActivate, domain.VotingStatusOpen)
+	u.recordQuorumSnapshot(ctx, voting.ID, status, domain.QuorumSnapshotOpen)
+	return nil
+}
+
+// closeVoting cierra la votación y registra el quórum y los resultados del cierre. Si otro cierre
+// se adelantó no hace nada.
+func (u *votingUseCase) closeVoting(ctx context.Context, voting *domain.Voting, now time.Time) error {
+	ok, err := u.repo.TransitionVoting(ctx, domain.VotingTransition{
+		VotingID: voting.ID,
+		From:     []string{domain.VotingStatusOpen},
+		To:       domain.VotingStatusClosed,
+		At:       now,
+	})
+	if err != nil || !ok {
+		return err
+	}
+	logVotingStatus(ctx, voting.ID, audit.ActionDeactivate, domain.VotingStatusClosed)
+	u.snapshotQuorum(ctx, voting.ID, voting.VotingGroupID, domain.QuorumSnapshotClose)
+	if err := u.snapshotResults(ctx, voting, now); err != nil {
+		u.logger.Error(ctx).Err(err).Uint("voting_id", voting.ID).Msg("Error registrando resultados del cierre")
+	}
+	return nil
+}
+
+// snapshotResults calcula los resultados de la votación y los registra como definitivos
+func (u *votingUseCase) snapshotResults(ctx context.Context, voting *domain.Voting, closedAt time.Time) error {
+	tally, err := u.computeTally(ctx, voting)
+	if err != nil {
+		return err
+	}
+	_, err = u.repo.CreateVotingResultSnapshot(ctx, domain.VotingResultSnapshot{
+		VotingID: voting.ID,
+		Tally:    *tally,
+		ClosedAt: closedAt,
+	})
+	return err
+}
+
+// ensureVotingEditable impide cambiar una votación cerrada o sus opciones
+func ensureVotingEditable(voting *domain.Voting) error {
+	if voting.IsClosed() {
+		return domain.ErrVotingClosed
+	}
+	return nil
+}
+
+// logVotingStatus registra en la auditoría el cambio de estado de una votación
+func logVotingStatus(ctx context.Context, id uint, action string, status string) {
+	audit.Log(ctx, audit.Entry{
+		EntityType: "voting",
+		EntityID:   id,
+		Action:     action,
+		After: map[string]any{
+			"status":    status,
+			"is_active": status == domain.VotingStatusOpen,
+		},
+	})
+}
+
+// ───────────────────────────────────────────
+// Programación
+// ───────────────────────────────────────────
+
+// Formatos aceptados para la hora local de la propiedad
+var scheduleLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// votingSchedule - Estado inicial y horario de una votación
+type votingSchedule struct {
+	status  string
+	openAt  *time.Time
+	closeAt *time.Time
+}
+
+// groupLocation obtiene el grupo y la zona horaria de su propiedad. Si la zona no es válida se usa
+// la de por defecto.
+func (u *votingUseCase) groupLocation(ctx context.Context, groupID uint) (*domain.VotingGroup, *time.Location, error) {
+	group, err := u.repo.GetVotingGroupByID(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	timezone, err := u.repo.GetBusinessTimezone(ctx, group.BusinessID)
+	if err != nil {
+		return nil, nil, err
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		u.logger.Warn(ctx).Str("timezone", timezone).Uint("business_id", group.BusinessID).Msg("Zona horaria inválida, se usa la de por defecto")
+		if loc, err = time.LoadLocation(domain.DefaultVotingTimezone); err != nil {
+			loc = time.UTC
+		}
+	}
+	return group, loc, nil
+}
+
+// parseScheduleTime interpreta la fecha en la hora local de la propiedad, salvo que traiga su zona (RFC3339)
+func parseScheduleTime(value string, loc *time.Location) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	for _, layout := range scheduleLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: fecha %q, use 2006-01-02T15:04 en la hora local de la propiedad", domain.ErrVotingScheduleInvalid, value)
+}
+
+// buildVotingSchedule calcula el horario de la votación dentro del periodo del grupo. Sin apertura
+// programada la votación se abre de inmediato (o en el inicio del grupo si aún no llega) y sin
+// cierre programado se cierra al terminar el periodo del grupo.
+func buildVotingSchedule(dto domain.CreateVotingDTO, group *domain.VotingGroup, loc *time.Location, now time.Time) (*votingSchedule, error) {
+	openAt, err := parseScheduleTime(dto.ScheduledOpenAt, loc)
+	if err != nil {
+		return nil, err
+	}
+	closeAt, err := parseScheduleTime(dto.ScheduledCloseAt, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if openAt == nil && group.VotingStartDate.After(now) {
+		start := group.VotingStartDate
+		openAt = &start
+	}
+	if closeAt == nil {
+		end := group.VotingEndDate
+		closeAt = &end
+	}
+
+	if openAt != nil && openAt.Before(group.VotingStartDate) {
+		return nil, fmt.Errorf("%w: la apertura es anterior al inicio del grupo de votación", domain.ErrVotingScheduleInvalid)
+	}
+	if closeAt.After(group.VotingEndDate) {
+		return nil, fmt.Errorf("%w: el cierre es posterior al fin del grupo de votación", domain.ErrVotingScheduleInvalid)
+	}
+	if !closeAt.After(now) {
+		return nil, fmt.Errorf("%w: el cierre programado ya pasó", domain.ErrVotingScheduleInvalid)
+	}
+	if openAt != nil && !closeAt.After(*openAt) {
+		return nil, fmt.Errorf("%w: el cierre debe ser posterior a la apertura", domain.ErrVotingScheduleInvalid)
+	}
+
+	schedule := &votingSchedule{status: domain.VotingStatusOpen, openAt: openAt, closeAt: closeAt}
+	if dto.Draft || (openAt != nil && openAt.After(now)) {
+		schedule.status = domain.VotingStatusDraft
+	}
+	return schedule, nil
+}
+
+// mapVotingToDTO arma el DTO de la votación con sus fechas en la zona horaria de la propiedad
+func mapVotingToDTO(v *domain.Voting, loc *time.Location) domain.VotingDTO {
+	return domain.VotingDTO{
+		ID:                 v.ID,
+		VotingGroupID:      v.VotingGroupID,
+		Title:              v.Title,
+		Description:        v.Description,
+		VotingType:         v.VotingType,
+		IsSecret:           v.IsSecret,
+		AllowAbstention:    v.AllowAbstention,
+		IsActive:           v.IsActive,
+		DisplayOrder:       v.DisplayOrder,
+		RequiredPercentage: v.RequiredPercentage,
+		MajorityBase:       v.MajorityBase,
+		MinSelections:      v.MinSelections,
+		MaxSelections:      v.MaxSelections,
+		Seats:              v.Seats,
+		Status:             v.Status,
+		ScheduledOpenAt:    inLocation(v.ScheduledOpenAt, loc),
+		ScheduledCloseAt:   inLocation(v.ScheduledCloseAt, loc),
+		OpenedAt:           inLocation(v.OpenedAt, loc),
+		ClosedAt:           inLocation(v.ClosedAt, loc),
+		CertifiedAt:        inLocation(v.CertifiedAt, loc),
+		Timezone:           loc.String(),
+		CreatedAt:          v.CreatedAt,
+		UpdatedAt:          v.UpdatedAt,
+	}
+}
+
+func inLocation(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	local := t.In(loc)
+	return &local
+}
@@ -191,32 +191,34 @@ type PaginatedHorizontalPropertyDTO struct {
 
 // CreateVotingGroupDTO - DTO para crear un grupo de votaciones
 type CreateVotingGroupDTO struct {
-	BusinessID       uint
-	Name             string
-	Description      string
-	VotingStartDate  time.Time
-	VotingEndDate    time.Time
-	RequiresQuorum   bool
-	QuorumPercentage *float64
-	CreatedByUserID  *uint
-	Notes            string
+	BusinessID               uint
+	Name                     string
+	Description              string
+	VotingStartDate          time.Time
+	VotingEndDate            time.Time
+	RequiresQuorum           bool
+	QuorumPercentage         *float64
+	AllowVotingWithoutQuorum bool
+	CreatedByUserID          *uint
+	Notes                    string
 }
 
 // VotingGroupDTO - DTO para respuesta de grupo de votaciones
 type VotingGroupDTO struct {
-	ID               uint
-	BusinessID       uint
-	Name             string
-	Description      string
-	VotingStartDate  time.Time
-	VotingEndDate    time.Time
-	IsActive         bool
-	RequiresQuorum   bool
-	QuorumPercentage *float64
-	CreatedByUserID  *uint
-	Notes            string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	ID                       uint
+	BusinessID               uint
+	Name                     string
+	Description              string
+	VotingStartDate          time.Time
+	VotingEndDate            time.Time
+	IsActive                 bool
+	RequiresQuorum           bool
+	QuorumPercentage         *float64
+	AllowVotingWithoutQuorum bool
+	CreatedByUserID          *uint
+	Notes                    string
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
 }
 
 // CreateVotingDTO - DTO para crear una votación
@@ -229,6 +231,15 @@ type CreateVotingDTO struct {
 	AllowAbstention    bool
 	DisplayOrder       int
 	RequiredPercentage *float64
+	MajorityBase       string
+	MinSelections      int
+	MaxSelections      int
+	Seats              int
+
+	// Fecha y hora local de la propiedad (2006-01-02T15:04) o RFC3339 con zona; vacío si no se programa
+	ScheduledOpenAt  string
+	ScheduledCloseAt string
+	Draft            bool // Crear en borrador aunque no tenga apertura programada
 }
 
 // VotingDTO - DTO para respuesta de una votación
@@ -243,6 +254,17 @@ type VotingDTO struct {
 	IsActive           bool
 	DisplayOrder       int
 	RequiredPercentage *float64
+	MajorityBase       string
+	MinSelections      int
+	MaxSelections      int
+	Seats              int
+	Status             string
+	ScheduledOpenAt    *time.Time // En la zona horaria de la propiedad
+	ScheduledCloseAt   *time.Time
+	OpenedAt           *time.Time
+	ClosedAt           *time.Time
+	CertifiedAt        *time.Time
+	Timezone           string
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 	Options            []VotingOptionDTO
@@ -270,27 +292,30 @@ type VotingOptionDTO struct {
 
 // CreateVoteDTO - DTO para emitir un voto
 type CreateVoteDTO struct {
-	VotingID       uint
-	PropertyUnitID uint
-	VotingOptionID uint
-	IPAddress      string
-	UserAgent      string
-	Notes          string
+	VotingID        uint
+	PropertyUnitID  uint
+	VotingOptionID  uint   // Opción única
+	VotingOptionIDs []uint // Opciones de la papeleta en orden de preferencia; reemplaza a VotingOptionID
+	IPAddress       string
+	UserAgent       string
+	Notes           string
 }
 
 // VoteDTO - DTO para respuesta de voto
 type VoteDTO struct {
-	ID             uint
-	VotingID       uint
-	PropertyUnitID uint
-	VotingOptionID uint
-	OptionText     string
-	OptionCode     string
-	OptionColor    string
-	VotedAt        time.Time
-	IPAddress      string
-	UserAgent      string
-	Notes          string
+	ID              uint
+	VotingID        uint
+	PropertyUnitID  uint
+	VotingOptionID  uint
+	VotingOptionIDs []uint
+	Secret          bool // Voto de una votación secreta
+	OptionText      string
+	OptionCode      string
+	OptionColor     string
+	VotedAt         time.Time
+	IPAddress       string
+	UserAgent       string
+	Notes           string
 }
 
 // VotingResultDTO - DTO para resultados de votación
@@ -300,7 +325,12 @@ type VotingResultDTO struct {
 	OptionCode     string
 	Color          string
 	VoteCount      int
-	Percentage     float64
+	Percentage     float64 // Sobre las unidades que votaron
+
+	Coefficient           float64 // Suma de coeficientes de las unidades que eligieron la opción
+	CoefficientPercentage float64 // Sobre el coeficiente de las unidades que votaron
+	BasePercentage        float64 // Sobre la base de la decisión (coeficientes presentes o totales)
+	Winner                bool    // Opción ganadora (selección múltiple, voto preferencial y elecciones)
 }
 
 // VotingDetailByUnitDTO - DTO para detalle de votación por unidad
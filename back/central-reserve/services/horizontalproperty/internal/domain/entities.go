@@ -138,17 +138,18 @@ type BusinessType struct {
 
 // VotingGroup - Grupo de votaciones (por ejemplo, Asamblea)
 type VotingGroup struct {
-	ID               uint
-	BusinessID       uint
-	Name             string
-	Description      string
-	VotingStartDate  time.Time
-	VotingEndDate    time.Time
-	IsActive         bool
-	RequiresQuorum   bool
-	QuorumPercentage *float64
-	CreatedByUserID  *uint
-	Notes            string
+	ID                       uint
+	BusinessID               uint
+	Name                     string
+	Description              string
+	VotingStartDate          time.Time
+	VotingEndDate            time.Time
+	IsActive                 bool
+	RequiresQuorum           bool
+	QuorumPercentage         *float64
+	AllowVotingWithoutQuorum bool
+	CreatedByUserID          *uint
+	Notes                    string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -167,6 +168,18 @@ type Voting struct {
 	IsActive           bool
 	DisplayOrder       int
 	RequiredPercentage *float64
+	MajorityBase       string
+	MinSelections      int // Mínimo de opciones a marcar (multiple_choice)
+	MaxSelections      int // Máximo de opciones a marcar u ordenar; 0 sin límite
+	Seats              int // Cargos a elegir (election)
+
+	Status            string // draft | open | closed | certified
+	ScheduledOpenAt   *time.Time
+	ScheduledCloseAt  *time.Time
+	OpenedAt          *time.Time
+	ClosedAt          *time.Time
+	CertifiedAt       *time.Time
+	CertifiedByUserID *uint
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -189,7 +202,8 @@ type Vote struct {
 	ID             uint
 	VotingID       uint
 	PropertyUnitID uint
-	VotingOptionID uint
+	VotingOptionID uint   // Primera opción de la papeleta
+	OptionIDs      []uint // Opciones marcadas en orden de preferencia (incluye VotingOptionID)
 	OptionText     string // Texto de la opción votada (solo cuando se carga con Preload)
 	OptionCode     string // Código de la opción votada (solo cuando se carga con Preload)
 	OptionColor    string // Color de la opción votada (solo cuando se carga con Preload)
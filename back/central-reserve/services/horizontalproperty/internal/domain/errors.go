@@ -52,4 +52,16 @@ var (
 	ErrResidentDniRequired   = errors.New("el DNI del residente es requerido")
 	ErrPropertyUnitRequired  = errors.New("la unidad de propiedad es requerida")
 	ErrResidentTypeRequired  = errors.New("el tipo de residente es requerido")
+
+	// Errores de votaciones
+	ErrQuorumNotMet          = errors.New("no se ha alcanzado el quórum requerido")
+	ErrVotingNotOpen         = errors.New("la votación no está abierta")
+	ErrVotingClosed          = errors.New("la votación está cerrada y no admite cambios")
+	ErrVotingNotClosed       = errors.New("solo se puede certificar una votación cerrada")
+	ErrVotingScheduleInvalid = errors.New("la programación de la votación no es válida")
+	ErrVotingConfigInvalid   = errors.New("la configuración del tipo de votación no es válida")
+	ErrVotingTypeLocked      = errors.New("no se puede cambiar el tipo de votación ni si es secreta después de recibir votos")
+	ErrInvalidBallot         = errors.New("la papeleta no es válida")
+	ErrVotingIsSecret        = errors.New("la votación es secreta y no expone votos por unidad")
+	ErrVotingNotSecret       = errors.New("la votación no es secreta")
 )
@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ═══════════════════════════════════════════════════════════════════
 //
@@ -70,28 +73,45 @@ type VotingRepository interface {
 	GetVotingByID(ctx context.Context, id uint) (*Voting, error)
 	ListVotingsByGroup(ctx context.Context, groupID uint) ([]Voting, error)
 	UpdateVoting(ctx context.Context, id uint, voting *Voting) (*Voting, error)
-	ActivateVoting(ctx context.Context, id uint) error
-	DeactivateVoting(ctx context.Context, id uint) error
+	TransitionVoting(ctx context.Context, transition VotingTransition) (bool, error)
+	ListVotingsDueToOpen(ctx context.Context, now time.Time) ([]Voting, error)
+	ListVotingsDueToClose(ctx context.Context, now time.Time) ([]Voting, error)
 	DeleteVoting(ctx context.Context, id uint) error
+	GetBusinessTimezone(ctx context.Context, businessID uint) (string, error)
 
 	// Voting Options
 	CreateVotingOption(ctx context.Context, option *VotingOption) (*VotingOption, error)
+	GetVotingOptionByID(ctx context.Context, id uint) (*VotingOption, error)
 	ListVotingOptionsByVoting(ctx context.Context, votingID uint) ([]VotingOption, error)
 	DeactivateVotingOption(ctx context.Context, id uint) error
 
 	// Votes
 	CreateVote(ctx context.Context, vote Vote) (*Vote, error)
+	CreateSecretVote(ctx context.Context, participation Vote, ballot VotingBallot) (*Vote, error)
 	GetVoteByID(ctx context.Context, voteID uint) (*Vote, error)
 	DeleteVote(ctx context.Context, voteID uint) error
 	HasUnitVoted(ctx context.Context, votingID uint, propertyUnitID uint) (bool, error)
 	GetUnitVote(ctx context.Context, votingID, propertyUnitID uint) (*Vote, error)
 	GetVotingResults(ctx context.Context, votingID uint) ([]VotingResultDTO, error)
+	GetVotingParticipation(ctx context.Context, votingID uint) (*VotingParticipation, error)
+	ListVotingBallots(ctx context.Context, votingID uint) ([]VotingBallot, error)
+	CountVotesByVoting(ctx context.Context, votingID uint) (int64, error)
+	ListSecretBallots(ctx context.Context, votingID uint) ([]VotingBallot, error)
 	GetVotingDetailsByUnit(ctx context.Context, votingID, hpID uint) ([]VotingDetailByUnitDTO, error)
 	GetUnitsWithResidents(ctx context.Context, hpID uint) ([]UnitWithResidentDTO, error)
 	ListVotesByVoting(ctx context.Context, votingID uint) ([]Vote, error)
 	GetUnvotedUnitsByVoting(ctx context.Context, votingID uint, unitNumberFilter string) ([]UnvotedUnit, error)
 	GetResidentMainUnitID(ctx context.Context, residentID uint) (uint, error)
 	CheckUnitAttendanceForVoting(ctx context.Context, votingID, propertyUnitID uint) (bool, error)
+
+	// Quorum
+	GetQuorumParticipation(ctx context.Context, votingGroupID uint) (*QuorumParticipation, error)
+	CreateQuorumSnapshot(ctx context.Context, snapshot VotingQuorumSnapshot) (*VotingQuorumSnapshot, error)
+	ListQuorumSnapshotsByVoting(ctx context.Context, votingID uint) ([]VotingQuorumSnapshot, error)
+
+	// Resultados al cierre
+	CreateVotingResultSnapshot(ctx context.Context, snapshot VotingResultSnapshot) (*VotingResultSnapshot, error)
+	GetVotingResultSnapshot(ctx context.Context, votingID uint) (*VotingResultSnapshot, error)
 }
 
 // VotingUseCase - Puerto para casos de uso de votaciones
@@ -110,7 +130,9 @@ type VotingUseCase interface {
 	UpdateVoting(ctx context.Context, id uint, dto CreateVotingDTO) (*VotingDTO, error)
 	ActivateVoting(ctx context.Context, id uint) error
 	DeactivateVoting(ctx context.Context, id uint) error
+	CertifyVoting(ctx context.Context, id, userID uint) error
 	DeleteVoting(ctx context.Context, id uint) error
+	RunVotingScheduler(ctx context.Context)
 
 	// Options
 	CreateVotingOption(ctx context.Context, dto CreateVotingOptionDTO) (*VotingOptionDTO, error)
@@ -124,6 +146,8 @@ type VotingUseCase interface {
 	HasUnitVoted(ctx context.Context, votingID, propertyUnitID uint) (bool, error)
 	GetUnitVote(ctx context.Context, votingID, propertyUnitID uint) (*VoteDTO, error)
 	GetVotingResults(ctx context.Context, votingID uint) ([]VotingResultDTO, error)
+	GetVotingTally(ctx context.Context, votingID uint) (*VotingTallyDTO, error)
+	ListSecretBallots(ctx context.Context, votingID uint) ([]VotingBallot, error)
 	GetVotingDetailsByUnit(ctx context.Context, votingID, hpID uint) ([]VotingDetailByUnitDTO, error)
 	GetUnvotedUnitsByVoting(ctx context.Context, votingID uint, unitNumberFilter string) ([]UnvotedUnitDTO, error)
 
@@ -131,6 +155,10 @@ type VotingUseCase interface {
 	ValidateResidentForVoting(ctx context.Context, hpID, propertyUnitID uint, dni string) (*ResidentBasicDTO, error)
 	GetUnitsWithResidents(ctx context.Context, hpID uint) ([]UnitWithResidentDTO, error)
 	CheckUnitAttendanceForVoting(ctx context.Context, votingID, propertyUnitID uint) (bool, error)
+
+	// Quorum
+	GetQuorumStatus(ctx context.Context, votingGroupID uint) (*QuorumStatusDTO, error)
+	ListQuorumSnapshots(ctx context.Context, votingID uint) ([]VotingQuorumSnapshot, error)
 }
 
 // ───────────────────────────────────────────
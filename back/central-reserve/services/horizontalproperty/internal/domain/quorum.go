@@ -0,0 +1,71 @@
+package domain
+
+import "time"
+
+// Momentos en que se registra el quórum de una votación
+const (
+	QuorumSnapshotOpen  = "open"
+	QuorumSnapshotClose = "close"
+)
+
+// DefaultQuorumPercentage es el quórum que se exige si el grupo lo requiere sin indicar porcentaje
+const DefaultQuorumPercentage = 50.0
+
+// QuorumRefreshInterval es cada cuánto el stream de quórum lo recalcula aunque no haya cambios de
+// asistencia, para reflejar poderes que vencen o se modifican
+const QuorumRefreshInterval = 15 * time.Second
+
+// QuorumParticipation - Unidades y coeficientes presentes en la asamblea de un grupo de votación.
+// Cuenta como presente la unidad que asistió como propietario o, como apoderado, con un poder
+// activo y vigente para la unidad.
+type QuorumParticipation struct {
+	TotalUnits       int
+	TotalCoefficient float64
+	OwnerUnits       int
+	OwnerCoefficient float64
+	ProxyUnits       int
+	ProxyCoefficient float64
+}
+
+// QuorumStatusDTO - Quórum de un grupo de votación en un momento dado
+type QuorumStatusDTO struct {
+	VotingGroupID            uint
+	BusinessID               uint
+	RequiresQuorum           bool
+	AllowVotingWithoutQuorum bool
+	QuorumPercentage         float64
+
+	TotalUnits         int
+	TotalCoefficient   float64
+	PresentUnits       int
+	PresentCoefficient float64
+	OwnerUnits         int
+	ProxyUnits         int
+
+	WeightedBy        string  // coefficient | units
+	PresentPercentage float64 // Porcentaje presente sobre el total según WeightedBy
+	QuorumMet         bool
+	Enforced          bool // El grupo bloquea activar votaciones y votar mientras no hay quórum
+	CalculatedAt      time.Time
+}
+
+// Blocking indica si la falta de quórum impide activar votaciones y votar
+func (s *QuorumStatusDTO) Blocking() bool {
+	return s.Enforced && !s.QuorumMet
+}
+
+// VotingQuorumSnapshot - Quórum registrado al abrir o cerrar una votación
+type VotingQuorumSnapshot struct {
+	ID                 uint
+	VotingID           uint
+	Event              string // open | close
+	PresentUnits       int
+	PresentCoefficient float64
+	TotalUnits         int
+	TotalCoefficient   float64
+	PresentPercentage  float64
+	WeightedBy         string
+	QuorumPercentage   float64
+	QuorumMet          bool
+	TakenAt            time.Time
+}
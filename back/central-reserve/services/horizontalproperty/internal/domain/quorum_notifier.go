@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"sync"
+)
+
+// QuorumNotifier - Avisa a los streams de quórum que la asistencia de un grupo de votación cambió
+type QuorumNotifier interface {
+	// Notify avisa a los suscriptores del grupo que deben recalcular el quórum
+	Notify(votingGroupID uint)
+
+	// Subscribe suscribe un canal que recibe un aviso por cada cambio de asistencia del grupo
+	Subscribe(ctx context.Context, votingGroupID uint) <-chan struct{}
+}
+
+// QuorumNotifierMemory - Implementación en memoria del notificador de quórum
+type QuorumNotifierMemory struct {
+	mu          sync.Mutex
+	subscribers map[uint][]chan struct{}
+}
+
+// NewQuorumNotifier crea una nueva instancia del notificador
+func NewQuorumNotifier() *QuorumNotifierMemory {
+	return &QuorumNotifierMemory{
+		subscribers: make(map[uint][]chan struct{}),
+	}
+}
+
+// Notify avisa sin bloquear: si un suscriptor ya tiene un aviso pendiente no hace falta otro
+func (n *QuorumNotifierMemory) Notify(votingGroupID uint) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, ch := range n.subscribers[votingGroupID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Subscribe crea la suscripción y la elimina cuando el contexto se cancela
+func (n *QuorumNotifierMemory) Subscribe(ctx context.Context, votingGroupID uint) <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	n.subscribers[votingGroupID] = append(n.subscribers[votingGroupID], ch)
+
+	go func() {
+		<-ctx.Done()
+		n.unsubscribe(votingGroupID, ch)
+	}()
+
+	return ch
+}
+
+func (n *QuorumNotifierMemory) unsubscribe(votingGroupID uint, ch chan struct{}) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	subscribers := n.subscribers[votingGroupID]
+	for i, subscriber := range subscribers {
+		if subscriber == ch {
+			n.subscribers[votingGroupID] = append(subscribers[:i], subscribers[i+1:]...)
+			close(subscriber)
+			break
+		}
+	}
+	if len(n.subscribers[votingGroupID]) == 0 {
+		delete(n.subscribers, votingGroupID)
+	}
+}
@@ -35,6 +35,20 @@ type VoteEvent struct {
 	Vote VoteDTO // Datos del voto
 }
 
+// Anonymous devuelve el voto de una votación secreta sin los datos que identifican a la unidad
+// (voto, unidad, fecha, IP y navegador), solo con las opciones elegidas
+func (v VoteDTO) Anonymous() VoteDTO {
+	return VoteDTO{
+		VotingID:        v.VotingID,
+		VotingOptionID:  v.VotingOptionID,
+		VotingOptionIDs: v.VotingOptionIDs,
+		Secret:          true,
+		OptionText:      v.OptionText,
+		OptionCode:      v.OptionCode,
+		OptionColor:     v.OptionColor,
+	}
+}
+
 // VotingCache - Implementación del cache de votaciones
 type VotingCache struct {
 	mu          sync.RWMutex
@@ -55,6 +69,11 @@ func (vc *VotingCache) PublishVote(votingID uint, vote VoteDTO) error {
 	vc.mu.Lock()
 	defer vc.mu.Unlock()
 
+	// Los votos secretos se guardan y notifican sin datos de la unidad
+	if vote.Secret {
+		vote = vote.Anonymous()
+	}
+
 	// Agregar voto al cache
 	vc.votings[votingID] = append(vc.votings[votingID], vote)
 
@@ -207,7 +226,12 @@ func (vc *VotingCache) InitializeVoting(votingID uint, votes []VoteDTO) error {
 	defer vc.mu.Unlock()
 
 	vc.votings[votingID] = make([]VoteDTO, len(votes))
-	copy(vc.votings[votingID], votes)
+	for i, vote := range votes {
+		if vote.Secret {
+			vote = vote.Anonymous()
+		}
+		vc.votings[votingID][i] = vote
+	}
 
 	return nil
 }
@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// Estados de una votación. Solo se vota con la votación abierta; al cerrarse quedan fijos sus
+// opciones, votos y resultados, y la certificación deja constancia de que el acta es definitiva.
+const (
+	VotingStatusDraft     = "draft"
+	VotingStatusOpen      = "open"
+	VotingStatusClosed    = "closed"
+	VotingStatusCertified = "certified"
+)
+
+// VotingScheduleInterval es cada cuánto se abren y cierran las votaciones programadas
+const VotingScheduleInterval = 30 * time.Second
+
+// DefaultVotingTimezone es la zona horaria que se usa si la propiedad no tiene una válida
+const DefaultVotingTimezone = "America/Bogota"
+
+// VotingTransition - Cambio de estado de una votación. Solo se aplica si la votación está en uno
+// de los estados From, para que el cierre manual y el programado no se pisen.
+type VotingTransition struct {
+	VotingID uint
+	From     []string
+	To       string
+	At       time.Time
+	UserID   *uint // Usuario que certifica
+}
+
+// VotingResultSnapshot - Resultados definitivos registrados al cerrar una votación
+type VotingResultSnapshot struct {
+	ID       uint
+	VotingID uint
+	Tally    VotingTallyDTO
+	ClosedAt time.Time
+}
+
+// IsClosed indica si la votación ya no admite cambios en opciones ni votos
+func (v *Voting) IsClosed() bool {
+	return v.Status == VotingStatusClosed || v.Status == VotingStatusCertified
+}
@@ -0,0 +1,81 @@
+package domain
+
+// Base sobre la que se calcula el porcentaje de una decisión (Ley 675 de 2001): las decisiones
+// ordinarias se toman sobre los coeficientes presentes en la asamblea y las que exigen mayoría
+// calificada sobre el total de coeficientes de la copropiedad.
+const (
+	VotingMajorityBasePresent = "present"
+	VotingMajorityBaseTotal   = "total"
+)
+
+// Resultado de una votación
+const (
+	VotingOutcomeApproved = "approved"
+	VotingOutcomeRejected = "rejected"
+	VotingOutcomeElected  = "elected" // Voto preferencial y elecciones con ganadores definidos
+	VotingOutcomeTied     = "tied"    // Un empate impide definir el ganador o el último cargo
+)
+
+// Peso de cada voto: el coeficiente de participación de la unidad o, si la copropiedad no tiene
+// coeficientes cargados, una unidad un voto
+const (
+	VotingWeightCoefficient = "coefficient"
+	VotingWeightUnits       = "units"
+)
+
+// DefaultRequiredPercentage es la mayoría simple que se aplica si la votación no indica otra
+const DefaultRequiredPercentage = 50.0
+
+// VotingParticipation - Unidades y coeficientes de la copropiedad y de los presentes en la votación.
+// Se consideran presentes las unidades que asistieron (como propietario o por poder) y las que votaron.
+type VotingParticipation struct {
+	TotalUnits         int
+	TotalCoefficient   float64
+	PresentUnits       int
+	PresentCoefficient float64
+}
+
+// VotingTallyDTO - Resultados de una votación por unidades y por coeficiente, con su decisión
+type VotingTallyDTO struct {
+	VotingID   uint
+	VotingType string
+	Options    []VotingResultDTO // En voto preferencial, conteo de primeras preferencias
+
+	VotedUnits         int
+	VotedCoefficient   float64
+	PresentUnits       int
+	PresentCoefficient float64
+	TotalUnits         int
+	TotalCoefficient   float64
+
+	MajorityBase       string  // present | total
+	WeightedBy         string  // coefficient | units
+	BaseWeight         float64 // Base de la decisión medida según WeightedBy
+	RequiredPercentage float64
+
+	DecidingOptionID   *uint   // Opción afirmativa o, si no hay, la más votada; nil si hay empate o no hay votos
+	ApprovalPercentage float64 // Porcentaje de la base que obtuvo la opción que decide
+	Outcome            string  // approved | rejected | elected | tied
+
+	Seats           int              // Cargos a elegir (election)
+	WinnerOptionIDs []uint           // Opciones ganadoras (selección múltiple, voto preferencial y elecciones)
+	Rounds          []VotingRoundDTO // Rondas de eliminación del voto preferencial
+}
+
+// VotingRoundDTO - Conteo de una ronda del voto preferencial. Cada papeleta cuenta para la opción
+// que prefiere entre las que siguen en competencia; si ya no le queda ninguna se agota.
+type VotingRoundDTO struct {
+	Round               int
+	Options             []VotingRoundOptionDTO
+	ExhaustedUnits      int
+	ExhaustedWeight     float64
+	EliminatedOptionIDs []uint // Opciones eliminadas al terminar la ronda
+}
+
+// VotingRoundOptionDTO - Peso de una opción en una ronda del voto preferencial
+type VotingRoundOptionDTO struct {
+	VotingOptionID uint
+	Units          int
+	Weight         float64
+	Percentage     float64 // Sobre el peso de las papeletas vigentes en la ronda
+}
@@ -0,0 +1,37 @@
+package domain
+
+// Tipos de votación. simple, majority y unanimity son de opción única y solo cambian la mayoría
+// requerida; los demás admiten varias opciones por papeleta.
+const (
+	VotingTypeSimple         = "simple"
+	VotingTypeMajority       = "majority"
+	VotingTypeUnanimity      = "unanimity"
+	VotingTypeSingleChoice   = "single_choice"
+	VotingTypeMultipleChoice = "multiple_choice" // Se marcan entre MinSelections y MaxSelections opciones
+	VotingTypeRankedChoice   = "ranked_choice"   // Se ordenan las opciones; gana por segunda vuelta instantánea
+	VotingTypeElection       = "election"        // Se eligen hasta Seats candidatos; ganan los Seats más votados
+)
+
+// VotingBallot - Papeleta de una unidad con las opciones marcadas en orden de preferencia. Las
+// papeletas de votaciones secretas solo tienen BallotID, sin voto ni unidad.
+type VotingBallot struct {
+	VoteID         uint
+	PropertyUnitID uint
+	BallotID       string
+	Coefficient    float64
+	OptionIDs      []uint
+}
+
+// IsSingleChoice indica si la papeleta lleva una sola opción
+func (v *Voting) IsSingleChoice() bool {
+	return IsSingleChoiceType(v.VotingType)
+}
+
+// IsSingleChoiceType indica si el tipo de votación es de opción única
+func IsSingleChoiceType(votingType string) bool {
+	switch votingType {
+	case VotingTypeMultipleChoice, VotingTypeRankedChoice, VotingTypeElection:
+		return false
+	}
+	return true
+}
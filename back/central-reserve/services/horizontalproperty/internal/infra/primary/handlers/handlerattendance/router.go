@@ -8,41 +8,49 @@ import (
 
 // RegisterRoutes - Registrar rutas de asistencia
 func (h *AttendanceHandler) RegisterRoutes(router *gin.RouterGroup) {
+	read := middleware.RequirePermission(middleware.ResourceAttendance, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceAttendance, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceAttendance, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceAttendance, middleware.ActionDelete)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourceAttendance)
+
 	attendance := router.Group("/attendance")
 	{
 		// Listas de asistencia
-		attendance.POST("/lists", middleware.JWT(), h.CreateAttendanceList)
-		attendance.GET("/lists", middleware.JWT(), h.ListAttendanceLists)
-		attendance.GET("/lists/:id", middleware.JWT(), h.GetAttendanceListByID)
-		attendance.PUT("/lists/:id", middleware.JWT(), h.UpdateAttendanceList)
-		attendance.DELETE("/lists/:id", middleware.JWT(), h.DeleteAttendanceList)
-		attendance.POST("/lists/generate", middleware.JWT(), h.GenerateAttendanceList)
+		attendance.POST("/lists", middleware.JWT(), feature, create, h.CreateAttendanceList)
+		attendance.GET("/lists", middleware.JWT(), feature, read, h.ListAttendanceLists)
+		attendance.GET("/lists/:id", middleware.JWT(), feature, read, h.GetAttendanceListByID)
+		attendance.PUT("/lists/:id", middleware.JWT(), feature, update, h.UpdateAttendanceList)
+		attendance.DELETE("/lists/:id", middleware.JWT(), feature, remove, h.DeleteAttendanceList)
+		attendance.POST("/lists/generate", middleware.JWT(), feature, create, h.GenerateAttendanceList)
 
 		// Apoderados
-		attendance.POST("/proxies", middleware.JWT(), h.CreateProxy)
-		attendance.GET("/proxies", middleware.JWT(), h.ListProxies)
-		attendance.GET("/proxies/:id", middleware.JWT(), h.GetProxyByID)
-		attendance.PUT("/proxies/:id", middleware.JWT(), h.UpdateProxy)
-		attendance.DELETE("/proxies/:id", middleware.JWT(), h.DeleteProxy)
-		attendance.GET("/proxies/unit/:unit_id", middleware.JWT(), h.GetProxiesByPropertyUnit)
+		attendance.POST("/proxies", middleware.JWT(), feature, create, h.CreateProxy)
+		attendance.GET("/proxies", middleware.JWT(), feature, read, h.ListProxies)
+		attendance.GET("/proxies/:id", middleware.JWT(), feature, read, h.GetProxyByID)
+		attendance.PUT("/proxies/:id", middleware.JWT(), feature, update, h.UpdateProxy)
+		attendance.DELETE("/proxies/:id", middleware.JWT(), feature, remove, h.DeleteProxy)
+		attendance.GET("/proxies/unit/:unit_id", middleware.JWT(), feature, read, h.GetProxiesByPropertyUnit)
 
 		// Registros de asistencia
-		attendance.POST("/records", middleware.JWT(), h.CreateAttendanceRecord)
-		attendance.GET("/records", middleware.JWT(), h.ListAttendanceRecords)
-		attendance.GET("/records/:id", middleware.JWT(), h.GetAttendanceRecordByID)
-		attendance.PUT("/records/:id", middleware.JWT(), h.UpdateAttendanceRecord)
-		attendance.DELETE("/records/:id", middleware.JWT(), h.DeleteAttendanceRecord)
-		attendance.POST("/records/:id/mark", middleware.JWT(), h.MarkAttendance)
-		attendance.POST("/records/:id/unmark", middleware.JWT(), h.UnmarkAttendance)
-		attendance.POST("/records/:id/verify", middleware.JWT(), h.VerifyAttendance)
+		attendance.POST("/records", middleware.JWT(), feature, create, h.CreateAttendanceRecord)
+		attendance.GET("/records", middleware.JWT(), feature, read, h.ListAttendanceRecords)
+		attendance.GET("/records/:id", middleware.JWT(), feature, read, h.GetAttendanceRecordByID)
+		attendance.PUT("/records/:id", middleware.JWT(), feature, update, h.UpdateAttendanceRecord)
+		attendance.DELETE("/records/:id", middleware.JWT(), feature, remove, h.DeleteAttendanceRecord)
+		attendance.POST("/records/:id/mark", middleware.JWT(), feature, update, h.MarkAttendance)
+		attendance.POST("/records/:id/unmark", middleware.JWT(), feature, update, h.UnmarkAttendance)
+		attendance.POST("/records/:id/verify", middleware.JWT(), feature, update, h.VerifyAttendance)
 
 		// Exportación
-		attendance.GET("/lists/:id/export-excel", middleware.JWT(), h.ExportAttendanceExcel)
-		attendance.GET("/lists/:id/export-detailed-excel", middleware.JWT(), h.ExportAttendanceExcelDetailed)
+		attendance.GET("/lists/:id/export-excel", middleware.JWT(), feature, read, h.ExportAttendanceExcel)
+		attendance.GET("/lists/:id/export-detailed-excel", middleware.JWT(), feature, read, h.ExportAttendanceExcelDetailed)
 
 		// Resúmenes y estadísticas
-		attendance.GET("/lists/:id/summary", middleware.JWT(), h.GetAttendanceSummary)
-		attendance.GET("/lists/:id/records", middleware.JWT(), h.GetAttendanceRecordsByList)
+		attendance.GET("/lists/:id/summary", middleware.JWT(), feature, read, h.GetAttendanceSummary)
+		attendance.GET("/lists/:id/records", middleware.JWT(), feature, read, h.GetAttendanceRecordsByList)
 
 	}
 }
@@ -7,18 +7,26 @@ import (
 )
 
 func (h *PropertyUnitHandler) RegisterRoutes(router *gin.RouterGroup) {
+	read := middleware.RequirePermission(middleware.ResourcePropertyUnits, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourcePropertyUnits, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourcePropertyUnits, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourcePropertyUnits, middleware.ActionDelete)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourcePropertyUnits)
+
 	// Ruta principal: listado con business_id opcional (query)
-	router.GET("/horizontal-properties/property-units", middleware.JWT(), h.ListPropertyUnits)
+	router.GET("/horizontal-properties/property-units", middleware.JWT(), feature, read, h.ListPropertyUnits)
 
 	// Rutas de creación e importación (sin business_id en path)
-	router.POST("/horizontal-properties/property-units", middleware.JWT(), h.CreatePropertyUnit)
-	router.POST("/horizontal-properties/property-units/import-excel", middleware.JWT(), h.ImportPropertyUnitsExcel)
+	router.POST("/horizontal-properties/property-units", middleware.JWT(), feature, create, h.CreatePropertyUnit)
+	router.POST("/horizontal-properties/property-units/import-excel", middleware.JWT(), feature, create, h.ImportPropertyUnitsExcel)
 
 	// Rutas específicas por unit_id (solo para operaciones individuales)
 	units := router.Group("/horizontal-properties/property-units")
 	{
-		units.GET("/:unit_id", middleware.JWT(), h.GetPropertyUnitByID)
-		units.PUT("/:unit_id", middleware.JWT(), h.UpdatePropertyUnit)
-		units.DELETE("/:unit_id", middleware.JWT(), h.DeletePropertyUnit)
+		units.GET("/:unit_id", middleware.JWT(), feature, read, h.GetPropertyUnitByID)
+		units.PUT("/:unit_id", middleware.JWT(), feature, update, h.UpdatePropertyUnit)
+		units.DELETE("/:unit_id", middleware.JWT(), feature, remove, h.DeletePropertyUnit)
 	}
 }
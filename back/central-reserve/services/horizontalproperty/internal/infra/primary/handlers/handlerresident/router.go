@@ -7,14 +7,22 @@ import (
 )
 
 func (h *ResidentHandler) RegisterRoutes(router *gin.RouterGroup) {
+	read := middleware.RequirePermission(middleware.ResourceResidents, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceResidents, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceResidents, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceResidents, middleware.ActionDelete)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourceResidents)
+
 	residents := router.Group("/horizontal-properties/residents")
 	{
-		residents.POST("", middleware.JWT(), h.CreateResident)
-		residents.POST("/import-excel", middleware.JWT(), h.ImportResidentsExcel) // Importar residentes desde Excel
-		residents.PUT("/bulk-update", middleware.JWT(), h.BulkUpdateResidents)    // Edición masiva de residentes
-		residents.GET("", middleware.JWT(), h.ListResidents)
-		residents.GET("/:resident_id", middleware.JWT(), h.GetResidentByID)
-		residents.PUT("/:resident_id", middleware.JWT(), h.UpdateResident)
-		residents.DELETE("/:resident_id", middleware.JWT(), h.DeleteResident)
+		residents.POST("", middleware.JWT(), feature, create, h.CreateResident)
+		residents.POST("/import-excel", middleware.JWT(), feature, create, h.ImportResidentsExcel) // Importar residentes desde Excel
+		residents.PUT("/bulk-update", middleware.JWT(), feature, update, h.BulkUpdateResidents)    // Edición masiva de residentes
+		residents.GET("", middleware.JWT(), feature, read, h.ListResidents)
+		residents.GET("/:resident_id", middleware.JWT(), feature, read, h.GetResidentByID)
+		residents.PUT("/:resident_id", middleware.JWT(), feature, update, h.UpdateResident)
+		residents.DELETE("/:resident_id", middleware.JWT(), feature, remove, h.DeleteResident)
 	}
 }
@@ -13,8 +13,8 @@ import (
 
 // ActivateVoting godoc
 //
-//	@Summary		Activar una votación
-//	@Description	Activa una votación existente (is_active = true)
+//	@Summary		Abrir una votación
+//	@Description	Abre una votación en borrador (status = open). Responde 409 si el grupo exige quórum y no se alcanza, o si la votación ya está cerrada
 //	@Tags			Votaciones
 //	@Security		BearerAuth
 //	@Accept			json
@@ -24,6 +24,7 @@ import (
 //	@Success		200			{object}	object
 //	@Failure		400			{object}	object
 //	@Failure		404			{object}	object
+//	@Failure		409			{object}	object	"Sin quórum o votación cerrada"
 //	@Failure		500			{object}	object
 //	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/activate [patch]
 func (h *VotingHandler) ActivateVoting(c *gin.Context) {
@@ -38,7 +39,7 @@ func (h *VotingHandler) ActivateVoting(c *gin.Context) {
 	if err := h.votingUseCase.ActivateVoting(c.Request.Context(), uint(id64)); err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/activate-voting.go - Error en handler: %v\n", err)
 		h.logger.Error().Err(err).Uint("voting_id", uint(id64)).Msg("Error activando votación")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{Success: false, Message: "No se pudo activar", Error: err.Error()})
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{Success: false, Message: "No se pudo activar", Error: err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"success": true, "message": "Votación activada"})
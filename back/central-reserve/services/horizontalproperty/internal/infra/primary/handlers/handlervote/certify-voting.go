@@ -0,0 +1,57 @@
+package handlervote
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CertifyVoting godoc
+//
+//	@Summary		Certificar los resultados de una votación
+//	@Description	Marca como definitivos los resultados registrados al cerrar la votación (status = certified). Una votación certificada no se puede modificar ni eliminar
+//	@Tags			Votaciones
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			group_id	path		int	true	"ID del grupo de votación"
+//	@Param			voting_id	path		int	true	"ID de la votación"
+//	@Success		200			{object}	object
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		409			{object}	response.ErrorResponse	"La votación no está cerrada"
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/certify [patch]
+func (h *VotingHandler) CertifyVoting(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "CertifyVoting")
+
+	idParam := c.Param("voting_id")
+	id64, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/certify-voting.go - Error parseando voting_id: %v\n", err)
+		h.logger.Error(ctx).Err(err).Str("voting_id", idParam).Msg("Error parseando ID de votación")
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{Success: false, Message: "id inválido", Error: "Debe ser numérico"})
+		return
+	}
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, response.ErrorResponse{Success: false, Message: "No autorizado", Error: "usuario no identificado"})
+		return
+	}
+
+	if err := h.votingUseCase.CertifyVoting(ctx, uint(id64), userID); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/certify-voting.go - Error certificando votación: voting_id=%d, error=%v\n", id64, err)
+		h.logger.Error(ctx).Err(err).Uint("voting_id", uint(id64)).Msg("Error certificando votación")
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{Success: false, Message: "No se pudo certificar", Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true, "message": "Votación certificada"})
+}
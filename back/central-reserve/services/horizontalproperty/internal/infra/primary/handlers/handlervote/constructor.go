@@ -11,6 +11,7 @@ type VotingHandler struct {
 	propertyUnitUseCase       domain.PropertyUnitUseCase
 	horizontalPropertyUseCase domain.HorizontalPropertyUseCase
 	votingCache               domain.VotingCacheService
+	quorumNotifier            domain.QuorumNotifier
 	jwtSecret                 string
 	logger                    log.ILogger
 }
@@ -21,6 +22,7 @@ func NewVotingHandler(
 	propertyUnitUseCase domain.PropertyUnitUseCase,
 	horizontalPropertyUseCase domain.HorizontalPropertyUseCase,
 	votingCache domain.VotingCacheService,
+	quorumNotifier domain.QuorumNotifier,
 	jwtSecret string,
 	logger log.ILogger,
 ) *VotingHandler {
@@ -34,6 +36,7 @@ func NewVotingHandler(
 		propertyUnitUseCase:       propertyUnitUseCase,
 		horizontalPropertyUseCase: horizontalPropertyUseCase,
 		votingCache:               votingCache,
+		quorumNotifier:            quorumNotifier,
 		jwtSecret:                 jwtSecret,
 		logger:                    contextualLogger,
 	}
@@ -1,6 +1,7 @@
 package handlervote
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -15,15 +16,16 @@ import (
 
 // CreatePublicVoteRequest - Request para crear voto público
 type CreatePublicVoteRequest struct {
-	PropertyUnitID uint   `json:"property_unit_id"` // Opcional - viene del token
-	Dni            string `json:"dni"`              // Opcional - viene del token
-	VotingOptionID uint   `json:"voting_option_id" binding:"required" example:"1"`
+	PropertyUnitID  uint   `json:"property_unit_id"` // Opcional - viene del token
+	Dni             string `json:"dni"`              // Opcional - viene del token
+	VotingOptionID  uint   `json:"voting_option_id" binding:"required_without=VotingOptionIDs" example:"1"`
+	VotingOptionIDs []uint `json:"voting_option_ids" example:"3,1,2"` // Opciones en orden de preferencia (multiple_choice, ranked_choice, election)
 }
 
 // CreatePublicVote godoc
 //
 //	@Summary		Emitir voto (público)
-//	@Description	Permite a un residente emitir su voto. Requiere token de autenticación de votación (VOTING_AUTH_TOKEN) obtenido después de validar el residente.
+//	@Description	Permite a un residente emitir su voto. Requiere token de autenticación de votación (VOTING_AUTH_TOKEN) obtenido después de validar el residente. Las votaciones multiple_choice, ranked_choice y election reciben voting_option_ids (en orden de preferencia en ranked_choice).
 //	@Tags			Votaciones Públicas
 //	@Accept			json
 //	@Produce		json
@@ -97,7 +99,6 @@ func (h *VotingHandler) CreatePublicVote(c *gin.Context) {
 	fmt.Printf("   Votación ID: %d\n", votingID)
 	fmt.Printf("   Residente ID (del token): %d\n", residentID)
 	fmt.Printf("   Unidad ID (del token): %d\n", propertyUnitID)
-	fmt.Printf("   Opción seleccionada ID: %d\n", req.VotingOptionID)
 
 	// Mostrar campos opcionales si se enviaron (se ignoran)
 	if req.PropertyUnitID != 0 {
@@ -139,11 +140,12 @@ func (h *VotingHandler) CreatePublicVote(c *gin.Context) {
 
 	// Crear DTO para el voto
 	voteDTO := domain.CreateVoteDTO{
-		VotingID:       votingID,
-		PropertyUnitID: propertyUnitID,
-		VotingOptionID: req.VotingOptionID,
-		IPAddress:      ipAddress,
-		UserAgent:      userAgent,
+		VotingID:        votingID,
+		PropertyUnitID:  propertyUnitID,
+		VotingOptionID:  req.VotingOptionID,
+		VotingOptionIDs: req.VotingOptionIDs,
+		IPAddress:       ipAddress,
+		UserAgent:       userAgent,
 	}
 
 	// Crear voto
@@ -151,6 +153,30 @@ func (h *VotingHandler) CreatePublicVote(c *gin.Context) {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/create-public-vote.go - Error creando voto: %v\n", err)
 		h.logger.Error().Err(err).Uint("voting_id", votingID).Uint("resident_id", residentID).Msg("Error creando voto público")
+		if errors.Is(err, domain.ErrQuorumNotMet) {
+			c.JSON(http.StatusConflict, response.ErrorResponse{
+				Success: false,
+				Message: "La votación aún no tiene quórum",
+				Error:   err.Error(),
+			})
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidBallot) {
+			c.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Message: "La papeleta no es válida",
+				Error:   err.Error(),
+			})
+			return
+		}
+		if errors.Is(err, domain.ErrVotingNotOpen) || errors.Is(err, domain.ErrVotingClosed) {
+			c.JSON(http.StatusConflict, response.ErrorResponse{
+				Success: false,
+				Message: "La votación no está abierta",
+				Error:   err.Error(),
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
 			Success: false,
 			Message: "Error registrando voto",
@@ -175,7 +201,6 @@ func (h *VotingHandler) CreatePublicVote(c *gin.Context) {
 	fmt.Printf("   Voto ID: %d\n", created.ID)
 	fmt.Printf("   Votación ID: %d\n", votingID)
 	fmt.Printf("   Unidad ID: %d\n", propertyUnitID)
-	fmt.Printf("   Opción seleccionada ID: %d\n", req.VotingOptionID)
 	fmt.Printf("   Timestamp: %s\n\n", created.VotedAt.Format("2006-01-02 15:04:05"))
 
 	h.logger.Info().
@@ -183,7 +208,6 @@ func (h *VotingHandler) CreatePublicVote(c *gin.Context) {
 		Uint("voting_id", votingID).
 		Uint("resident_id", residentID).
 		Uint("property_unit_id", propertyUnitID).
-		Uint("voting_option_id", req.VotingOptionID).
 		Str("ip_address", ipAddress).
 		Msg("✅ [VOTACION PUBLICA] Voto registrado exitosamente")
 
@@ -17,7 +17,7 @@ import (
 // CreateVote godoc
 //
 //	@Summary		Registrar un voto
-//	@Description	Registra el voto de un residente para una votación específica
+//	@Description	Registra el voto de un residente para una votación específica. Las votaciones multiple_choice, ranked_choice y election reciben voting_option_ids (en orden de preferencia en ranked_choice); las demás una sola opción
 //	@Tags			Votaciones
 //	@Security		BearerAuth
 //	@Accept			json
@@ -26,7 +26,8 @@ import (
 //	@Param			voting_id	path		int							true	"ID de la votación"
 //	@Param			vote		body		request.CreateVoteRequest	true	"Datos del voto"
 //	@Success		201			{object}	object
-//	@Failure		400			{object}	object
+//	@Failure		400			{object}	object	"Papeleta inválida"
+//	@Failure		409			{object}	object	"Sin quórum"
 //	@Failure		500			{object}	object
 //	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/votes [post]
 func (h *VotingHandler) CreateVote(c *gin.Context) {
@@ -48,24 +49,21 @@ func (h *VotingHandler) CreateVote(c *gin.Context) {
 	fmt.Printf("\n🗳️  [VOTACION PUBLICA - REGISTRANDO VOTO]\n")
 	fmt.Printf("   Votación ID: %d\n", uint(id64))
 	fmt.Printf("   Unidad ID: %d\n", req.PropertyUnitID)
-	fmt.Printf("   Opción seleccionada ID: %d\n", req.VotingOptionID)
 	fmt.Printf("   IP: %s\n", req.IPAddress)
 	fmt.Printf("   User Agent: %s\n\n", req.UserAgent)
 
 	dto := domain.CreateVoteDTO{
-		VotingID:       uint(id64),
-		PropertyUnitID: req.PropertyUnitID,
-		VotingOptionID: req.VotingOptionID,
-		IPAddress:      req.IPAddress,
-		UserAgent:      req.UserAgent,
+		VotingID:        uint(id64),
+		PropertyUnitID:  req.PropertyUnitID,
+		VotingOptionID:  req.VotingOptionID,
+		VotingOptionIDs: req.VotingOptionIDs,
+		IPAddress:       req.IPAddress,
+		UserAgent:       req.UserAgent,
 	}
 	created, err := h.votingUseCase.CreateVote(c.Request.Context(), dto)
 	if err != nil {
-		fmt.Printf("[ERROR] CreateVote - Error registrando voto: voting_id=%d, property_unit_id=%d, option_id=%d, error=%v\n",
-			uint(id64), req.PropertyUnitID, req.VotingOptionID, err)
-		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/create-vote.go - Error en handler: %v\n", err)
-		h.logger.Error().Err(err).Uint("voting_id", uint(id64)).Uint("property_unit_id", req.PropertyUnitID).Uint("option_id", req.VotingOptionID).Msg("Error registrando voto")
-		c.JSON(http.StatusBadRequest, response.ErrorResponse{Success: false, Message: "No se pudo registrar el voto", Error: err.Error()})
+		h.logger.Error().Err(err).Uint("voting_id", uint(id64)).Uint("property_unit_id", req.PropertyUnitID).Msg("Error registrando voto")
+		c.JSON(votingErrorStatus(err, http.StatusBadRequest), response.ErrorResponse{Success: false, Message: "No se pudo registrar el voto", Error: err.Error()})
 		return
 	}
 
@@ -73,7 +71,6 @@ func (h *VotingHandler) CreateVote(c *gin.Context) {
 	fmt.Printf("   Voto ID: %d\n", created.ID)
 	fmt.Printf("   Votación ID: %d\n", created.VotingID)
 	fmt.Printf("   Unidad ID: %d\n", created.PropertyUnitID)
-	fmt.Printf("   Opción ID: %d\n", created.VotingOptionID)
 	fmt.Printf("   Fecha: %s\n\n", created.VotedAt)
 
 	// Publicar voto en el cache para SSE (tiempo real)
@@ -90,7 +87,6 @@ func (h *VotingHandler) CreateVote(c *gin.Context) {
 		Uint("vote_id", created.ID).
 		Uint("voting_id", created.VotingID).
 		Uint("property_unit_id", created.PropertyUnitID).
-		Uint("option_id", created.VotingOptionID).
 		Msg("✅ [VOTACION PUBLICA] Voto registrado exitosamente")
 
 	// Mapear DTO a response
@@ -46,15 +46,16 @@ func (h *VotingHandler) CreateVotingGroup(c *gin.Context) {
 	}
 
 	dto := domain.CreateVotingGroupDTO{
-		BusinessID:       uint(id64),
-		Name:             req.Name,
-		Description:      req.Description,
-		VotingStartDate:  req.VotingStartDate,
-		VotingEndDate:    req.VotingEndDate,
-		RequiresQuorum:   req.RequiresQuorum,
-		QuorumPercentage: req.QuorumPercentage,
-		CreatedByUserID:  req.CreatedByUserID,
-		Notes:            req.Notes,
+		BusinessID:               uint(id64),
+		Name:                     req.Name,
+		Description:              req.Description,
+		VotingStartDate:          req.VotingStartDate,
+		VotingEndDate:            req.VotingEndDate,
+		RequiresQuorum:           req.RequiresQuorum,
+		QuorumPercentage:         req.QuorumPercentage,
+		AllowVotingWithoutQuorum: req.AllowVotingWithoutQuorum,
+		CreatedByUserID:          req.CreatedByUserID,
+		Notes:                    req.Notes,
 	}
 
 	created, err := h.votingUseCase.CreateVotingGroup(c.Request.Context(), dto)
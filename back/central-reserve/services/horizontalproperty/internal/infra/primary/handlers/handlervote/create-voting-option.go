@@ -27,6 +27,7 @@ import (
 //	@Param			option		body		request.CreateVotingOptionRequest	true	"Datos de la opción de votación"
 //	@Success		201			{object}	object
 //	@Failure		400			{object}	object
+//	@Failure		409			{object}	object	"Votación cerrada"
 //	@Failure		500			{object}	object
 //	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/options [post]
 func (h *VotingHandler) CreateVotingOption(c *gin.Context) {
@@ -56,7 +57,7 @@ func (h *VotingHandler) CreateVotingOption(c *gin.Context) {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/create-voting-option.go - Error en handler: %v\n", err)
 		h.logger.Error().Err(err).Uint("voting_id", uint(id64)).Str("option_text", req.OptionText).Msg("Error creando opción de votación")
-		c.JSON(http.StatusBadRequest, response.ErrorResponse{Success: false, Message: "No se pudo crear la opción", Error: err.Error()})
+		c.JSON(votingErrorStatus(err, http.StatusBadRequest), response.ErrorResponse{Success: false, Message: "No se pudo crear la opción", Error: err.Error()})
 		return
 	}
 
@@ -17,7 +17,7 @@ import (
 // CreateVoting godoc
 //
 //	@Summary		Crear una nueva votación
-//	@Description	Crea una nueva votación dentro de un grupo de votación
+//	@Description	Crea una votación dentro de un grupo. Se crea abierta salvo que se pida en borrador o tenga apertura programada; las fechas sin zona se toman en la hora local de la propiedad. El tipo define la papeleta: opción única (simple, majority, unanimity, single_choice), multiple_choice con min_selections y max_selections, ranked_choice y election con seats
 //	@Tags			Votaciones
 //	@Security		BearerAuth
 //	@Accept			json
@@ -53,6 +53,13 @@ func (h *VotingHandler) CreateVoting(c *gin.Context) {
 		AllowAbstention:    req.AllowAbstention,
 		DisplayOrder:       req.DisplayOrder,
 		RequiredPercentage: req.RequiredPercentage,
+		MajorityBase:       req.MajorityBase,
+		MinSelections:      req.MinSelections,
+		MaxSelections:      req.MaxSelections,
+		Seats:              req.Seats,
+		ScheduledOpenAt:    req.ScheduledOpenAt,
+		ScheduledCloseAt:   req.ScheduledCloseAt,
+		Draft:              req.Draft,
 	}
 	created, err := h.votingUseCase.CreateVoting(c.Request.Context(), dto)
 	if err != nil {
@@ -13,8 +13,8 @@ import (
 
 // DeactivateVotingHandler godoc
 //
-//	@Summary		Desactivar una votación
-//	@Description	Desactiva una votación existente (is_active = false)
+//	@Summary		Cerrar una votación
+//	@Description	Cierra una votación abierta (status = closed): desde entonces no admite votos ni cambios y quedan registrados el quórum y los resultados del cierre. Responde 409 si la votación está en borrador
 //	@Tags			Votaciones
 //	@Security		BearerAuth
 //	@Accept			json
@@ -24,6 +24,7 @@ import (
 //	@Success		200			{object}	object
 //	@Failure		400			{object}	object
 //	@Failure		404			{object}	object
+//	@Failure		409			{object}	object	"Votación en borrador"
 //	@Failure		500			{object}	object
 //	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/deactivate [patch]
 func (h *VotingHandler) DeactivateVotingHandler(c *gin.Context) {
@@ -41,7 +42,7 @@ func (h *VotingHandler) DeactivateVotingHandler(c *gin.Context) {
 			Err(err).
 			Uint("voting_id", uint(id64)).
 			Msg("Error desactivando votación")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{Success: false, Message: "No se pudo desactivar", Error: err.Error()})
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{Success: false, Message: "No se pudo desactivar", Error: err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"success": true, "message": "Votación desactivada"})
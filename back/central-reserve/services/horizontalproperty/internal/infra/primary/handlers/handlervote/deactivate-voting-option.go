@@ -39,7 +39,7 @@ func (h *VotingHandler) DeactivateVotingOption(c *gin.Context) {
 	if err := h.votingUseCase.DeactivateVotingOption(c.Request.Context(), uint(id64)); err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/deactivate-voting-option.go - Error en handler: %v\n", err)
 		h.logger.Error().Err(err).Uint("option_id", uint(id64)).Msg("Error desactivando opción de votación")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{Success: false, Message: "No se pudo desactivar", Error: err.Error()})
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{Success: false, Message: "No se pudo desactivar", Error: err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"success": true, "message": "Opción desactivada"})
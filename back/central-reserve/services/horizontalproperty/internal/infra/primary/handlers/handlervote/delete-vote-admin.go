@@ -97,7 +97,7 @@ func (h *VotingHandler) DeleteVoteAdmin(c *gin.Context) {
 	if err := h.votingUseCase.DeleteVote(c.Request.Context(), uint(voteID)); err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/delete-vote-admin.go - Error eliminando voto: %v\n", err)
 		h.logger.Error().Err(err).Uint("vote_id", uint(voteID)).Msg("Error eliminando voto")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{
 			Success: false,
 			Message: "Error eliminando voto",
 			Error:   err.Error(),
@@ -38,7 +38,7 @@ func (h *VotingHandler) DeleteVoting(c *gin.Context) {
 	if err := h.votingUseCase.DeleteVoting(c.Request.Context(), uint(id64)); err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/delete-voting.go - Error en handler: %v\n", err)
 		h.logger.Error().Err(err).Uint("voting_id", uint(id64)).Msg("Error eliminando votación")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{Success: false, Message: "No se pudo eliminar", Error: err.Error()})
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{Success: false, Message: "No se pudo eliminar", Error: err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"success": true, "message": "Votación eliminada"})
@@ -0,0 +1,35 @@
+package handlervote
+
+import (
+	"errors"
+	"net/http"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+)
+
+// votingErrorStatuses asocia los errores de estado de una votación con su código HTTP
+var votingErrorStatuses = []struct {
+	err    error
+	status int
+}{
+	{domain.ErrQuorumNotMet, http.StatusConflict},
+	{domain.ErrVotingNotOpen, http.StatusConflict},
+	{domain.ErrVotingClosed, http.StatusConflict},
+	{domain.ErrVotingNotClosed, http.StatusConflict},
+	{domain.ErrVotingScheduleInvalid, http.StatusBadRequest},
+	{domain.ErrVotingConfigInvalid, http.StatusBadRequest},
+	{domain.ErrVotingTypeLocked, http.StatusConflict},
+	{domain.ErrInvalidBallot, http.StatusBadRequest},
+	{domain.ErrVotingIsSecret, http.StatusForbidden},
+	{domain.ErrVotingNotSecret, http.StatusConflict},
+}
+
+// votingErrorStatus devuelve el código HTTP del error de dominio o fallback si no es uno conocido
+func votingErrorStatus(err error, fallback int) int {
+	for _, e := range votingErrorStatuses {
+		if errors.Is(err, e.err) {
+			return e.status
+		}
+	}
+	return fallback
+}
@@ -22,6 +22,7 @@ import (
 //	@Param			Authorization	header	string	true	"Token de autenticación de votación (Bearer token)"
 //	@Success		200				{object}	object
 //	@Failure		401				{object}	object
+//	@Failure		403				{object}	object
 //	@Failure		500				{object}	object
 //	@Router			/public/votes [get]
 func (h *VotingHandler) GetPublicVotes(c *gin.Context) {
@@ -70,7 +71,7 @@ func (h *VotingHandler) GetPublicVotes(c *gin.Context) {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/get-public-votes.go - Error listando votos: voting_id=%d, error=%v\n", votingID, err)
 		h.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error listando votos")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{
 			Success: false,
 			Message: "Error obteniendo votos",
 			Error:   err.Error(),
@@ -1,10 +1,12 @@
 package handlervote
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 
+	"central_reserve/services/horizontalproperty/internal/domain"
 	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/mapper"
 	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
 	sharedjwt "central_reserve/shared/jwt"
@@ -15,7 +17,7 @@ import (
 // GetPublicVotingStats godoc
 //
 //	@Summary		Obtener estadísticas de votación (público)
-//	@Description	Obtiene las estadísticas completas de la votación: resultados por opción, total de votos, porcentajes. Toda la información viene del token.
+//	@Description	Obtiene las estadísticas completas de la votación: resultados por opción, total de votos, porcentajes. Toda la información viene del token. En votaciones secretas no se incluye el detalle por unidad.
 //	@Tags			Votaciones Públicas
 //	@Accept			json
 //	@Produce		json
@@ -67,9 +69,11 @@ func (h *VotingHandler) GetPublicVotingStats(c *gin.Context) {
 	fmt.Printf("   Residente ID: %d\n", residentID)
 	fmt.Printf("   HP ID: %d\n\n", hpID)
 
-	// Obtener detalle por unidad (TODAS las unidades con su estado de votación)
+	// Obtener detalle por unidad (TODAS las unidades con su estado de votación); en una votación
+	// secreta no se expone y los totales salen del conteo
 	unitDetails, err := h.votingUseCase.GetVotingDetailsByUnit(c.Request.Context(), votingID, hpID)
-	if err != nil {
+	secret := errors.Is(err, domain.ErrVotingIsSecret)
+	if err != nil && !secret {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/get-public-voting-stats.go - Error obteniendo detalles: voting_id=%d, hp_id=%d, error=%v\n", votingID, hpID, err)
 		h.logger.Error().Err(err).Uint("voting_id", votingID).Uint("hp_id", hpID).Msg("Error obteniendo detalles por unidad")
 		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
@@ -81,7 +85,7 @@ func (h *VotingHandler) GetPublicVotingStats(c *gin.Context) {
 	}
 
 	// Obtener resumen de resultados (para el dashboard)
-	results, err := h.votingUseCase.GetVotingResults(c.Request.Context(), votingID)
+	tally, err := h.votingUseCase.GetVotingTally(c.Request.Context(), votingID)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/get-public-voting-stats.go - Error obteniendo resultados: voting_id=%d, error=%v\n", votingID, err)
 		h.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error obteniendo estadísticas de votación")
@@ -92,6 +96,7 @@ func (h *VotingHandler) GetPublicVotingStats(c *gin.Context) {
 		})
 		return
 	}
+	results := tally.Options
 
 	// Calcular totales
 	totalUnits := len(unitDetails)
@@ -101,6 +106,10 @@ func (h *VotingHandler) GetPublicVotingStats(c *gin.Context) {
 			totalVoted++
 		}
 	}
+	if secret {
+		totalUnits = tally.TotalUnits
+		totalVoted = tally.VotedUnits
+	}
 	totalVotes := 0
 	for _, result := range results {
 		totalVotes += result.VoteCount
@@ -139,6 +148,7 @@ func (h *VotingHandler) GetPublicVotingStats(c *gin.Context) {
 			"units_voted":   totalVoted,
 			"units_pending": totalUnits - totalVoted,
 			"total_votes":   totalVotes,
+			"outcome":       mapper.MapVotingOutcomeToResponse(tally),
 			"voting_id":     votingID,
 		},
 	})
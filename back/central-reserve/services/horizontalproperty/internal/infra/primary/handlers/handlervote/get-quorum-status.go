@@ -0,0 +1,70 @@
+package handlervote
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/mapper"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetQuorumStatus godoc
+//
+//	@Summary		Obtener el quórum de un grupo de votación
+//	@Description	Calcula en el momento el coeficiente presente (propietarios y apoderados con poder vigente) frente al quórum exigido por el grupo
+//	@Tags			Votaciones
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			group_id	path		int	true	"ID del grupo de votación"
+//	@Success		200			{object}	response.QuorumStatusSuccess
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/horizontal-properties/voting-groups/{group_id}/quorum [get]
+func (h *VotingHandler) GetQuorumStatus(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "GetQuorumStatus")
+
+	groupIDParam := c.Param("group_id")
+	groupID, err := strconv.ParseUint(groupIDParam, 10, 32)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/get-quorum-status.go - Error parseando group_id: %v\n", err)
+		h.logger.Error(ctx).Err(err).Str("group_id", groupIDParam).Msg("Error parseando ID de grupo")
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{Success: false, Message: "ID de grupo de votación inválido", Error: "Debe ser numérico"})
+		return
+	}
+
+	status, err := h.votingUseCase.GetQuorumStatus(ctx, uint(groupID))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/get-quorum-status.go - Error calculando quórum: group_id=%d, error=%v\n", groupID, err)
+		h.logger.Error(ctx).Err(err).Uint("group_id", uint(groupID)).Msg("Error calculando quórum")
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{Success: false, Message: "Error calculando quórum", Error: err.Error()})
+		return
+	}
+	if !canAccessBusiness(c, status.BusinessID) {
+		c.JSON(http.StatusNotFound, response.ErrorResponse{Success: false, Message: "Grupo de votación no encontrado", Error: "grupo de votación no encontrado"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.QuorumStatusSuccess{
+		Success: true,
+		Message: "Quórum calculado exitosamente",
+		Data:    mapper.MapQuorumStatusToResponse(status),
+	})
+}
+
+// canAccessBusiness indica si el usuario puede ver datos del negocio: el super admin ve todos y
+// los demás solo el de su token
+func canAccessBusiness(c *gin.Context, businessID uint) bool {
+	if middleware.IsSuperAdmin(c) {
+		return true
+	}
+	bid, ok := middleware.GetBusinessID(c)
+	return ok && bid == businessID
+}
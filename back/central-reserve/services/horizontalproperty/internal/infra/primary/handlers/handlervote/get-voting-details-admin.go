@@ -27,6 +27,7 @@ import (
 //	 	@Param          business_id	query		int	false	"ID del business (opcional para super admin)"
 //		@Success		200			{object}	object
 //		@Failure		400			{object}	object
+//		@Failure		403			{object}	object
 //		@Failure		500			{object}	object
 //		@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/voting-details [get]
 func (h *VotingHandler) GetVotingDetailsAdmin(c *gin.Context) {
@@ -82,7 +83,7 @@ func (h *VotingHandler) GetVotingDetailsAdmin(c *gin.Context) {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] GetVotingDetailsAdmin - Error obteniendo detalles: %v\n", err)
 		h.logger.Error(ctx).Err(err).Uint("voting_id", uint(votingID)).Uint("business_id", businessID).Msg("Error obteniendo detalles de votación")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{
 			Success: false,
 			Message: "Error obteniendo detalles de votación",
 			Error:   err.Error(),
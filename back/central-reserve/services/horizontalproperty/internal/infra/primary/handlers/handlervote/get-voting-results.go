@@ -0,0 +1,72 @@
+package handlervote
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/mapper"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetVotingResults godoc
+//
+//	@Summary		Obtener resultados y decisión de una votación
+//	@Description	Devuelve por opción el número de unidades y el coeficiente que la votaron, los totales presentes y de la copropiedad, y si la votación queda aprobada o rechazada según el porcentaje requerido sobre la base configurada (present o total). En selección múltiple se aprueba cada opción que alcance el porcentaje, en elecciones ganan los seats candidatos más votados y en voto preferencial se incluyen las rondas de eliminación
+//	@Tags			Votaciones
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			group_id	path		int	true	"ID del grupo de votación"
+//	@Param			voting_id	path		int	true	"ID de la votación"
+//	@Success		200			{object}	response.VotingTallySuccess
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/results [get]
+func (h *VotingHandler) GetVotingResults(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "GetVotingResults")
+
+	// Parsear voting_id
+	votingIDParam := c.Param("voting_id")
+	votingID, err := strconv.ParseUint(votingIDParam, 10, 32)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/get-voting-results.go - Error parseando voting_id: %v\n", err)
+		h.logger.Error(ctx).Err(err).Str("voting_id", votingIDParam).Msg("Error parseando Voting ID")
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "ID de votación inválido",
+			Error:   "Debe ser numérico",
+		})
+		return
+	}
+
+	// Calcular resultados y decisión
+	tally, err := h.votingUseCase.GetVotingTally(ctx, uint(votingID))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/get-voting-results.go - Error obteniendo resultados: voting_id=%d, error=%v\n", votingID, err)
+		h.logger.Error(ctx).Err(err).Uint("voting_id", uint(votingID)).Msg("Error obteniendo resultados de votación")
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Error obteniendo resultados de votación",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Responder
+	h.logger.Info(ctx).
+		Uint("voting_id", uint(votingID)).
+		Str("outcome", tally.Outcome).
+		Float64("approval_percentage", tally.ApprovalPercentage).
+		Msg("Resultados de votación obtenidos")
+
+	c.JSON(http.StatusOK, response.VotingTallySuccess{
+		Success: true,
+		Message: "Resultados de votación obtenidos exitosamente",
+		Data:    mapper.MapVotingTallyToResponse(tally),
+	})
+}
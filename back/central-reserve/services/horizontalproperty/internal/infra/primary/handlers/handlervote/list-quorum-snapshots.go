@@ -0,0 +1,55 @@
+package handlervote
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/mapper"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListQuorumSnapshots godoc
+//
+//	@Summary		Listar el quórum registrado de una votación
+//	@Description	Devuelve el quórum con que se abrió y se cerró la votación, en orden cronológico
+//	@Tags			Votaciones
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			group_id	path		int	true	"ID del grupo de votación"
+//	@Param			voting_id	path		int	true	"ID de la votación"
+//	@Success		200			{object}	response.VotingQuorumSnapshotsSuccess
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/quorum-snapshots [get]
+func (h *VotingHandler) ListQuorumSnapshots(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "ListQuorumSnapshots")
+
+	votingIDParam := c.Param("voting_id")
+	votingID, err := strconv.ParseUint(votingIDParam, 10, 32)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/list-quorum-snapshots.go - Error parseando voting_id: %v\n", err)
+		h.logger.Error(ctx).Err(err).Str("voting_id", votingIDParam).Msg("Error parseando Voting ID")
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{Success: false, Message: "ID de votación inválido", Error: "Debe ser numérico"})
+		return
+	}
+
+	snapshots, err := h.votingUseCase.ListQuorumSnapshots(ctx, uint(votingID))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/list-quorum-snapshots.go - Error listando quórum: voting_id=%d, error=%v\n", votingID, err)
+		h.logger.Error(ctx).Err(err).Uint("voting_id", uint(votingID)).Msg("Error listando quórum registrado")
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{Success: false, Message: "Error listando quórum registrado", Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.VotingQuorumSnapshotsSuccess{
+		Success: true,
+		Message: "Quórum registrado obtenido exitosamente",
+		Data:    mapper.MapQuorumSnapshotsToResponses(snapshots),
+	})
+}
@@ -0,0 +1,56 @@
+package handlervote
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/mapper"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
+	"central_reserve/shared/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListSecretBallots godoc
+//
+//	@Summary		Listar las papeletas anónimas de una votación secreta
+//	@Description	Devuelve cada papeleta con su coeficiente y sus opciones en orden de preferencia, sin ningún dato de la unidad que la emitió, para poder repetir el conteo
+//	@Tags			Votaciones
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			group_id	path		int	true	"ID del grupo de votación"
+//	@Param			voting_id	path		int	true	"ID de la votación"
+//	@Success		200			{object}	response.SecretBallotsSuccess
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		409			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/ballots [get]
+func (h *VotingHandler) ListSecretBallots(c *gin.Context) {
+	ctx := log.WithFunctionCtx(c.Request.Context(), "ListSecretBallots")
+
+	votingIDParam := c.Param("voting_id")
+	votingID, err := strconv.ParseUint(votingIDParam, 10, 32)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/list-secret-ballots.go - Error parseando voting_id: %v\n", err)
+		h.logger.Error(ctx).Err(err).Str("voting_id", votingIDParam).Msg("Error parseando Voting ID")
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{Success: false, Message: "ID de votación inválido", Error: "Debe ser numérico"})
+		return
+	}
+
+	ballots, err := h.votingUseCase.ListSecretBallots(ctx, uint(votingID))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/list-secret-ballots.go - Error listando papeletas: voting_id=%d, error=%v\n", votingID, err)
+		h.logger.Error(ctx).Err(err).Uint("voting_id", uint(votingID)).Msg("Error listando papeletas secretas")
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{Success: false, Message: "Error listando papeletas", Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SecretBallotsSuccess{
+		Success: true,
+		Message: "Papeletas obtenidas exitosamente",
+		Data:    mapper.MapSecretBallotsToResponses(ballots),
+	})
+}
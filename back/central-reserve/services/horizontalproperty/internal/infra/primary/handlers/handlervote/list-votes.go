@@ -24,6 +24,7 @@ import (
 //	@Param			voting_id	path		int	true	"ID de la votación"
 //	@Success		200			{object}	object
 //	@Failure		400			{object}	object
+//	@Failure		403			{object}	object
 //	@Failure		500			{object}	object
 //	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id}/votes [get]
 func (h *VotingHandler) ListVotes(c *gin.Context) {
@@ -39,7 +40,7 @@ func (h *VotingHandler) ListVotes(c *gin.Context) {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/list-votes.go - Error en handler: %v\n", err)
 		h.logger.Error().Err(err).Uint("voting_id", uint(id64)).Msg("Error listando votos")
-		c.JSON(http.StatusInternalServerError, response.ErrorResponse{Success: false, Message: "Error listando votos", Error: err.Error()})
+		c.JSON(votingErrorStatus(err, http.StatusInternalServerError), response.ErrorResponse{Success: false, Message: "Error listando votos", Error: err.Error()})
 		return
 	}
 
@@ -8,19 +8,20 @@ import (
 // MapVotingGroupDTOToResponse mapea DTO de dominio a response
 func MapVotingGroupDTOToResponse(dto *domain.VotingGroupDTO) response.VotingGroupResponse {
 	return response.VotingGroupResponse{
-		ID:               dto.ID,
-		BusinessID:       dto.BusinessID,
-		Name:             dto.Name,
-		Description:      dto.Description,
-		VotingStartDate:  dto.VotingStartDate,
-		VotingEndDate:    dto.VotingEndDate,
-		IsActive:         dto.IsActive,
-		RequiresQuorum:   dto.RequiresQuorum,
-		QuorumPercentage: dto.QuorumPercentage,
-		CreatedByUserID:  dto.CreatedByUserID,
-		Notes:            dto.Notes,
-		CreatedAt:        dto.CreatedAt,
-		UpdatedAt:        dto.UpdatedAt,
+		ID:                       dto.ID,
+		BusinessID:               dto.BusinessID,
+		Name:                     dto.Name,
+		Description:              dto.Description,
+		VotingStartDate:          dto.VotingStartDate,
+		VotingEndDate:            dto.VotingEndDate,
+		IsActive:                 dto.IsActive,
+		RequiresQuorum:           dto.RequiresQuorum,
+		QuorumPercentage:         dto.QuorumPercentage,
+		AllowVotingWithoutQuorum: dto.AllowVotingWithoutQuorum,
+		CreatedByUserID:          dto.CreatedByUserID,
+		Notes:                    dto.Notes,
+		CreatedAt:                dto.CreatedAt,
+		UpdatedAt:                dto.UpdatedAt,
 	}
 }
 
@@ -46,6 +47,17 @@ func MapVotingDTOToResponse(dto *domain.VotingDTO) response.VotingResponse {
 		IsActive:           dto.IsActive,
 		DisplayOrder:       dto.DisplayOrder,
 		RequiredPercentage: dto.RequiredPercentage,
+		MajorityBase:       dto.MajorityBase,
+		MinSelections:      dto.MinSelections,
+		MaxSelections:      dto.MaxSelections,
+		Seats:              dto.Seats,
+		Status:             dto.Status,
+		ScheduledOpenAt:    dto.ScheduledOpenAt,
+		ScheduledCloseAt:   dto.ScheduledCloseAt,
+		OpenedAt:           dto.OpenedAt,
+		ClosedAt:           dto.ClosedAt,
+		CertifiedAt:        dto.CertifiedAt,
+		Timezone:           dto.Timezone,
 		CreatedAt:          dto.CreatedAt,
 		UpdatedAt:          dto.UpdatedAt,
 	}
@@ -85,17 +97,19 @@ func MapVotingOptionDTOsToResponses(dtos []domain.VotingOptionDTO) []response.Vo
 // MapVoteDTOToResponse mapea DTO de voto a response
 func MapVoteDTOToResponse(dto *domain.VoteDTO) response.VoteResponse {
 	return response.VoteResponse{
-		ID:             dto.ID,
-		VotingID:       dto.VotingID,
-		PropertyUnitID: dto.PropertyUnitID,
-		VotingOptionID: dto.VotingOptionID,
-		OptionText:     dto.OptionText,
-		OptionCode:     dto.OptionCode,
-		Color:          dto.OptionColor, // Cambiar OptionColor por Color
-		VotedAt:        dto.VotedAt,
-		IPAddress:      dto.IPAddress,
-		UserAgent:      dto.UserAgent,
-		Notes:          dto.Notes,
+		ID:              dto.ID,
+		VotingID:        dto.VotingID,
+		PropertyUnitID:  dto.PropertyUnitID,
+		VotingOptionID:  dto.VotingOptionID,
+		VotingOptionIDs: dto.VotingOptionIDs,
+		Secret:          dto.Secret,
+		OptionText:      dto.OptionText,
+		OptionCode:      dto.OptionCode,
+		Color:           dto.OptionColor, // Cambiar OptionColor por Color
+		VotedAt:         dto.VotedAt,
+		IPAddress:       dto.IPAddress,
+		UserAgent:       dto.UserAgent,
+		Notes:           dto.Notes,
 	}
 }
 
@@ -117,6 +131,11 @@ func MapVotingResultToResponse(dto *domain.VotingResultDTO) response.VotingResul
 		Color:          dto.Color,
 		VoteCount:      dto.VoteCount,
 		Percentage:     dto.Percentage,
+
+		Coefficient:           dto.Coefficient,
+		CoefficientPercentage: dto.CoefficientPercentage,
+		BasePercentage:        dto.BasePercentage,
+		Winner:                dto.Winner,
 	}
 }
 
@@ -129,6 +148,119 @@ func MapVotingResultsToResponses(dtos []domain.VotingResultDTO) []response.Votin
 	return responses
 }
 
+// MapVotingOutcomeToResponse mapea los totales y la decisión de una votación a response
+func MapVotingOutcomeToResponse(dto *domain.VotingTallyDTO) response.VotingOutcomeResponse {
+	return response.VotingOutcomeResponse{
+		VotedUnits:         dto.VotedUnits,
+		VotedCoefficient:   dto.VotedCoefficient,
+		PresentUnits:       dto.PresentUnits,
+		PresentCoefficient: dto.PresentCoefficient,
+		TotalUnits:         dto.TotalUnits,
+		TotalCoefficient:   dto.TotalCoefficient,
+		MajorityBase:       dto.MajorityBase,
+		WeightedBy:         dto.WeightedBy,
+		RequiredPercentage: dto.RequiredPercentage,
+		DecidingOptionID:   dto.DecidingOptionID,
+		ApprovalPercentage: dto.ApprovalPercentage,
+		Outcome:            dto.Outcome,
+		VotingType:         dto.VotingType,
+		Seats:              dto.Seats,
+		WinnerOptionIDs:    dto.WinnerOptionIDs,
+	}
+}
+
+// MapVotingTallyToResponse mapea los resultados con su decisión a response
+func MapVotingTallyToResponse(dto *domain.VotingTallyDTO) response.VotingTallyResponse {
+	return response.VotingTallyResponse{
+		Results: MapVotingResultsToResponses(dto.Options),
+		Outcome: MapVotingOutcomeToResponse(dto),
+		Rounds:  MapVotingRoundsToResponses(dto.Rounds),
+	}
+}
+
+// MapVotingRoundsToResponses mapea las rondas del voto preferencial a responses
+func MapVotingRoundsToResponses(rounds []domain.VotingRoundDTO) []response.VotingRoundResponse {
+	if len(rounds) == 0 {
+		return nil
+	}
+	responses := make([]response.VotingRoundResponse, len(rounds))
+	for i, round := range rounds {
+		options := make([]response.VotingRoundOptionResponse, len(round.Options))
+		for j, option := range round.Options {
+			options[j] = response.VotingRoundOptionResponse{
+				VotingOptionID: option.VotingOptionID,
+				Units:          option.Units,
+				Weight:         option.Weight,
+				Percentage:     option.Percentage,
+			}
+		}
+		responses[i] = response.VotingRoundResponse{
+			Round:               round.Round,
+			Options:             options,
+			ExhaustedUnits:      round.ExhaustedUnits,
+			ExhaustedWeight:     round.ExhaustedWeight,
+			EliminatedOptionIDs: round.EliminatedOptionIDs,
+		}
+	}
+	return responses
+}
+
+// MapQuorumStatusToResponse mapea el quórum de un grupo a response
+func MapQuorumStatusToResponse(dto *domain.QuorumStatusDTO) response.QuorumStatusResponse {
+	return response.QuorumStatusResponse{
+		VotingGroupID:            dto.VotingGroupID,
+		RequiresQuorum:           dto.RequiresQuorum,
+		AllowVotingWithoutQuorum: dto.AllowVotingWithoutQuorum,
+		QuorumPercentage:         dto.QuorumPercentage,
+		TotalUnits:               dto.TotalUnits,
+		TotalCoefficient:         dto.TotalCoefficient,
+		PresentUnits:             dto.PresentUnits,
+		PresentCoefficient:       dto.PresentCoefficient,
+		OwnerUnits:               dto.OwnerUnits,
+		ProxyUnits:               dto.ProxyUnits,
+		WeightedBy:               dto.WeightedBy,
+		PresentPercentage:        dto.PresentPercentage,
+		QuorumMet:                dto.QuorumMet,
+		Enforced:                 dto.Enforced,
+		CalculatedAt:             dto.CalculatedAt,
+	}
+}
+
+// MapQuorumSnapshotsToResponses mapea el quórum registrado de una votación a responses
+func MapQuorumSnapshotsToResponses(snapshots []domain.VotingQuorumSnapshot) []response.VotingQuorumSnapshotResponse {
+	responses := make([]response.VotingQuorumSnapshotResponse, len(snapshots))
+	for i, s := range snapshots {
+		responses[i] = response.VotingQuorumSnapshotResponse{
+			ID:                 s.ID,
+			VotingID:           s.VotingID,
+			Event:              s.Event,
+			PresentUnits:       s.PresentUnits,
+			PresentCoefficient: s.PresentCoefficient,
+			TotalUnits:         s.TotalUnits,
+			TotalCoefficient:   s.TotalCoefficient,
+			PresentPercentage:  s.PresentPercentage,
+			WeightedBy:         s.WeightedBy,
+			QuorumPercentage:   s.QuorumPercentage,
+			QuorumMet:          s.QuorumMet,
+			TakenAt:            s.TakenAt,
+		}
+	}
+	return responses
+}
+
+// MapSecretBallotsToResponses mapea las papeletas anónimas de una votación secreta a responses
+func MapSecretBallotsToResponses(ballots []domain.VotingBallot) []response.SecretBallotResponse {
+	responses := make([]response.SecretBallotResponse, len(ballots))
+	for i, b := range ballots {
+		responses[i] = response.SecretBallotResponse{
+			BallotID:        b.BallotID,
+			Coefficient:     b.Coefficient,
+			VotingOptionIDs: b.OptionIDs,
+		}
+	}
+	return responses
+}
+
 // MapUnitWithResidentToResponse mapea DTO a response
 func MapUnitWithResidentToResponse(dto *domain.UnitWithResidentDTO) response.UnitWithResidentResponse {
 	return response.UnitWithResidentResponse{
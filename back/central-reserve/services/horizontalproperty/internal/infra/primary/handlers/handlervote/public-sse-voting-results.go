@@ -118,17 +118,20 @@ func (h *VotingHandler) PublicSSEVotingResults(c *gin.Context) {
 		fmt.Printf("📊 [SSE PUBLICO] Precarga enviada: 0 votos\n")
 	}
 
-	// Obtener resultados de votación con colores
-	votingResults, err := h.votingUseCase.GetVotingResults(c.Request.Context(), votingID)
+	// Obtener resultados de votación con colores y la decisión por coeficiente
+	tally, err := h.votingUseCase.GetVotingTally(c.Request.Context(), votingID)
 	var resultsResponse []response.VotingResultResponse
-	if err == nil && len(votingResults) > 0 {
-		resultsResponse = mapper.MapVotingResultsToResponses(votingResults)
-		fmt.Printf("📊 [SSE PUBLICO] Resultados enviados: %d opciones\n", len(votingResults))
+	var outcomeResponse *response.VotingOutcomeResponse
+	if err == nil {
+		resultsResponse = mapper.MapVotingResultsToResponses(tally.Options)
+		outcome := mapper.MapVotingOutcomeToResponse(tally)
+		outcomeResponse = &outcome
 	}
 
 	c.SSEvent("initial_data", gin.H{
 		"votes":   votesResponse,
 		"results": resultsResponse,
+		"outcome": outcomeResponse,
 	})
 	c.Writer.Flush()
 
@@ -153,11 +156,14 @@ func (h *VotingHandler) PublicSSEVotingResults(c *gin.Context) {
 			// Evento de voto recibido - enviar voto y resultados actualizados
 			voteResponse := mapper.MapVoteDTOToResponse(&voteEvent.Vote)
 
-			// Obtener resultados actualizados
-			updatedResults, err := h.votingUseCase.GetVotingResults(c.Request.Context(), votingID)
+			// Obtener resultados y decisión actualizados
+			updatedTally, err := h.votingUseCase.GetVotingTally(c.Request.Context(), votingID)
 			var resultsResponse []response.VotingResultResponse
-			if err == nil && len(updatedResults) > 0 {
-				resultsResponse = mapper.MapVotingResultsToResponses(updatedResults)
+			var outcomeResponse *response.VotingOutcomeResponse
+			if err == nil {
+				resultsResponse = mapper.MapVotingResultsToResponses(updatedTally.Options)
+				outcome := mapper.MapVotingOutcomeToResponse(updatedTally)
+				outcomeResponse = &outcome
 			}
 
 			// Determinar el tipo de evento
@@ -171,11 +177,11 @@ func (h *VotingHandler) PublicSSEVotingResults(c *gin.Context) {
 			c.SSEvent(eventType, gin.H{
 				"vote":    voteResponse,
 				"results": resultsResponse,
+				"outcome": outcomeResponse,
 			})
 			c.Writer.Flush()
 
 			fmt.Printf("🗳️  [SSE PUBLICO] Evento de voto transmitido: %s\n", eventType)
-			fmt.Printf("   Unidad ID: %d\n", voteEvent.Vote.PropertyUnitID)
 			fmt.Printf("   Opción ID: %d\n", voteEvent.Vote.VotingOptionID)
 			fmt.Printf("   Votación ID: %d\n\n", votingID)
 		}
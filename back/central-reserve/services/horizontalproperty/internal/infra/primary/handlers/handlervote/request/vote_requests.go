@@ -3,24 +3,32 @@ package request
 import "time"
 
 type CreateVotingGroupRequest struct {
-	Name             string    `json:"name" binding:"required,min=3,max=150"`
-	Description      string    `json:"description" binding:"max=1000"`
-	VotingStartDate  time.Time `json:"voting_start_date" binding:"required"`
-	VotingEndDate    time.Time `json:"voting_end_date" binding:"required"`
-	RequiresQuorum   bool      `json:"requires_quorum"`
-	QuorumPercentage *float64  `json:"quorum_percentage"`
-	CreatedByUserID  *uint     `json:"created_by_user_id"`
-	Notes            string    `json:"notes" binding:"max=2000"`
+	Name                     string    `json:"name" binding:"required,min=3,max=150"`
+	Description              string    `json:"description" binding:"max=1000"`
+	VotingStartDate          time.Time `json:"voting_start_date" binding:"required"`
+	VotingEndDate            time.Time `json:"voting_end_date" binding:"required"`
+	RequiresQuorum           bool      `json:"requires_quorum"`
+	QuorumPercentage         *float64  `json:"quorum_percentage"`
+	AllowVotingWithoutQuorum bool      `json:"allow_voting_without_quorum"`
+	CreatedByUserID          *uint     `json:"created_by_user_id"`
+	Notes                    string    `json:"notes" binding:"max=2000"`
 }
 
 type CreateVotingRequest struct {
 	Title              string   `json:"title" binding:"required,min=3,max=200"`
 	Description        string   `json:"description" binding:"required,max=2000"`
-	VotingType         string   `json:"voting_type" binding:"required,oneof=simple majority unanimity"`
+	VotingType         string   `json:"voting_type" binding:"required,oneof=simple majority unanimity single_choice multiple_choice ranked_choice election"`
 	IsSecret           bool     `json:"is_secret"`
 	AllowAbstention    bool     `json:"allow_abstention"`
 	DisplayOrder       int      `json:"display_order" binding:"min=1"`
-	RequiredPercentage *float64 `json:"required_percentage"`
+	RequiredPercentage *float64 `json:"required_percentage" binding:"omitempty,gt=0,lte=100"`
+	MajorityBase       string   `json:"majority_base" binding:"omitempty,oneof=present total"` // Base del porcentaje: present (por defecto) o total
+	MinSelections      int      `json:"min_selections" binding:"min=0"`                        // multiple_choice: mínimo de opciones a marcar (por defecto 1)
+	MaxSelections      int      `json:"max_selections" binding:"min=0"`                        // multiple_choice y ranked_choice: máximo de opciones; 0 sin límite
+	Seats              int      `json:"seats" binding:"min=0"`                                 // election: cargos a elegir (por defecto 1)
+	ScheduledOpenAt    string   `json:"scheduled_open_at"`                                     // Apertura programada: 2006-01-02T15:04 en la hora local de la propiedad o RFC3339
+	ScheduledCloseAt   string   `json:"scheduled_close_at"`                                    // Cierre programado; por defecto el fin del grupo de votación
+	Draft              bool     `json:"draft"`                                                 // Crear en borrador para abrirla manualmente
 }
 
 type CreateVotingOptionRequest struct {
@@ -31,8 +39,9 @@ type CreateVotingOptionRequest struct {
 }
 
 type CreateVoteRequest struct {
-	PropertyUnitID uint   `json:"property_unit_id" binding:"required"`
-	VotingOptionID uint   `json:"voting_option_id" binding:"required"`
-	IPAddress      string `json:"ip_address"`
-	UserAgent      string `json:"user_agent"`
+	PropertyUnitID  uint   `json:"property_unit_id" binding:"required"`
+	VotingOptionID  uint   `json:"voting_option_id" binding:"required_without=VotingOptionIDs"`
+	VotingOptionIDs []uint `json:"voting_option_ids"` // Opciones en orden de preferencia (multiple_choice, ranked_choice, election)
+	IPAddress       string `json:"ip_address"`
+	UserAgent       string `json:"user_agent"`
 }
@@ -16,35 +16,47 @@ type ErrorResponse struct {
 
 // VotingGroupResponse - Response para grupo de votación
 type VotingGroupResponse struct {
-	ID               uint      `json:"id" example:"1"`
-	BusinessID       uint      `json:"business_id" example:"1"`
-	Name             string    `json:"name" example:"Asamblea Ordinaria 2025"`
-	Description      string    `json:"description" example:"Primera asamblea del año"`
-	VotingStartDate  time.Time `json:"voting_start_date" example:"2025-03-01T08:00:00Z"`
-	VotingEndDate    time.Time `json:"voting_end_date" example:"2025-03-15T23:59:59Z"`
-	IsActive         bool      `json:"is_active" example:"true"`
-	RequiresQuorum   bool      `json:"requires_quorum" example:"true"`
-	QuorumPercentage *float64  `json:"quorum_percentage,omitempty" example:"50.0"`
-	CreatedByUserID  *uint     `json:"created_by_user_id,omitempty" example:"5"`
-	Notes            string    `json:"notes,omitempty" example:"Notas adicionales"`
-	CreatedAt        time.Time `json:"created_at" example:"2025-01-15T10:30:00Z"`
-	UpdatedAt        time.Time `json:"updated_at" example:"2025-01-15T10:30:00Z"`
+	ID                       uint      `json:"id" example:"1"`
+	BusinessID               uint      `json:"business_id" example:"1"`
+	Name                     string    `json:"name" example:"Asamblea Ordinaria 2025"`
+	Description              string    `json:"description" example:"Primera asamblea del año"`
+	VotingStartDate          time.Time `json:"voting_start_date" example:"2025-03-01T08:00:00Z"`
+	VotingEndDate            time.Time `json:"voting_end_date" example:"2025-03-15T23:59:59Z"`
+	IsActive                 bool      `json:"is_active" example:"true"`
+	RequiresQuorum           bool      `json:"requires_quorum" example:"true"`
+	QuorumPercentage         *float64  `json:"quorum_percentage,omitempty" example:"50.0"`
+	AllowVotingWithoutQuorum bool      `json:"allow_voting_without_quorum" example:"false"` // Con quórum requerido, solo advierte en lugar de bloquear
+	CreatedByUserID          *uint     `json:"created_by_user_id,omitempty" example:"5"`
+	Notes                    string    `json:"notes,omitempty" example:"Notas adicionales"`
+	CreatedAt                time.Time `json:"created_at" example:"2025-01-15T10:30:00Z"`
+	UpdatedAt                time.Time `json:"updated_at" example:"2025-01-15T10:30:00Z"`
 }
 
 // VotingResponse - Response para votación
 type VotingResponse struct {
-	ID                 uint      `json:"id" example:"1"`
-	VotingGroupID      uint      `json:"voting_group_id" example:"1"`
-	Title              string    `json:"title" example:"Aprobación de presupuesto"`
-	Description        string    `json:"description" example:"Se votará el presupuesto 2025"`
-	VotingType         string    `json:"voting_type" example:"single_choice"`
-	IsSecret           bool      `json:"is_secret" example:"false"`
-	AllowAbstention    bool      `json:"allow_abstention" example:"true"`
-	IsActive           bool      `json:"is_active" example:"true"`
-	DisplayOrder       int       `json:"display_order" example:"1"`
-	RequiredPercentage *float64  `json:"required_percentage,omitempty" example:"50.0"`
-	CreatedAt          time.Time `json:"created_at" example:"2025-01-15T10:30:00Z"`
-	UpdatedAt          time.Time `json:"updated_at" example:"2025-01-15T10:30:00Z"`
+	ID                 uint       `json:"id" example:"1"`
+	VotingGroupID      uint       `json:"voting_group_id" example:"1"`
+	Title              string     `json:"title" example:"Aprobación de presupuesto"`
+	Description        string     `json:"description" example:"Se votará el presupuesto 2025"`
+	VotingType         string     `json:"voting_type" example:"single_choice"`
+	IsSecret           bool       `json:"is_secret" example:"false"`
+	AllowAbstention    bool       `json:"allow_abstention" example:"true"`
+	IsActive           bool       `json:"is_active" example:"true"`
+	DisplayOrder       int        `json:"display_order" example:"1"`
+	RequiredPercentage *float64   `json:"required_percentage,omitempty" example:"50.0"`
+	MajorityBase       string     `json:"majority_base" example:"present"`
+	MinSelections      int        `json:"min_selections" example:"1"`
+	MaxSelections      int        `json:"max_selections" example:"0"` // 0 sin límite
+	Seats              int        `json:"seats" example:"1"`
+	Status             string     `json:"status" example:"open"` // draft | open | closed | certified
+	ScheduledOpenAt    *time.Time `json:"scheduled_open_at,omitempty" example:"2025-03-05T09:00:00-05:00"`
+	ScheduledCloseAt   *time.Time `json:"scheduled_close_at,omitempty" example:"2025-03-05T12:00:00-05:00"`
+	OpenedAt           *time.Time `json:"opened_at,omitempty" example:"2025-03-05T09:00:00-05:00"`
+	ClosedAt           *time.Time `json:"closed_at,omitempty" example:"2025-03-05T12:00:00-05:00"`
+	CertifiedAt        *time.Time `json:"certified_at,omitempty" example:"2025-03-05T12:30:00-05:00"`
+	Timezone           string     `json:"timezone" example:"America/Bogota"` // Zona horaria de la propiedad
+	CreatedAt          time.Time  `json:"created_at" example:"2025-01-15T10:30:00Z"`
+	UpdatedAt          time.Time  `json:"updated_at" example:"2025-01-15T10:30:00Z"`
 }
 
 // VotingOptionResponse - Response para opción de votación
@@ -60,17 +72,19 @@ type VotingOptionResponse struct {
 
 // VoteResponse - Response para voto
 type VoteResponse struct {
-	ID             uint      `json:"id" example:"1"`
-	VotingID       uint      `json:"voting_id" example:"1"`
-	PropertyUnitID uint      `json:"property_unit_id" example:"10"`
-	VotingOptionID uint      `json:"voting_option_id" example:"1"`
-	OptionText     string    `json:"option_text,omitempty" example:"Sí, apruebo"`
-	OptionCode     string    `json:"option_code,omitempty" example:"YES"`
-	Color          string    `json:"color,omitempty" example:"#22c55e"`
-	VotedAt        time.Time `json:"voted_at" example:"2025-03-05T14:30:00Z"`
-	IPAddress      string    `json:"ip_address,omitempty" example:"192.168.1.100"`
-	UserAgent      string    `json:"user_agent,omitempty" example:"Mozilla/5.0"`
-	Notes          string    `json:"notes,omitempty" example:"Voto emitido correctamente"`
+	ID              uint      `json:"id" example:"1"`
+	VotingID        uint      `json:"voting_id" example:"1"`
+	PropertyUnitID  uint      `json:"property_unit_id" example:"10"`
+	VotingOptionID  uint      `json:"voting_option_id" example:"1"`
+	VotingOptionIDs []uint    `json:"voting_option_ids" example:"1,3"`  // Opciones de la papeleta en orden de preferencia
+	Secret          bool      `json:"secret,omitempty" example:"false"` // Voto de una votación secreta: sin datos de la unidad en los eventos
+	OptionText      string    `json:"option_text,omitempty" example:"Sí, apruebo"`
+	OptionCode      string    `json:"option_code,omitempty" example:"YES"`
+	Color           string    `json:"color,omitempty" example:"#22c55e"`
+	VotedAt         time.Time `json:"voted_at" example:"2025-03-05T14:30:00Z"`
+	IPAddress       string    `json:"ip_address,omitempty" example:"192.168.1.100"`
+	UserAgent       string    `json:"user_agent,omitempty" example:"Mozilla/5.0"`
+	Notes           string    `json:"notes,omitempty" example:"Voto emitido correctamente"`
 }
 
 // VotingResultResponse - Response para resultados de votación
@@ -81,6 +95,96 @@ type VotingResultResponse struct {
 	Color          string  `json:"color" example:"#22c55e"`
 	VoteCount      int     `json:"vote_count" example:"45"`
 	Percentage     float64 `json:"percentage" example:"75.5"`
+
+	Coefficient           float64 `json:"coefficient" example:"0.412"`           // Suma de coeficientes de quienes eligieron la opción
+	CoefficientPercentage float64 `json:"coefficient_percentage" example:"71.2"` // Sobre el coeficiente de quienes votaron
+	BasePercentage        float64 `json:"base_percentage" example:"58.3"`        // Sobre la base de la decisión (presentes o total)
+	Winner                bool    `json:"winner" example:"false"`                // Ganadora en selección múltiple, voto preferencial y elecciones
+}
+
+// VotingOutcomeResponse - Totales y decisión de una votación
+type VotingOutcomeResponse struct {
+	VotedUnits         int     `json:"voted_units" example:"45"`
+	VotedCoefficient   float64 `json:"voted_coefficient" example:"0.58"`
+	PresentUnits       int     `json:"present_units" example:"60"`
+	PresentCoefficient float64 `json:"present_coefficient" example:"0.71"`
+	TotalUnits         int     `json:"total_units" example:"100"`
+	TotalCoefficient   float64 `json:"total_coefficient" example:"1"`
+	MajorityBase       string  `json:"majority_base" example:"present"`   // present | total
+	WeightedBy         string  `json:"weighted_by" example:"coefficient"` // coefficient | units (sin coeficientes cargados)
+	RequiredPercentage float64 `json:"required_percentage" example:"50"`
+	DecidingOptionID   *uint   `json:"deciding_option_id" example:"1"`     // Opción afirmativa o la más votada
+	ApprovalPercentage float64 `json:"approval_percentage" example:"58.3"` // Porcentaje de la base obtenido por la opción que decide
+	Outcome            string  `json:"outcome" example:"approved"`         // approved | rejected | elected | tied
+	VotingType         string  `json:"voting_type" example:"simple"`
+	Seats              int     `json:"seats,omitempty" example:"5"`               // Cargos a elegir (election)
+	WinnerOptionIDs    []uint  `json:"winner_option_ids,omitempty" example:"1,4"` // Ganadoras en selección múltiple, voto preferencial y elecciones
+}
+
+// VotingTallyResponse - Resultados por opción con la decisión de la votación
+type VotingTallyResponse struct {
+	Results []VotingResultResponse `json:"results"`
+	Outcome VotingOutcomeResponse  `json:"outcome"`
+	Rounds  []VotingRoundResponse  `json:"rounds,omitempty"` // Rondas del voto preferencial
+}
+
+// VotingRoundResponse - Conteo de una ronda del voto preferencial
+type VotingRoundResponse struct {
+	Round               int                         `json:"round" example:"1"`
+	Options             []VotingRoundOptionResponse `json:"options"`
+	ExhaustedUnits      int                         `json:"exhausted_units" example:"2"` // Papeletas sin opciones en competencia
+	ExhaustedWeight     float64                     `json:"exhausted_weight" example:"0.021"`
+	EliminatedOptionIDs []uint                      `json:"eliminated_option_ids,omitempty" example:"3"`
+}
+
+// VotingRoundOptionResponse - Peso de una opción en una ronda del voto preferencial
+type VotingRoundOptionResponse struct {
+	VotingOptionID uint    `json:"voting_option_id" example:"1"`
+	Units          int     `json:"units" example:"20"`
+	Weight         float64 `json:"weight" example:"0.245"`    // Coeficiente o unidades según weighted_by
+	Percentage     float64 `json:"percentage" example:"48.1"` // Sobre las papeletas vigentes en la ronda
+}
+
+// QuorumStatusResponse - Quórum de un grupo de votación
+type QuorumStatusResponse struct {
+	VotingGroupID            uint      `json:"voting_group_id" example:"1"`
+	RequiresQuorum           bool      `json:"requires_quorum" example:"true"`
+	AllowVotingWithoutQuorum bool      `json:"allow_voting_without_quorum" example:"false"`
+	QuorumPercentage         float64   `json:"quorum_percentage" example:"50"`
+	TotalUnits               int       `json:"total_units" example:"100"`
+	TotalCoefficient         float64   `json:"total_coefficient" example:"1"`
+	PresentUnits             int       `json:"present_units" example:"62"`
+	PresentCoefficient       float64   `json:"present_coefficient" example:"0.5875"`
+	OwnerUnits               int       `json:"owner_units" example:"55"`           // Propietarios presentes
+	ProxyUnits               int       `json:"proxy_units" example:"7"`            // Unidades representadas por apoderado con poder vigente
+	WeightedBy               string    `json:"weighted_by" example:"coefficient"`  // coefficient | units (sin coeficientes cargados)
+	PresentPercentage        float64   `json:"present_percentage" example:"58.75"` // Porcentaje presente sobre el total
+	QuorumMet                bool      `json:"quorum_met" example:"true"`
+	Enforced                 bool      `json:"enforced" example:"true"` // Sin quórum se bloquea activar votaciones y votar
+	CalculatedAt             time.Time `json:"calculated_at" example:"2025-03-05T14:30:00Z"`
+}
+
+// VotingQuorumSnapshotResponse - Quórum registrado al abrir o cerrar una votación
+type VotingQuorumSnapshotResponse struct {
+	ID                 uint      `json:"id" example:"1"`
+	VotingID           uint      `json:"voting_id" example:"1"`
+	Event              string    `json:"event" example:"open"` // open | close
+	PresentUnits       int       `json:"present_units" example:"62"`
+	PresentCoefficient float64   `json:"present_coefficient" example:"0.5875"`
+	TotalUnits         int       `json:"total_units" example:"100"`
+	TotalCoefficient   float64   `json:"total_coefficient" example:"1"`
+	PresentPercentage  float64   `json:"present_percentage" example:"58.75"`
+	WeightedBy         string    `json:"weighted_by" example:"coefficient"`
+	QuorumPercentage   float64   `json:"quorum_percentage" example:"50"`
+	QuorumMet          bool      `json:"quorum_met" example:"true"`
+	TakenAt            time.Time `json:"taken_at" example:"2025-03-05T14:30:00Z"`
+}
+
+// SecretBallotResponse - Papeleta anónima de una votación secreta
+type SecretBallotResponse struct {
+	BallotID        string  `json:"ballot_id" example:"9f2c4e7a1b3d5f60718293a4b5c6d7e8"`
+	Coefficient     float64 `json:"coefficient" example:"0.012345"`
+	VotingOptionIDs []uint  `json:"voting_option_ids" example:"1,3"` // En orden de preferencia
 }
 
 // UnitWithResidentResponse - Response para unidad con residente
@@ -123,4 +227,8 @@ type VotingOptionSuccess = SuccessResponse[VotingOptionResponse]
 type VotingOptionsSuccess = SuccessResponse[[]VotingOptionResponse]
 type VoteSuccess = SuccessResponse[VoteResponse]
 type VotesSuccess = SuccessResponse[[]VoteResponse]
+type VotingTallySuccess = SuccessResponse[VotingTallyResponse]
+type QuorumStatusSuccess = SuccessResponse[QuorumStatusResponse]
+type VotingQuorumSnapshotsSuccess = SuccessResponse[[]VotingQuorumSnapshotResponse]
 type UnvotedUnitsSuccess = SuccessResponse[[]UnvotedUnitResponse]
+type SecretBallotsSuccess = SuccessResponse[[]SecretBallotResponse]
@@ -7,39 +7,53 @@ import (
 )
 
 func (h *VotingHandler) RegisterRoutes(router *gin.RouterGroup) {
+	read := middleware.RequirePermission(middleware.ResourceVotings, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceVotings, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceVotings, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceVotings, middleware.ActionDelete)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourceVotings)
+
 	// Rutas privadas (requieren autenticación admin)
 	groups := router.Group("/horizontal-properties/voting-groups")
 	{
-		groups.POST("", middleware.JWT(), h.CreateVotingGroup)
-		groups.GET("", middleware.JWT(), h.ListVotingGroups)
-		groups.PUT("/:group_id", middleware.JWT(), h.UpdateVotingGroup)
-		groups.DELETE("/:group_id", middleware.JWT(), h.DeactivateVotingGroup)
+		groups.POST("", middleware.JWT(), feature, create, h.CreateVotingGroup)
+		groups.GET("", middleware.JWT(), feature, read, h.ListVotingGroups)
+		groups.PUT("/:group_id", middleware.JWT(), feature, update, h.UpdateVotingGroup)
+		groups.DELETE("/:group_id", middleware.JWT(), feature, remove, h.DeactivateVotingGroup)
+		groups.GET("/:group_id/quorum", middleware.JWT(), feature, read, h.GetQuorumStatus)  // Quórum en el momento
+		groups.GET("/:group_id/quorum/stream", middleware.JWT(), feature, read, h.SSEQuorum) // SSE del quórum
 
 		votings := groups.Group("/:group_id/votings")
 		{
-			votings.POST("", middleware.JWT(), h.CreateVoting)
-			votings.GET("", middleware.JWT(), h.ListVotings)
-			votings.PUT("/:voting_id", middleware.JWT(), h.UpdateVoting)
-			votings.DELETE("/:voting_id", middleware.JWT(), h.DeleteVoting)
-			votings.PATCH("/:voting_id/activate", middleware.JWT(), h.ActivateVoting)                    // Activar votación
-			votings.PATCH("/:voting_id/deactivate", middleware.JWT(), h.DeactivateVotingHandler)         // Desactivar votación
-			votings.GET("/:voting_id/stream", middleware.JWT(), h.SSEVotingResults)                      // SSE en tiempo real
-			votings.GET("/:voting_id/voting-details", middleware.JWT(), h.GetVotingDetailsAdmin)         // Detalles completos por unidad (admin)
-			votings.GET("/:voting_id/unvoted-units", middleware.JWT(), h.GetUnvotedUnitsByVoting)        // Unidades que no han votado
-			votings.POST("/:voting_id/generate-public-url", middleware.JWT(), h.GeneratePublicVotingURL) // Generar URL pública
+			votings.POST("", middleware.JWT(), feature, create, h.CreateVoting)
+			votings.GET("", middleware.JWT(), feature, read, h.ListVotings)
+			votings.PUT("/:voting_id", middleware.JWT(), feature, update, h.UpdateVoting)
+			votings.DELETE("/:voting_id", middleware.JWT(), feature, remove, h.DeleteVoting)
+			votings.PATCH("/:voting_id/activate", middleware.JWT(), feature, update, h.ActivateVoting)                    // Abrir votación
+			votings.PATCH("/:voting_id/deactivate", middleware.JWT(), feature, update, h.DeactivateVotingHandler)         // Cerrar votación
+			votings.PATCH("/:voting_id/certify", middleware.JWT(), feature, update, h.CertifyVoting)                      // Certificar resultados
+			votings.GET("/:voting_id/stream", middleware.JWT(), feature, read, h.SSEVotingResults)                        // SSE en tiempo real
+			votings.GET("/:voting_id/results", middleware.JWT(), feature, read, h.GetVotingResults)                       // Resultados y decisión por coeficiente
+			votings.GET("/:voting_id/quorum-snapshots", middleware.JWT(), feature, read, h.ListQuorumSnapshots)           // Quórum al abrir y cerrar
+			votings.GET("/:voting_id/ballots", middleware.JWT(), feature, read, h.ListSecretBallots)                      // Papeletas anónimas (votación secreta)
+			votings.GET("/:voting_id/voting-details", middleware.JWT(), feature, read, h.GetVotingDetailsAdmin)           // Detalles completos por unidad (admin)
+			votings.GET("/:voting_id/unvoted-units", middleware.JWT(), feature, read, h.GetUnvotedUnitsByVoting)          // Unidades que no han votado
+			votings.POST("/:voting_id/generate-public-url", middleware.JWT(), feature, update, h.GeneratePublicVotingURL) // Generar URL pública
 
 			options := votings.Group("/:voting_id/options")
 			{
-				options.POST("", middleware.JWT(), h.CreateVotingOption)
-				options.GET("", middleware.JWT(), h.ListVotingOptions)
-				options.DELETE("/:option_id", middleware.JWT(), h.DeactivateVotingOption)
+				options.POST("", middleware.JWT(), feature, create, h.CreateVotingOption)
+				options.GET("", middleware.JWT(), feature, read, h.ListVotingOptions)
+				options.DELETE("/:option_id", middleware.JWT(), feature, remove, h.DeactivateVotingOption)
 			}
 
 			votes := votings.Group("/:voting_id/votes")
 			{
-				votes.POST("", middleware.JWT(), h.CreateVote)
-				votes.GET("", middleware.JWT(), h.ListVotes)
-				votes.DELETE("/:vote_id", middleware.JWT(), h.DeleteVoteAdmin) // Eliminar voto (admin)
+				votes.POST("", middleware.JWT(), feature, create, h.CreateVote)
+				votes.GET("", middleware.JWT(), feature, read, h.ListVotes)
+				votes.DELETE("/:vote_id", middleware.JWT(), feature, remove, h.DeleteVoteAdmin) // Eliminar voto (admin)
 			}
 		}
 	}
@@ -0,0 +1,145 @@
+package handlervote
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/mapper"
+	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SSEQuorum godoc
+//
+//	@Summary		Stream del quórum de un grupo de votación (SSE)
+//	@Description	Envía el quórum al conectarse y cada vez que cambia: al marcar o desmarcar asistencia, y al recalcularlo periódicamente para reflejar poderes que vencen o se modifican
+//	@Tags			Votaciones
+//	@Accept			json
+//	@Produce		text/event-stream
+//	@Security		BearerAuth
+//	@Param			group_id	path		int		true	"ID del grupo de votación"
+//	@Success		200			{string}	string	"Event stream"
+//	@Failure		400			{object}	object
+//	@Failure		404			{object}	object
+//	@Failure		500			{object}	object
+//	@Router			/horizontal-properties/voting-groups/{group_id}/quorum/stream [get]
+func (h *VotingHandler) SSEQuorum(c *gin.Context) {
+	groupIDParam := c.Param("group_id")
+	groupID64, err := strconv.ParseUint(groupIDParam, 10, 32)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/sse-quorum.go - Error parseando ID: %v\n", err)
+		h.logger.Error().Err(err).Str("group_id", groupIDParam).Msg("Error parseando ID de grupo para SSE de quórum")
+		c.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "ID de grupo de votación inválido",
+			Error:   "Debe ser numérico",
+		})
+		return
+	}
+	groupID := uint(groupID64)
+
+	status, err := h.votingUseCase.GetQuorumStatus(c.Request.Context(), groupID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/sse-quorum.go - Error calculando quórum: %v\n", err)
+		h.logger.Error().Err(err).Uint("group_id", groupID).Msg("Error calculando quórum")
+		c.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Error calculando quórum",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if !canAccessBusiness(c, status.BusinessID) {
+		c.JSON(http.StatusNotFound, response.ErrorResponse{Success: false, Message: "Grupo de votación no encontrado", Error: "grupo de votación no encontrado"})
+		return
+	}
+
+	// Suscribirse antes de enviar el estado inicial para no perder cambios intermedios
+	changes := h.quorumNotifier.Subscribe(c.Request.Context(), groupID)
+
+	// Configurar headers para SSE
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
+
+	last, ok := h.writeQuorumEvent(c, status)
+	if !ok {
+		return
+	}
+
+	h.logger.Info().Uint("group_id", groupID).Msg("Cliente conectado al SSE de quórum")
+
+	refresh := time.NewTicker(domain.QuorumRefreshInterval)
+	defer refresh.Stop()
+	heartbeat := time.NewTicker(30 * time.Second)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			h.logger.Info().Uint("group_id", groupID).Msg("Cliente desconectado del SSE de quórum")
+			return
+
+		case _, open := <-changes:
+			if !open {
+				return
+			}
+			if last, ok = h.pushQuorumIfChanged(c, groupID, last); !ok {
+				return
+			}
+
+		case <-refresh.C:
+			if last, ok = h.pushQuorumIfChanged(c, groupID, last); !ok {
+				return
+			}
+
+		case <-heartbeat.C:
+			// Enviar heartbeat cada 30 segundos para mantener la conexión viva
+			event := fmt.Sprintf("event: heartbeat\ndata: {\"timestamp\": \"%s\"}\n\n", time.Now().Format(time.RFC3339))
+			if _, err := c.Writer.WriteString(event); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// pushQuorumIfChanged recalcula el quórum y lo envía si cambió respecto al último enviado.
+// Devuelve false si el cliente se desconectó.
+func (h *VotingHandler) pushQuorumIfChanged(c *gin.Context, groupID uint, last response.QuorumStatusResponse) (response.QuorumStatusResponse, bool) {
+	status, err := h.votingUseCase.GetQuorumStatus(c.Request.Context(), groupID)
+	if err != nil {
+		h.logger.Error().Err(err).Uint("group_id", groupID).Msg("Error recalculando quórum para SSE")
+		return last, true
+	}
+	current := mapper.MapQuorumStatusToResponse(status)
+	current.CalculatedAt = last.CalculatedAt
+	if current == last {
+		return last, true
+	}
+	return h.writeQuorumEvent(c, status)
+}
+
+// writeQuorumEvent envía el evento quorum con el estado dado
+func (h *VotingHandler) writeQuorumEvent(c *gin.Context, status *domain.QuorumStatusDTO) (response.QuorumStatusResponse, bool) {
+	payload := mapper.MapQuorumStatusToResponse(status)
+	data, err := json.Marshal(payload)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/sse-quorum.go - Error serializando quórum: %v\n", err)
+		h.logger.Error().Err(err).Uint("group_id", status.VotingGroupID).Msg("Error serializando quórum")
+		return payload, true
+	}
+	if _, err := c.Writer.WriteString(fmt.Sprintf("event: quorum\ndata: %s\n\n", string(data))); err != nil {
+		h.logger.Error().Err(err).Uint("group_id", status.VotingGroupID).Msg("Error enviando quórum por SSE")
+		return payload, false
+	}
+	c.Writer.Flush()
+	return payload, true
+}
@@ -2,12 +2,14 @@ package handlervote
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"strconv"
 	"time"
 
+	"central_reserve/services/horizontalproperty/internal/domain"
 	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/mapper"
 	"central_reserve/services/horizontalproperty/internal/infra/primary/handlers/handlervote/response"
 
@@ -61,10 +63,11 @@ func (h *VotingHandler) SSEVotingResults(c *gin.Context) {
 		return
 	}
 
-	// Si el cache está vacío, cargar desde la base de datos
+	// Si el cache está vacío, cargar desde la base de datos. Las votaciones secretas no exponen
+	// votos por unidad: el stream arranca sin precarga y solo recibe los eventos anónimos.
 	if len(existingVotes) == 0 {
 		votes, err := h.votingUseCase.ListVotesByVoting(c.Request.Context(), uint(votingID))
-		if err != nil {
+		if err != nil && !errors.Is(err, domain.ErrVotingIsSecret) {
 			fmt.Fprintf(os.Stderr, "[ERROR] handlervote/sse-voting-results.go - Error cargando votos desde BD: %v\n", err)
 			h.logger.Error().Err(err).Uint("voting_id", uint(votingID)).Msg("Error cargando votos desde BD")
 			c.JSON(http.StatusInternalServerError, response.ErrorResponse{
@@ -90,18 +93,22 @@ func (h *VotingHandler) SSEVotingResults(c *gin.Context) {
 		h.logger.Info().Uint("voting_id", uint(votingID)).Int("votes_count", len(existingVotes)).Msg("Enviando precarga de votos")
 	}
 
-	// Obtener resultados de votación con colores
-	votingResults, err := h.votingUseCase.GetVotingResults(c.Request.Context(), uint(votingID))
+	// Obtener resultados de votación con colores y la decisión por coeficiente
+	tally, err := h.votingUseCase.GetVotingTally(c.Request.Context(), uint(votingID))
 	var resultsResponse []response.VotingResultResponse
-	if err == nil && len(votingResults) > 0 {
-		resultsResponse = mapper.MapVotingResultsToResponses(votingResults)
-		h.logger.Info().Uint("voting_id", uint(votingID)).Int("results_count", len(votingResults)).Msg("Enviando resultados de votación")
+	var outcomeResponse *response.VotingOutcomeResponse
+	if err == nil {
+		resultsResponse = mapper.MapVotingResultsToResponses(tally.Options)
+		outcome := mapper.MapVotingOutcomeToResponse(tally)
+		outcomeResponse = &outcome
+		h.logger.Info().Uint("voting_id", uint(votingID)).Int("results_count", len(tally.Options)).Str("outcome", tally.Outcome).Msg("Enviando resultados de votación")
 	}
 
 	// Enviar datos iniciales completos
 	initialData := gin.H{
 		"votes":   votesResponse,
 		"results": resultsResponse,
+		"outcome": outcomeResponse,
 	}
 	data, err := json.Marshal(initialData)
 	if err != nil {
@@ -159,16 +166,20 @@ func (h *VotingHandler) SSEVotingResults(c *gin.Context) {
 			// Enviar evento de voto y resultados actualizados
 			responseVote := mapper.MapVoteDTOToResponse(&voteEvent.Vote)
 
-			// Obtener resultados actualizados
-			updatedResults, err := h.votingUseCase.GetVotingResults(c.Request.Context(), uint(votingID))
+			// Obtener resultados y decisión actualizados
+			updatedTally, err := h.votingUseCase.GetVotingTally(c.Request.Context(), uint(votingID))
 			var resultsResponse []response.VotingResultResponse
-			if err == nil && len(updatedResults) > 0 {
-				resultsResponse = mapper.MapVotingResultsToResponses(updatedResults)
+			var outcomeResponse *response.VotingOutcomeResponse
+			if err == nil {
+				resultsResponse = mapper.MapVotingResultsToResponses(updatedTally.Options)
+				outcome := mapper.MapVotingOutcomeToResponse(updatedTally)
+				outcomeResponse = &outcome
 			}
 
 			voteData := gin.H{
 				"vote":    responseVote,
 				"results": resultsResponse,
+				"outcome": outcomeResponse,
 			}
 
 			data, err := json.Marshal(voteData)
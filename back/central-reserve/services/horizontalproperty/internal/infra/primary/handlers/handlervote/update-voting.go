@@ -17,7 +17,7 @@ import (
 // UpdateVoting godoc
 //
 //	@Summary		Actualizar una votación
-//	@Description	Actualiza los datos de una votación existente
+//	@Description	Actualiza los datos y el horario de una votación que no esté cerrada. El tipo de votación y su papeleta no se pueden cambiar si ya tiene votos
 //	@Tags			Votaciones
 //	@Security		BearerAuth
 //	@Accept			json
@@ -28,6 +28,7 @@ import (
 //	@Success		200			{object}	object
 //	@Failure		400			{object}	object
 //	@Failure		404			{object}	object
+//	@Failure		409			{object}	object	"Votación cerrada o con votos al cambiar el tipo"
 //	@Failure		500			{object}	object
 //	@Router			/horizontal-properties/voting-groups/{group_id}/votings/{voting_id} [put]
 func (h *VotingHandler) UpdateVoting(c *gin.Context) {
@@ -54,12 +55,18 @@ func (h *VotingHandler) UpdateVoting(c *gin.Context) {
 		AllowAbstention:    req.AllowAbstention,
 		DisplayOrder:       req.DisplayOrder,
 		RequiredPercentage: req.RequiredPercentage,
+		MajorityBase:       req.MajorityBase,
+		MinSelections:      req.MinSelections,
+		MaxSelections:      req.MaxSelections,
+		Seats:              req.Seats,
+		ScheduledOpenAt:    req.ScheduledOpenAt,
+		ScheduledCloseAt:   req.ScheduledCloseAt,
 	}
 	updated, err := h.votingUseCase.UpdateVoting(c.Request.Context(), uint(id64), dto)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] handlervote/update-voting.go - Error en handler: %v\n", err)
 		h.logger.Error().Err(err).Uint("voting_id", uint(id64)).Str("title", req.Title).Msg("Error actualizando votación")
-		c.JSON(http.StatusBadRequest, response.ErrorResponse{Success: false, Message: "No se pudo actualizar", Error: err.Error()})
+		c.JSON(votingErrorStatus(err, http.StatusBadRequest), response.ErrorResponse{Success: false, Message: "No se pudo actualizar", Error: err.Error()})
 		return
 	}
 
@@ -8,14 +8,19 @@ import (
 
 // RegisterRoutes registra las rutas para el handler de propiedades horizontales
 func (h *HorizontalPropertyHandler) RegisterRoutes(router *gin.RouterGroup) {
+	read := middleware.RequirePermission(middleware.ResourceHorizontalProperties, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceHorizontalProperties, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceHorizontalProperties, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceHorizontalProperties, middleware.ActionDelete)
+
 	// Grupo de rutas para propiedades horizontales
 	horizontalProperties := router.Group("/horizontal-properties")
 	{
 		// CRUD básico
-		horizontalProperties.POST("", middleware.JWT(), h.CreateHorizontalProperty)                // POST /api/v1/horizontal-properties
-		horizontalProperties.GET("", middleware.JWT(), h.ListHorizontalProperties)                 // GET /api/v1/horizontal-properties
-		horizontalProperties.GET("/:business_id", middleware.JWT(), h.GetHorizontalPropertyByID)   // GET /api/v1/horizontal-properties/:business_id
-		horizontalProperties.PUT("/:business_id", middleware.JWT(), h.UpdateHorizontalProperty)    // PUT /api/v1/horizontal-properties/:business_id
-		horizontalProperties.DELETE("/:business_id", middleware.JWT(), h.DeleteHorizontalProperty) // DELETE /api/v1/horizontal-properties/:business_id
+		horizontalProperties.POST("", middleware.JWT(), create, h.CreateHorizontalProperty)                // POST /api/v1/horizontal-properties
+		horizontalProperties.GET("", middleware.JWT(), read, h.ListHorizontalProperties)                   // GET /api/v1/horizontal-properties
+		horizontalProperties.GET("/:business_id", middleware.JWT(), read, h.GetHorizontalPropertyByID)     // GET /api/v1/horizontal-properties/:business_id
+		horizontalProperties.PUT("/:business_id", middleware.JWT(), update, h.UpdateHorizontalProperty)    // PUT /api/v1/horizontal-properties/:business_id
+		horizontalProperties.DELETE("/:business_id", middleware.JWT(), remove, h.DeleteHorizontalProperty) // DELETE /api/v1/horizontal-properties/:business_id
 	}
 }
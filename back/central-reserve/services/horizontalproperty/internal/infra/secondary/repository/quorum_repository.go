@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+	"dbpostgres/app/infra/models"
+
+	"gorm.io/gorm"
+)
+
+// GetQuorumParticipation calcula las unidades y coeficientes presentes en la asamblea del grupo.
+// Una unidad está presente si asistió como propietario, o como apoderado con un poder activo y
+// vigente a la fecha (el registrado en la asistencia o, si no se indicó, cualquiera de la unidad).
+// Cada unidad cuenta una sola vez aunque tenga varios registros de asistencia.
+func (r *Repository) GetQuorumParticipation(ctx context.Context, votingGroupID uint) (*domain.QuorumParticipation, error) {
+	var group models.VotingGroup
+	if err := r.db.Conn(ctx).First(&group, votingGroupID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("grupo de votación no encontrado")
+		}
+		r.logger.Error().Err(err).Uint("voting_group_id", votingGroupID).Msg("Error obteniendo grupo de votación")
+		return nil, fmt.Errorf("error obteniendo grupo de votación: %w", err)
+	}
+
+	var total struct {
+		Units       int
+		Coefficient float64
+	}
+	if err := r.db.Conn(ctx).
+		Model(&models.PropertyUnit{}).
+		Select("COUNT(*) as units, COALESCE(SUM(participation_coefficient), 0) as coefficient").
+		Where("business_id = ? AND is_active = ?", group.BusinessID, true).
+		Scan(&total).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_group_id", votingGroupID).Msg("Error calculando coeficiente total")
+		return nil, fmt.Errorf("error calculando coeficiente total: %w", err)
+	}
+
+	// Una unidad puede tener varios registros (varias listas del grupo, o como propietario y como
+	// apoderado): se cuenta una sola vez, como propietario si alguno de sus registros lo es
+	presentUnits := r.db.Conn(ctx).
+		Table("horizontal_property.attendance_records ar").
+		Select("ar.property_unit_id, bool_or(ar.attended_as_owner) as as_owner").
+		Joins("JOIN horizontal_property.attendance_lists al ON al.id = ar.attendance_list_id AND al.deleted_at IS NULL").
+		Joins("JOIN horizontal_property.property_units pu ON pu.id = ar.property_unit_id AND pu.deleted_at IS NULL").
+		Where("al.voting_group_id = ? AND ar.deleted_at IS NULL AND pu.business_id = ? AND pu.is_active = ?", votingGroupID, group.BusinessID, true).
+		Where(`ar.attended_as_owner = ? OR (ar.attended_as_proxy = ? AND EXISTS (
+			SELECT 1 FROM horizontal_property.proxies p
+			WHERE p.property_unit_id = ar.property_unit_id AND p.deleted_at IS NULL AND p.is_active = ?
+				AND p.start_date <= NOW() AND (p.end_date IS NULL OR p.end_date >= NOW())
+				AND (ar.proxy_id IS NULL OR ar.proxy_id = p.id)
+		))`, true, true, true).
+		Group("ar.property_unit_id")
+
+	var present struct {
+		OwnerUnits       int
+		OwnerCoefficient float64
+		ProxyUnits       int
+		ProxyCoefficient float64
+	}
+	if err := r.db.Conn(ctx).
+		Table("(?) as present_units", presentUnits).
+		Select(`COUNT(*) FILTER (WHERE present_units.as_owner) as owner_units,
+			COALESCE(SUM(pu.participation_coefficient) FILTER (WHERE present_units.as_owner), 0) as owner_coefficient,
+			COUNT(*) FILTER (WHERE NOT present_units.as_owner) as proxy_units,
+			COALESCE(SUM(pu.participation_coefficient) FILTER (WHERE NOT present_units.as_owner), 0) as proxy_coefficient`).
+		Joins("JOIN horizontal_property.property_units pu ON pu.id = present_units.property_unit_id").
+		Scan(&present).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_group_id", votingGroupID).Msg("Error calculando coeficiente presente")
+		return nil, fmt.Errorf("error calculando coeficiente presente: %w", err)
+	}
+
+	return &domain.QuorumParticipation{
+		TotalUnits:       total.Units,
+		TotalCoefficient: total.Coefficient,
+		OwnerUnits:       present.OwnerUnits,
+		OwnerCoefficient: present.OwnerCoefficient,
+		ProxyUnits:       present.ProxyUnits,
+		ProxyCoefficient: present.ProxyCoefficient,
+	}, nil
+}
+
+func (r *Repository) CreateQuorumSnapshot(ctx context.Context, snapshot domain.VotingQuorumSnapshot) (*domain.VotingQuorumSnapshot, error) {
+	m := &models.VotingQuorumSnapshot{
+		VotingID:           snapshot.VotingID,
+		Event:              snapshot.Event,
+		PresentUnits:       snapshot.PresentUnits,
+		PresentCoefficient: snapshot.PresentCoefficient,
+		TotalUnits:         snapshot.TotalUnits,
+		TotalCoefficient:   snapshot.TotalCoefficient,
+		PresentPercentage:  snapshot.PresentPercentage,
+		WeightedBy:         snapshot.WeightedBy,
+		QuorumPercentage:   snapshot.QuorumPercentage,
+		QuorumMet:          snapshot.QuorumMet,
+		TakenAt:            snapshot.TakenAt,
+	}
+	if err := r.db.Conn(ctx).Create(m).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", snapshot.VotingID).Str("event", snapshot.Event).Msg("Error registrando quórum de votación")
+		return nil, fmt.Errorf("error registrando quórum de votación: %w", err)
+	}
+	return mapQuorumSnapshotToDomain(m), nil
+}
+
+func (r *Repository) ListQuorumSnapshotsByVoting(ctx context.Context, votingID uint) ([]domain.VotingQuorumSnapshot, error) {
+	var rows []models.VotingQuorumSnapshot
+	if err := r.db.Conn(ctx).Where("voting_id = ?", votingID).Order("taken_at ASC, id ASC").Find(&rows).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error listando quórum de votación")
+		return nil, fmt.Errorf("error listando quórum de votación: %w", err)
+	}
+	res := make([]domain.VotingQuorumSnapshot, len(rows))
+	for i := range rows {
+		res[i] = *mapQuorumSnapshotToDomain(&rows[i])
+	}
+	return res, nil
+}
+
+func mapQuorumSnapshotToDomain(m *models.VotingQuorumSnapshot) *domain.VotingQuorumSnapshot {
+	return &domain.VotingQuorumSnapshot{
+		ID:                 m.ID,
+		VotingID:           m.VotingID,
+		Event:              m.Event,
+		PresentUnits:       m.PresentUnits,
+		PresentCoefficient: m.PresentCoefficient,
+		TotalUnits:         m.TotalUnits,
+		TotalCoefficient:   m.TotalCoefficient,
+		PresentPercentage:  m.PresentPercentage,
+		WeightedBy:         m.WeightedBy,
+		QuorumPercentage:   m.QuorumPercentage,
+		QuorumMet:          m.QuorumMet,
+		TakenAt:            m.TakenAt,
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+	"dbpostgres/app/infra/models"
+
+	"gorm.io/gorm"
+)
+
+// CreateSecretVote registra en una misma transacción la participación de la unidad (voto sin
+// opción) y su papeleta anónima, con el coeficiente de la unidad como peso
+func (r *Repository) CreateSecretVote(ctx context.Context, participation domain.Vote, ballot domain.VotingBallot) (*domain.Vote, error) {
+	m := &models.Vote{
+		VotingID:       participation.VotingID,
+		PropertyUnitID: participation.PropertyUnitID,
+		VotedAt:        time.Now(),
+		IPAddress:      participation.IPAddress,
+		UserAgent:      participation.UserAgent,
+		Notes:          participation.Notes,
+	}
+	secret := &models.SecretBallot{
+		ID:         ballot.BallotID,
+		VotingID:   participation.VotingID,
+		Selections: make([]models.SecretBallotSelection, len(ballot.OptionIDs)),
+	}
+	for i, optionID := range ballot.OptionIDs {
+		secret.Selections[i] = models.SecretBallotSelection{VotingOptionID: optionID, Rank: i + 1}
+	}
+
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenVoting(tx, participation.VotingID); err != nil {
+			return err
+		}
+		var unit models.PropertyUnit
+		if err := tx.Select("id", "participation_coefficient").First(&unit, participation.PropertyUnitID).Error; err != nil {
+			return err
+		}
+		if unit.ParticipationCoefficient != nil {
+			secret.Coefficient = *unit.ParticipationCoefficient
+		}
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		return tx.Create(secret).Error
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrVotingNotOpen) || errors.Is(err, domain.ErrVotingClosed) {
+			return nil, err
+		}
+		r.logger.Error().Err(err).Uint("voting_id", participation.VotingID).Msg("Error creando voto secreto")
+		return nil, fmt.Errorf("error creando voto: %w", err)
+	}
+	return r.mapVoteToDomain(m), nil
+}
+
+// ListSecretBallots obtiene las papeletas anónimas de una votación secreta con sus opciones en
+// orden de preferencia
+func (r *Repository) ListSecretBallots(ctx context.Context, votingID uint) ([]domain.VotingBallot, error) {
+	var m []models.SecretBallot
+	if err := r.db.Conn(ctx).
+		Preload("Selections", orderedSelections).
+		Where("voting_id = ?", votingID).
+		Order("id ASC").
+		Find(&m).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error obteniendo papeletas secretas")
+		return nil, fmt.Errorf("error obteniendo papeletas secretas: %w", err)
+	}
+
+	ballots := make([]domain.VotingBallot, len(m))
+	for i, ballot := range m {
+		ballots[i] = domain.VotingBallot{
+			BallotID:    ballot.ID,
+			Coefficient: ballot.Coefficient,
+			OptionIDs:   make([]uint, len(ballot.Selections)),
+		}
+		for j, selection := range ballot.Selections {
+			ballots[i].OptionIDs[j] = selection.VotingOptionID
+		}
+	}
+	return ballots, nil
+}
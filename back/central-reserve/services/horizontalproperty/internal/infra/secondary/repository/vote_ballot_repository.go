@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+	"dbpostgres/app/infra/models"
+
+	"gorm.io/gorm"
+)
+
+// ListVotingBallots obtiene las papeletas de una votación con el coeficiente de cada unidad y sus
+// opciones en orden de preferencia. Los votos emitidos antes de guardar las opciones por papeleta
+// llevan solo su opción única.
+func (r *Repository) ListVotingBallots(ctx context.Context, votingID uint) ([]domain.VotingBallot, error) {
+	type ballotRow struct {
+		VoteID            uint
+		PropertyUnitID    uint
+		Coefficient       float64
+		VotingOptionID    *uint
+		SelectionOptionID *uint
+	}
+
+	var rows []ballotRow
+	err := r.db.Conn(ctx).
+		Table("horizontal_property.votes").
+		Select("votes.id as vote_id, votes.property_unit_id, COALESCE(property_units.participation_coefficient, 0) as coefficient, votes.voting_option_id, vote_selections.voting_option_id as selection_option_id").
+		Joins("LEFT JOIN horizontal_property.vote_selections ON vote_selections.vote_id = votes.id AND vote_selections.deleted_at IS NULL").
+		Joins("LEFT JOIN horizontal_property.property_units ON property_units.id = votes.property_unit_id").
+		Where("votes.voting_id = ? AND votes.deleted_at IS NULL", votingID).
+		Order("votes.id ASC, vote_selections.rank ASC").
+		Scan(&rows).Error
+	if err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error obteniendo papeletas de votación")
+		return nil, fmt.Errorf("error obteniendo papeletas de votación: %w", err)
+	}
+
+	var ballots []domain.VotingBallot
+	for _, row := range rows {
+		if len(ballots) == 0 || ballots[len(ballots)-1].VoteID != row.VoteID {
+			ballots = append(ballots, domain.VotingBallot{
+				VoteID:         row.VoteID,
+				PropertyUnitID: row.PropertyUnitID,
+				Coefficient:    row.Coefficient,
+			})
+		}
+		ballot := &ballots[len(ballots)-1]
+		switch {
+		case row.SelectionOptionID != nil:
+			ballot.OptionIDs = append(ballot.OptionIDs, *row.SelectionOptionID)
+		case row.VotingOptionID != nil:
+			ballot.OptionIDs = []uint{*row.VotingOptionID}
+		}
+	}
+	return ballots, nil
+}
+
+func (r *Repository) CountVotesByVoting(ctx context.Context, votingID uint) (int64, error) {
+	var count int64
+	if err := r.db.Conn(ctx).Model(&models.Vote{}).Where("voting_id = ?", votingID).Count(&count).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error contando votos")
+		return 0, fmt.Errorf("error contando votos: %w", err)
+	}
+	return count, nil
+}
+
+// orderedSelections carga las opciones de cada papeleta en orden de preferencia
+func orderedSelections(db *gorm.DB) *gorm.DB {
+	return db.Order("rank ASC")
+}
+
+// newVoteSelections arma las opciones de la papeleta a guardar; un voto sin lista lleva su opción única
+func newVoteSelections(vote domain.Vote) []models.VoteSelection {
+	optionIDs := vote.OptionIDs
+	if len(optionIDs) == 0 {
+		optionIDs = []uint{vote.VotingOptionID}
+	}
+	selections := make([]models.VoteSelection, len(optionIDs))
+	for i, optionID := range optionIDs {
+		selections[i] = models.VoteSelection{VotingOptionID: optionID, Rank: i + 1}
+	}
+	return selections
+}
+
+// voteOptionIDs devuelve las opciones de la papeleta cargadas o, si no las tiene, su opción única.
+// El voto de una votación secreta no tiene opciones.
+func voteOptionIDs(m *models.Vote) []uint {
+	if len(m.Selections) == 0 {
+		if m.VotingOptionID == nil {
+			return nil
+		}
+		return []uint{*m.VotingOptionID}
+	}
+	optionIDs := make([]uint, len(m.Selections))
+	for i, selection := range m.Selections {
+		optionIDs[i] = selection.VotingOptionID
+	}
+	return optionIDs
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"dbpostgres/app/infra/models"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -19,16 +20,17 @@ import (
 
 func (r *Repository) CreateVotingGroup(ctx context.Context, group *domain.VotingGroup) (*domain.VotingGroup, error) {
 	m := &models.VotingGroup{
-		BusinessID:       group.BusinessID,
-		Name:             group.Name,
-		Description:      group.Description,
-		VotingStartDate:  group.VotingStartDate,
-		VotingEndDate:    group.VotingEndDate,
-		IsActive:         true,
-		RequiresQuorum:   group.RequiresQuorum,
-		QuorumPercentage: group.QuorumPercentage,
-		CreatedByUserID:  group.CreatedByUserID,
-		Notes:            group.Notes,
+		BusinessID:               group.BusinessID,
+		Name:                     group.Name,
+		Description:              group.Description,
+		VotingStartDate:          group.VotingStartDate,
+		VotingEndDate:            group.VotingEndDate,
+		IsActive:                 true,
+		RequiresQuorum:           group.RequiresQuorum,
+		QuorumPercentage:         group.QuorumPercentage,
+		AllowVotingWithoutQuorum: group.AllowVotingWithoutQuorum,
+		CreatedByUserID:          group.CreatedByUserID,
+		Notes:                    group.Notes,
 	}
 	if err := r.db.Conn(ctx).Create(m).Error; err != nil {
 		r.logger.Error().Err(err).Msg("Error creando grupo de votación")
@@ -78,6 +80,7 @@ func (r *Repository) UpdateVotingGroup(ctx context.Context, id uint, group *doma
 	existing.VotingEndDate = group.VotingEndDate
 	existing.RequiresQuorum = group.RequiresQuorum
 	existing.QuorumPercentage = group.QuorumPercentage
+	existing.AllowVotingWithoutQuorum = group.AllowVotingWithoutQuorum
 	existing.Notes = group.Notes
 	if err := r.db.Conn(ctx).Save(&existing).Error; err != nil {
 		r.logger.Error().Err(err).Uint("id", id).Msg("Error actualizando grupo de votación")
@@ -106,9 +109,17 @@ func (r *Repository) CreateVoting(ctx context.Context, voting *domain.Voting) (*
 		VotingType:         voting.VotingType,
 		IsSecret:           voting.IsSecret,
 		AllowAbstention:    voting.AllowAbstention,
-		IsActive:           true,
+		IsActive:           voting.Status == domain.VotingStatusOpen,
 		DisplayOrder:       voting.DisplayOrder,
 		RequiredPercentage: voting.RequiredPercentage,
+		MajorityBase:       voting.MajorityBase,
+		MinSelections:      voting.MinSelections,
+		MaxSelections:      voting.MaxSelections,
+		Seats:              voting.Seats,
+		Status:             voting.Status,
+		ScheduledOpenAt:    voting.ScheduledOpenAt,
+		ScheduledCloseAt:   voting.ScheduledCloseAt,
+		OpenedAt:           voting.OpenedAt,
 	}
 	if err := r.db.Conn(ctx).Create(m).Error; err != nil {
 		r.logger.Error().Err(err).Msg("Error creando votación")
@@ -157,6 +168,12 @@ func (r *Repository) UpdateVoting(ctx context.Context, id uint, voting *domain.V
 	existing.AllowAbstention = voting.AllowAbstention
 	existing.DisplayOrder = voting.DisplayOrder
 	existing.RequiredPercentage = voting.RequiredPercentage
+	existing.MajorityBase = voting.MajorityBase
+	existing.MinSelections = voting.MinSelections
+	existing.MaxSelections = voting.MaxSelections
+	existing.Seats = voting.Seats
+	existing.ScheduledOpenAt = voting.ScheduledOpenAt
+	existing.ScheduledCloseAt = voting.ScheduledCloseAt
 	if err := r.db.Conn(ctx).Save(&existing).Error; err != nil {
 		r.logger.Error().Err(err).Uint("id", id).Msg("Error actualizando votación")
 		return nil, fmt.Errorf("error actualizando votación: %w", err)
@@ -164,22 +181,6 @@ func (r *Repository) UpdateVoting(ctx context.Context, id uint, voting *domain.V
 	return r.mapVotingToDomain(&existing), nil
 }
 
-func (r *Repository) DeactivateVoting(ctx context.Context, id uint) error {
-	if err := r.db.Conn(ctx).Model(&models.Voting{}).Where("id = ?", id).Update("is_active", false).Error; err != nil {
-		r.logger.Error().Err(err).Uint("id", id).Msg("Error desactivando votación")
-		return fmt.Errorf("error desactivando votación: %w", err)
-	}
-	return nil
-}
-
-func (r *Repository) ActivateVoting(ctx context.Context, id uint) error {
-	if err := r.db.Conn(ctx).Model(&models.Voting{}).Where("id = ?", id).Update("is_active", true).Error; err != nil {
-		r.logger.Error().Err(err).Uint("id", id).Msg("Error activando votación")
-		return fmt.Errorf("error activando votación: %w", err)
-	}
-	return nil
-}
-
 func (r *Repository) DeleteVoting(ctx context.Context, id uint) error {
 	if err := r.db.Conn(ctx).Delete(&models.Voting{}, id).Error; err != nil {
 		r.logger.Error().Err(err).Uint("id", id).Msg("Error eliminando votación")
@@ -208,6 +209,18 @@ func (r *Repository) CreateVotingOption(ctx context.Context, option *domain.Voti
 	return r.mapVotingOptionToDomain(m), nil
 }
 
+func (r *Repository) GetVotingOptionByID(ctx context.Context, id uint) (*domain.VotingOption, error) {
+	var m models.VotingOption
+	if err := r.db.Conn(ctx).First(&m, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("opción de votación no encontrada")
+		}
+		r.logger.Error().Err(err).Uint("id", id).Msg("Error obteniendo opción de votación")
+		return nil, fmt.Errorf("error obteniendo opción de votación: %w", err)
+	}
+	return r.mapVotingOptionToDomain(&m), nil
+}
+
 func (r *Repository) ListVotingOptionsByVoting(ctx context.Context, votingID uint) ([]domain.VotingOption, error) {
 	var m []models.VotingOption
 	if err := r.db.Conn(ctx).Where("voting_id = ?", votingID).Order("display_order ASC, id ASC").Find(&m).Error; err != nil {
@@ -234,16 +247,28 @@ func (r *Repository) DeactivateVotingOption(ctx context.Context, id uint) error
 // ───────────────────────────────────────────
 
 func (r *Repository) CreateVote(ctx context.Context, vote domain.Vote) (*domain.Vote, error) {
+	optionID := vote.VotingOptionID
 	m := &models.Vote{
 		VotingID:       vote.VotingID,
 		PropertyUnitID: vote.PropertyUnitID,
-		VotingOptionID: vote.VotingOptionID,
+		VotingOptionID: &optionID,
 		VotedAt:        time.Now(),
 		IPAddress:      vote.IPAddress,
 		UserAgent:      vote.UserAgent,
 		Notes:          vote.Notes,
+		Selections:     newVoteSelections(vote),
 	}
-	if err := r.db.Conn(ctx).Create(m).Error; err != nil {
+	// El voto se guarda con la votación bloqueada para que no entre después de su cierre
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenVoting(tx, vote.VotingID); err != nil {
+			return err
+		}
+		return tx.Create(m).Error
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrVotingNotOpen) || errors.Is(err, domain.ErrVotingClosed) {
+			return nil, err
+		}
 		r.logger.Error().Err(err).Uint("voting_id", vote.VotingID).Uint("property_unit_id", vote.PropertyUnitID).Msg("Error creando voto")
 		return nil, fmt.Errorf("error creando voto: %w", err)
 	}
@@ -259,7 +284,7 @@ func (r *Repository) CreateVote(ctx context.Context, vote domain.Vote) (*domain.
 
 func (r *Repository) GetVoteByID(ctx context.Context, voteID uint) (*domain.Vote, error) {
 	var m models.Vote
-	if err := r.db.Conn(ctx).Preload("VotingOption").First(&m, voteID).Error; err != nil {
+	if err := r.db.Conn(ctx).Preload("VotingOption").Preload("Selections", orderedSelections).First(&m, voteID).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("voto no encontrado")
 		}
@@ -291,23 +316,32 @@ func (r *Repository) DeleteVote(ctx context.Context, voteID uint) error {
 	fmt.Printf("   Property Unit ID: %d\n", existingVote.PropertyUnitID)
 	fmt.Printf("   Eliminando permanentemente...\n")
 
-	// Hard delete - eliminar permanentemente de la base de datos
-	result := r.db.Conn(ctx).Unscoped().Delete(&models.Vote{}, voteID)
-	if result.Error != nil {
-		fmt.Printf("❌ [REPOSITORY] DeleteVote - Error eliminando: %v\n", result.Error)
-		r.logger.Error().Err(result.Error).Uint("vote_id", voteID).Msg("Error eliminando voto")
-		return fmt.Errorf("error eliminando voto: %w", result.Error)
+	// Hard delete - eliminar permanentemente de la base de datos, solo con la votación abierta
+	var rowsAffected int64
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenVoting(tx, existingVote.VotingID); err != nil {
+			return err
+		}
+		result := tx.Unscoped().Delete(&models.Vote{}, voteID)
+		rowsAffected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrVotingNotOpen) || errors.Is(err, domain.ErrVotingClosed) {
+			return err
+		}
+		r.logger.Error().Err(err).Uint("vote_id", voteID).Msg("Error eliminando voto")
+		return fmt.Errorf("error eliminando voto: %w", err)
 	}
 
-	if result.RowsAffected == 0 {
+	if rowsAffected == 0 {
 		fmt.Printf("⚠️ [REPOSITORY] DeleteVote - No se eliminó ningún registro\n")
 		return fmt.Errorf("no se eliminó ningún voto")
 	}
 
 	fmt.Printf("✅ [REPOSITORY] DeleteVote - Voto eliminado exitosamente\n")
-	fmt.Printf("   Rows affected: %d\n", result.RowsAffected)
 
-	r.logger.Info().Uint("vote_id", voteID).Int64("rows_affected", result.RowsAffected).Msg("Voto eliminado permanentemente")
+	r.logger.Info().Uint("vote_id", voteID).Int64("rows_affected", rowsAffected).Msg("Voto eliminado permanentemente")
 	return nil
 }
 
@@ -324,6 +358,7 @@ func (r *Repository) GetUnitVote(ctx context.Context, votingID, propertyUnitID u
 	var m models.Vote
 	if err := r.db.Conn(ctx).
 		Preload("VotingOption").
+		Preload("Selections", orderedSelections).
 		Where("voting_id = ? AND property_unit_id = ?", votingID, propertyUnitID).
 		First(&m).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -342,14 +377,16 @@ func (r *Repository) GetVotingResults(ctx context.Context, votingID uint) ([]dom
 		OptionCode     string
 		Color          string
 		VoteCount      int
+		Coefficient    float64
 		DisplayOrder   int
 	}
 
 	var results []ResultRow
 	err := r.db.Conn(ctx).
 		Table("horizontal_property.voting_options").
-		Select("voting_options.id as voting_option_id, voting_options.option_text, voting_options.option_code, voting_options.color, voting_options.display_order, COUNT(votes.id) as vote_count").
-		Joins("LEFT JOIN horizontal_property.votes ON votes.voting_option_id = voting_options.id AND votes.voting_id = ?", votingID).
+		Select("voting_options.id as voting_option_id, voting_options.option_text, voting_options.option_code, voting_options.color, voting_options.display_order, COUNT(votes.id) as vote_count, COALESCE(SUM(property_units.participation_coefficient), 0) as coefficient").
+		Joins("LEFT JOIN horizontal_property.votes ON votes.voting_option_id = voting_options.id AND votes.voting_id = ? AND votes.deleted_at IS NULL", votingID).
+		Joins("LEFT JOIN horizontal_property.property_units ON property_units.id = votes.property_unit_id").
 		Where("voting_options.voting_id = ? AND voting_options.is_active = ?", votingID, true).
 		Group("voting_options.id, voting_options.option_text, voting_options.option_code, voting_options.color, voting_options.display_order").
 		Order("voting_options.display_order ASC").
@@ -360,32 +397,96 @@ func (r *Repository) GetVotingResults(ctx context.Context, votingID uint) ([]dom
 		return nil, fmt.Errorf("error obteniendo resultados de votación: %w", err)
 	}
 
-	// Calcular total de votos y porcentajes
+	// Calcular totales de votos y de coeficiente
 	totalVotes := 0
+	totalCoefficient := 0.0
 	for _, result := range results {
 		totalVotes += result.VoteCount
+		totalCoefficient += result.Coefficient
 	}
 
-	// Construir DTOs con porcentajes
+	// Construir DTOs con porcentajes por unidades y por coeficiente
 	dtos := make([]domain.VotingResultDTO, len(results))
 	for i, result := range results {
 		percentage := 0.0
 		if totalVotes > 0 {
 			percentage = (float64(result.VoteCount) / float64(totalVotes)) * 100
 		}
+		coefficientPercentage := 0.0
+		if totalCoefficient > 0 {
+			coefficientPercentage = (result.Coefficient / totalCoefficient) * 100
+		}
 		dtos[i] = domain.VotingResultDTO{
-			VotingOptionID: result.VotingOptionID,
-			OptionText:     result.OptionText,
-			OptionCode:     result.OptionCode,
-			Color:          result.Color,
-			VoteCount:      result.VoteCount,
-			Percentage:     percentage,
+			VotingOptionID:        result.VotingOptionID,
+			OptionText:            result.OptionText,
+			OptionCode:            result.OptionCode,
+			Color:                 result.Color,
+			VoteCount:             result.VoteCount,
+			Percentage:            percentage,
+			Coefficient:           result.Coefficient,
+			CoefficientPercentage: coefficientPercentage,
 		}
 	}
 
 	return dtos, nil
 }
 
+// GetVotingParticipation obtiene las unidades y coeficientes de la copropiedad y de los presentes.
+// Presentes son las unidades que asistieron según la lista del grupo (propietario o apoderado) más
+// las que votaron, ya que quien vota está presente aunque no se haya registrado su asistencia.
+func (r *Repository) GetVotingParticipation(ctx context.Context, votingID uint) (*domain.VotingParticipation, error) {
+	var voting models.Voting
+	if err := r.db.Conn(ctx).Preload("VotingGroup").First(&voting, votingID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("votación no encontrada")
+		}
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error obteniendo votación")
+		return nil, fmt.Errorf("error obteniendo votación: %w", err)
+	}
+
+	type participationRow struct {
+		Units       int
+		Coefficient float64
+	}
+
+	var total participationRow
+	if err := r.db.Conn(ctx).
+		Model(&models.PropertyUnit{}).
+		Select("COUNT(*) as units, COALESCE(SUM(participation_coefficient), 0) as coefficient").
+		Where("business_id = ? AND is_active = ?", voting.VotingGroup.BusinessID, true).
+		Scan(&total).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error calculando coeficiente total")
+		return nil, fmt.Errorf("error calculando coeficiente total: %w", err)
+	}
+
+	var present participationRow
+	if err := r.db.Conn(ctx).
+		Model(&models.PropertyUnit{}).
+		Select("COUNT(*) as units, COALESCE(SUM(participation_coefficient), 0) as coefficient").
+		Where(`id IN (
+			SELECT ar.property_unit_id
+			FROM horizontal_property.attendance_records ar
+			JOIN horizontal_property.attendance_lists al ON al.id = ar.attendance_list_id
+			WHERE al.voting_group_id = ? AND al.deleted_at IS NULL AND ar.deleted_at IS NULL
+				AND (ar.attended_as_owner = ? OR ar.attended_as_proxy = ?)
+			UNION
+			SELECT v.property_unit_id
+			FROM horizontal_property.votes v
+			WHERE v.voting_id = ? AND v.deleted_at IS NULL
+		)`, voting.VotingGroupID, true, true, votingID).
+		Scan(&present).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error calculando coeficiente presente")
+		return nil, fmt.Errorf("error calculando coeficiente presente: %w", err)
+	}
+
+	return &domain.VotingParticipation{
+		TotalUnits:         total.Units,
+		TotalCoefficient:   total.Coefficient,
+		PresentUnits:       present.Units,
+		PresentCoefficient: present.Coefficient,
+	}, nil
+}
+
 func (r *Repository) GetVotingDetailsByUnit(ctx context.Context, votingID, hpID uint) ([]domain.VotingDetailByUnitDTO, error) {
 	// PASO 0: Validar que la votación pertenezca al business_id correcto
 	votingBusinessID, err := r.getBusinessIDByVotingID(ctx, votingID)
@@ -479,7 +580,7 @@ func (r *Repository) GetVotingDetailsByUnit(ctx context.Context, votingID, hpID
 		// Verificar si la unidad ha votado
 		if vote, hasVoted := voteByUnit[unit.ID]; hasVoted {
 			dto.HasVoted = true
-			dto.VotingOptionID = &vote.VotingOptionID
+			dto.VotingOptionID = vote.VotingOptionID
 
 			if vote.VotingOption.ID != 0 {
 				dto.OptionText = &vote.VotingOption.OptionText
@@ -584,7 +685,7 @@ func (r *Repository) GetUnitsWithResidents(ctx context.Context, hpID uint) ([]do
 
 func (r *Repository) ListVotesByVoting(ctx context.Context, votingID uint) ([]domain.Vote, error) {
 	var m []models.Vote
-	if err := r.db.Conn(ctx).Where("voting_id = ?", votingID).Order("voted_at DESC").Find(&m).Error; err != nil {
+	if err := r.db.Conn(ctx).Preload("Selections", orderedSelections).Where("voting_id = ?", votingID).Order("voted_at DESC").Find(&m).Error; err != nil {
 		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error listando votos")
 		return nil, fmt.Errorf("error listando votos: %w", err)
 	}
@@ -601,19 +702,20 @@ func (r *Repository) ListVotesByVoting(ctx context.Context, votingID uint) ([]do
 
 func (r *Repository) mapVotingGroupToDomain(m *models.VotingGroup) *domain.VotingGroup {
 	return &domain.VotingGroup{
-		ID:               m.ID,
-		BusinessID:       m.BusinessID,
-		Name:             m.Name,
-		Description:      m.Description,
-		VotingStartDate:  m.VotingStartDate,
-		VotingEndDate:    m.VotingEndDate,
-		IsActive:         m.IsActive,
-		RequiresQuorum:   m.RequiresQuorum,
-		QuorumPercentage: m.QuorumPercentage,
-		CreatedByUserID:  m.CreatedByUserID,
-		Notes:            m.Notes,
-		CreatedAt:        m.CreatedAt,
-		UpdatedAt:        m.UpdatedAt,
+		ID:                       m.ID,
+		BusinessID:               m.BusinessID,
+		Name:                     m.Name,
+		Description:              m.Description,
+		VotingStartDate:          m.VotingStartDate,
+		VotingEndDate:            m.VotingEndDate,
+		IsActive:                 m.IsActive,
+		RequiresQuorum:           m.RequiresQuorum,
+		QuorumPercentage:         m.QuorumPercentage,
+		AllowVotingWithoutQuorum: m.AllowVotingWithoutQuorum,
+		CreatedByUserID:          m.CreatedByUserID,
+		Notes:                    m.Notes,
+		CreatedAt:                m.CreatedAt,
+		UpdatedAt:                m.UpdatedAt,
 	}
 }
 
@@ -629,6 +731,17 @@ func (r *Repository) mapVotingToDomain(m *models.Voting) *domain.Voting {
 		IsActive:           m.IsActive,
 		DisplayOrder:       m.DisplayOrder,
 		RequiredPercentage: m.RequiredPercentage,
+		MajorityBase:       m.MajorityBase,
+		MinSelections:      m.MinSelections,
+		MaxSelections:      m.MaxSelections,
+		Seats:              m.Seats,
+		Status:             m.Status,
+		ScheduledOpenAt:    m.ScheduledOpenAt,
+		ScheduledCloseAt:   m.ScheduledCloseAt,
+		OpenedAt:           m.OpenedAt,
+		ClosedAt:           m.ClosedAt,
+		CertifiedAt:        m.CertifiedAt,
+		CertifiedByUserID:  m.CertifiedByUserID,
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
 	}
@@ -651,13 +764,17 @@ func (r *Repository) mapVoteToDomain(m *models.Vote) *domain.Vote {
 		ID:             m.ID,
 		VotingID:       m.VotingID,
 		PropertyUnitID: m.PropertyUnitID,
-		VotingOptionID: m.VotingOptionID,
+		OptionIDs:      voteOptionIDs(m),
 		VotedAt:        m.VotedAt,
 		IPAddress:      m.IPAddress,
 		UserAgent:      m.UserAgent,
 		Notes:          m.Notes,
 	}
 
+	if m.VotingOptionID != nil {
+		vote.VotingOptionID = *m.VotingOptionID
+	}
+
 	// Agregar información de la opción si está cargada (Preload)
 	if m.VotingOption.ID != 0 {
 		vote.OptionText = m.VotingOption.OptionText
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"central_reserve/services/horizontalproperty/internal/domain"
+	"dbpostgres/app/infra/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TransitionVoting cambia el estado de la votación si está en uno de los estados de origen y
+// devuelve false si ya no lo estaba (otro cierre o apertura se adelantó)
+func (r *Repository) TransitionVoting(ctx context.Context, transition domain.VotingTransition) (bool, error) {
+	updates := map[string]any{
+		"status":    transition.To,
+		"is_active": transition.To == domain.VotingStatusOpen,
+	}
+	switch transition.To {
+	case domain.VotingStatusOpen:
+		updates["opened_at"] = transition.At
+	case domain.VotingStatusClosed:
+		updates["closed_at"] = transition.At
+	case domain.VotingStatusCertified:
+		updates["certified_at"] = transition.At
+		updates["certified_by_user_id"] = transition.UserID
+	}
+
+	result := r.db.Conn(ctx).Model(&models.Voting{}).
+		Where("id = ? AND status IN ?", transition.VotingID, transition.From).
+		Updates(updates)
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("id", transition.VotingID).Str("status", transition.To).Msg("Error cambiando estado de votación")
+		return false, fmt.Errorf("error cambiando estado de votación: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ListVotingsDueToOpen lista los borradores cuya apertura programada ya llegó
+func (r *Repository) ListVotingsDueToOpen(ctx context.Context, now time.Time) ([]domain.Voting, error) {
+	return r.listVotingsDue(ctx, "status = ? AND scheduled_open_at <= ?", domain.VotingStatusDraft, now)
+}
+
+// ListVotingsDueToClose lista las votaciones abiertas cuyo cierre programado ya llegó
+func (r *Repository) ListVotingsDueToClose(ctx context.Context, now time.Time) ([]domain.Voting, error) {
+	return r.listVotingsDue(ctx, "status = ? AND scheduled_close_at <= ?", domain.VotingStatusOpen, now)
+}
+
+func (r *Repository) listVotingsDue(ctx context.Context, query string, status string, now time.Time) ([]domain.Voting, error) {
+	var m []models.Voting
+	if err := r.db.Conn(ctx).Where(query, status, now).Order("id ASC").Find(&m).Error; err != nil {
+		r.logger.Error().Err(err).Str("status", status).Msg("Error listando votaciones programadas")
+		return nil, fmt.Errorf("error listando votaciones programadas: %w", err)
+	}
+	res := make([]domain.Voting, len(m))
+	for i := range m {
+		res[i] = *r.mapVotingToDomain(&m[i])
+	}
+	return res, nil
+}
+
+func (r *Repository) GetBusinessTimezone(ctx context.Context, businessID uint) (string, error) {
+	var business models.Business
+	if err := r.db.Conn(ctx).Select("id", "timezone").First(&business, businessID).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error obteniendo zona horaria de la propiedad")
+		return "", fmt.Errorf("error obteniendo zona horaria de la propiedad: %w", err)
+	}
+	return business.Timezone, nil
+}
+
+// lockOpenVoting bloquea la votación dentro de la transacción y verifica que siga abierta. El
+// cierre actualiza la misma fila, así que espera a que terminen los votos en curso.
+func lockOpenVoting(tx *gorm.DB, votingID uint) error {
+	var voting models.Voting
+	if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Select("id", "status").First(&voting, votingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("votación no encontrada")
+		}
+		return err
+	}
+	switch voting.Status {
+	case domain.VotingStatusOpen:
+		return nil
+	case domain.VotingStatusClosed, domain.VotingStatusCertified:
+		return domain.ErrVotingClosed
+	default:
+		return domain.ErrVotingNotOpen
+	}
+}
+
+// ───────────────────────────────────────────
+// Voting Result Snapshots
+// ───────────────────────────────────────────
+
+func (r *Repository) CreateVotingResultSnapshot(ctx context.Context, snapshot domain.VotingResultSnapshot) (*domain.VotingResultSnapshot, error) {
+	tally, err := json.Marshal(snapshot.Tally)
+	if err != nil {
+		return nil, fmt.Errorf("error serializando resultados de votación: %w", err)
+	}
+	m := &models.VotingResultSnapshot{
+		VotingID:           snapshot.VotingID,
+		Outcome:            snapshot.Tally.Outcome,
+		ApprovalPercentage: snapshot.Tally.ApprovalPercentage,
+		VotedUnits:         snapshot.Tally.VotedUnits,
+		VotedCoefficient:   snapshot.Tally.VotedCoefficient,
+		Tally:              string(tally),
+		ClosedAt:           snapshot.ClosedAt,
+	}
+	if err := r.db.Conn(ctx).Create(m).Error; err != nil {
+		r.logger.Error().Err(err).Uint("voting_id", snapshot.VotingID).Msg("Error registrando resultados de votación")
+		return nil, fmt.Errorf("error registrando resultados de votación: %w", err)
+	}
+	return mapVotingResultSnapshotToDomain(m)
+}
+
+// GetVotingResultSnapshot devuelve los resultados registrados al cierre, o nil si la votación no
+// tiene (sigue abierta o se cerró antes de que se registraran)
+func (r *Repository) GetVotingResultSnapshot(ctx context.Context, votingID uint) (*domain.VotingResultSnapshot, error) {
+	var m models.VotingResultSnapshot
+	if err := r.db.Conn(ctx).Where("voting_id = ?", votingID).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Uint("voting_id", votingID).Msg("Error obteniendo resultados registrados de votación")
+		return nil, fmt.Errorf("error obteniendo resultados registrados de votación: %w", err)
+	}
+	return mapVotingResultSnapshotToDomain(&m)
+}
+
+func mapVotingResultSnapshotToDomain(m *models.VotingResultSnapshot) (*domain.VotingResultSnapshot, error) {
+	snapshot := &domain.VotingResultSnapshot{
+		ID:       m.ID,
+		VotingID: m.VotingID,
+		ClosedAt: m.ClosedAt,
+	}
+	if err := json.Unmarshal([]byte(m.Tally), &snapshot.Tally); err != nil {
+		return nil, fmt.Errorf("error leyendo resultados registrados de votación: %w", err)
+	}
+	return snapshot, nil
+}
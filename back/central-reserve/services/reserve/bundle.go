@@ -7,14 +7,21 @@ import (
 	"central_reserve/shared/db"
 	"central_reserve/shared/email"
 	"central_reserve/shared/env"
+	"central_reserve/shared/jwt"
 	"central_reserve/shared/log"
+	"context"
 
 	"github.com/gin-gonic/gin"
 )
 
 func New(db db.IDatabase, env env.IConfig, logger log.ILogger, email email.IEmailService, v1Group *gin.RouterGroup) {
 	repository := repository.New(db, logger)
-	usecasereserve := usecasereserve.New(repository, email, logger)
+	tokens := jwt.New(env.Get("JWT_SECRET"))
+	usecasereserve := usecasereserve.New(repository, email, env, tokens, logger)
 	handler := reservehandler.New(usecasereserve, logger)
 	reservehandler.RegisterRoutes(v1Group, handler, logger)
+
+	// Tareas en segundo plano: ofertas de lista de espera vencidas, outbox de emails,
+	// recordatorios y no-shows automáticos
+	go usecasereserve.RunScheduler(context.Background())
 }
@@ -0,0 +1,64 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// AcceptWaitlistOffer convierte la oferta en una reserva. Si el horario volvió a ocuparse,
+// la entrada regresa a la lista de espera con su prioridad original.
+func (u *ReserveUseCase) AcceptWaitlistOffer(ctx context.Context, token string) (*domain.ReserveDetailDTO, error) {
+	entry, err := u.getOfferEntry(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status == domain.WaitlistStatusExpired {
+		return nil, domain.ErrWaitlistOfferExpired
+	}
+	if entry.Status != domain.WaitlistStatusOffered {
+		return nil, domain.ErrWaitlistInvalidTransition
+	}
+	if entry.OfferExpiresAt != nil && !time.Now().Before(*entry.OfferExpiresAt) {
+		if _, err := u.expireOffer(ctx, *entry); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrWaitlistOfferExpired
+	}
+
+	// Reclamar la oferta antes de crear la reserva para que un doble clic no reserve dos veces
+	claimed, err := u.repository.UpdateWaitlistStatus(ctx, entry.ID, domain.WaitlistStatusOffered, domain.WaitlistStatusAccepted, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error al aceptar oferta de lista de espera: %w", err)
+	}
+	if !claimed {
+		return nil, domain.ErrWaitlistInvalidTransition
+	}
+
+	dni := ""
+	if entry.ClientDni != nil {
+		dni = *entry.ClientDni
+	}
+	reservation, err := u.CreateReserve(ctx, domain.Reservation{
+		BusinessID:     entry.BusinessID,
+		RoomID:         entry.RoomID,
+		StartAt:        entry.StartAt,
+		EndAt:          entry.EndAt,
+		NumberOfGuests: entry.NumberOfGuests,
+	}, entry.ClientName, entry.ClientEmail, entry.ClientPhone, dni)
+	if err != nil {
+		u.log.Warn().Err(err).Uint("waitlist_id", entry.ID).Msg("No se pudo crear la reserva de la oferta, la entrada vuelve a la lista de espera")
+		if _, revertErr := u.repository.UpdateWaitlistStatus(ctx, entry.ID, domain.WaitlistStatusAccepted, domain.WaitlistStatusWaiting, nil); revertErr != nil {
+			u.log.Error().Err(revertErr).Uint("waitlist_id", entry.ID).Msg("Error al devolver entrada a la lista de espera")
+		}
+		return nil, err
+	}
+
+	if _, err := u.repository.UpdateWaitlistStatus(ctx, entry.ID, domain.WaitlistStatusAccepted, domain.WaitlistStatusAccepted, &reservation.ReservaID); err != nil {
+		u.log.Error().Err(err).Uint("waitlist_id", entry.ID).Uint("reservation_id", reservation.ReservaID).Msg("Error al vincular reserva con lista de espera")
+	}
+
+	u.log.Info().Uint("waitlist_id", entry.ID).Uint("reservation_id", reservation.ReservaID).Msg("Oferta de lista de espera aceptada")
+	return reservation, nil
+}
@@ -0,0 +1,170 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"sort"
+)
+
+// maxAssignAttempts es el número de intentos de auto-asignación cuando otra reserva
+// concurrente ocupa la mesa elegida entre el cálculo y la inserción
+const maxAssignAttempts = 3
+
+// tableAssignment es el resultado de la auto-asignación de mesas
+type tableAssignment struct {
+	TableIDs []uint
+	RoomID   *uint
+}
+
+// assignTables busca la mesa libre que mejor se ajusta al número de invitados y, si ninguna
+// mesa individual alcanza, una combinación de mesas adyacentes de la misma sala.
+// Retorna nil si el negocio (o la sala solicitada) no tiene mesas configuradas.
+func (u *ReserveUseCase) assignTables(ctx context.Context, reservation domain.Reservation) (*tableAssignment, error) {
+	tables, err := u.repository.GetTablesByBusiness(ctx, reservation.BusinessID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener mesas: %w", err)
+	}
+	if len(tables) == 0 {
+		return nil, nil
+	}
+	if reservation.RoomID != nil && !hasTablesInRoom(tables, *reservation.RoomID) {
+		// Sala sin mesas: la reserva se registra a nivel de sala
+		return nil, nil
+	}
+
+	reservations, err := u.repository.GetBlockingReservations(ctx, reservation.BusinessID, reservation.StartAt, reservation.EndAt)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener reservas: %w", err)
+	}
+
+	occupied := make(map[uint]bool)
+	for _, r := range reservations {
+		for _, tableID := range r.TableIDs {
+			occupied[tableID] = true
+		}
+	}
+
+	free := make([]domain.Table, 0, len(tables))
+	for _, table := range tables {
+		if !occupied[table.ID] {
+			free = append(free, table)
+		}
+	}
+
+	selected := selectTables(free, reservation.NumberOfGuests, reservation.RoomID)
+	if len(selected) == 0 {
+		return nil, domain.ErrNoTableAvailable
+	}
+
+	assignment := &tableAssignment{TableIDs: make([]uint, len(selected)), RoomID: selected[0].RoomID}
+	for i, table := range selected {
+		assignment.TableIDs[i] = table.ID
+	}
+	return assignment, nil
+}
+
+// selectTables elige la mesa o combinación de mesas para el número de invitados:
+//  1. la mesa individual de menor capacidad que alcance, prefiriendo la sala solicitada;
+//  2. si ninguna alcanza, la combinación de mesas adyacentes (misma sala y números consecutivos)
+//     con menos mesas y menor capacidad sobrante, prefiriendo la sala solicitada.
+func selectTables(free []domain.Table, guests int, preferredRoomID *uint) []domain.Table {
+	if preferredRoomID != nil {
+		inRoom := make([]domain.Table, 0)
+		for _, table := range free {
+			if sameRoom(table.RoomID, preferredRoomID) {
+				inRoom = append(inRoom, table)
+			}
+		}
+		if best := bestSingleTable(inRoom, guests); best != nil {
+			return []domain.Table{*best}
+		}
+		if combo := bestCombination(inRoom, guests); combo != nil {
+			return combo
+		}
+	}
+
+	if best := bestSingleTable(free, guests); best != nil {
+		return []domain.Table{*best}
+	}
+	return bestCombination(free, guests)
+}
+
+// bestSingleTable retorna la mesa de menor capacidad que alcanza para los invitados
+func bestSingleTable(tables []domain.Table, guests int) *domain.Table {
+	var best *domain.Table
+	for i := range tables {
+		table := &tables[i]
+		if table.Capacity < guests {
+			continue
+		}
+		if best == nil || table.Capacity < best.Capacity ||
+			(table.Capacity == best.Capacity && table.Number < best.Number) {
+			best = table
+		}
+	}
+	return best
+}
+
+// bestCombination busca la secuencia de mesas adyacentes con capacidad suficiente.
+// Dos mesas son adyacentes si están en la misma sala y sus números son consecutivos.
+func bestCombination(tables []domain.Table, guests int) []domain.Table {
+	byRoom := make(map[uint][]domain.Table)
+	for _, table := range tables {
+		key := uint(0)
+		if table.RoomID != nil {
+			key = *table.RoomID
+		}
+		byRoom[key] = append(byRoom[key], table)
+	}
+
+	roomKeys := make([]uint, 0, len(byRoom))
+	for key := range byRoom {
+		roomKeys = append(roomKeys, key)
+	}
+	sort.Slice(roomKeys, func(i, j int) bool { return roomKeys[i] < roomKeys[j] })
+
+	var best []domain.Table
+	bestCapacity := 0
+	for _, key := range roomKeys {
+		group := byRoom[key]
+		sort.Slice(group, func(i, j int) bool { return group[i].Number < group[j].Number })
+
+		for start := range group {
+			capacity := 0
+			for end := start; end < len(group); end++ {
+				if end > start && group[end].Number != group[end-1].Number+1 {
+					break
+				}
+				capacity += group[end].Capacity
+				if capacity < guests {
+					continue
+				}
+
+				size := end - start + 1
+				if best == nil || size < len(best) || (size == len(best) && capacity < bestCapacity) {
+					best = append([]domain.Table(nil), group[start:end+1]...)
+					bestCapacity = capacity
+				}
+				break
+			}
+		}
+	}
+	return best
+}
+
+func hasTablesInRoom(tables []domain.Table, roomID uint) bool {
+	for _, table := range tables {
+		if table.RoomID != nil && *table.RoomID == roomID {
+			return true
+		}
+	}
+	return false
+}
+
+func sameRoom(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,162 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"reflect"
+	"testing"
+)
+
+func roomID(id uint) *uint {
+	return &id
+}
+
+func table(id uint, number, capacity int, room *uint) domain.Table {
+	return domain.Table{ID: id, Number: number, Capacity: capacity, RoomID: room}
+}
+
+func tableIDs(tables []domain.Table) []uint {
+	if tables == nil {
+		return nil
+	}
+	ids := make([]uint, len(tables))
+	for i, t := range tables {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestSelectTables(t *testing.T) {
+	terrace, hall := roomID(1), roomID(2)
+
+	tests := []struct {
+		name      string
+		free      []domain.Table
+		guests    int
+		preferred *uint
+		want      []uint
+	}{
+		{
+			name:   "mesa individual de menor capacidad que alcanza",
+			free:   []domain.Table{table(1, 1, 8, nil), table(2, 2, 4, nil), table(3, 3, 2, nil)},
+			guests: 3,
+			want:   []uint{2},
+		},
+		{
+			name:   "a igual capacidad gana el menor número",
+			free:   []domain.Table{table(1, 7, 4, nil), table(2, 3, 4, nil)},
+			guests: 4,
+			want:   []uint{2},
+		},
+		{
+			name:      "prefiere la sala solicitada aunque haya una mesa más ajustada en otra",
+			free:      []domain.Table{table(1, 1, 4, hall), table(2, 2, 6, terrace)},
+			guests:    4,
+			preferred: terrace,
+			want:      []uint{2},
+		},
+		{
+			name:      "en la sala solicitada prefiere combinar antes que cambiar de sala",
+			free:      []domain.Table{table(1, 1, 4, terrace), table(2, 2, 4, terrace), table(3, 1, 8, hall)},
+			guests:    6,
+			preferred: terrace,
+			want:      []uint{1, 2},
+		},
+		{
+			name:      "si la sala solicitada no alcanza usa cualquier otra",
+			free:      []domain.Table{table(1, 1, 2, terrace), table(2, 1, 6, hall)},
+			guests:    6,
+			preferred: terrace,
+			want:      []uint{2},
+		},
+		{
+			name:   "combina mesas cuando ninguna alcanza sola",
+			free:   []domain.Table{table(1, 1, 4, hall), table(2, 2, 4, hall)},
+			guests: 7,
+			want:   []uint{1, 2},
+		},
+		{
+			name:   "sin mesas suficientes",
+			free:   []domain.Table{table(1, 1, 2, hall), table(2, 3, 2, hall)},
+			guests: 4,
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tableIDs(selectTables(tt.free, tt.guests, tt.preferred))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectTables() = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBestCombination(t *testing.T) {
+	terrace, hall := roomID(1), roomID(2)
+
+	tests := []struct {
+		name   string
+		tables []domain.Table
+		guests int
+		want   []uint
+	}{
+		{
+			name:   "mesas consecutivas de la misma sala",
+			tables: []domain.Table{table(1, 1, 4, hall), table(2, 2, 4, hall), table(3, 3, 4, hall)},
+			guests: 8,
+			want:   []uint{1, 2},
+		},
+		{
+			name:   "no combina números no consecutivos",
+			tables: []domain.Table{table(1, 1, 4, hall), table(2, 3, 4, hall)},
+			guests: 8,
+			want:   nil,
+		},
+		{
+			name:   "no combina mesas de salas distintas",
+			tables: []domain.Table{table(1, 1, 4, terrace), table(2, 2, 4, hall)},
+			guests: 8,
+			want:   nil,
+		},
+		{
+			name: "prefiere menos mesas",
+			tables: []domain.Table{
+				table(1, 1, 2, hall), table(2, 2, 2, hall), table(3, 3, 2, hall),
+				table(4, 5, 4, hall), table(5, 6, 4, hall),
+			},
+			guests: 6,
+			want:   []uint{4, 5},
+		},
+		{
+			name: "a igual cantidad de mesas prefiere menos capacidad sobrante",
+			tables: []domain.Table{
+				table(1, 1, 6, hall), table(2, 2, 6, hall),
+				table(3, 1, 4, terrace), table(4, 2, 4, terrace),
+			},
+			guests: 7,
+			want:   []uint{3, 4},
+		},
+		{
+			name:   "desordenadas se ordenan por número",
+			tables: []domain.Table{table(3, 3, 2, hall), table(1, 1, 2, hall), table(2, 2, 2, hall)},
+			guests: 6,
+			want:   []uint{1, 2, 3},
+		},
+		{
+			name:   "mesas sin sala se combinan entre sí",
+			tables: []domain.Table{table(1, 1, 3, nil), table(2, 2, 3, nil)},
+			guests: 5,
+			want:   []uint{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tableIDs(bestCombination(tt.tables, tt.guests))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("bestCombination() = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/email"
+	"central_reserve/shared/ical"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// calendarTexts son los textos de los eventos de calendario por idioma
+var calendarTexts = map[string]struct {
+	inviteSummary, inviteFilename, feedName, feedSummary     string
+	reservation, guests, table, phone, email, status, manage string
+}{
+	"es": {
+		inviteSummary:  "Reserva en %s",
+		inviteFilename: "reserva.ics",
+		feedName:       "Reservas - %s",
+		feedSummary:    "%s (%d personas)",
+		reservation:    "Reserva #%d",
+		guests:         "Personas: %d",
+		table:          "Mesa: %s",
+		phone:          "Teléfono: %s",
+		email:          "Email: %s",
+		status:         "Estado: %s",
+		manage:         "Gestionar mi reserva: %s",
+	},
+	"en": {
+		inviteSummary:  "Reservation at %s",
+		inviteFilename: "reservation.ics",
+		feedName:       "Reservations - %s",
+		feedSummary:    "%s (%d guests)",
+		reservation:    "Reservation #%d",
+		guests:         "Guests: %d",
+		table:          "Table: %s",
+		phone:          "Phone: %s",
+		email:          "Email: %s",
+		status:         "Status: %s",
+		manage:         "Manage my reservation: %s",
+	},
+}
+
+// reservationInvite arma el adjunto .ics de una reserva para el cliente. Con ical.MethodCancel
+// el calendario del cliente elimina el evento que recibió en la confirmación (mismo UID).
+func (u *ReserveUseCase) reservationInvite(branding domain.EmailBranding, reservation domain.Reservation, guestName, guestEmail, manageURL, method string) email.Attachment {
+	texts := calendarTexts[branding.Locale]
+
+	description := []string{
+		fmt.Sprintf(texts.reservation, reservation.ID),
+		fmt.Sprintf(texts.guests, reservation.NumberOfGuests),
+	}
+	if manageURL != "" {
+		description = append(description, fmt.Sprintf(texts.manage, manageURL))
+	}
+
+	status := ical.StatusConfirmed
+	if method == ical.MethodCancel {
+		status = ical.StatusCancelled
+	}
+
+	event := ical.Event{
+		UID:         u.calendarUID(reservation.ID),
+		Sequence:    calendarSequence(reservation.CreatedAt, reservation.UpdatedAt),
+		Stamp:       time.Now(),
+		Start:       reservation.StartAt,
+		End:         reservation.EndAt,
+		Summary:     fmt.Sprintf(texts.inviteSummary, branding.Name),
+		Description: strings.Join(description, "\n"),
+		Location:    branding.Address,
+		URL:         manageURL,
+		Status:      status,
+		Attendees:   []ical.Person{{Name: guestName, Email: guestEmail}},
+	}
+
+	// Una invitación REQUEST/CANCEL requiere organizador (RFC 5546); sin remitente configurado
+	// se publica como evento informativo
+	from := ""
+	if u.env != nil {
+		from = u.env.Get("FROM_EMAIL")
+	}
+	if from != "" {
+		event.Organizer = &ical.Person{Name: branding.Name, Email: from}
+	} else {
+		method = ical.MethodPublish
+	}
+
+	calendar := ical.Calendar{
+		ProdID: domain.CalendarProdID,
+		Method: method,
+		Events: []ical.Event{event},
+	}
+	return email.Attachment{
+		Filename:    texts.inviteFilename,
+		ContentType: ical.ContentType + "; method=" + method,
+		Content:     calendar.Bytes(),
+	}
+}
+
+// feedEvent arma el evento del feed del negocio con los datos que necesita el staff
+func (u *ReserveUseCase) feedEvent(branding domain.EmailBranding, reservation domain.ReserveDetailDTO, stamp time.Time) ical.Event {
+	texts := calendarTexts[branding.Locale]
+
+	description := []string{
+		fmt.Sprintf(texts.reservation, reservation.ReservaID),
+		fmt.Sprintf(texts.guests, reservation.NumberOfGuests),
+	}
+	if tables := feedTables(reservation); tables != "" {
+		description = append(description, fmt.Sprintf(texts.table, tables))
+	}
+	if reservation.ClienteTelefono != "" {
+		description = append(description, fmt.Sprintf(texts.phone, reservation.ClienteTelefono))
+	}
+	if reservation.ClienteEmail != "" {
+		description = append(description, fmt.Sprintf(texts.email, reservation.ClienteEmail))
+	}
+	description = append(description, fmt.Sprintf(texts.status, reservation.EstadoNombre))
+
+	status := ical.StatusConfirmed
+	if reservation.EstadoCodigo == domain.StatusPending {
+		status = ical.StatusTentative
+	}
+
+	return ical.Event{
+		UID:         u.calendarUID(reservation.ReservaID),
+		Sequence:    calendarSequence(reservation.ReservaCreada, reservation.ReservaActualizada),
+		Stamp:       stamp,
+		Start:       reservation.StartAt,
+		End:         reservation.EndAt,
+		Summary:     fmt.Sprintf(texts.feedSummary, reservation.ClienteNombre, reservation.NumberOfGuests),
+		Description: strings.Join(description, "\n"),
+		Location:    branding.Address,
+		Status:      status,
+	}
+}
+
+// feedTables lista los números de las mesas asignadas a una reserva
+func feedTables(reservation domain.ReserveDetailDTO) string {
+	numbers := make([]string, 0, len(reservation.MesasAsignadas))
+	for _, table := range reservation.MesasAsignadas {
+		numbers = append(numbers, fmt.Sprintf("%d", table.Number))
+	}
+	if len(numbers) == 0 && reservation.MesaNumero != nil && *reservation.MesaNumero != 0 {
+		numbers = append(numbers, fmt.Sprintf("%d", *reservation.MesaNumero))
+	}
+	return strings.Join(numbers, ", ")
+}
+
+// calendarUID identifica una reserva en todos los calendarios: la invitación del cliente, su
+// cancelación y el feed del negocio usan el mismo UID para que los clientes actualicen el evento
+func (u *ReserveUseCase) calendarUID(reservationID uint) string {
+	host := "central-reserve"
+	if parsed, err := url.Parse(u.apiBaseURL()); err == nil && parsed.Hostname() != "" {
+		host = parsed.Hostname()
+	}
+	return fmt.Sprintf("reservation-%d@%s", reservationID, host)
+}
+
+// calendarSequence crece con cada modificación de la reserva (segundos desde su creación),
+// lo que permite a los clientes de calendario descartar versiones anteriores del evento
+func calendarSequence(createdAt, updatedAt time.Time) int {
+	if createdAt.IsZero() || !updatedAt.After(createdAt) {
+		return 0
+	}
+	return int(updatedAt.Sub(createdAt) / time.Second)
+}
+
+// apiBaseURL es la URL pública de la API (URL_BASE_SWAGGER), usada en enlaces que no pasan por el front-end
+func (u *ReserveUseCase) apiBaseURL() string {
+	base := ""
+	if u.env != nil {
+		base = u.env.Get("URL_BASE_SWAGGER")
+		if base == "" && u.env.Get("HTTP_PORT") != "" {
+			base = "http://localhost:" + u.env.Get("HTTP_PORT")
+		}
+	}
+	if base == "" {
+		base = "http://localhost:3050" // Default para desarrollo
+	}
+	return strings.TrimRight(base, "/")
+}
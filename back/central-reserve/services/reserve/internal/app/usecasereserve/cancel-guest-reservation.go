@@ -0,0 +1,27 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"strings"
+	"time"
+)
+
+// CancelGuestReservation permite al cliente cancelar su reserva desde el enlace de autogestión
+func (u *ReserveUseCase) CancelGuestReservation(ctx context.Context, token string, reason string) error {
+	reservation, err := u.resolveGuestReservation(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !guestCanModify(*reservation, time.Now()) {
+		return domain.ErrGuestChangeNotAllowed
+	}
+
+	historyReason := "Cancelada por el cliente"
+	if reason = strings.TrimSpace(reason); reason != "" {
+		historyReason += ": " + reason
+	}
+
+	_, err = u.CancelReservation(ctx, reservation.ReservaID, historyReason, nil)
+	return err
+}
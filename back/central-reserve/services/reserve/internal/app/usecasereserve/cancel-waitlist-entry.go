@@ -0,0 +1,37 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+)
+
+// CancelWaitlistEntry retira a un cliente de la lista de espera. businessID = 0 indica super admin.
+// Si tenía una oferta vigente, el horario se ofrece al siguiente en la lista.
+func (u *ReserveUseCase) CancelWaitlistEntry(ctx context.Context, id uint, businessID uint) error {
+	entry, err := u.repository.GetWaitlistEntryByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if businessID != 0 && entry.BusinessID != businessID {
+		return domain.ErrWaitlistEntryNotFound
+	}
+	if entry.Status != domain.WaitlistStatusWaiting && entry.Status != domain.WaitlistStatusOffered {
+		return domain.ErrWaitlistInvalidTransition
+	}
+
+	updated, err := u.repository.UpdateWaitlistStatus(ctx, id, entry.Status, domain.WaitlistStatusCancelled, nil)
+	if err != nil {
+		return fmt.Errorf("error al cancelar entrada de lista de espera: %w", err)
+	}
+	if !updated {
+		return domain.ErrWaitlistInvalidTransition
+	}
+
+	u.log.Info().Uint("waitlist_id", id).Msg("Entrada de lista de espera cancelada")
+
+	if entry.Status == domain.WaitlistStatusOffered {
+		u.offerFreedSlot(ctx, entry.BusinessID, domain.TimeSlot{StartAt: entry.StartAt, EndAt: entry.EndAt})
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+)
+
+// ChangeReservationStatus aplica una transición de estado validada por la máquina de estados.
+// El historial registra el usuario que hizo el cambio (nil si fue automático).
+func (u *ReserveUseCase) ChangeReservationStatus(ctx context.Context, change domain.StatusChange) (*domain.ReserveDetailDTO, error) {
+	if _, known := domain.ReservationTransitions[change.StatusCode]; !known {
+		return nil, domain.ErrReservationStatusNotFound
+	}
+
+	before, _ := u.repository.GetReserveByID(ctx, change.ReservationID)
+
+	if err := u.repository.ChangeReservationStatus(ctx, change); err != nil {
+		u.log.Warn().Err(err).Uint("reservation_id", change.ReservationID).Str("status", change.StatusCode).Msg("Cambio de estado rechazado")
+		return nil, fmt.Errorf("error al cambiar estado de reserva: %w", err)
+	}
+
+	reservation, err := u.getReservation(ctx, change.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	u.log.Info().Uint("reservation_id", change.ReservationID).Str("status", change.StatusCode).Msg("Estado de reserva actualizado")
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: reservation.NegocioID,
+		EntityType: "reservation",
+		EntityID:   change.ReservationID,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      reservation,
+	})
+
+	u.afterStatusChange(ctx, reservation)
+	return reservation, nil
+}
+
+// afterStatusChange ejecuta los efectos de un cambio de estado ya persistido
+func (u *ReserveUseCase) afterStatusChange(ctx context.Context, reservation *domain.ReserveDetailDTO) {
+	if reservation.EstadoCodigo != domain.StatusCancelled {
+		return
+	}
+
+	reservationEntity := u.convertDTOToReservation(reservation)
+
+	if err := u.QueueReservationCancellation(ctx, reservation.ClienteEmail, reservation.ClienteNombre, reservationEntity); err != nil {
+		u.log.Error().Err(err).Str("email", reservation.ClienteEmail).Msg("Error al encolar email de cancelación")
+	}
+
+	// El horario liberado se ofrece al siguiente cliente en lista de espera
+	u.offerFreedSlot(ctx, reservation.NegocioID, domain.TimeSlot{StartAt: reservation.StartAt, EndAt: reservation.EndAt})
+}
+
+// getReservation obtiene el detalle de una reserva traduciendo su ausencia a un error de dominio
+func (u *ReserveUseCase) getReservation(ctx context.Context, id uint) (*domain.ReserveDetailDTO, error) {
+	reservation, err := u.repository.GetReserveByID(ctx, id)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return nil, domain.ErrReservationNotFound
+		}
+		u.log.Error().Err(err).Uint("reservation_id", id).Msg("Error al obtener reserva")
+		return nil, fmt.Errorf("error al obtener reserva: %w", err)
+	}
+	return reservation, nil
+}
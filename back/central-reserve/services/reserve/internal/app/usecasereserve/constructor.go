@@ -3,6 +3,8 @@ package usecasereserve
 import (
 	"central_reserve/services/reserve/internal/domain"
 	"central_reserve/shared/email"
+	"central_reserve/shared/env"
+	"central_reserve/shared/jwt"
 	"central_reserve/shared/log"
 	"context"
 	"time"
@@ -13,21 +15,63 @@ type IUseCaseReserve interface {
 	CreateReserve(ctx context.Context, reserve domain.Reservation, name, email, phone string, dni string) (*domain.ReserveDetailDTO, error)
 	GetReserves(ctx context.Context, statusID *uint, clientID *uint, tableID *uint, startDate *time.Time, endDate *time.Time) ([]domain.ReserveDetailDTO, error)
 	GetReserveByID(ctx context.Context, id uint) (*domain.ReserveDetailDTO, error)
-	CancelReservation(ctx context.Context, id uint, reason string) (string, error)
+	CancelReservation(ctx context.Context, id uint, reason string, changedByUserID *uint) (string, error)
 	UpdateReservation(ctx context.Context, params domain.UpdateReservationDTO) (string, error)
 	GetReservationStatuses(ctx context.Context) ([]domain.ReservationStatusDTO, error)
+	ChangeReservationStatus(ctx context.Context, change domain.StatusChange) (*domain.ReserveDetailDTO, error)
+	GetStatusTransitions(ctx context.Context, id uint) (*domain.StatusTransitionsDTO, error)
+	GetAvailability(ctx context.Context, query domain.AvailabilityQuery) (*domain.AvailabilityDTO, error)
+
+	// Lista de espera
+	JoinWaitlist(ctx context.Context, entry domain.WaitlistEntry, name, email, phone string, dni string) (*domain.WaitlistEntryDTO, error)
+	GetWaitlist(ctx context.Context, query domain.WaitlistQuery) ([]domain.WaitlistEntryDTO, error)
+	CancelWaitlistEntry(ctx context.Context, id uint, businessID uint) error
+	GetWaitlistOffer(ctx context.Context, token string) (*domain.WaitlistOfferDTO, error)
+	AcceptWaitlistOffer(ctx context.Context, token string) (*domain.ReserveDetailDTO, error)
+	DeclineWaitlistOffer(ctx context.Context, token string) error
+	ExpireWaitlistOffers(ctx context.Context) (int, error)
+
+	// Autogestión del cliente mediante el enlace firmado
+	GetGuestReservation(ctx context.Context, token string) (*domain.GuestReservationDTO, error)
+	RescheduleGuestReservation(ctx context.Context, token string, params domain.GuestRescheduleDTO) (*domain.GuestReservationDTO, error)
+	CancelGuestReservation(ctx context.Context, token string, reason string) error
+
+	// Plantillas de email por negocio
+	GetEmailTemplates(ctx context.Context, businessID uint) ([]domain.EmailTemplateStatusDTO, error)
+	SaveEmailTemplate(ctx context.Context, template domain.EmailTemplate) (*domain.EmailTemplate, error)
+	DeleteEmailTemplate(ctx context.Context, businessID uint, code, locale string) error
+	PreviewEmailTemplate(ctx context.Context, req domain.EmailPreviewRequest) (*domain.RenderedEmail, error)
+
+	// Outbox de emails
+	DeliverDueNotifications(ctx context.Context) (int, error)
+	GetNotifications(ctx context.Context, query domain.NotificationQuery) (*domain.NotificationListDTO, error)
+	GetNotificationByID(ctx context.Context, id uint, businessID uint) (*domain.Notification, error)
+	ResendNotification(ctx context.Context, id uint, businessID uint) (*domain.Notification, error)
+
+	// Feed de calendario del negocio
+	RotateCalendarFeed(ctx context.Context, businessID uint, createdByUserID *uint) (*domain.CalendarFeedDTO, error)
+	RevokeCalendarFeed(ctx context.Context, businessID uint) error
+	GetCalendarFeed(ctx context.Context, token string) ([]byte, error)
+
+	// Tareas programadas
+	SendDueReminders(ctx context.Context) (int, error)
+	ProcessNoShows(ctx context.Context) (int, error)
 }
 
 type ReserveUseCase struct {
 	repository domain.IReservationRepository
 	sender     email.IEmailService
+	env        env.IConfig
+	tokens     jwt.IJWTService
 	log        log.ILogger
 }
 
-func New(repository domain.IReservationRepository, sender domain.IEmailService, log log.ILogger) *ReserveUseCase {
+func New(repository domain.IReservationRepository, sender email.IEmailService, env env.IConfig, tokens jwt.IJWTService, log log.ILogger) *ReserveUseCase {
 	return &ReserveUseCase{
 		repository: repository,
 		sender:     sender,
+		env:        env,
+		tokens:     tokens,
 		log:        log,
 	}
 }
@@ -3,13 +3,40 @@ package usecasereserve
 import (
 	"central_reserve/services/reserve/internal/domain"
 	"context"
+	"errors"
 	"fmt"
+	"time"
 )
 
 // CreateReserve crea una nueva reserva
 func (u *ReserveUseCase) CreateReserve(ctx context.Context, req domain.Reservation, name, email, phone string, dni string) (*domain.ReserveDetailDTO, error) {
 	u.log.Info().Str("email", email).Msg("Iniciando creación de reserva")
 
+	if req.NumberOfGuests <= 0 {
+		return nil, domain.ErrInvalidNumberOfGuests
+	}
+	if req.StartAt.IsZero() {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	// Validar contra el horario y las reglas de reserva del negocio
+	schedule, err := u.getBusinessSchedule(ctx, req.BusinessID, req.StartAt, req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+	if req.EndAt.IsZero() {
+		req.EndAt = req.StartAt.Add(time.Duration(schedule.DefaultDurationMinutes) * time.Minute)
+	}
+	if !req.EndAt.After(req.StartAt) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+	if err := validateBookingWindow(schedule, req.StartAt, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := validateSchedule(schedule, domain.TimeSlot{StartAt: req.StartAt, EndAt: req.EndAt}); err != nil {
+		return nil, err
+	}
+
 	// ✅ VERIFICAR: Log para confirmar que el EmailService está inyectado
 	if u.sender == nil {
 		u.log.Error().Msg("❌ EmailService es nil - no se inyectó correctamente")
@@ -17,77 +44,63 @@ func (u *ReserveUseCase) CreateReserve(ctx context.Context, req domain.Reservati
 		u.log.Info().Msg("✅ EmailService inyectado correctamente")
 	}
 
-	// Verificar si el cliente ya existe
-	existingClient, err := u.repository.GetClientByEmailAndBusiness(ctx, email, req.BusinessID)
-	if err != nil && err.Error() != "record not found" {
-		u.log.Error().Err(err).Str("email", email).Msg("Error al verificar cliente existente")
-		return nil, fmt.Errorf("error al verificar cliente: %w", err)
+	// Toda reserva nace en el estado inicial de la máquina de estados
+	pending, err := u.repository.GetReservationStatusByCode(ctx, domain.StatusPending)
+	if err != nil {
+		u.log.Error().Err(err).Msg("Estado inicial de reserva no configurado")
+		return nil, fmt.Errorf("error al obtener estado inicial: %w", err)
 	}
 
-	var clientID uint
-
-	if existingClient != nil {
-		// Cliente existe, usar su ID
-		clientID = existingClient.ID
-		u.log.Info().Uint("client_id", clientID).Str("email", email).Msg("Cliente existente encontrado")
-	} else {
-		// Crear nuevo cliente
-		var dniPtr *string
-		if dni != "" {
-			dniPtr = &dni
-		}
-
-		newClient := domain.Client{
-			Name:       name,
-			Email:      email,
-			Phone:      phone,
-			Dni:        dniPtr,
-			BusinessID: req.BusinessID,
-		}
-
-		_, err := u.repository.CreateClient(ctx, newClient)
-		if err != nil {
-			u.log.Error().Err(err).Str("email", email).Msg("Error al crear cliente")
-			return nil, fmt.Errorf("error al crear cliente: %w", err)
-		}
-
-		// Obtener el ID del cliente recién creado
-		createdClient, err := u.repository.GetClientByEmailAndBusiness(ctx, email, req.BusinessID)
-		if err != nil {
-			u.log.Error().Err(err).Str("email", email).Msg("Error al obtener cliente recién creado")
-			return nil, fmt.Errorf("error al obtener cliente: %w", err)
-		}
-		clientID = createdClient.ID
-		u.log.Info().Uint("client_id", clientID).Str("email", email).Msg("Nuevo cliente creado")
+	clientID, err := u.resolveClient(ctx, req.BusinessID, name, email, phone, dni)
+	if err != nil {
+		return nil, err
 	}
 
 	// Crear la reserva
 	reservation := domain.Reservation{
-		ClientID:       clientID,
-		TableID:        req.TableID,
-		BusinessID:     req.BusinessID,
-		StartAt:        req.StartAt,
-		EndAt:          req.EndAt,
-		NumberOfGuests: req.NumberOfGuests,
-		StatusID:       1, // Estado inicial: Pendiente
+		ClientID:        clientID,
+		RoomID:          req.RoomID,
+		TableID:         req.TableID,
+		TableIDs:        req.TableIDs,
+		BusinessID:      req.BusinessID,
+		StartAt:         req.StartAt,
+		EndAt:           req.EndAt,
+		NumberOfGuests:  req.NumberOfGuests,
+		StatusID:        pending.ID,
+		CreatedByUserID: req.CreatedByUserID,
 	}
 
-	reservationID, err := u.repository.CreateReserve(ctx, reservation)
-	if err != nil {
+	// Sin mesa elegida por el cliente: auto-asignar la mesa (o combinación) que mejor se ajuste
+	autoAssign := req.TableID == nil && len(req.TableIDs) == 0
+
+	var reservationID uint
+	for attempt := 1; ; attempt++ {
+		if autoAssign {
+			assignment, err := u.assignTables(ctx, reservation)
+			if err != nil {
+				u.log.Warn().Err(err).Uint("business_id", req.BusinessID).Int("guests", req.NumberOfGuests).Msg("No se pudo auto-asignar mesa")
+				return nil, fmt.Errorf("error al asignar mesa: %w", err)
+			}
+			if assignment != nil {
+				reservation.TableIDs = assignment.TableIDs
+				reservation.TableID = &assignment.TableIDs[0]
+				reservation.RoomID = assignment.RoomID
+				u.log.Info().Uints("table_ids", assignment.TableIDs).Msg("Mesas auto-asignadas")
+			}
+		}
+
+		reservationID, err = u.repository.CreateReserve(ctx, reservation)
+		if err == nil {
+			break
+		}
+		if autoAssign && attempt < maxAssignAttempts && errors.Is(err, domain.ErrReservationConflict) {
+			u.log.Warn().Int("attempt", attempt).Msg("Mesa ocupada concurrentemente, reintentando asignación")
+			continue
+		}
 		u.log.Error().Err(err).Uint("client_id", clientID).Msg("Error al crear reserva")
 		return nil, fmt.Errorf("error al crear reserva: %w", err)
 	}
 
-	// Crear historial de estado
-	history := domain.ReservationStatusHistory{
-		ReservationID: clientID,
-		StatusID:      1,
-	}
-
-	if err := u.repository.CreateReservationStatusHistory(ctx, history); err != nil {
-		u.log.Warn().Err(err).Msg("Error al crear historial de estado")
-	}
-
 	// ✅ NUEVO: Obtener la reserva completa con todos los datos relacionados
 	completeReservation, err := u.repository.GetReserveByID(ctx, reservationID)
 	if err != nil {
@@ -97,25 +110,20 @@ func (u *ReserveUseCase) CreateReserve(ctx context.Context, req domain.Reservati
 
 	u.log.Info().Uint("client_id", clientID).Str("email", email).Msg("Reserva creada exitosamente")
 
-	// ✅ OPTIMIZADO: Enviar email de confirmación de forma asíncrona (en background)
-	go func() {
-		reservationWithID := domain.Reservation{
-			ID:             reservationID,
-			ClientID:       clientID,
-			TableID:        req.TableID,
-			BusinessID:     req.BusinessID,
-			StartAt:        req.StartAt,
-			EndAt:          req.EndAt,
-			NumberOfGuests: req.NumberOfGuests,
-			StatusID:       1,
-		}
-
-		if err := u.SendReservationConfirmation(ctx, email, name, reservationWithID); err != nil {
-			u.log.Warn().Err(err).Str("email", email).Msg("Error al enviar email de confirmación")
-		} else {
-			u.log.Info().Str("email", email).Msg("Email de confirmación enviado exitosamente")
-		}
-	}()
+	// Encolar email de confirmación: el worker del outbox lo envía y reintenta si falla
+	reservationWithID := domain.Reservation{
+		ID:             reservationID,
+		ClientID:       clientID,
+		TableID:        reservation.TableID,
+		BusinessID:     req.BusinessID,
+		StartAt:        req.StartAt,
+		EndAt:          req.EndAt,
+		NumberOfGuests: req.NumberOfGuests,
+		StatusID:       pending.ID,
+	}
+	if err := u.QueueReservationConfirmation(ctx, email, name, reservationWithID); err != nil {
+		u.log.Error().Err(err).Str("email", email).Msg("Error al encolar email de confirmación")
+	}
 
 	return completeReservation, nil
 }
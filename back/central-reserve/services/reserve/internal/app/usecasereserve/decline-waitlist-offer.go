@@ -0,0 +1,30 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+)
+
+// DeclineWaitlistOffer rechaza la oferta y pasa el horario al siguiente cliente en espera
+func (u *ReserveUseCase) DeclineWaitlistOffer(ctx context.Context, token string) error {
+	entry, err := u.getOfferEntry(ctx, token)
+	if err != nil {
+		return err
+	}
+	if entry.Status != domain.WaitlistStatusOffered {
+		return domain.ErrWaitlistInvalidTransition
+	}
+
+	declined, err := u.repository.UpdateWaitlistStatus(ctx, entry.ID, domain.WaitlistStatusOffered, domain.WaitlistStatusDeclined, nil)
+	if err != nil {
+		return fmt.Errorf("error al rechazar oferta de lista de espera: %w", err)
+	}
+	if !declined {
+		return domain.ErrWaitlistInvalidTransition
+	}
+
+	u.log.Info().Uint("waitlist_id", entry.ID).Msg("Oferta de lista de espera rechazada")
+	u.offerFreedSlot(ctx, entry.BusinessID, domain.TimeSlot{StartAt: entry.StartAt, EndAt: entry.EndAt})
+	return nil
+}
@@ -0,0 +1,31 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+)
+
+// DeleteEmailTemplate elimina la personalización de un negocio; se vuelve a usar la plantilla por defecto
+func (u *ReserveUseCase) DeleteEmailTemplate(ctx context.Context, businessID uint, code, locale string) error {
+	if err := validateEmailTemplateKey(code, locale); err != nil {
+		return err
+	}
+
+	deleted, err := u.repository.DeleteEmailTemplate(ctx, businessID, code, locale)
+	if err != nil {
+		return fmt.Errorf("error al eliminar plantilla de email: %w", err)
+	}
+	if !deleted {
+		return domain.ErrEmailTemplateNotFound
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "email_template",
+		Action:     audit.ActionDelete,
+		Before:     map[string]string{"code": code, "locale": locale},
+	})
+	return nil
+}
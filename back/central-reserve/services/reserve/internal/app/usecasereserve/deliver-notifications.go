@@ -0,0 +1,78 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/email"
+	"context"
+	"fmt"
+	"time"
+)
+
+// DeliverDueNotifications envía los emails del outbox cuyo intento venció. Retorna cuántos se enviaron.
+func (u *ReserveUseCase) DeliverDueNotifications(ctx context.Context) (int, error) {
+	notifications, err := u.repository.ClaimDueNotifications(ctx, time.Now(), domain.OutboxBatchSize, domain.OutboxSendLease)
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener notificaciones pendientes: %w", err)
+	}
+
+	sent := 0
+	for _, notification := range notifications {
+		if u.deliverNotification(ctx, notification) {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// deliverNotification intenta enviar un email y registra el intento. Si falla se reprograma con
+// backoff exponencial, o se marca como fallido al agotar los intentos.
+func (u *ReserveUseCase) deliverNotification(ctx context.Context, notification domain.Notification) bool {
+	msg := email.Message{
+		To:      notification.Recipient,
+		Subject: notification.Subject,
+		HTML:    notification.HTMLBody,
+		Text:    notification.TextBody,
+	}
+	for _, attachment := range notification.Attachments {
+		msg.Attachments = append(msg.Attachments, email.Attachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+		})
+	}
+
+	started := time.Now()
+	err := u.sender.Send(ctx, msg)
+	finished := time.Now()
+
+	delivery := domain.NotificationDelivery{
+		NotificationID: notification.ID,
+		Attempt: domain.NotificationAttempt{
+			Number:     notification.Attempts + 1,
+			Success:    err == nil,
+			DurationMs: finished.Sub(started).Milliseconds(),
+		},
+		NextAttemptAt: finished,
+	}
+
+	switch {
+	case err == nil:
+		delivery.Status = domain.NotificationSent
+		delivery.SentAt = &finished
+	case delivery.Attempt.Number >= notification.MaxAttempts:
+		delivery.Status = domain.NotificationFailed
+		delivery.Attempt.Error = err.Error()
+		u.log.Error().Err(err).Uint("notification_id", notification.ID).Int("attempt", delivery.Attempt.Number).Msg("Email fallido tras agotar los intentos")
+	default:
+		delivery.Status = domain.NotificationPending
+		delivery.Attempt.Error = err.Error()
+		delivery.NextAttemptAt = finished.Add(domain.OutboxBackoff(delivery.Attempt.Number))
+		u.log.Warn().Err(err).Uint("notification_id", notification.ID).Int("attempt", delivery.Attempt.Number).Time("next_attempt_at", delivery.NextAttemptAt).Msg("Error al enviar email, se reintentará")
+	}
+
+	if err := u.repository.RecordNotificationDelivery(ctx, delivery); err != nil {
+		// El email queda en "sending" y se reintenta al vencer el plazo del worker
+		u.log.Error().Err(err).Uint("notification_id", notification.ID).Msg("Error al registrar intento de email")
+	}
+	return delivery.Status == domain.NotificationSent
+}
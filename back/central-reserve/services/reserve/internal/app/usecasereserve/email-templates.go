@@ -0,0 +1,207 @@
+package usecasereserve
+
+import (
+	"bytes"
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/email"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// defaultEmailTemplates contiene las plantillas por defecto: templates/<idioma>/<código>.{subject,html,txt}
+// y el diseño con la marca del negocio templates/<idioma>/layout.html
+//
+//go:embed templates
+var defaultEmailTemplates embed.FS
+
+// emailDateLayouts son los formatos de fecha y hora por idioma
+var emailDateLayouts = map[string]struct{ date, dateTime string }{
+	"es": {date: "02/01/2006", dateTime: "02/01/2006 15:04"},
+	"en": {date: "Jan 2, 2006", dateTime: "Jan 2, 2006 15:04"},
+}
+
+var hexColorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}){1,2}$`)
+
+// emailTemplateSource son las fuentes de una plantilla, por defecto o personalizada por el negocio
+type emailTemplateSource struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// queueTemplatedEmail renderiza la plantilla del negocio en su idioma y encola el email con su alternativa en texto
+func (u *ReserveUseCase) queueTemplatedEmail(ctx context.Context, code, to string, data domain.EmailTemplateData, attachments ...email.Attachment) error {
+	source, err := u.loadEmailTemplate(ctx, data.Business.BusinessID, code, data.Business.Locale)
+	if err != nil {
+		return err
+	}
+	rendered, err := renderEmail(data.Business.Locale, source, data)
+	if err != nil {
+		u.log.Error().Err(err).Uint("business_id", data.Business.BusinessID).Str("code", code).Msg("Error al renderizar plantilla de email")
+		return err
+	}
+
+	businessID := data.Business.BusinessID
+	var reservationID *uint
+	if data.Reservation.ID != 0 {
+		reservationID = &data.Reservation.ID
+	}
+	return u.enqueueEmail(ctx, code, &businessID, reservationID, email.Message{
+		To:          to,
+		Subject:     rendered.Subject,
+		HTML:        rendered.HTML,
+		Text:        rendered.Text,
+		Attachments: attachments,
+	})
+}
+
+// emailBranding obtiene la marca del negocio normalizando el idioma y el color
+func (u *ReserveUseCase) emailBranding(ctx context.Context, businessID uint) (*domain.EmailBranding, error) {
+	branding, err := u.repository.GetEmailBranding(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener marca del negocio: %w", err)
+	}
+	branding.Locale = resolveEmailLocale(branding.Locale)
+	if !hexColorPattern.MatchString(branding.PrimaryColor) {
+		branding.PrimaryColor = domain.DefaultEmailPrimaryColor
+	}
+	if branding.Location == nil {
+		branding.Location = time.UTC
+	}
+	return branding, nil
+}
+
+// loadEmailTemplate obtiene la plantilla personalizada del negocio o, si no existe, la plantilla por defecto
+func (u *ReserveUseCase) loadEmailTemplate(ctx context.Context, businessID uint, code, locale string) (emailTemplateSource, error) {
+	override, err := u.repository.GetEmailTemplate(ctx, businessID, code, locale)
+	if err != nil {
+		return emailTemplateSource{}, fmt.Errorf("error al obtener plantilla de email: %w", err)
+	}
+	if override != nil {
+		return emailTemplateSource{Subject: override.Subject, HTML: override.HTMLBody, Text: override.TextBody}, nil
+	}
+	return defaultEmailTemplate(code, locale)
+}
+
+// newEmailData arma los datos de una plantilla con las fechas en el idioma y zona horaria del negocio
+func newEmailData(branding domain.EmailBranding, guestName string, reservationID uint, startAt, endAt time.Time, guests int) domain.EmailTemplateData {
+	layouts := emailDateLayouts[branding.Locale]
+	start, end := startAt.In(branding.Location), endAt.In(branding.Location)
+	return domain.EmailTemplateData{
+		Business:  branding,
+		GuestName: guestName,
+		Reservation: domain.EmailReservationData{
+			ID:             reservationID,
+			Date:           start.Format(layouts.date),
+			StartTime:      start.Format("15:04"),
+			EndTime:        end.Format("15:04"),
+			NumberOfGuests: guests,
+		},
+	}
+}
+
+// formatEmailDateTime formatea una fecha y hora en el idioma y zona horaria del negocio
+func formatEmailDateTime(branding domain.EmailBranding, t time.Time) string {
+	return t.In(branding.Location).Format(emailDateLayouts[branding.Locale].dateTime)
+}
+
+// defaultEmailTemplate lee la plantilla por defecto de un código e idioma
+func defaultEmailTemplate(code, locale string) (emailTemplateSource, error) {
+	var source emailTemplateSource
+	for _, part := range []struct {
+		ext    string
+		target *string
+	}{
+		{"subject", &source.Subject},
+		{"html", &source.HTML},
+		{"txt", &source.Text},
+	} {
+		content, err := defaultEmailTemplates.ReadFile(fmt.Sprintf("templates/%s/%s.%s", locale, code, part.ext))
+		if err != nil {
+			return emailTemplateSource{}, fmt.Errorf("%w: %s (%s)", domain.ErrEmailTemplateNotFound, code, locale)
+		}
+		*part.target = string(content)
+	}
+	return source, nil
+}
+
+// renderEmail ejecuta las tres partes de la plantilla. El contenido HTML se inserta en el diseño
+// con la marca del negocio; html/template escapa los datos del cliente.
+func renderEmail(locale string, source emailTemplateSource, data domain.EmailTemplateData) (*domain.RenderedEmail, error) {
+	layout, err := defaultEmailTemplates.ReadFile(fmt.Sprintf("templates/%s/layout.html", locale))
+	if err != nil {
+		return nil, fmt.Errorf("%w: diseño (%s)", domain.ErrEmailTemplateNotFound, locale)
+	}
+
+	subject, err := executeText("subject", source.Subject, data)
+	if err != nil {
+		return nil, err
+	}
+	text, err := executeText("text", source.Text, data)
+	if err != nil {
+		return nil, err
+	}
+
+	html, err := htmltemplate.New("layout").Parse(string(layout))
+	if err != nil {
+		return nil, fmt.Errorf("%w: diseño: %v", domain.ErrInvalidEmailTemplate, err)
+	}
+	if _, err := html.New("content").Parse(source.HTML); err != nil {
+		return nil, fmt.Errorf("%w: html: %v", domain.ErrInvalidEmailTemplate, err)
+	}
+	var htmlBody bytes.Buffer
+	if err := html.ExecuteTemplate(&htmlBody, "layout", data); err != nil {
+		return nil, fmt.Errorf("%w: html: %v", domain.ErrInvalidEmailTemplate, err)
+	}
+
+	return &domain.RenderedEmail{
+		// El asunto es una sola línea de cabecera
+		Subject: strings.Join(strings.Fields(subject), " "),
+		HTML:    htmlBody.String(),
+		Text:    text,
+	}, nil
+}
+
+func executeText(name, source string, data domain.EmailTemplateData) (string, error) {
+	tmpl, err := texttemplate.New(name).Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %v", domain.ErrInvalidEmailTemplate, name, err)
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("%w: %s: %v", domain.ErrInvalidEmailTemplate, name, err)
+	}
+	return out.String(), nil
+}
+
+// resolveEmailLocale retorna el idioma si tiene plantillas, o el idioma por defecto
+func resolveEmailLocale(locale string) string {
+	if isSupportedEmailLocale(locale) {
+		return locale
+	}
+	return domain.DefaultEmailLocale
+}
+
+func isSupportedEmailLocale(locale string) bool {
+	for _, supported := range domain.SupportedEmailLocales {
+		if locale == supported {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmailTemplateCode(code string) bool {
+	for _, known := range domain.EmailTemplateCodes {
+		if code == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/email"
+	"context"
+	"fmt"
+	"time"
+)
+
+// enqueueEmail guarda el email en el outbox; el worker lo envía y reintenta si falla.
+// Así un fallo SMTP, la cancelación del contexto de la petición o un reinicio no pierden el email.
+func (u *ReserveUseCase) enqueueEmail(ctx context.Context, code string, businessID, reservationID *uint, msg email.Message) error {
+	attachments := make([]domain.NotificationAttachment, 0, len(msg.Attachments))
+	for _, attachment := range msg.Attachments {
+		attachments = append(attachments, domain.NotificationAttachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+		})
+	}
+
+	id, err := u.repository.EnqueueNotification(ctx, domain.Notification{
+		BusinessID:    businessID,
+		ReservationID: reservationID,
+		Code:          code,
+		Recipient:     msg.To,
+		Subject:       msg.Subject,
+		HTMLBody:      msg.HTML,
+		TextBody:      msg.Text,
+		Status:        domain.NotificationPending,
+		MaxAttempts:   domain.OutboxMaxAttempts,
+		NextAttemptAt: time.Now(),
+		Attachments:   attachments,
+	})
+	if err != nil {
+		return fmt.Errorf("error al encolar email: %w", err)
+	}
+
+	u.log.Info().Uint("notification_id", id).Str("code", code).Str("email", msg.To).Msg("Email encolado")
+	return nil
+}
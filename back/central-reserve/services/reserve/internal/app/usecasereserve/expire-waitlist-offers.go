@@ -0,0 +1,28 @@
+package usecasereserve
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ExpireWaitlistOffers vence las ofertas cuyo enlace caducó y ofrece cada horario al siguiente en la lista
+func (u *ReserveUseCase) ExpireWaitlistOffers(ctx context.Context) (int, error) {
+	entries, err := u.repository.GetExpiredWaitlistOffers(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener ofertas vencidas: %w", err)
+	}
+
+	expired := 0
+	for _, entry := range entries {
+		ok, err := u.expireOffer(ctx, entry)
+		if err != nil {
+			u.log.Error().Err(err).Uint("waitlist_id", entry.ID).Msg("Error al expirar oferta de lista de espera")
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+	return expired, nil
+}
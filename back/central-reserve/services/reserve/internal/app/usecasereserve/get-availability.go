@@ -0,0 +1,198 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// GetAvailability calcula los slots libres por mesa y sala de un negocio en un rango de fechas
+func (u *ReserveUseCase) GetAvailability(ctx context.Context, query domain.AvailabilityQuery) (*domain.AvailabilityDTO, error) {
+	if query.NumberOfGuests < 0 {
+		return nil, domain.ErrInvalidNumberOfGuests
+	}
+	if !query.EndDate.After(query.StartDate) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+	if query.EndDate.Sub(query.StartDate) > time.Duration(domain.MaxAvailabilityRangeDays)*24*time.Hour {
+		return nil, fmt.Errorf("%w: máximo %d días", domain.ErrAvailabilityRange, domain.MaxAvailabilityRangeDays)
+	}
+
+	// La granularidad y duración por defecto son las configuradas por el negocio
+	schedule, err := u.getBusinessSchedule(ctx, query.BusinessID, query.StartDate, query.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if query.SlotMinutes <= 0 {
+		query.SlotMinutes = schedule.SlotMinutes
+	}
+	if query.DurationMinutes <= 0 {
+		query.DurationMinutes = schedule.DefaultDurationMinutes
+	}
+
+	tables, err := u.repository.GetTablesByBusiness(ctx, query.BusinessID, query.RoomID)
+	if err != nil {
+		u.log.Error().Err(err).Uint("business_id", query.BusinessID).Msg("Error al obtener mesas para disponibilidad")
+		return nil, fmt.Errorf("error al obtener mesas: %w", err)
+	}
+
+	var rooms []domain.Room
+	if query.TableID == nil {
+		rooms, err = u.repository.GetRoomsByBusiness(ctx, query.BusinessID, query.RoomID)
+		if err != nil {
+			u.log.Error().Err(err).Uint("business_id", query.BusinessID).Msg("Error al obtener salas para disponibilidad")
+			return nil, fmt.Errorf("error al obtener salas: %w", err)
+		}
+	}
+
+	reservations, err := u.repository.GetBlockingReservations(ctx, query.BusinessID, query.StartDate, query.EndDate)
+	if err != nil {
+		u.log.Error().Err(err).Uint("business_id", query.BusinessID).Msg("Error al obtener reservas para disponibilidad")
+		return nil, fmt.Errorf("error al obtener reservas: %w", err)
+	}
+
+	// Solo se ofrecen slots que cumplan el horario de atención y las ventanas de anticipación
+	now := time.Now()
+	candidates := make([]domain.TimeSlot, 0)
+	for _, candidate := range buildCandidateSlots(alignToSlot(schedule, query.StartDate), query.EndDate, query.SlotMinutes, query.DurationMinutes) {
+		if validateBookingWindow(schedule, candidate.StartAt, now) == nil && validateSchedule(schedule, candidate) == nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	result := &domain.AvailabilityDTO{
+		BusinessID:      query.BusinessID,
+		StartDate:       query.StartDate,
+		EndDate:         query.EndDate,
+		SlotMinutes:     query.SlotMinutes,
+		DurationMinutes: query.DurationMinutes,
+		Tables:          []domain.TableAvailabilityDTO{},
+		Rooms:           []domain.RoomAvailabilityDTO{},
+	}
+
+	// Mesas
+	busyByTable := make(map[uint][]domain.TimeSlot)
+	for _, r := range reservations {
+		for _, tableID := range r.TableIDs {
+			busyByTable[tableID] = append(busyByTable[tableID], domain.TimeSlot{StartAt: r.StartAt, EndAt: r.EndAt})
+		}
+	}
+
+	for _, table := range tables {
+		if query.TableID != nil && table.ID != *query.TableID {
+			continue
+		}
+		if query.NumberOfGuests > 0 && table.Capacity < query.NumberOfGuests {
+			continue
+		}
+
+		busy := mergeSlots(busyByTable[table.ID])
+		free := make([]domain.TimeSlot, 0)
+		for _, candidate := range candidates {
+			if !overlapsAny(candidate, busy) {
+				free = append(free, candidate)
+			}
+		}
+
+		result.Tables = append(result.Tables, domain.TableAvailabilityDTO{
+			TableID:   table.ID,
+			Number:    table.Number,
+			Capacity:  table.Capacity,
+			RoomID:    table.RoomID,
+			FreeSlots: free,
+			BusySlots: busy,
+		})
+	}
+
+	// Salas (reservas por sala sin mesa asignada)
+	occupancyByRoom := make(map[uint][]domain.Occupancy)
+	for _, r := range reservations {
+		if len(r.TableIDs) == 0 && r.RoomID != nil {
+			occupancyByRoom[*r.RoomID] = append(occupancyByRoom[*r.RoomID], domain.Occupancy{
+				TimeSlot: domain.TimeSlot{StartAt: r.StartAt, EndAt: r.EndAt},
+				Guests:   r.NumberOfGuests,
+			})
+		}
+	}
+
+	for _, room := range rooms {
+		if room.MaxCapacity > 0 && query.NumberOfGuests > room.MaxCapacity {
+			continue
+		}
+		if query.NumberOfGuests > 0 && query.NumberOfGuests < room.MinCapacity {
+			continue
+		}
+
+		free := make([]domain.RoomSlotDTO, 0)
+		for _, candidate := range candidates {
+			// Se descuenta el máximo de personas presentes a la vez durante el slot
+			remaining := room.Capacity - domain.PeakOccupancy(candidate, occupancyByRoom[room.ID])
+			if remaining > 0 && remaining >= query.NumberOfGuests {
+				free = append(free, domain.RoomSlotDTO{TimeSlot: candidate, RemainingCapacity: remaining})
+			}
+		}
+
+		result.Rooms = append(result.Rooms, domain.RoomAvailabilityDTO{
+			RoomID:    room.ID,
+			Name:      room.Name,
+			Capacity:  room.Capacity,
+			FreeSlots: free,
+		})
+	}
+
+	u.log.Info().
+		Uint("business_id", query.BusinessID).
+		Int("tables", len(result.Tables)).
+		Int("rooms", len(result.Rooms)).
+		Msg("Disponibilidad calculada exitosamente")
+
+	return result, nil
+}
+
+// buildCandidateSlots genera los slots candidatos de duración fija alineados a la granularidad
+func buildCandidateSlots(startDate, endDate time.Time, slotMinutes, durationMinutes int) []domain.TimeSlot {
+	step := time.Duration(slotMinutes) * time.Minute
+	duration := time.Duration(durationMinutes) * time.Minute
+
+	slots := make([]domain.TimeSlot, 0)
+	for start := startDate; !start.Add(duration).After(endDate); start = start.Add(step) {
+		slots = append(slots, domain.TimeSlot{StartAt: start, EndAt: start.Add(duration)})
+	}
+	return slots
+}
+
+// mergeSlots ordena y fusiona intervalos solapados o contiguos
+func mergeSlots(slots []domain.TimeSlot) []domain.TimeSlot {
+	if len(slots) == 0 {
+		return []domain.TimeSlot{}
+	}
+
+	sorted := make([]domain.TimeSlot, len(slots))
+	copy(sorted, slots)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].StartAt.Before(sorted[j].StartAt) })
+
+	merged := []domain.TimeSlot{sorted[0]}
+	for _, slot := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if !slot.StartAt.After(last.EndAt) {
+			if slot.EndAt.After(last.EndAt) {
+				last.EndAt = slot.EndAt
+			}
+			continue
+		}
+		merged = append(merged, slot)
+	}
+	return merged
+}
+
+// overlapsAny indica si el slot se solapa con alguno de los intervalos ocupados
+func overlapsAny(slot domain.TimeSlot, busy []domain.TimeSlot) bool {
+	for _, b := range busy {
+		if slot.Overlaps(b) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2025, 3, 14, hour, minute, 0, 0, time.UTC)
+}
+
+func slot(startHour, startMinute, endHour, endMinute int) domain.TimeSlot {
+	return domain.TimeSlot{StartAt: at(startHour, startMinute), EndAt: at(endHour, endMinute)}
+}
+
+func TestBuildCandidateSlots(t *testing.T) {
+	tests := []struct {
+		name            string
+		start, end      time.Time
+		slotMinutes     int
+		durationMinutes int
+		want            []domain.TimeSlot
+	}{
+		{
+			name:  "slots solapados cuando la duración supera la granularidad",
+			start: at(19, 0), end: at(21, 0), slotMinutes: 30, durationMinutes: 60,
+			want: []domain.TimeSlot{slot(19, 0, 20, 0), slot(19, 30, 20, 30), slot(20, 0, 21, 0)},
+		},
+		{
+			name:  "slots contiguos cuando la duración es la granularidad",
+			start: at(19, 0), end: at(20, 30), slotMinutes: 30, durationMinutes: 30,
+			want: []domain.TimeSlot{slot(19, 0, 19, 30), slot(19, 30, 20, 0), slot(20, 0, 20, 30)},
+		},
+		{
+			name:  "el último slot no pasa del fin del rango",
+			start: at(19, 0), end: at(20, 45), slotMinutes: 60, durationMinutes: 60,
+			want: []domain.TimeSlot{slot(19, 0, 20, 0)},
+		},
+		{
+			name:  "rango más corto que la duración",
+			start: at(19, 0), end: at(19, 45), slotMinutes: 30, durationMinutes: 60,
+			want: []domain.TimeSlot{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildCandidateSlots(tt.start, tt.end, tt.slotMinutes, tt.durationMinutes)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildCandidateSlots() = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeSlots(t *testing.T) {
+	tests := []struct {
+		name  string
+		slots []domain.TimeSlot
+		want  []domain.TimeSlot
+	}{
+		{name: "sin intervalos", slots: nil, want: []domain.TimeSlot{}},
+		{
+			name:  "solapados se fusionan",
+			slots: []domain.TimeSlot{slot(19, 0, 20, 30), slot(20, 0, 21, 0)},
+			want:  []domain.TimeSlot{slot(19, 0, 21, 0)},
+		},
+		{
+			name:  "contiguos se fusionan",
+			slots: []domain.TimeSlot{slot(19, 0, 20, 0), slot(20, 0, 21, 0)},
+			want:  []domain.TimeSlot{slot(19, 0, 21, 0)},
+		},
+		{
+			name:  "desordenados y contenidos",
+			slots: []domain.TimeSlot{slot(22, 0, 23, 0), slot(19, 0, 21, 0), slot(19, 30, 20, 0)},
+			want:  []domain.TimeSlot{slot(19, 0, 21, 0), slot(22, 0, 23, 0)},
+		},
+		{
+			name:  "separados se mantienen",
+			slots: []domain.TimeSlot{slot(12, 0, 13, 0), slot(19, 0, 20, 0)},
+			want:  []domain.TimeSlot{slot(12, 0, 13, 0), slot(19, 0, 20, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeSlots(tt.slots)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeSlots() = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlapsAny(t *testing.T) {
+	busy := []domain.TimeSlot{slot(12, 0, 13, 0), slot(20, 0, 21, 0)}
+
+	tests := []struct {
+		name string
+		slot domain.TimeSlot
+		want bool
+	}{
+		{name: "libre entre ocupados", slot: slot(13, 0, 20, 0), want: false},
+		{name: "solapa el segundo", slot: slot(19, 30, 20, 30), want: true},
+		{name: "dentro del primero", slot: slot(12, 15, 12, 45), want: true},
+		{name: "termina cuando empieza el ocupado", slot: slot(19, 0, 20, 0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := overlapsAny(tt.slot, busy); got != tt.want {
+				t.Errorf("overlapsAny() = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/ical"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GetCalendarFeed genera el feed iCal de las próximas reservas del negocio dueño del token.
+// Incluye desde el día anterior para que el staff vea las reservas en curso.
+func (u *ReserveUseCase) GetCalendarFeed(ctx context.Context, token string) ([]byte, error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, domain.ErrCalendarFeedNotFound
+	}
+	businessID, err := u.repository.GetBusinessIDByCalendarFeedToken(ctx, hashSecretToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	branding, err := u.emailBranding(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reservations, err := u.repository.GetCalendarReservations(ctx, businessID, now.Add(-domain.CalendarFeedPast), now.Add(domain.CalendarFeedAhead), domain.CalendarFeedExcludedStatuses)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener reservas del feed: %w", err)
+	}
+
+	calendar := ical.Calendar{
+		ProdID:   domain.CalendarProdID,
+		Method:   ical.MethodPublish,
+		Name:     fmt.Sprintf(calendarTexts[branding.Locale].feedName, branding.Name),
+		Timezone: branding.Location.String(),
+		Events:   make([]ical.Event, 0, len(reservations)),
+	}
+	for _, reservation := range reservations {
+		calendar.Events = append(calendar.Events, u.feedEvent(*branding, reservation, now))
+	}
+	return calendar.Bytes(), nil
+}
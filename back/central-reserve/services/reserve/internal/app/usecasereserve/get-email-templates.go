@@ -0,0 +1,34 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+)
+
+// GetEmailTemplates lista, para cada plantilla e idioma soportado, si el negocio la tiene personalizada
+func (u *ReserveUseCase) GetEmailTemplates(ctx context.Context, businessID uint) ([]domain.EmailTemplateStatusDTO, error) {
+	templates, err := u.repository.GetEmailTemplates(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener plantillas de email: %w", err)
+	}
+
+	customized := make(map[string]domain.EmailTemplate, len(templates))
+	for _, template := range templates {
+		customized[template.Code+"/"+template.Locale] = template
+	}
+
+	result := make([]domain.EmailTemplateStatusDTO, 0, len(domain.EmailTemplateCodes)*len(domain.SupportedEmailLocales))
+	for _, code := range domain.EmailTemplateCodes {
+		for _, locale := range domain.SupportedEmailLocales {
+			status := domain.EmailTemplateStatusDTO{Code: code, Locale: locale}
+			if template, ok := customized[code+"/"+locale]; ok {
+				template := template
+				status.Customized = true
+				status.Template = &template
+			}
+			result = append(result, status)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,20 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"time"
+)
+
+// GetGuestReservation obtiene la reserva del cliente a partir del token de su enlace de autogestión
+func (u *ReserveUseCase) GetGuestReservation(ctx context.Context, token string) (*domain.GuestReservationDTO, error) {
+	reservation, err := u.resolveGuestReservation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.GuestReservationDTO{
+		Reservation: *reservation,
+		CanModify:   guestCanModify(*reservation, time.Now()),
+	}, nil
+}
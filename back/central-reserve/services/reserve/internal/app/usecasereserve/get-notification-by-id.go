@@ -0,0 +1,12 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+)
+
+// GetNotificationByID obtiene un email del outbox con su historial de intentos.
+// businessID = 0 permite consultar cualquier negocio (super admin).
+func (u *ReserveUseCase) GetNotificationByID(ctx context.Context, id uint, businessID uint) (*domain.Notification, error) {
+	return u.repository.GetNotificationByID(ctx, id, businessID)
+}
@@ -0,0 +1,45 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"math"
+)
+
+// GetNotifications obtiene una página del outbox de emails con filtros
+func (u *ReserveUseCase) GetNotifications(ctx context.Context, query domain.NotificationQuery) (*domain.NotificationListDTO, error) {
+	if query.Status != nil && !isNotificationStatus(*query.Status) {
+		return nil, domain.ErrInvalidNotificationStatus
+	}
+
+	// Configurar valores por defecto para paginación
+	if query.PageSize <= 0 || query.PageSize > 100 {
+		query.PageSize = 20
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+
+	notifications, total, err := u.repository.GetNotifications(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener notificaciones: %w", err)
+	}
+
+	return &domain.NotificationListDTO{
+		Notifications: notifications,
+		Total:         total,
+		Page:          query.Page,
+		PageSize:      query.PageSize,
+		TotalPages:    int(math.Ceil(float64(total) / float64(query.PageSize))),
+	}, nil
+}
+
+func isNotificationStatus(status string) bool {
+	for _, known := range domain.NotificationStatuses {
+		if status == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+)
+
+// GetStatusTransitions retorna el estado actual de una reserva y los estados a los que puede pasar
+func (u *ReserveUseCase) GetStatusTransitions(ctx context.Context, id uint) (*domain.StatusTransitionsDTO, error) {
+	reservation, err := u.getReservation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := u.repository.GetReservationStatuses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error al obtener estados de reserva: %w", err)
+	}
+	byCode := make(map[string]domain.ReservationStatus, len(statuses))
+	for _, status := range statuses {
+		byCode[status.Code] = status
+	}
+
+	result := &domain.StatusTransitionsDTO{
+		ReservationID: reservation.ReservaID,
+		Current: domain.ReservationStatusDTO{
+			ID:   reservation.EstadoID,
+			Code: reservation.EstadoCodigo,
+			Name: reservation.EstadoNombre,
+		},
+		Allowed: []domain.ReservationStatusDTO{},
+	}
+	for _, code := range domain.AllowedTransitions(reservation.EstadoCodigo) {
+		status, ok := byCode[code]
+		if !ok {
+			continue
+		}
+		result.Allowed = append(result.Allowed, domain.ReservationStatusDTO{ID: status.ID, Code: status.Code, Name: status.Name})
+	}
+	return result, nil
+}
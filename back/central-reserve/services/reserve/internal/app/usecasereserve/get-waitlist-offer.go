@@ -0,0 +1,25 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"time"
+)
+
+// GetWaitlistOffer obtiene la vista pública de una oferta a partir del token del enlace
+func (u *ReserveUseCase) GetWaitlistOffer(ctx context.Context, token string) (*domain.WaitlistOfferDTO, error) {
+	entry, err := u.getOfferEntry(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Vencimiento perezoso: si el ticker aún no la procesó, se expira al consultarla
+	if entry.Status == domain.WaitlistStatusOffered && entry.OfferExpiresAt != nil && !time.Now().Before(*entry.OfferExpiresAt) {
+		if _, err := u.expireOffer(ctx, *entry); err != nil {
+			return nil, err
+		}
+		entry.Status = domain.WaitlistStatusExpired
+	}
+
+	return toWaitlistOffer(*entry), nil
+}
@@ -0,0 +1,17 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+)
+
+// GetWaitlist lista la lista de espera de un negocio ordenada por horario y prioridad
+func (u *ReserveUseCase) GetWaitlist(ctx context.Context, query domain.WaitlistQuery) ([]domain.WaitlistEntryDTO, error) {
+	entries, err := u.repository.GetWaitlistEntries(ctx, query)
+	if err != nil {
+		u.log.Error().Err(err).Uint("business_id", query.BusinessID).Msg("Error al obtener lista de espera")
+		return nil, fmt.Errorf("error al obtener lista de espera: %w", err)
+	}
+	return entries, nil
+}
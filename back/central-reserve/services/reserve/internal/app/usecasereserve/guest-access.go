@@ -0,0 +1,77 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// resolveGuestReservation valida el token del enlace de autogestión y obtiene la reserva.
+// El token debe corresponder al mismo negocio y cliente de la reserva.
+func (u *ReserveUseCase) resolveGuestReservation(ctx context.Context, token string) (*domain.ReserveDetailDTO, error) {
+	claims, err := u.tokens.ValidateGuestReservationToken(token)
+	if err != nil {
+		u.log.Warn().Err(err).Msg("Token de reserva de cliente inválido")
+		return nil, domain.ErrGuestTokenInvalid
+	}
+
+	reservation, err := u.getReservation(ctx, claims.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+	if reservation.NegocioID != claims.BusinessID || reservation.ClienteID != claims.ClientID {
+		u.log.Warn().Uint("reservation_id", claims.ReservationID).Msg("Token de reserva de cliente no corresponde a la reserva")
+		return nil, domain.ErrGuestTokenInvalid
+	}
+	return reservation, nil
+}
+
+// guestCanModify indica si el cliente aún puede reprogramar o cancelar la reserva:
+// debe estar pendiente o confirmada y no haber comenzado
+func guestCanModify(reservation domain.ReserveDetailDTO, now time.Time) bool {
+	if !now.Before(reservation.StartAt) {
+		return false
+	}
+	for _, code := range domain.GuestModifiableStatuses {
+		if reservation.EstadoCodigo == code {
+			return true
+		}
+	}
+	return false
+}
+
+// guestManageURL genera el enlace de autogestión de una reserva. El token vence al terminar la reserva.
+func (u *ReserveUseCase) guestManageURL(reservation domain.Reservation) (string, error) {
+	token, err := u.guestToken(reservation)
+	if err != nil {
+		return "", err
+	}
+	return u.publicURL("/public/reservations/manage", token), nil
+}
+
+// guestToken firma el token de autogestión de una reserva
+func (u *ReserveUseCase) guestToken(reservation domain.Reservation) (string, error) {
+	token, err := u.tokens.GenerateGuestReservationToken(reservation.ID, reservation.BusinessID, reservation.ClientID, reservation.EndAt)
+	if err != nil {
+		return "", fmt.Errorf("error al generar enlace de la reserva: %w", err)
+	}
+	return token, nil
+}
+
+// publicURL arma un enlace público del front-end con el token como parámetro
+func (u *ReserveUseCase) publicURL(path, token string) string {
+	base := ""
+	if u.env != nil {
+		base = u.env.Get("URL_BASE_FRONTEND")
+		if base == "" {
+			base = u.env.Get("URL_BASE_SWAGGER")
+		}
+	}
+	if base == "" {
+		base = "http://localhost:3050" // Default para desarrollo
+	}
+	return fmt.Sprintf("%s%s?token=%s", strings.TrimRight(base, "/"), path, url.QueryEscape(token))
+}
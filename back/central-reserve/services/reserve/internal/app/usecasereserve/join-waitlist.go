@@ -0,0 +1,79 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// JoinWaitlist registra a un cliente en lista de espera para un horario sin mesas disponibles
+func (u *ReserveUseCase) JoinWaitlist(ctx context.Context, entry domain.WaitlistEntry, name, email, phone string, dni string) (*domain.WaitlistEntryDTO, error) {
+	u.log.Info().Str("email", email).Uint("business_id", entry.BusinessID).Msg("Registrando cliente en lista de espera")
+
+	if entry.NumberOfGuests <= 0 {
+		return nil, domain.ErrInvalidNumberOfGuests
+	}
+	if entry.StartAt.IsZero() {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	// El horario solicitado debe ser reservable según las reglas del negocio
+	schedule, err := u.getBusinessSchedule(ctx, entry.BusinessID, entry.StartAt, entry.StartAt)
+	if err != nil {
+		return nil, err
+	}
+	if entry.EndAt.IsZero() {
+		entry.EndAt = entry.StartAt.Add(time.Duration(schedule.DefaultDurationMinutes) * time.Minute)
+	}
+	if !entry.EndAt.After(entry.StartAt) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+	if err := validateBookingWindow(schedule, entry.StartAt, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := validateSchedule(schedule, domain.TimeSlot{StartAt: entry.StartAt, EndAt: entry.EndAt}); err != nil {
+		return nil, err
+	}
+
+	// Si todavía hay mesas libres no tiene sentido esperar
+	assignment, err := u.assignTables(ctx, domain.Reservation{
+		BusinessID:     entry.BusinessID,
+		RoomID:         entry.RoomID,
+		StartAt:        entry.StartAt,
+		EndAt:          entry.EndAt,
+		NumberOfGuests: entry.NumberOfGuests,
+	})
+	if err != nil && !errors.Is(err, domain.ErrNoTableAvailable) {
+		u.log.Error().Err(err).Uint("business_id", entry.BusinessID).Msg("Error al verificar disponibilidad para lista de espera")
+		return nil, err
+	}
+	if err == nil && assignment != nil {
+		return nil, domain.ErrWaitlistSlotAvailable
+	}
+
+	clientID, err := u.resolveClient(ctx, entry.BusinessID, name, email, phone, dni)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicated, err := u.repository.HasActiveWaitlistEntry(ctx, clientID, entry.StartAt, entry.EndAt)
+	if err != nil {
+		return nil, fmt.Errorf("error al verificar lista de espera: %w", err)
+	}
+	if duplicated {
+		return nil, domain.ErrWaitlistDuplicate
+	}
+
+	entry.ClientID = clientID
+	entry.Status = domain.WaitlistStatusWaiting
+
+	id, err := u.repository.CreateWaitlistEntry(ctx, entry)
+	if err != nil {
+		return nil, fmt.Errorf("error al registrar en lista de espera: %w", err)
+	}
+
+	u.log.Info().Uint("waitlist_id", id).Uint("client_id", clientID).Int("priority", entry.Priority).Msg("Cliente registrado en lista de espera")
+	return u.repository.GetWaitlistEntryByID(ctx, id)
+}
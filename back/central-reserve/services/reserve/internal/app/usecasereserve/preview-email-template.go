@@ -0,0 +1,61 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"time"
+)
+
+// PreviewEmailTemplate renderiza una plantilla con datos de ejemplo y la marca del negocio.
+// Los campos enviados en la solicitud reemplazan a los de la plantilla vigente sin guardarse.
+func (u *ReserveUseCase) PreviewEmailTemplate(ctx context.Context, req domain.EmailPreviewRequest) (*domain.RenderedEmail, error) {
+	branding, err := u.emailBranding(ctx, req.BusinessID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Locale == "" {
+		req.Locale = branding.Locale
+	}
+	if err := validateEmailTemplateKey(req.Code, req.Locale); err != nil {
+		return nil, err
+	}
+	branding.Locale = req.Locale
+
+	source, err := u.loadEmailTemplate(ctx, req.BusinessID, req.Code, req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	if req.Subject != nil {
+		source.Subject = *req.Subject
+	}
+	if req.HTMLBody != nil {
+		source.HTML = *req.HTMLBody
+	}
+	if req.TextBody != nil {
+		source.Text = *req.TextBody
+	}
+
+	return renderEmail(req.Locale, source, sampleEmailData(*branding, req.Code))
+}
+
+// sampleEmailData arma datos ficticios para previsualizar y validar plantillas
+func sampleEmailData(branding domain.EmailBranding, code string) domain.EmailTemplateData {
+	start := time.Now().In(branding.Location).AddDate(0, 0, 1)
+	start = time.Date(start.Year(), start.Month(), start.Day(), 20, 0, 0, 0, branding.Location)
+
+	guestName := "María García"
+	if branding.Locale == "en" {
+		guestName = "Jane Doe"
+	}
+
+	data := newEmailData(branding, guestName, 1234, start, start.Add(2*time.Hour), 4)
+	switch code {
+	case domain.EmailReservationConfirmation, domain.EmailReservationReminder:
+		data.ManageURL = "https://example.com/public/reservations/manage?token=preview"
+	case domain.EmailWaitlistOffer:
+		data.Reservation.ID = 0
+		data.AcceptURL = "https://example.com/public/waitlist/offer?token=preview"
+		data.ExpiresAt = formatEmailDateTime(branding, time.Now().Add(30*time.Minute))
+	}
+	return data
+}
@@ -0,0 +1,37 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ProcessNoShows marca como no-show las reservas cuyo cliente no llegó dentro de la tolerancia del
+// negocio. El cambio pasa por la máquina de estados y suma al historial de no-shows del cliente.
+// Retorna cuántas reservas se marcaron.
+func (u *ReserveUseCase) ProcessNoShows(ctx context.Context) (int, error) {
+	ids, err := u.repository.GetReservationsPastNoShowGrace(ctx, time.Now(), domain.SchedulerBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener reservas vencidas: %w", err)
+	}
+
+	marked := 0
+	for _, id := range ids {
+		_, err := u.ChangeReservationStatus(ctx, domain.StatusChange{
+			ReservationID: id,
+			StatusCode:    domain.StatusNoShow,
+			Reason:        domain.NoShowAutomaticReason,
+		})
+		if err != nil {
+			// El cliente pudo sentarse o cancelar entre la consulta y el cambio
+			if !errors.Is(err, domain.ErrInvalidStatusTransition) {
+				u.log.Error().Err(err).Uint("reservation_id", id).Msg("Error al marcar no-show automático")
+			}
+			continue
+		}
+		marked++
+	}
+	return marked, nil
+}
@@ -0,0 +1,76 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// RescheduleGuestReservation permite al cliente mover su reserva a otro horario (y ajustar el
+// número de invitados) respetando la disponibilidad y las reglas de reserva del negocio.
+// El cambio queda registrado en el historial de la reserva.
+func (u *ReserveUseCase) RescheduleGuestReservation(ctx context.Context, token string, params domain.GuestRescheduleDTO) (*domain.GuestReservationDTO, error) {
+	current, err := u.resolveGuestReservation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !guestCanModify(*current, time.Now()) {
+		return nil, domain.ErrGuestChangeNotAllowed
+	}
+	if params.StartAt.IsZero() {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	// Sin hora de fin se conserva la duración actual de la reserva
+	endAt := params.StartAt.Add(current.EndAt.Sub(current.StartAt))
+	if params.EndAt != nil {
+		endAt = *params.EndAt
+	}
+
+	_, err = u.UpdateReservation(ctx, domain.UpdateReservationDTO{
+		ID:             current.ReservaID,
+		StartAt:        &params.StartAt,
+		EndAt:          &endAt,
+		NumberOfGuests: params.NumberOfGuests,
+	})
+	if err != nil {
+		u.log.Warn().Err(err).Uint("reservation_id", current.ReservaID).Msg("Reprogramación de reserva por el cliente rechazada")
+		return nil, err
+	}
+
+	history := domain.ReservationStatusHistory{
+		ReservationID: current.ReservaID,
+		StatusID:      current.EstadoID,
+		Reason: fmt.Sprintf("Reprogramada por el cliente: %s → %s",
+			current.StartAt.Format("2006-01-02 15:04"), params.StartAt.Format("2006-01-02 15:04")),
+	}
+	if err := u.repository.CreateReservationStatusHistory(ctx, history); err != nil {
+		u.log.Warn().Err(err).Uint("reservation_id", current.ReservaID).Msg("Error al registrar reprogramación en el historial")
+	}
+
+	updated, err := u.getReservation(ctx, current.ReservaID)
+	if err != nil {
+		return nil, err
+	}
+	reservationEntity := u.convertDTOToReservation(updated)
+
+	// El token anterior vence con el horario original: se emite uno nuevo para el nuevo horario
+	newToken, err := u.guestToken(reservationEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reenviar la confirmación con los nuevos datos
+	if err := u.QueueReservationConfirmation(ctx, updated.ClienteEmail, updated.ClienteNombre, reservationEntity); err != nil {
+		u.log.Error().Err(err).Str("email", updated.ClienteEmail).Msg("Error al encolar email de reprogramación")
+	}
+
+	u.log.Info().Uint("reservation_id", current.ReservaID).Msg("Reserva reprogramada por el cliente")
+
+	return &domain.GuestReservationDTO{
+		Reservation: *updated,
+		CanModify:   guestCanModify(*updated, time.Now()),
+		Token:       newToken,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ResendNotification vuelve a poner en cola un email fallido con un nuevo ciclo de intentos.
+// El historial de intentos anteriores se conserva.
+func (u *ReserveUseCase) ResendNotification(ctx context.Context, id uint, businessID uint) (*domain.Notification, error) {
+	requeued, err := u.repository.RequeueNotification(ctx, id, businessID, time.Now(), domain.OutboxMaxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("error al reencolar notificación: %w", err)
+	}
+	if !requeued {
+		// Distinguir entre un email inexistente (o de otro negocio) y uno que no está fallido
+		if _, err := u.repository.GetNotificationByID(ctx, id, businessID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrNotificationNotResendable
+	}
+
+	u.log.Info().Uint("notification_id", id).Msg("Email reencolado manualmente")
+	return u.repository.GetNotificationByID(ctx, id, businessID)
+}
@@ -0,0 +1,51 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+)
+
+// resolveClient obtiene el cliente del negocio por email o lo crea si no existe
+func (u *ReserveUseCase) resolveClient(ctx context.Context, businessID uint, name, email, phone string, dni string) (uint, error) {
+	// Verificar si el cliente ya existe
+	existingClient, err := u.repository.GetClientByEmailAndBusiness(ctx, email, businessID)
+	if err != nil && err.Error() != "record not found" {
+		u.log.Error().Err(err).Str("email", email).Msg("Error al verificar cliente existente")
+		return 0, fmt.Errorf("error al verificar cliente: %w", err)
+	}
+
+	if existingClient != nil {
+		// Cliente existe, usar su ID
+		u.log.Info().Uint("client_id", existingClient.ID).Str("email", email).Msg("Cliente existente encontrado")
+		return existingClient.ID, nil
+	}
+
+	// Crear nuevo cliente
+	var dniPtr *string
+	if dni != "" {
+		dniPtr = &dni
+	}
+
+	newClient := domain.Client{
+		Name:       name,
+		Email:      email,
+		Phone:      phone,
+		Dni:        dniPtr,
+		BusinessID: businessID,
+	}
+
+	if _, err := u.repository.CreateClient(ctx, newClient); err != nil {
+		u.log.Error().Err(err).Str("email", email).Msg("Error al crear cliente")
+		return 0, fmt.Errorf("error al crear cliente: %w", err)
+	}
+
+	// Obtener el ID del cliente recién creado
+	createdClient, err := u.repository.GetClientByEmailAndBusiness(ctx, email, businessID)
+	if err != nil {
+		u.log.Error().Err(err).Str("email", email).Msg("Error al obtener cliente recién creado")
+		return 0, fmt.Errorf("error al obtener cliente: %w", err)
+	}
+	u.log.Info().Uint("client_id", createdClient.ID).Str("email", email).Msg("Nuevo cliente creado")
+	return createdClient.ID, nil
+}
@@ -0,0 +1,30 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+)
+
+// RevokeCalendarFeed invalida el enlace del feed iCal del negocio
+func (u *ReserveUseCase) RevokeCalendarFeed(ctx context.Context, businessID uint) error {
+	revoked, err := u.repository.DeleteCalendarFeedToken(ctx, businessID)
+	if err != nil {
+		return fmt.Errorf("error al revocar feed de calendario: %w", err)
+	}
+	if !revoked {
+		return domain.ErrCalendarFeedNotFound
+	}
+
+	u.log.Info().Uint("business_id", businessID).Msg("Feed de calendario revocado")
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "calendar_feed",
+		Action:     audit.ActionRevoke,
+		Before:     map[string]bool{"active": true},
+		After:      map[string]bool{"active": false},
+	})
+	return nil
+}
@@ -0,0 +1,43 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+	"time"
+)
+
+// RotateCalendarFeed genera un nuevo enlace de suscripción al feed iCal del negocio.
+// El enlace anterior deja de funcionar; el token solo se muestra en esta respuesta.
+func (u *ReserveUseCase) RotateCalendarFeed(ctx context.Context, businessID uint, createdByUserID *uint) (*domain.CalendarFeedDTO, error) {
+	token, err := generateSecretToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := u.repository.SaveCalendarFeedToken(ctx, businessID, hashSecretToken(token), createdByUserID); err != nil {
+		return nil, fmt.Errorf("error al guardar feed de calendario: %w", err)
+	}
+
+	u.log.Info().Uint("business_id", businessID).Msg("Feed de calendario generado")
+
+	// El token nunca se guarda en la auditoría
+	audit.Log(ctx, audit.Entry{
+		BusinessID: businessID,
+		EntityType: "calendar_feed",
+		Action:     audit.ActionCreate,
+		After:      map[string]bool{"active": true},
+	})
+
+	return &domain.CalendarFeedDTO{
+		BusinessID: businessID,
+		URL:        u.calendarFeedURL(token),
+		Token:      token,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// calendarFeedURL construye el enlace público del feed; la extensión .ics ayuda a los clientes de calendario
+func (u *ReserveUseCase) calendarFeedURL(token string) string {
+	return fmt.Sprintf("%s/api/v1/calendar/feed/%s.ics", u.apiBaseURL(), token)
+}
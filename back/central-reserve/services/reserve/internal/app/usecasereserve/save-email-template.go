@@ -0,0 +1,57 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/audit"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SaveEmailTemplate crea o reemplaza la plantilla personalizada de un negocio.
+// La plantilla se renderiza con datos de ejemplo antes de guardarse para que un
+// error de sintaxis o un campo inexistente no rompa los envíos reales.
+func (u *ReserveUseCase) SaveEmailTemplate(ctx context.Context, template domain.EmailTemplate) (*domain.EmailTemplate, error) {
+	if err := validateEmailTemplateKey(template.Code, template.Locale); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(template.Subject) == "" || strings.TrimSpace(template.HTMLBody) == "" || strings.TrimSpace(template.TextBody) == "" {
+		return nil, fmt.Errorf("%w: el asunto, el HTML y el texto son obligatorios", domain.ErrInvalidEmailTemplate)
+	}
+
+	branding, err := u.emailBranding(ctx, template.BusinessID)
+	if err != nil {
+		return nil, err
+	}
+	branding.Locale = template.Locale
+
+	source := emailTemplateSource{Subject: template.Subject, HTML: template.HTMLBody, Text: template.TextBody}
+	if _, err := renderEmail(template.Locale, source, sampleEmailData(*branding, template.Code)); err != nil {
+		return nil, err
+	}
+
+	saved, err := u.repository.SaveEmailTemplate(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("error al guardar plantilla de email: %w", err)
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: saved.BusinessID,
+		EntityType: "email_template",
+		EntityID:   saved.ID,
+		Action:     audit.ActionUpdate,
+		After:      saved,
+	})
+	return saved, nil
+}
+
+// validateEmailTemplateKey verifica que el código y el idioma correspondan a una plantilla existente
+func validateEmailTemplateKey(code, locale string) error {
+	if !isEmailTemplateCode(code) {
+		return fmt.Errorf("%w: %s", domain.ErrEmailTemplateNotFound, code)
+	}
+	if !isSupportedEmailLocale(locale) {
+		return fmt.Errorf("%w: %s", domain.ErrUnsupportedLocale, locale)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// getBusinessSchedule obtiene el horario del negocio para validar reservas en el rango indicado
+func (u *ReserveUseCase) getBusinessSchedule(ctx context.Context, businessID uint, startAt, endAt time.Time) (*domain.BusinessSchedule, error) {
+	schedule, err := u.repository.GetBusinessSchedule(ctx, businessID, startAt, endAt)
+	if err != nil {
+		u.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener horario del negocio")
+		return nil, fmt.Errorf("error al obtener horario del negocio: %w", err)
+	}
+	return schedule, nil
+}
+
+// validateBookingWindow valida la anticipación mínima y los días máximos de anticipación respecto a now
+func validateBookingWindow(schedule *domain.BusinessSchedule, startAt, now time.Time) error {
+	if startAt.Before(now.Add(time.Duration(schedule.MinLeadTimeMinutes) * time.Minute)) {
+		return fmt.Errorf("%w: mínimo %d minutos", domain.ErrLeadTimeTooShort, schedule.MinLeadTimeMinutes)
+	}
+	if schedule.MaxAdvanceDays > 0 && startAt.After(now.AddDate(0, 0, schedule.MaxAdvanceDays)) {
+		return fmt.Errorf("%w: máximo %d días", domain.ErrBeyondMaxAdvance, schedule.MaxAdvanceDays)
+	}
+	return nil
+}
+
+// validateSchedule valida que el slot esté alineado a la granularidad del negocio y que quepa completo
+// dentro de una franja de atención del día (o de una franja nocturna que empezó el día anterior).
+// Si el negocio no configuró horario semanal ni excepción para el día, no se restringe la hora.
+func validateSchedule(schedule *domain.BusinessSchedule, slot domain.TimeSlot) error {
+	local := slot.StartAt.In(schedule.Location)
+
+	minuteOfDay := local.Hour()*60 + local.Minute()
+	if local.Second() != 0 || local.Nanosecond() != 0 || minuteOfDay%schedule.SlotMinutes != 0 {
+		return fmt.Errorf("%w: intervalos de %d minutos", domain.ErrSlotNotAligned, schedule.SlotMinutes)
+	}
+
+	windows, blackout := openWindows(schedule, local)
+	if len(schedule.OpeningHours) == 0 && blackout == nil {
+		return nil
+	}
+	previous, _ := openWindows(schedule, local.AddDate(0, 0, -1))
+	windows = append(windows, previous...)
+
+	for _, window := range windows {
+		if !slot.StartAt.Before(window.StartAt) && !slot.EndAt.After(window.EndAt) {
+			return nil
+		}
+	}
+
+	if blackout != nil && blackout.IsClosed {
+		if blackout.Reason != "" {
+			return fmt.Errorf("%w: %s", domain.ErrBusinessClosedDate, blackout.Reason)
+		}
+		return domain.ErrBusinessClosedDate
+	}
+	return domain.ErrOutsideOpeningHours
+}
+
+// openWindows devuelve las franjas de atención que empiezan en el día local de day.
+// Una excepción de horario para ese día reemplaza al horario semanal y también se retorna.
+func openWindows(schedule *domain.BusinessSchedule, day time.Time) ([]domain.TimeSlot, *domain.BlackoutDate) {
+	year, month, date := day.Date()
+	key := time.Date(year, month, date, 0, 0, 0, 0, time.UTC)
+
+	for i := range schedule.BlackoutDates {
+		blackout := &schedule.BlackoutDates[i]
+		if !blackout.Date.Equal(key) {
+			continue
+		}
+		if blackout.IsClosed || blackout.OpenTime == nil || blackout.CloseTime == nil {
+			return nil, blackout
+		}
+		window, ok := buildWindow(schedule.Location, year, month, date, *blackout.OpenTime, *blackout.CloseTime)
+		if !ok {
+			return nil, blackout
+		}
+		return []domain.TimeSlot{window}, blackout
+	}
+
+	windows := make([]domain.TimeSlot, 0)
+	weekday := int(time.Date(year, month, date, 0, 0, 0, 0, time.UTC).Weekday())
+	for _, hour := range schedule.OpeningHours {
+		if hour.DayOfWeek != weekday {
+			continue
+		}
+		if window, ok := buildWindow(schedule.Location, year, month, date, hour.OpenTime, hour.CloseTime); ok {
+			windows = append(windows, window)
+		}
+	}
+	return windows, nil
+}
+
+// buildWindow construye la franja [open, close) del día local; si close <= open termina al día siguiente
+func buildWindow(location *time.Location, year int, month time.Month, date int, openTime, closeTime string) (domain.TimeSlot, bool) {
+	open, err := time.Parse("15:04", openTime)
+	if err != nil {
+		return domain.TimeSlot{}, false
+	}
+	closeAt, err := time.Parse("15:04", closeTime)
+	if err != nil {
+		return domain.TimeSlot{}, false
+	}
+
+	closeDate := date
+	if closeAt.Hour()*60+closeAt.Minute() <= open.Hour()*60+open.Minute() {
+		closeDate++
+	}
+
+	return domain.TimeSlot{
+		StartAt: time.Date(year, month, date, open.Hour(), open.Minute(), 0, 0, location),
+		EndAt:   time.Date(year, month, closeDate, closeAt.Hour(), closeAt.Minute(), 0, 0, location),
+	}, true
+}
+
+// alignToSlot redondea hacia arriba al siguiente inicio de intervalo del negocio en hora local
+func alignToSlot(schedule *domain.BusinessSchedule, t time.Time) time.Time {
+	local := t.In(schedule.Location)
+	aligned := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), 0, 0, schedule.Location)
+	if aligned.Before(t) {
+		aligned = aligned.Add(time.Minute)
+	}
+	if rem := (aligned.Hour()*60 + aligned.Minute()) % schedule.SlotMinutes; rem != 0 {
+		aligned = aligned.Add(time.Duration(schedule.SlotMinutes-rem) * time.Minute)
+	}
+	return aligned
+}
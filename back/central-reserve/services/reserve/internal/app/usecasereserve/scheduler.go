@@ -0,0 +1,56 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"sync"
+	"time"
+)
+
+// scheduledJob es una tarea periódica del servicio de reservas; run retorna cuántos elementos procesó
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) (int, error)
+}
+
+// RunScheduler ejecuta las tareas programadas de reservas hasta que se cancele el contexto: vencimiento
+// de ofertas de lista de espera, envío del outbox de emails, recordatorios y no-shows automáticos.
+// Cada tarea corre con su propio intervalo para que una lenta no retrase a las demás.
+func (u *ReserveUseCase) RunScheduler(ctx context.Context) {
+	jobs := []scheduledJob{
+		{name: "waitlist_expiry", interval: domain.WaitlistExpiryInterval, run: u.ExpireWaitlistOffers},
+		{name: "outbox", interval: domain.OutboxPollInterval, run: u.DeliverDueNotifications},
+		{name: "reminders", interval: domain.ReminderInterval, run: u.SendDueReminders},
+		{name: "no_shows", interval: domain.NoShowInterval, run: u.ProcessNoShows},
+	}
+
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job scheduledJob) {
+			defer wg.Done()
+			u.runScheduledJob(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+// runScheduledJob ejecuta una tarea en cada tick y registra el resultado
+func (u *ReserveUseCase) runScheduledJob(ctx context.Context, job scheduledJob) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if processed, err := job.run(ctx); err != nil {
+				u.log.Error().Err(err).Str("job", job.name).Msg("Error en tarea programada de reservas")
+			} else if processed > 0 {
+				u.log.Info().Str("job", job.name).Int("processed", processed).Msg("Tarea programada de reservas ejecutada")
+			}
+		}
+	}
+}
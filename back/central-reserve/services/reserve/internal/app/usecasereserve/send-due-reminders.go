@@ -0,0 +1,40 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// SendDueReminders encola el recordatorio de las reservas que entraron en la ventana configurada por
+// su negocio. Retorna cuántos recordatorios se encolaron.
+func (u *ReserveUseCase) SendDueReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	reservations, err := u.repository.GetReservationsDueForReminder(ctx, now, domain.SchedulerBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener reservas pendientes de recordatorio: %w", err)
+	}
+
+	queued := 0
+	for i := range reservations {
+		reservation := &reservations[i]
+
+		claimed, err := u.repository.ClaimReservationReminder(ctx, reservation.ReservaID, now)
+		if err != nil || !claimed {
+			continue
+		}
+
+		reservationEntity := u.convertDTOToReservation(reservation)
+		if err := u.QueueReservationReminder(ctx, reservation.ClienteEmail, reservation.ClienteNombre, reservationEntity); err != nil {
+			u.log.Error().Err(err).Uint("reservation_id", reservation.ReservaID).Msg("Error al encolar recordatorio de reserva")
+			// Se libera para reintentarlo en el próximo ciclo
+			if err := u.repository.ReleaseReservationReminder(ctx, reservation.ReservaID); err != nil {
+				u.log.Error().Err(err).Uint("reservation_id", reservation.ReservaID).Msg("El recordatorio no se reintentará")
+			}
+			continue
+		}
+		queued++
+	}
+	return queued, nil
+}
@@ -2,10 +2,39 @@ package usecasereserve
 
 import (
 	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
+	"time"
 )
 
+// UpdateReservation actualiza una reserva validando la disponibilidad de la mesa o sala
+// y, si cambia el horario, las reglas y el horario de atención del negocio
 func (u *ReserveUseCase) UpdateReservation(ctx context.Context, params domain.UpdateReservationDTO) (string, error) {
+	if params.NumberOfGuests != nil && *params.NumberOfGuests <= 0 {
+		return "", domain.ErrInvalidNumberOfGuests
+	}
+	if params.StartAt != nil && params.EndAt != nil && !params.EndAt.After(*params.StartAt) {
+		return "", domain.ErrInvalidTimeRange
+	}
+
+	if params.StartAt != nil || params.EndAt != nil {
+		if err := u.validateReschedule(ctx, params); err != nil {
+			return "", err
+		}
+	}
+
+	// El repositorio valida la transición; aquí solo se detecta si el estado cambia
+	statusChanged := false
+	if params.StatusID != nil {
+		current, err := u.getReservation(ctx, params.ID)
+		if err != nil {
+			return "", err
+		}
+		statusChanged = current.EstadoID != *params.StatusID
+	}
+
+	before, _ := u.repository.GetReserveByID(ctx, params.ID)
+
 	response, err := u.repository.UpdateReservation(ctx, params)
 	if err != nil {
 		return "", err
@@ -15,5 +44,50 @@ func (u *ReserveUseCase) UpdateReservation(ctx context.Context, params domain.Up
 		return "", nil // Reserva no encontrada
 	}
 
+	if reservation, err := u.getReservation(ctx, params.ID); err == nil {
+		audit.Log(ctx, audit.Entry{
+			BusinessID: reservation.NegocioID,
+			EntityType: "reservation",
+			EntityID:   params.ID,
+			Action:     audit.ActionUpdate,
+			Before:     before,
+			After:      reservation,
+		})
+		if statusChanged {
+			u.afterStatusChange(ctx, reservation)
+		}
+	}
+
 	return response, nil
 }
+
+// validateReschedule combina el horario actual con los cambios y lo valida contra las reglas del negocio
+func (u *ReserveUseCase) validateReschedule(ctx context.Context, params domain.UpdateReservationDTO) error {
+	current, err := u.getReservation(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+
+	startAt, endAt := current.StartAt, current.EndAt
+	if params.StartAt != nil {
+		startAt = *params.StartAt
+	}
+	if params.EndAt != nil {
+		endAt = *params.EndAt
+	}
+	if !endAt.After(startAt) {
+		return domain.ErrInvalidTimeRange
+	}
+
+	schedule, err := u.getBusinessSchedule(ctx, current.NegocioID, startAt, startAt)
+	if err != nil {
+		return err
+	}
+	// La anticipación solo aplica si se mueve la hora de inicio
+	if params.StartAt != nil && !params.StartAt.Equal(current.StartAt) {
+		if err := validateBookingWindow(schedule, startAt, time.Now()); err != nil {
+			return err
+		}
+	}
+	return validateSchedule(schedule, domain.TimeSlot{StartAt: startAt, EndAt: endAt})
+}
@@ -0,0 +1,139 @@
+package usecasereserve
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// offerFreedSlot ofrece un horario liberado al primer cliente en espera (por prioridad y antigüedad)
+// para el que vuelva a haber mesa. Los errores se registran y no se propagan: liberar el horario
+// no debe fallar porque no se pudo notificar a la lista de espera.
+func (u *ReserveUseCase) offerFreedSlot(ctx context.Context, businessID uint, slot domain.TimeSlot) {
+	candidates, err := u.repository.GetWaitlistCandidates(ctx, businessID, slot.StartAt, slot.EndAt, time.Now())
+	if err != nil {
+		u.log.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener candidatos de lista de espera")
+		return
+	}
+
+	for _, candidate := range candidates {
+		_, err := u.assignTables(ctx, domain.Reservation{
+			BusinessID:     candidate.BusinessID,
+			RoomID:         candidate.RoomID,
+			StartAt:        candidate.StartAt,
+			EndAt:          candidate.EndAt,
+			NumberOfGuests: candidate.NumberOfGuests,
+		})
+		if errors.Is(err, domain.ErrNoTableAvailable) {
+			continue
+		}
+		if err != nil {
+			u.log.Error().Err(err).Uint("waitlist_id", candidate.ID).Msg("Error al verificar disponibilidad para oferta de lista de espera")
+			return
+		}
+
+		offered, err := u.sendWaitlistOffer(ctx, candidate)
+		if err != nil {
+			u.log.Error().Err(err).Uint("waitlist_id", candidate.ID).Msg("Error al ofrecer horario de lista de espera")
+			continue
+		}
+		if offered {
+			return
+		}
+	}
+}
+
+// sendWaitlistOffer genera el enlace de aceptación, marca la entrada como ofrecida y encola el email.
+// Retorna false si otra petición ya tomó la entrada.
+func (u *ReserveUseCase) sendWaitlistOffer(ctx context.Context, entry domain.WaitlistEntryDTO) (bool, error) {
+	token, err := generateSecretToken()
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(u.waitlistOfferDuration())
+
+	offered, err := u.repository.MarkWaitlistOffered(ctx, entry.ID, hashSecretToken(token), now, expiresAt)
+	if err != nil || !offered {
+		return false, err
+	}
+
+	u.log.Info().Uint("waitlist_id", entry.ID).Time("expires_at", expiresAt).Msg("Horario ofrecido a cliente en lista de espera")
+
+	acceptURL := u.waitlistOfferURL(token)
+	if err := u.QueueWaitlistOffer(ctx, entry.ClientEmail, entry.ClientName, entry.WaitlistEntry, acceptURL, expiresAt); err != nil {
+		u.log.Error().Err(err).Str("email", entry.ClientEmail).Msg("Error al encolar email de oferta de lista de espera")
+	}
+	return true, nil
+}
+
+// getOfferEntry obtiene la entrada asociada a un token de oferta
+func (u *ReserveUseCase) getOfferEntry(ctx context.Context, token string) (*domain.WaitlistEntryDTO, error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, domain.ErrWaitlistOfferNotFound
+	}
+	return u.repository.GetWaitlistEntryByOfferToken(ctx, hashSecretToken(token))
+}
+
+// expireOffer marca una oferta vencida y pasa el horario al siguiente en la lista
+func (u *ReserveUseCase) expireOffer(ctx context.Context, entry domain.WaitlistEntryDTO) (bool, error) {
+	expired, err := u.repository.UpdateWaitlistStatus(ctx, entry.ID, domain.WaitlistStatusOffered, domain.WaitlistStatusExpired, nil)
+	if err != nil || !expired {
+		return false, err
+	}
+	u.log.Info().Uint("waitlist_id", entry.ID).Msg("Oferta de lista de espera expirada")
+	u.offerFreedSlot(ctx, entry.BusinessID, domain.TimeSlot{StartAt: entry.StartAt, EndAt: entry.EndAt})
+	return true, nil
+}
+
+// waitlistOfferDuration es la vigencia del enlace de aceptación (WAITLIST_OFFER_MINUTES)
+func (u *ReserveUseCase) waitlistOfferDuration() time.Duration {
+	minutes := domain.DefaultWaitlistOfferMinutes
+	if u.env != nil {
+		if value, err := strconv.Atoi(u.env.Get("WAITLIST_OFFER_MINUTES")); err == nil && value > 0 {
+			minutes = value
+		}
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// waitlistOfferURL construye el enlace público de aceptación de la oferta
+func (u *ReserveUseCase) waitlistOfferURL(token string) string {
+	return u.publicURL("/public/waitlist/offer", token)
+}
+
+// generateSecretToken genera un token aleatorio para un enlace público (oferta, feed de calendario)
+func generateSecretToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("error al generar token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// hashSecretToken calcula el hash que se guarda en base de datos en lugar del token
+func hashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// toWaitlistOffer convierte la entrada en la vista pública de la oferta
+func toWaitlistOffer(entry domain.WaitlistEntryDTO) *domain.WaitlistOfferDTO {
+	return &domain.WaitlistOfferDTO{
+		EntryID:        entry.ID,
+		Status:         entry.Status,
+		StartAt:        entry.StartAt,
+		EndAt:          entry.EndAt,
+		NumberOfGuests: entry.NumberOfGuests,
+		ExpiresAt:      entry.OfferExpiresAt,
+		ReservationID:  entry.ReservationID,
+	}
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// NonBlockingStatusCodes son los códigos de estado cuyas reservas no ocupan mesa ni sala
+var NonBlockingStatusCodes = []string{StatusCancelled, StatusNoShow, StatusCompleted}
+
+const (
+	// DefaultSlotMinutes es la granularidad de los slots si el negocio no configuró reglas de reserva
+	DefaultSlotMinutes = 30
+	// DefaultReservationMinutes es la duración de una reserva sin hora de fin si el negocio no configuró reglas
+	DefaultReservationMinutes = 120
+	// MaxAvailabilityRangeDays limita el rango de fechas que se puede consultar de una vez
+	MaxAvailabilityRangeDays = 31
+)
+
+// TimeSlot representa un intervalo [StartAt, EndAt)
+type TimeSlot struct {
+	StartAt time.Time
+	EndAt   time.Time
+}
+
+// Overlaps indica si dos intervalos se solapan
+func (s TimeSlot) Overlaps(other TimeSlot) bool {
+	return s.StartAt.Before(other.EndAt) && other.StartAt.Before(s.EndAt)
+}
+
+// Occupancy representa las personas de una reserva de sala durante su intervalo
+type Occupancy struct {
+	TimeSlot
+	Guests int
+}
+
+// PeakOccupancy devuelve el máximo de personas presentes a la vez dentro de window. Las reservas
+// que se suceden sin solaparse dentro del intervalo no se suman.
+func PeakOccupancy(window TimeSlot, occupancies []Occupancy) int {
+	type event struct {
+		at    time.Time
+		delta int
+	}
+	events := make([]event, 0, 2*len(occupancies))
+	for _, o := range occupancies {
+		if !window.Overlaps(o.TimeSlot) {
+			continue
+		}
+		start, end := o.StartAt, o.EndAt
+		if start.Before(window.StartAt) {
+			start = window.StartAt
+		}
+		if end.After(window.EndAt) {
+			end = window.EndAt
+		}
+		events = append(events, event{at: start, delta: o.Guests}, event{at: end, delta: -o.Guests})
+	}
+
+	// Los intervalos son [inicio, fin): a la misma hora primero sale una reserva y luego entra otra
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].at.Equal(events[j].at) {
+			return events[i].delta < events[j].delta
+		}
+		return events[i].at.Before(events[j].at)
+	})
+
+	current, peak := 0, 0
+	for _, e := range events {
+		current += e.delta
+		if current > peak {
+			peak = current
+		}
+	}
+	return peak
+}
+
+// AvailabilityQuery encapsula los filtros para consultar disponibilidad
+type AvailabilityQuery struct {
+	BusinessID      uint
+	StartDate       time.Time
+	EndDate         time.Time
+	RoomID          *uint
+	TableID         *uint
+	NumberOfGuests  int
+	SlotMinutes     int
+	DurationMinutes int
+}
+
+// TableAvailabilityDTO contiene los slots libres y ocupados de una mesa
+type TableAvailabilityDTO struct {
+	TableID   uint
+	Number    int
+	Capacity  int
+	RoomID    *uint
+	FreeSlots []TimeSlot
+	BusySlots []TimeSlot
+}
+
+// RoomSlotDTO representa un slot libre de una sala con su capacidad restante
+type RoomSlotDTO struct {
+	TimeSlot
+	RemainingCapacity int
+}
+
+// RoomAvailabilityDTO contiene los slots con capacidad suficiente de una sala
+type RoomAvailabilityDTO struct {
+	RoomID    uint
+	Name      string
+	Capacity  int
+	FreeSlots []RoomSlotDTO
+}
+
+// AvailabilityDTO es el resultado de una consulta de disponibilidad
+type AvailabilityDTO struct {
+	BusinessID      uint
+	StartDate       time.Time
+	EndDate         time.Time
+	SlotMinutes     int
+	DurationMinutes int
+	Tables          []TableAvailabilityDTO
+	Rooms           []RoomAvailabilityDTO
+}
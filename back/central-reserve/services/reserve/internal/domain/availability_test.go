@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2025, 3, 14, hour, minute, 0, 0, time.UTC)
+}
+
+func slot(startHour, startMinute, endHour, endMinute int) TimeSlot {
+	return TimeSlot{StartAt: at(startHour, startMinute), EndAt: at(endHour, endMinute)}
+}
+
+func TestTimeSlotOverlaps(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  TimeSlot
+		wants bool
+	}{
+		{name: "solapados", a: slot(19, 0, 21, 0), b: slot(20, 0, 22, 0), wants: true},
+		{name: "uno dentro de otro", a: slot(19, 0, 23, 0), b: slot(20, 0, 21, 0), wants: true},
+		{name: "contiguos", a: slot(19, 0, 20, 0), b: slot(20, 0, 21, 0), wants: false},
+		{name: "separados", a: slot(12, 0, 13, 0), b: slot(20, 0, 21, 0), wants: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(tt.b); got != tt.wants {
+				t.Errorf("a.Overlaps(b) = %v, se esperaba %v", got, tt.wants)
+			}
+			if got := tt.b.Overlaps(tt.a); got != tt.wants {
+				t.Errorf("b.Overlaps(a) = %v, se esperaba %v", got, tt.wants)
+			}
+		})
+	}
+}
+
+func TestPeakOccupancy(t *testing.T) {
+	window := slot(19, 0, 21, 0)
+
+	tests := []struct {
+		name        string
+		occupancies []Occupancy
+		want        int
+	}{
+		{name: "sin reservas", want: 0},
+		{
+			name:        "una reserva",
+			occupancies: []Occupancy{{TimeSlot: slot(19, 0, 21, 0), Guests: 10}},
+			want:        10,
+		},
+		{
+			name: "reservas consecutivas no se suman",
+			occupancies: []Occupancy{
+				{TimeSlot: slot(19, 0, 20, 0), Guests: 10},
+				{TimeSlot: slot(20, 0, 21, 0), Guests: 15},
+			},
+			want: 15,
+		},
+		{
+			name: "reservas simultáneas se suman",
+			occupancies: []Occupancy{
+				{TimeSlot: slot(19, 0, 21, 0), Guests: 10},
+				{TimeSlot: slot(19, 30, 20, 30), Guests: 5},
+			},
+			want: 15,
+		},
+		{
+			name: "el pico es el mayor solapamiento",
+			occupancies: []Occupancy{
+				{TimeSlot: slot(19, 0, 20, 0), Guests: 8},
+				{TimeSlot: slot(19, 30, 20, 30), Guests: 4},
+				{TimeSlot: slot(20, 0, 21, 0), Guests: 6},
+			},
+			want: 12,
+		},
+		{
+			name: "reservas fuera de la ventana se ignoran",
+			occupancies: []Occupancy{
+				{TimeSlot: slot(17, 0, 19, 0), Guests: 30},
+				{TimeSlot: slot(21, 0, 23, 0), Guests: 30},
+				{TimeSlot: slot(20, 0, 20, 30), Guests: 3},
+			},
+			want: 3,
+		},
+		{
+			name: "reservas que cruzan el borde se recortan a la ventana",
+			occupancies: []Occupancy{
+				{TimeSlot: slot(18, 0, 19, 30), Guests: 7},
+				{TimeSlot: slot(20, 30, 22, 0), Guests: 9},
+			},
+			want: 9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PeakOccupancy(window, tt.occupancies); got != tt.want {
+				t.Errorf("PeakOccupancy() = %d, se esperaba %d", got, tt.want)
+			}
+		})
+	}
+}
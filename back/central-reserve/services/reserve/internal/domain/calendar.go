@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+const (
+	// CalendarProdID identifica al generador en los archivos iCalendar
+	CalendarProdID = "-//Central Reserve//Reservas//ES"
+	// CalendarFeedPast y CalendarFeedAhead acotan las reservas que incluye el feed del negocio
+	CalendarFeedPast  = 24 * time.Hour
+	CalendarFeedAhead = 90 * 24 * time.Hour
+)
+
+// CalendarFeedExcludedStatuses son los estados cuyas reservas no aparecen en el feed
+var CalendarFeedExcludedStatuses = []string{StatusCancelled, StatusNoShow}
+
+// CalendarFeedDTO es el enlace de suscripción al feed de un negocio. El token solo se
+// muestra al generarlo; en base de datos se guarda su hash.
+type CalendarFeedDTO struct {
+	BusinessID uint
+	URL        string
+	Token      string
+	CreatedAt  time.Time
+}
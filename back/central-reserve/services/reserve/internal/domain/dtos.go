@@ -22,12 +22,16 @@ type ReserveDetailDTO struct {
 	ClienteEmail    string
 	ClienteTelefono string
 	ClienteDni      *string
+	ClienteNoShows  int // No-shows acumulados del cliente en el negocio
 
 	// Mesa
 	MesaID        *uint
 	MesaNumero    *int
 	MesaCapacidad *int
 
+	// Mesas asignadas (varias si se combinaron para un grupo grande)
+	MesasAsignadas []AssignedTableDTO
+
 	// Negocio
 	NegocioID        uint
 	NegocioNombre    string
@@ -55,6 +59,8 @@ type UpdateReservationDTO struct {
 	EndAt          *time.Time
 	NumberOfGuests *int
 	StatusID       *uint
+
+	ChangedByUserID *uint // Usuario que realiza el cambio (para el historial de estados)
 }
 
 type ReservationStatusDTO struct {
@@ -62,3 +68,11 @@ type ReservationStatusDTO struct {
 	Code string
 	Name string
 }
+
+// AssignedTableDTO representa una mesa asignada a una reserva
+type AssignedTableDTO struct {
+	ID       uint
+	Number   int
+	Capacity int
+	RoomID   *uint
+}
@@ -0,0 +1,93 @@
+package domain
+
+import "time"
+
+// Códigos de las plantillas de email transaccionales de reservas
+const (
+	EmailReservationConfirmation = "reservation_confirmation"
+	EmailReservationCancellation = "reservation_cancellation"
+	EmailWaitlistOffer           = "waitlist_offer"
+	EmailReservationReminder     = "reservation_reminder"
+)
+
+// EmailTemplateCodes son las plantillas que un negocio puede personalizar
+var EmailTemplateCodes = []string{EmailReservationConfirmation, EmailReservationCancellation, EmailWaitlistOffer, EmailReservationReminder}
+
+const (
+	// DefaultEmailLocale es el idioma de los emails si el negocio no lo configura
+	DefaultEmailLocale = "es"
+	// DefaultEmailPrimaryColor es el color de marca si el negocio no tiene uno válido
+	DefaultEmailPrimaryColor = "#1f2937"
+)
+
+// SupportedEmailLocales son los idiomas con plantillas por defecto
+var SupportedEmailLocales = []string{"es", "en"}
+
+// EmailTemplate es una plantilla personalizada por un negocio para un código e idioma.
+// HTMLBody es el contenido que se inserta dentro del diseño con la marca del negocio.
+type EmailTemplate struct {
+	ID         uint
+	BusinessID uint
+	Code       string
+	Locale     string
+	Subject    string
+	HTMLBody   string
+	TextBody   string
+	UpdatedAt  time.Time
+}
+
+// EmailTemplateStatusDTO indica, por código e idioma, si el negocio tiene la plantilla personalizada
+type EmailTemplateStatusDTO struct {
+	Code       string
+	Locale     string
+	Customized bool
+	Template   *EmailTemplate // nil si se usa la plantilla por defecto
+}
+
+// EmailBranding son los datos de marca blanca del negocio usados en los emails
+type EmailBranding struct {
+	BusinessID   uint
+	Name         string
+	LogoURL      string
+	PrimaryColor string
+	Address      string
+	Locale       string
+	Location     *time.Location
+}
+
+// EmailTemplateData son los datos disponibles en las plantillas
+type EmailTemplateData struct {
+	Business    EmailBranding
+	GuestName   string
+	Reservation EmailReservationData
+	ManageURL   string // Enlace de autogestión de la reserva (confirmación y recordatorio)
+	AcceptURL   string // Enlace para aceptar la oferta (lista de espera)
+	ExpiresAt   string // Vencimiento de la oferta, ya formateado
+}
+
+// EmailReservationData son los datos de la reserva ya formateados en el idioma y zona horaria del negocio
+type EmailReservationData struct {
+	ID             uint
+	Date           string
+	StartTime      string
+	EndTime        string
+	NumberOfGuests int
+}
+
+// RenderedEmail es el resultado de renderizar una plantilla
+type RenderedEmail struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// EmailPreviewRequest solicita la vista previa de una plantilla. Si se envían Subject, HTMLBody
+// o TextBody se previsualizan sin guardar; si no, se usa la plantilla guardada o la por defecto.
+type EmailPreviewRequest struct {
+	BusinessID uint
+	Code       string
+	Locale     string
+	Subject    *string
+	HTMLBody   *string
+	TextBody   *string
+}
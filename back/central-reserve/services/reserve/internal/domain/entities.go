@@ -5,7 +5,9 @@ import "time"
 type Reservation struct {
 	ID              uint
 	BusinessID      uint
+	RoomID          *uint
 	TableID         *uint
+	TableIDs        []uint // Todas las mesas asignadas (incluye TableID); más de una si se combinaron
 	ClientID        uint
 	CreatedByUserID *uint
 	StartAt         time.Time
@@ -36,11 +38,24 @@ type Table struct {
 	BusinessID uint
 	Number     int
 	Capacity   int
+	RoomID     *uint
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	DeletedAt  *time.Time
 }
 
+// Room representa una sala dentro de un negocio
+type Room struct {
+	ID          uint
+	BusinessID  uint
+	Name        string
+	Code        string
+	Capacity    int
+	MinCapacity int
+	MaxCapacity int
+	IsActive    bool
+}
+
 // ReservationStatus representa el estado de una reserva
 type ReservationStatus struct {
 	ID        uint
@@ -57,6 +72,7 @@ type ReservationStatusHistory struct {
 	ReservationID   uint
 	StatusID        uint
 	ChangedByUserID *uint
+	Reason          string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       *time.Time
@@ -64,3 +80,22 @@ type ReservationStatusHistory struct {
 	StatusName      string
 	ChangedByUser   *string
 }
+
+// WaitlistEntry representa un cliente en lista de espera para un horario sin disponibilidad
+type WaitlistEntry struct {
+	ID             uint
+	BusinessID     uint
+	ClientID       uint
+	RoomID         *uint
+	StartAt        time.Time
+	EndAt          time.Time
+	NumberOfGuests int
+	Priority       int
+	Status         string
+	Notes          string
+	OfferedAt      *time.Time
+	OfferExpiresAt *time.Time
+	ReservationID  *uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
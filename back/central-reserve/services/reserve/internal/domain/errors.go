@@ -0,0 +1,69 @@
+package domain
+
+import "errors"
+
+var (
+	// Errores de reservas
+	ErrReservationNotFound   = errors.New("reserva no encontrada")
+	ErrReservationConflict   = errors.New("la mesa o sala ya tiene una reserva en ese horario")
+	ErrInvalidTimeRange      = errors.New("la fecha de fin debe ser posterior a la fecha de inicio")
+	ErrInvalidNumberOfGuests = errors.New("el número de invitados debe ser mayor a 0")
+
+	// Errores de la máquina de estados
+	ErrReservationStatusNotFound = errors.New("estado de reserva no encontrado")
+	ErrInvalidStatusTransition   = errors.New("la reserva no puede pasar a ese estado desde su estado actual")
+
+	// Errores de disponibilidad
+	ErrTableNotFound         = errors.New("mesa no encontrada en el negocio")
+	ErrRoomNotFound          = errors.New("sala no encontrada en el negocio")
+	ErrTableCapacityExceeded = errors.New("el número de invitados supera la capacidad de la mesa")
+	ErrRoomCapacityExceeded  = errors.New("el número de invitados supera la capacidad disponible de la sala")
+	ErrAvailabilityRange     = errors.New("el rango de consulta de disponibilidad no es válido")
+
+	// Errores de asignación de mesas
+	ErrNoTableAvailable = errors.New("no hay mesas o combinaciones de mesas libres para el número de invitados")
+)
+
+var (
+	// Errores de horario y reglas de reserva del negocio
+	ErrOutsideOpeningHours = errors.New("el horario solicitado está fuera del horario de atención del negocio")
+	ErrBusinessClosedDate  = errors.New("el negocio está cerrado en la fecha solicitada")
+	ErrSlotNotAligned      = errors.New("la hora de inicio no coincide con los intervalos de reserva del negocio")
+	ErrLeadTimeTooShort    = errors.New("la reserva no cumple la anticipación mínima requerida")
+	ErrBeyondMaxAdvance    = errors.New("la reserva supera los días máximos de anticipación permitidos")
+)
+
+var (
+	// Errores de lista de espera
+	ErrWaitlistEntryNotFound     = errors.New("entrada de lista de espera no encontrada")
+	ErrWaitlistDuplicate         = errors.New("el cliente ya está en lista de espera para ese horario")
+	ErrWaitlistSlotAvailable     = errors.New("hay disponibilidad para ese horario, la reserva puede hacerse directamente")
+	ErrWaitlistInvalidTransition = errors.New("la entrada de lista de espera no admite esa operación en su estado actual")
+	ErrWaitlistOfferNotFound     = errors.New("oferta de lista de espera no encontrada")
+	ErrWaitlistOfferExpired      = errors.New("la oferta de lista de espera ha expirado")
+)
+
+var (
+	// Errores de autogestión de reservas por el cliente
+	ErrGuestTokenInvalid     = errors.New("el enlace de la reserva no es válido o ha expirado")
+	ErrGuestChangeNotAllowed = errors.New("la reserva ya no puede modificarse ni cancelarse desde el enlace")
+)
+
+var (
+	// Errores de plantillas de email
+	ErrEmailTemplateNotFound = errors.New("plantilla de email no encontrada")
+	ErrInvalidEmailTemplate  = errors.New("la plantilla de email no es válida")
+	ErrUnsupportedLocale     = errors.New("idioma de email no soportado")
+)
+
+var (
+	// Errores del outbox de emails
+	ErrNotificationNotFound      = errors.New("notificación no encontrada")
+	ErrNotificationNotResendable = errors.New("solo se pueden reenviar notificaciones fallidas")
+	ErrInvalidNotificationStatus = errors.New("estado de notificación inválido")
+)
+
+var (
+	// Errores del feed de calendario
+	ErrCalendarFeedNotFound = errors.New("el feed de calendario no existe o fue revocado")
+)
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// GuestModifiableStatuses son los estados en los que el cliente puede reprogramar o cancelar
+// su reserva desde el enlace de autogestión
+var GuestModifiableStatuses = []string{StatusPending, StatusConfirmed}
+
+// GuestReservationDTO es la vista de una reserva para su cliente
+type GuestReservationDTO struct {
+	Reservation ReserveDetailDTO
+	CanModify   bool
+	// Token es el nuevo enlace de autogestión cuando el anterior deja de cubrir la reserva
+	// (por ejemplo, al reprogramarla a una fecha posterior). Vacío si no cambia.
+	Token string
+}
+
+// GuestRescheduleDTO son los cambios que el cliente puede hacer sobre su reserva.
+// Si EndAt es nil se conserva la duración actual.
+type GuestRescheduleDTO struct {
+	StartAt        time.Time
+	EndAt          *time.Time
+	NumberOfGuests *int
+}
@@ -0,0 +1,109 @@
+package domain
+
+import "time"
+
+// Estados de un email del outbox
+const (
+	NotificationPending = "pending" // En cola, se envía cuando vence NextAttemptAt
+	NotificationSending = "sending" // Tomado por el worker; si el envío no termina se reintenta al vencer el plazo
+	NotificationSent    = "sent"
+	NotificationFailed  = "failed" // Agotó los intentos; solo se reenvía manualmente
+)
+
+// NotificationStatuses son los estados válidos para filtrar el outbox
+var NotificationStatuses = []string{NotificationPending, NotificationSending, NotificationSent, NotificationFailed}
+
+const (
+	// OutboxPollInterval es cada cuánto el worker busca emails pendientes
+	OutboxPollInterval = 15 * time.Second
+	// OutboxBatchSize es la cantidad máxima de emails que el worker toma por ciclo
+	OutboxBatchSize = 20
+	// OutboxMaxAttempts es la cantidad de intentos antes de marcar un email como fallido
+	OutboxMaxAttempts = 6
+	// OutboxSendLease es el tiempo que un email queda reservado para el worker que lo tomó
+	OutboxSendLease = 2 * time.Minute
+	// OutboxBaseBackoff y OutboxMaxBackoff acotan la espera entre intentos
+	OutboxBaseBackoff = time.Minute
+	OutboxMaxBackoff  = time.Hour
+)
+
+// OutboxBackoff retorna la espera antes del siguiente intento tras fallar el intento número attempt:
+// 1m, 2m, 4m, 8m... hasta OutboxMaxBackoff
+func OutboxBackoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	backoff := OutboxBaseBackoff
+	for i := 1; i < attempt && backoff < OutboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > OutboxMaxBackoff {
+		backoff = OutboxMaxBackoff
+	}
+	return backoff
+}
+
+// Notification es un email persistido en el outbox junto con su historial de entrega
+type Notification struct {
+	ID               uint
+	BusinessID       *uint
+	ReservationID    *uint
+	Code             string
+	Recipient        string
+	Subject          string
+	HTMLBody         string
+	TextBody         string
+	Status           string
+	Attempts         int
+	MaxAttempts      int
+	NextAttemptAt    time.Time
+	LastError        string
+	SentAt           *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Attachments      []NotificationAttachment
+	DeliveryAttempts []NotificationAttempt
+}
+
+// NotificationAttachment es un archivo adjunto de un email del outbox
+type NotificationAttachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// NotificationAttempt es el resultado de un intento de entrega
+type NotificationAttempt struct {
+	ID          uint
+	Number      int
+	Success     bool
+	Error       string
+	DurationMs  int64
+	AttemptedAt time.Time
+}
+
+// NotificationDelivery es el resultado de un intento que el worker registra sobre un email
+type NotificationDelivery struct {
+	NotificationID uint
+	Attempt        NotificationAttempt
+	Status         string
+	NextAttemptAt  time.Time
+	SentAt         *time.Time
+}
+
+// NotificationQuery filtra el outbox. BusinessID = db.AllBusinesses consulta todos los negocios (super admin).
+type NotificationQuery struct {
+	BusinessID uint
+	Status     *string
+	Page       int
+	PageSize   int
+}
+
+// NotificationListDTO es una página del outbox
+type NotificationListDTO struct {
+	Notifications []Notification
+	Total         int64
+	Page          int
+	PageSize      int
+	TotalPages    int
+}
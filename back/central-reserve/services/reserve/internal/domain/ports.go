@@ -11,13 +11,60 @@ type IReservationRepository interface {
 	GetLatestReservationByClient(ctx context.Context, clientID uint) (*Reservation, error)
 	GetReserves(ctx context.Context, statusID *uint, clientID *uint, tableID *uint, startDate *time.Time, endDate *time.Time) ([]ReserveDetailDTO, error)
 	GetReserveByID(ctx context.Context, id uint) (*ReserveDetailDTO, error)
-	CancelReservation(ctx context.Context, id uint, reason string) (string, error)
 	UpdateReservation(ctx context.Context, params UpdateReservationDTO) (string, error)
 	CreateReservationStatusHistory(ctx context.Context, history ReservationStatusHistory) error
 	GetClientByEmailAndBusiness(ctx context.Context, email string, businessID uint) (*Client, error)
 	CreateClient(ctx context.Context, client Client) (string, error)
 	GetReservationStatuses(ctx context.Context) ([]ReservationStatus, error)
-}
-type IEmailService interface {
-	SendHTML(ctx context.Context, to, subject, html string) error
+
+	// Máquina de estados
+	GetReservationStatusByCode(ctx context.Context, code string) (*ReservationStatus, error)
+	GetReservationStatusByID(ctx context.Context, id uint) (*ReservationStatus, error)
+	ChangeReservationStatus(ctx context.Context, change StatusChange) error
+
+	// Disponibilidad
+	GetTablesByBusiness(ctx context.Context, businessID uint, roomID *uint) ([]Table, error)
+	GetRoomsByBusiness(ctx context.Context, businessID uint, roomID *uint) ([]Room, error)
+	GetBlockingReservations(ctx context.Context, businessID uint, startDate, endDate time.Time) ([]Reservation, error)
+
+	// Horario de atención
+	GetBusinessSchedule(ctx context.Context, businessID uint, startDate, endDate time.Time) (*BusinessSchedule, error)
+
+	// Lista de espera
+	CreateWaitlistEntry(ctx context.Context, entry WaitlistEntry) (uint, error)
+	GetWaitlistEntries(ctx context.Context, query WaitlistQuery) ([]WaitlistEntryDTO, error)
+	GetWaitlistEntryByID(ctx context.Context, id uint) (*WaitlistEntryDTO, error)
+	GetWaitlistEntryByOfferToken(ctx context.Context, tokenHash string) (*WaitlistEntryDTO, error)
+	HasActiveWaitlistEntry(ctx context.Context, clientID uint, startAt, endAt time.Time) (bool, error)
+	GetWaitlistCandidates(ctx context.Context, businessID uint, startAt, endAt, now time.Time) ([]WaitlistEntryDTO, error)
+	GetExpiredWaitlistOffers(ctx context.Context, now time.Time) ([]WaitlistEntryDTO, error)
+	MarkWaitlistOffered(ctx context.Context, id uint, tokenHash string, offeredAt, expiresAt time.Time) (bool, error)
+	UpdateWaitlistStatus(ctx context.Context, id uint, fromStatus, toStatus string, reservationID *uint) (bool, error)
+
+	// Plantillas de email
+	GetEmailBranding(ctx context.Context, businessID uint) (*EmailBranding, error)
+	GetEmailTemplate(ctx context.Context, businessID uint, code, locale string) (*EmailTemplate, error)
+	GetEmailTemplates(ctx context.Context, businessID uint) ([]EmailTemplate, error)
+	SaveEmailTemplate(ctx context.Context, template EmailTemplate) (*EmailTemplate, error)
+	DeleteEmailTemplate(ctx context.Context, businessID uint, code, locale string) (bool, error)
+
+	// Outbox de emails
+	EnqueueNotification(ctx context.Context, notification Notification) (uint, error)
+	ClaimDueNotifications(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]Notification, error)
+	RecordNotificationDelivery(ctx context.Context, delivery NotificationDelivery) error
+	GetNotifications(ctx context.Context, query NotificationQuery) ([]Notification, int64, error)
+	GetNotificationByID(ctx context.Context, id uint, businessID uint) (*Notification, error)
+	RequeueNotification(ctx context.Context, id uint, businessID uint, now time.Time, extraAttempts int) (bool, error)
+
+	// Feed de calendario
+	SaveCalendarFeedToken(ctx context.Context, businessID uint, tokenHash string, createdByUserID *uint) error
+	DeleteCalendarFeedToken(ctx context.Context, businessID uint) (bool, error)
+	GetBusinessIDByCalendarFeedToken(ctx context.Context, tokenHash string) (uint, error)
+	GetCalendarReservations(ctx context.Context, businessID uint, from, to time.Time, excludedStatusCodes []string) ([]ReserveDetailDTO, error)
+
+	// Tareas programadas: recordatorios y no-shows
+	GetReservationsDueForReminder(ctx context.Context, now time.Time, limit int) ([]ReserveDetailDTO, error)
+	ClaimReservationReminder(ctx context.Context, id uint, sentAt time.Time) (bool, error)
+	ReleaseReservationReminder(ctx context.Context, id uint) error
+	GetReservationsPastNoShowGrace(ctx context.Context, now time.Time, limit int) ([]uint, error)
 }
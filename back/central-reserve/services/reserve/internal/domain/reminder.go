@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+const (
+	// ReminderInterval es cada cuánto se buscan reservas que deben recibir el recordatorio
+	ReminderInterval = 5 * time.Minute
+	// NoShowInterval es cada cuánto se revisan las reservas cuyo cliente no llegó
+	NoShowInterval = time.Minute
+	// SchedulerBatchSize es la cantidad máxima de reservas que una tarea programada procesa por ciclo
+	SchedulerBatchSize = 100
+
+	// DefaultReminderHoursBefore es la anticipación del recordatorio si el negocio no la configura (0 = sin recordatorio)
+	DefaultReminderHoursBefore = 24
+
+	// RepeatNoShowThreshold es la cantidad de no-shows a partir de la cual un cliente se considera reincidente
+	RepeatNoShowThreshold = 2
+
+	// NoShowAutomaticReason queda en el historial cuando el no-show lo marca el sistema
+	NoShowAutomaticReason = "Marcada automáticamente: el cliente no llegó dentro del tiempo de tolerancia"
+)
+
+// ReminderStatuses son los estados en los que una reserva recibe recordatorio y puede pasar a no-show
+var ReminderStatuses = []string{StatusPending, StatusConfirmed}
+
+// IsRepeatNoShow indica si la cantidad de no-shows de un cliente lo marca como reincidente
+func IsRepeatNoShow(noShowCount int) bool {
+	return noShowCount >= RepeatNoShowThreshold
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// BusinessSchedule reúne las reglas de reserva, el horario semanal y las excepciones de un negocio
+type BusinessSchedule struct {
+	BusinessID             uint
+	Location               *time.Location
+	SlotMinutes            int
+	DefaultDurationMinutes int
+	MinLeadTimeMinutes     int
+	MaxAdvanceDays         int // 0 = sin límite
+	OpeningHours           []OpeningHour
+	BlackoutDates          []BlackoutDate
+}
+
+// OpeningHour representa una franja del horario semanal en hora local del negocio
+type OpeningHour struct {
+	DayOfWeek int    // 0=domingo ... 6=sábado
+	OpenTime  string // HH:MM
+	CloseTime string // HH:MM; si es <= OpenTime cierra al día siguiente
+}
+
+// BlackoutDate representa un día cerrado o con horario especial (Date en UTC a medianoche)
+type BlackoutDate struct {
+	Date      time.Time
+	IsClosed  bool
+	OpenTime  *string
+	CloseTime *string
+	Reason    string
+}
@@ -0,0 +1,57 @@
+package domain
+
+// Códigos de los estados de reserva (tabla reservation_status)
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusSeated    = "seated"
+	StatusCompleted = "completed"
+	StatusCancelled = "cancelled"
+	StatusNoShow    = "no_show"
+)
+
+// ReservationTransitions define la máquina de estados de una reserva:
+// para cada estado de origen, los estados de destino permitidos.
+// Los estados completed, cancelled y no_show son finales.
+//
+//	pending → confirmed → seated → completed
+//	   │          │
+//	   └──────────┴──→ cancelled | no_show
+var ReservationTransitions = map[string][]string{
+	StatusPending:   {StatusConfirmed, StatusCancelled, StatusNoShow},
+	StatusConfirmed: {StatusSeated, StatusCancelled, StatusNoShow},
+	StatusSeated:    {StatusCompleted},
+	StatusCompleted: {},
+	StatusCancelled: {},
+	StatusNoShow:    {},
+}
+
+// AllowedTransitions retorna los estados a los que puede pasar una reserva desde el estado dado
+func AllowedTransitions(from string) []string {
+	return ReservationTransitions[from]
+}
+
+// CanTransition indica si la máquina de estados permite pasar de from a to
+func CanTransition(from, to string) bool {
+	for _, allowed := range ReservationTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusChange describe un cambio de estado solicitado sobre una reserva
+type StatusChange struct {
+	ReservationID   uint
+	StatusCode      string
+	ChangedByUserID *uint // nil si el cambio es automático
+	Reason          string
+}
+
+// StatusTransitionsDTO expone el estado actual de una reserva y los estados a los que puede pasar
+type StatusTransitionsDTO struct {
+	ReservationID uint
+	Current       ReservationStatusDTO
+	Allowed       []ReservationStatusDTO
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// Estados de una entrada en lista de espera
+const (
+	WaitlistStatusWaiting   = "waiting"
+	WaitlistStatusOffered   = "offered"
+	WaitlistStatusAccepted  = "accepted"
+	WaitlistStatusDeclined  = "declined"
+	WaitlistStatusExpired   = "expired"
+	WaitlistStatusCancelled = "cancelled"
+)
+
+const (
+	// DefaultWaitlistOfferMinutes es la vigencia del enlace de aceptación si no se configura WAITLIST_OFFER_MINUTES
+	DefaultWaitlistOfferMinutes = 30
+	// WaitlistExpiryInterval es cada cuánto se revisan las ofertas vencidas
+	WaitlistExpiryInterval = time.Minute
+)
+
+// WaitlistEntryDTO representa una entrada en lista de espera con los datos del cliente
+type WaitlistEntryDTO struct {
+	WaitlistEntry
+
+	ClientName  string
+	ClientEmail string
+	ClientPhone string
+	ClientDni   *string
+}
+
+// WaitlistQuery encapsula los filtros para listar la lista de espera de un negocio
+type WaitlistQuery struct {
+	BusinessID uint
+	Status     *string
+	StartDate  *time.Time
+	EndDate    *time.Time
+}
+
+// WaitlistOfferDTO es la vista pública de una oferta enviada a un cliente en espera
+type WaitlistOfferDTO struct {
+	EntryID        uint
+	Status         string
+	StartAt        time.Time
+	EndAt          time.Time
+	NumberOfGuests int
+	ExpiresAt      *time.Time
+	ReservationID  *uint
+}
@@ -0,0 +1,41 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Aceptar oferta de lista de espera
+// @Description	Endpoint público del enlace enviado por email. Crea la reserva del horario ofrecido si la oferta sigue vigente.
+// @Tags			Lista de espera
+// @Produce		json
+// @Param			token	path		string					true	"Token de la oferta"
+// @Success		201		{object}	map[string]interface{}	"Reserva creada a partir de la oferta"
+// @Failure		404		{object}	map[string]interface{}	"Oferta no encontrada"
+// @Failure		409		{object}	map[string]interface{}	"La oferta ya fue usada o el horario volvió a ocuparse"
+// @Failure		410		{object}	map[string]interface{}	"La oferta expiró"
+// @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/waitlist/offers/{token}/accept [post]
+func (h *ReserveHandler) AcceptWaitlistOfferHandler(c *gin.Context) {
+	reservation, err := h.usecase.AcceptWaitlistOffer(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error al aceptar oferta de lista de espera")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo aceptar la oferta",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Reserva creada exitosamente",
+		"data":    mapper.MapToReserveDetail(*reservation),
+	})
+}
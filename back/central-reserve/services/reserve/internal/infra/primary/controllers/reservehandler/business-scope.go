@@ -0,0 +1,37 @@
+package reservehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/shared/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// scopeSingleBusiness acota la petición a un único negocio: el del token o, para el super admin,
+// el ?business_id= que debe indicar. ?business_id=all no aplica a operaciones de un solo negocio.
+func scopeSingleBusiness(c *gin.Context) (uint, error) {
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err == nil && businessID == db.AllBusinesses {
+		return 0, middleware.ErrInvalidBusinessFilter
+	}
+	return businessID, err
+}
+
+// respondBusinessScopeError responde el error al acotar la request al negocio del token
+func respondBusinessScopeError(c *gin.Context, err error) {
+	c.JSON(middleware.BusinessScopeStatus(err), gin.H{
+		"success": false,
+		"error":   "invalid_business_scope",
+		"message": err.Error(),
+	})
+}
+
+// actingUserID retorna el usuario autenticado que realiza la operación, para registrarlo
+// en el historial de estados. Retorna nil si la petición no tiene usuario asociado.
+func actingUserID(c *gin.Context) *uint {
+	userID, ok := middleware.GetUserID(c)
+	if !ok || userID == 0 {
+		return nil
+	}
+	return &userID
+}
@@ -0,0 +1,53 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Cancelar reserva (cliente)
+// @Description	Endpoint público del enlace de autogestión. Cancela la reserva si aún no ha comenzado; el cambio queda en el historial de estados.
+// @Tags			Reservas (cliente)
+// @Accept			json
+// @Produce		json
+// @Param			token	path		string					true	"Token del enlace de la reserva"
+// @Param			cancel	body		request.GuestCancel		false	"Razón de cancelación (opcional)"
+// @Success		200		{object}	map[string]interface{}	"Reserva cancelada exitosamente"
+// @Failure		401		{object}	map[string]interface{}	"Enlace inválido o expirado"
+// @Failure		409		{object}	map[string]interface{}	"La reserva ya no puede cancelarse"
+// @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/guest/reservations/{token}/cancel [post]
+func (h *ReserveHandler) CancelGuestReservationHandler(c *gin.Context) {
+	// El body es opcional: sin él se cancela sin razón
+	var req request.GuestCancel
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "invalid_request",
+				"message": "La razón de cancelación no es válida",
+			})
+			return
+		}
+	}
+
+	if err := h.usecase.CancelGuestReservation(c.Request.Context(), c.Param("token"), req.Reason); err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error interno al cancelar reserva del cliente")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo cancelar la reserva",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Reserva cancelada exitosamente",
+	})
+}
@@ -20,6 +20,7 @@ import (
 // @Failure		400		{object}	map[string]interface{}		"Solicitud inválida"
 // @Failure		401		{object}	map[string]interface{}		"Token de acceso requerido"
 // @Failure		404		{object}	map[string]interface{}		"Reserva no encontrada"
+// @Failure		409		{object}	map[string]interface{}		"La reserva no puede cancelarse desde su estado actual"
 // @Failure		500		{object}	map[string]interface{}		"Error interno del servidor"
 // @Router			/reserves/{id}/cancel [patch]
 func (h *ReserveHandler) CancelReservationHandler(c *gin.Context) {
@@ -46,8 +47,12 @@ func (h *ReserveHandler) CancelReservationHandler(c *gin.Context) {
 	}
 
 	// 3. Caso de uso ─────────────────────────────────────────
-	response, err := h.usecase.CancelReservation(ctx, uint(reservationID), cancelReq.Reason)
+	response, err := h.usecase.CancelReservation(ctx, uint(reservationID), cancelReq.Reason, actingUserID(c))
 	if err != nil {
+		if respondDomainError(c, err) {
+			h.logger.Warn().Err(err).Uint64("reservation_id", reservationID).Msg("cancelación de reserva rechazada")
+			return
+		}
 		h.logger.Error().Err(err).Msg("error interno al cancelar reserva")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
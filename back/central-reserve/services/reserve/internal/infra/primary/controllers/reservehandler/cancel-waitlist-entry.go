@@ -0,0 +1,58 @@
+package reservehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Retirar de la lista de espera
+// @Description	Cancela una entrada de la lista de espera. Si tenía una oferta vigente, el horario se ofrece al siguiente cliente.
+// @Tags			Lista de espera
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		int						true	"ID de la entrada"
+// @Success		200	{object}	map[string]interface{}	"Entrada cancelada exitosamente"
+// @Failure		400	{object}	map[string]interface{}	"Solicitud inválida"
+// @Failure		401	{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		404	{object}	map[string]interface{}	"Entrada no encontrada"
+// @Failure		409	{object}	map[string]interface{}	"La entrada ya no está en espera"
+// @Failure		500	{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/waitlist/{id} [delete]
+func (h *ReserveHandler) CancelWaitlistEntryHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Entrada ──────────────────────────────────────────────
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "ID de lista de espera inválido",
+		})
+		return
+	}
+
+	// 2. Caso de uso (business_id = 0 para super admin) ─────
+	businessID, _ := middleware.GetBusinessID(c)
+	if err := h.usecase.CancelWaitlistEntry(ctx, uint(id), businessID); err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("waitlist_id", uint(id)).Msg("error al cancelar entrada de lista de espera")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo cancelar la entrada de lista de espera",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Entrada de lista de espera cancelada exitosamente",
+	})
+}
@@ -0,0 +1,80 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Cambia el estado de una reserva
+// @Description	Aplica una transición de la máquina de estados (pending → confirmed → seated → completed, o cancelled / no_show). Las transiciones no permitidas se rechazan y cada cambio queda en el historial con el usuario que lo realizó.
+// @Tags			Reservas
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		int								true	"ID de la reserva"
+// @Param			status	body		request.ChangeReservationStatus	true	"Estado destino"
+// @Success		200		{object}	map[string]interface{}			"Estado actualizado exitosamente"
+// @Failure		400		{object}	map[string]interface{}			"Solicitud inválida o estado desconocido"
+// @Failure		401		{object}	map[string]interface{}			"Token de acceso requerido"
+// @Failure		404		{object}	map[string]interface{}			"Reserva no encontrada"
+// @Failure		409		{object}	map[string]interface{}			"Transición de estado no permitida"
+// @Failure		500		{object}	map[string]interface{}			"Error interno del servidor"
+// @Router			/reserves/{id}/status [patch]
+func (h *ReserveHandler) ChangeReservationStatusHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Obtener ID de la reserva ─────────────────────────────
+	reservationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "ID de reserva inválido",
+		})
+		return
+	}
+
+	// 2. Entrada ──────────────────────────────────────────────
+	var req request.ChangeReservationStatus
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_request",
+			"message": "Debe indicar el estado destino",
+		})
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	reservation, err := h.usecase.ChangeReservationStatus(ctx, domain.StatusChange{
+		ReservationID:   uint(reservationID),
+		StatusCode:      req.Status,
+		ChangedByUserID: actingUserID(c),
+		Reason:          req.Reason,
+	})
+	if err != nil {
+		if respondDomainError(c, err) {
+			h.logger.Warn().Err(err).Uint64("reservation_id", reservationID).Str("status", req.Status).Msg("cambio de estado rechazado")
+			return
+		}
+		h.logger.Error().Err(err).Uint64("reservation_id", reservationID).Msg("error interno al cambiar estado de reserva")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo cambiar el estado de la reserva",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Estado de la reserva actualizado",
+		"data":    mapper.MapToReserveDetail(*reservation),
+	})
+}
@@ -15,6 +15,38 @@ type IReserveHandler interface {
 	CancelReservationHandler(c *gin.Context)
 	UpdateReservationHandler(c *gin.Context)
 	GetReservationStatusesHandler(c *gin.Context)
+	ChangeReservationStatusHandler(c *gin.Context)
+	GetStatusTransitionsHandler(c *gin.Context)
+	GetAvailabilityHandler(c *gin.Context)
+
+	// Lista de espera
+	JoinWaitlistHandler(c *gin.Context)
+	GetWaitlistHandler(c *gin.Context)
+	CancelWaitlistEntryHandler(c *gin.Context)
+	GetWaitlistOfferHandler(c *gin.Context)
+	AcceptWaitlistOfferHandler(c *gin.Context)
+	DeclineWaitlistOfferHandler(c *gin.Context)
+
+	// Autogestión del cliente
+	GetGuestReservationHandler(c *gin.Context)
+	RescheduleGuestReservationHandler(c *gin.Context)
+	CancelGuestReservationHandler(c *gin.Context)
+
+	// Plantillas de email
+	GetEmailTemplatesHandler(c *gin.Context)
+	SaveEmailTemplateHandler(c *gin.Context)
+	DeleteEmailTemplateHandler(c *gin.Context)
+	PreviewEmailTemplateHandler(c *gin.Context)
+
+	// Outbox de emails
+	GetNotificationsHandler(c *gin.Context)
+	GetNotificationByIDHandler(c *gin.Context)
+	ResendNotificationHandler(c *gin.Context)
+
+	// Feed de calendario
+	RotateCalendarFeedHandler(c *gin.Context)
+	RevokeCalendarFeedHandler(c *gin.Context)
+	GetCalendarFeedHandler(c *gin.Context)
 }
 
 type ReserveHandler struct {
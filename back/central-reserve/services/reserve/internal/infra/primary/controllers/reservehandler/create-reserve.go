@@ -11,7 +11,7 @@ import (
 )
 
 // @Summary		Crea una nueva reserva
-// @Description	Este endpoint permite crear una nueva reserva para una mesa en un restaurante.
+// @Description	Este endpoint permite crear una nueva reserva para una mesa en un restaurante. Si no se envía end_at se usa la duración por defecto del negocio.
 // @Tags			Reservas
 // @Accept			json
 // @Produce		json
@@ -20,6 +20,8 @@ import (
 // @Success		201			{object}	object	"Reserva creada exitosamente"
 // @Failure		400			{object}	map[string]interface{}			"Solicitud inválida"
 // @Failure		401			{object}	map[string]interface{}			"Token de acceso requerido"
+// @Failure		409			{object}	map[string]interface{}			"Mesa o sala ocupada en ese horario"
+// @Failure		422			{object}	map[string]interface{}			"Fuera del horario de atención o de las reglas de reserva del negocio"
 // @Failure		500			{object}	map[string]interface{}			"Error interno del servidor"
 // @Router			/reserves [post]
 func (h *ReserveHandler) CreateReserveHandler(c *gin.Context) {
@@ -52,6 +54,7 @@ func (h *ReserveHandler) CreateReserveHandler(c *gin.Context) {
 
 	// 3. DTO → Dominio ───────────────────────────────────────
 	reserve := mapper.ReserveToDomain(req)
+	reserve.CreatedByUserID = actingUserID(c)
 
 	// 4. Caso de uso ─────────────────────────────────────────
 	dni := ""
@@ -60,6 +63,10 @@ func (h *ReserveHandler) CreateReserveHandler(c *gin.Context) {
 	}
 	responseReserve, err := h.usecase.CreateReserve(ctx, reserve, req.Name, req.Email, req.Phone, dni)
 	if err != nil {
+		if respondDomainError(c, err) {
+			h.logger.Warn().Err(err).Msg("reserva rechazada por reglas de disponibilidad")
+			return
+		}
 		h.logger.Error().Err(err).Msg("error interno al crear reserva")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
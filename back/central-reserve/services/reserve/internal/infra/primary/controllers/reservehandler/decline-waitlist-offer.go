@@ -0,0 +1,37 @@
+package reservehandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Rechazar oferta de lista de espera
+// @Description	Endpoint público del enlace enviado por email. Libera el horario para el siguiente cliente en la lista.
+// @Tags			Lista de espera
+// @Produce		json
+// @Param			token	path		string					true	"Token de la oferta"
+// @Success		200		{object}	map[string]interface{}	"Oferta rechazada"
+// @Failure		404		{object}	map[string]interface{}	"Oferta no encontrada"
+// @Failure		409		{object}	map[string]interface{}	"La oferta ya no está vigente"
+// @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/waitlist/offers/{token}/decline [post]
+func (h *ReserveHandler) DeclineWaitlistOfferHandler(c *gin.Context) {
+	if err := h.usecase.DeclineWaitlistOffer(c.Request.Context(), c.Param("token")); err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error al rechazar oferta de lista de espera")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo rechazar la oferta",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Oferta rechazada, el horario se ofrecerá al siguiente cliente",
+	})
+}
@@ -0,0 +1,53 @@
+package reservehandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Restablece una plantilla de email
+// @Description	Elimina la personalización del negocio para un código e idioma; los siguientes envíos usan la plantilla por defecto. Los super admins deben indicar business_id.
+// @Tags			Plantillas de email
+// @Produce		json
+// @Security		BearerAuth
+// @Param			code		path		string					true	"Código de la plantilla"
+// @Param			locale		path		string					true	"Idioma (es, en)"
+// @Param			business_id	query		int						false	"ID del negocio (solo super admin)"
+// @Success		200			{object}	map[string]interface{}	"Plantilla restablecida exitosamente"
+// @Failure		400			{object}	map[string]interface{}	"Solicitud inválida o idioma no soportado"
+// @Failure		401			{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403			{object}	map[string]interface{}	"Sin acceso al negocio indicado"
+// @Failure		404			{object}	map[string]interface{}	"El negocio no tiene esa plantilla personalizada"
+// @Failure		500			{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/email-templates/{code}/{locale} [delete]
+func (h *ReserveHandler) DeleteEmailTemplateHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Negocio ─────────────────────────────────────────────
+	businessID, err := scopeSingleBusiness(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.DeleteEmailTemplate(ctx, businessID, c.Param("code"), c.Param("locale")); err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Str("code", c.Param("code")).Msg("error al eliminar plantilla de email")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo restablecer la plantilla de email",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Plantilla restablecida; se usará la plantilla por defecto",
+	})
+}
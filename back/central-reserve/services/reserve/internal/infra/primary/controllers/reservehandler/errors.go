@@ -0,0 +1,72 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// domainErrorResponses asocia los errores de dominio con su código HTTP y código de error.
+// Si detailed es true se responde con el mensaje completo del error (p. ej. la línea de
+// la plantilla inválida) en lugar del mensaje genérico.
+var domainErrorResponses = []struct {
+	err      error
+	status   int
+	code     string
+	detailed bool
+}{
+	{domain.ErrReservationNotFound, http.StatusNotFound, "not_found", false},
+	{domain.ErrReservationConflict, http.StatusConflict, "reservation_conflict", false},
+	{domain.ErrInvalidTimeRange, http.StatusBadRequest, "invalid_time_range", false},
+	{domain.ErrInvalidNumberOfGuests, http.StatusBadRequest, "invalid_number_of_guests", false},
+	{domain.ErrReservationStatusNotFound, http.StatusBadRequest, "invalid_status", false},
+	{domain.ErrInvalidStatusTransition, http.StatusConflict, "invalid_status_transition", false},
+	{domain.ErrTableNotFound, http.StatusNotFound, "table_not_found", false},
+	{domain.ErrRoomNotFound, http.StatusNotFound, "room_not_found", false},
+	{domain.ErrTableCapacityExceeded, http.StatusUnprocessableEntity, "table_capacity_exceeded", false},
+	{domain.ErrRoomCapacityExceeded, http.StatusConflict, "room_capacity_exceeded", false},
+	{domain.ErrAvailabilityRange, http.StatusBadRequest, "invalid_availability_range", false},
+	{domain.ErrNoTableAvailable, http.StatusConflict, "no_table_available", false},
+	{domain.ErrOutsideOpeningHours, http.StatusUnprocessableEntity, "outside_opening_hours", false},
+	{domain.ErrBusinessClosedDate, http.StatusUnprocessableEntity, "business_closed", false},
+	{domain.ErrSlotNotAligned, http.StatusUnprocessableEntity, "slot_not_aligned", false},
+	{domain.ErrLeadTimeTooShort, http.StatusUnprocessableEntity, "min_lead_time", false},
+	{domain.ErrBeyondMaxAdvance, http.StatusUnprocessableEntity, "max_advance_days", false},
+	{domain.ErrWaitlistEntryNotFound, http.StatusNotFound, "waitlist_entry_not_found", false},
+	{domain.ErrWaitlistDuplicate, http.StatusConflict, "waitlist_duplicate", false},
+	{domain.ErrWaitlistSlotAvailable, http.StatusConflict, "slot_available", false},
+	{domain.ErrWaitlistInvalidTransition, http.StatusConflict, "waitlist_invalid_transition", false},
+	{domain.ErrWaitlistOfferNotFound, http.StatusNotFound, "waitlist_offer_not_found", false},
+	{domain.ErrWaitlistOfferExpired, http.StatusGone, "waitlist_offer_expired", false},
+	{domain.ErrGuestTokenInvalid, http.StatusUnauthorized, "invalid_guest_token", false},
+	{domain.ErrGuestChangeNotAllowed, http.StatusConflict, "guest_change_not_allowed", false},
+	{domain.ErrEmailTemplateNotFound, http.StatusNotFound, "email_template_not_found", false},
+	{domain.ErrInvalidEmailTemplate, http.StatusUnprocessableEntity, "invalid_email_template", true},
+	{domain.ErrUnsupportedLocale, http.StatusBadRequest, "unsupported_locale", true},
+	{domain.ErrNotificationNotFound, http.StatusNotFound, "notification_not_found", false},
+	{domain.ErrNotificationNotResendable, http.StatusConflict, "notification_not_resendable", false},
+	{domain.ErrInvalidNotificationStatus, http.StatusBadRequest, "invalid_status", false},
+	{domain.ErrCalendarFeedNotFound, http.StatusNotFound, "calendar_feed_not_found", false},
+}
+
+// respondDomainError responde con el código HTTP adecuado si err es un error de dominio conocido.
+// Retorna false si el error no es de dominio y debe tratarse como error interno.
+func respondDomainError(c *gin.Context, err error) bool {
+	for _, candidate := range domainErrorResponses {
+		if errors.Is(err, candidate.err) {
+			message := candidate.err.Error()
+			if candidate.detailed {
+				message = err.Error()
+			}
+			c.JSON(candidate.status, gin.H{
+				"success": false,
+				"error":   candidate.code,
+				"message": message,
+			})
+			return true
+		}
+	}
+	return false
+}
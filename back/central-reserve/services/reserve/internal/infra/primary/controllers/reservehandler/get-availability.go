@@ -0,0 +1,153 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Consulta la disponibilidad de mesas y salas
+// @Description	Calcula los slots libres por mesa y sala del negocio en un rango de fechas, respetando su horario de atención, festivos y reglas de anticipación. Los super admins deben indicar business_id.
+// @Tags			Reservas
+// @Produce		json
+// @Security		BearerAuth
+// @Param			start_date			query		string								true	"Fecha de inicio (RFC3339)"
+// @Param			end_date			query		string								true	"Fecha de fin (RFC3339)"
+// @Param			business_id			query		int									false	"ID del negocio (solo super admin)"
+// @Param			room_id				query		int									false	"ID de la sala"
+// @Param			table_id			query		int									false	"ID de la mesa"
+// @Param			number_of_guests	query		int									false	"Número de invitados"
+// @Param			slot_minutes		query		int									false	"Granularidad de los slots en minutos (default 30)"
+// @Param			duration_minutes	query		int									false	"Duración de la reserva en minutos (default 120)"
+// @Success		200					{object}	response.AvailabilitySuccessResponse	"Disponibilidad calculada exitosamente"
+// @Failure		400					{object}	map[string]interface{}				"Parámetros inválidos"
+// @Failure		401					{object}	map[string]interface{}				"Token de acceso requerido"
+// @Failure		403					{object}	map[string]interface{}				"Sin acceso al negocio indicado"
+// @Failure		500					{object}	map[string]interface{}				"Error interno del servidor"
+// @Router			/reserves/availability [get]
+func (h *ReserveHandler) GetAvailabilityHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Rango de fechas ─────────────────────────────────────
+	startDate, err := time.Parse(time.RFC3339, c.Query("start_date"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_start_date",
+			"message": "El parámetro start_date es requerido y debe tener formato RFC3339 (ej: 2024-01-01T00:00:00Z)",
+		})
+		return
+	}
+	endDate, err := time.Parse(time.RFC3339, c.Query("end_date"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_end_date",
+			"message": "El parámetro end_date es requerido y debe tener formato RFC3339 (ej: 2024-01-01T23:59:59Z)",
+		})
+		return
+	}
+
+	// 2. Negocio ─────────────────────────────────────────────
+	businessID, err := scopeSingleBusiness(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Filtros opcionales ──────────────────────────────────
+	roomID, ok := parseOptionalUint(c, "room_id")
+	if !ok {
+		return
+	}
+	tableID, ok := parseOptionalUint(c, "table_id")
+	if !ok {
+		return
+	}
+
+	query := domain.AvailabilityQuery{
+		BusinessID: businessID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		RoomID:     roomID,
+		TableID:    tableID,
+	}
+
+	for name, target := range map[string]*int{
+		"number_of_guests": &query.NumberOfGuests,
+		"slot_minutes":     &query.SlotMinutes,
+		"duration_minutes": &query.DurationMinutes,
+	} {
+		value, ok := parseOptionalUint(c, name)
+		if !ok {
+			return
+		}
+		if value != nil {
+			*target = int(*value)
+		}
+	}
+
+	// 4. Caso de uso ─────────────────────────────────────────
+	availability, err := h.usecase.GetAvailability(ctx, query)
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("error interno al calcular disponibilidad")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo calcular la disponibilidad",
+		})
+		return
+	}
+
+	// 5. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.AvailabilitySuccessResponse{
+		Success: true,
+		Data:    mapper.MapToAvailability(*availability),
+	})
+}
+
+// parseOptionalUint lee un parámetro numérico opcional del query string.
+// Si el valor es inválido responde 400 y retorna ok=false.
+func parseOptionalUint(c *gin.Context, name string) (*uint, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, true
+	}
+	parsed, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_" + name,
+			"message": "El parámetro " + name + " debe ser un número válido",
+		})
+		return nil, false
+	}
+	value := uint(parsed)
+	return &value, true
+}
+
+// parseOptionalTime lee un parámetro de query opcional en formato RFC3339
+func parseOptionalTime(c *gin.Context, name string) (*time.Time, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, true
+	}
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_" + name,
+			"message": "El parámetro " + name + " debe tener formato RFC3339",
+		})
+		return nil, false
+	}
+	return &parsed, true
+}
@@ -0,0 +1,45 @@
+package reservehandler
+
+import (
+	"central_reserve/shared/ical"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Feed iCal de reservas
+// @Description	Feed público de suscripción (RFC 5545) con las reservas del negocio desde el día anterior hasta 90 días adelante, sin canceladas ni no-shows. El token del enlace es la autorización.
+// @Tags			Calendario
+// @Produce		text/calendar
+// @Param			token	path		string					true	"Token del feed (con o sin extensión .ics)"
+// @Success		200		{string}	string					"Calendario iCalendar"
+// @Failure		404		{object}	map[string]interface{}	"El feed no existe o fue revocado"
+// @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/calendar/feed/{token} [get]
+func (h *ReserveHandler) GetCalendarFeedHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Token (los clientes de calendario suelen requerir la extensión .ics) ─
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	feed, err := h.usecase.GetCalendarFeed(ctx, token)
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error al generar feed de calendario")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo generar el feed de calendario",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.Header("Content-Disposition", `inline; filename="reservas.ics"`)
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Data(http.StatusOK, ical.ContentType, feed)
+}
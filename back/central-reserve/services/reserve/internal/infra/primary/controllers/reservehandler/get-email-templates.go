@@ -0,0 +1,50 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Lista las plantillas de email del negocio
+// @Description	Indica, para cada plantilla (confirmación, cancelación, oferta de lista de espera) e idioma soportado, si el negocio la tiene personalizada o usa la plantilla por defecto. Los super admins deben indicar business_id.
+// @Tags			Plantillas de email
+// @Produce		json
+// @Security		BearerAuth
+// @Param			business_id	query		int										false	"ID del negocio (solo super admin)"
+// @Success		200			{object}	response.EmailTemplateListSuccessResponse	"Plantillas obtenidas exitosamente"
+// @Failure		400			{object}	map[string]interface{}					"Solicitud inválida"
+// @Failure		401			{object}	map[string]interface{}					"Token de acceso requerido"
+// @Failure		403			{object}	map[string]interface{}					"Sin acceso al negocio indicado"
+// @Failure		500			{object}	map[string]interface{}					"Error interno del servidor"
+// @Router			/email-templates [get]
+func (h *ReserveHandler) GetEmailTemplatesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Negocio ─────────────────────────────────────────────
+	businessID, err := scopeSingleBusiness(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	statuses, err := h.usecase.GetEmailTemplates(ctx, businessID)
+	if err != nil {
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("error al obtener plantillas de email")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudieron obtener las plantillas de email",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.EmailTemplateListSuccessResponse{
+		Success: true,
+		Data:    mapper.MapToEmailTemplateStatuses(statuses),
+	})
+}
@@ -0,0 +1,40 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Consultar reserva (cliente)
+// @Description	Endpoint público del enlace de autogestión enviado en el email de confirmación. Muestra la reserva e indica si aún puede modificarse.
+// @Tags			Reservas (cliente)
+// @Produce		json
+// @Param			token	path		string					true	"Token del enlace de la reserva"
+// @Success		200		{object}	map[string]interface{}	"Reserva obtenida exitosamente"
+// @Failure		401		{object}	map[string]interface{}	"Enlace inválido o expirado"
+// @Failure		404		{object}	map[string]interface{}	"Reserva no encontrada"
+// @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/guest/reservations/{token} [get]
+func (h *ReserveHandler) GetGuestReservationHandler(c *gin.Context) {
+	reservation, err := h.usecase.GetGuestReservation(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error al obtener reserva del cliente")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo obtener la reserva",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Reserva obtenida exitosamente",
+		"data":    mapper.MapToGuestReservation(*reservation),
+	})
+}
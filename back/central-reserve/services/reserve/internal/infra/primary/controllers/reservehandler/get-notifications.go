@@ -0,0 +1,80 @@
+package reservehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Lista el outbox de emails
+// @Description	Obtiene los emails encolados con su estado de entrega (pending, sending, sent, failed), del más reciente al más antiguo. Los usuarios de negocio ven solo los de su negocio; los super admins deben indicar business_id, o business_id=all para ver todos.
+// @Tags			Notificaciones
+// @Produce		json
+// @Security		BearerAuth
+// @Param			status		query		string										false	"Estado (pending, sending, sent, failed)"
+// @Param			business_id	query		string										false	"ID del negocio o \"all\" (requerido para super admin)"
+// @Param			page		query		int											false	"Página (default 1)"
+// @Param			page_size	query		int											false	"Tamaño de página (default 20, máximo 100)"
+// @Success		200			{object}	response.NotificationListSuccessResponse	"Notificaciones obtenidas exitosamente"
+// @Failure		400			{object}	map[string]interface{}						"Parámetros inválidos"
+// @Failure		401			{object}	map[string]interface{}						"Token de acceso requerido"
+// @Failure		403			{object}	map[string]interface{}						"Sin acceso al negocio indicado"
+// @Failure		500			{object}	map[string]interface{}						"Error interno del servidor"
+// @Router			/notifications [get]
+func (h *ReserveHandler) GetNotificationsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Negocio (el super admin indica business_id o "all") ─
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+	query := domain.NotificationQuery{BusinessID: businessID}
+
+	// 2. Filtros y paginación ────────────────────────────────
+	if status := c.Query("status"); status != "" {
+		query.Status = &status
+	}
+	for name, target := range map[string]*int{
+		"page":      &query.Page,
+		"page_size": &query.PageSize,
+	} {
+		value, ok := parseOptionalUint(c, name)
+		if !ok {
+			return
+		}
+		if value != nil {
+			*target = int(*value)
+		}
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	list, err := h.usecase.GetNotifications(ctx, query)
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("error al obtener notificaciones")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudieron obtener las notificaciones",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.NotificationListSuccessResponse{
+		Success:    true,
+		Data:       mapper.MapToNotifications(list.Notifications),
+		Total:      list.Total,
+		Page:       list.Page,
+		PageSize:   list.PageSize,
+		TotalPages: list.TotalPages,
+	})
+}
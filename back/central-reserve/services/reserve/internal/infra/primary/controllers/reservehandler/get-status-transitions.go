@@ -0,0 +1,58 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Lista las transiciones de estado permitidas
+// @Description	Retorna el estado actual de la reserva y los estados a los que puede pasar según la máquina de estados
+// @Tags			Reservas
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		int											true	"ID de la reserva"
+// @Success		200	{object}	response.StatusTransitionsSuccessResponse	"Transiciones obtenidas exitosamente"
+// @Failure		400	{object}	map[string]interface{}						"ID inválido"
+// @Failure		401	{object}	map[string]interface{}						"Token de acceso requerido"
+// @Failure		404	{object}	map[string]interface{}						"Reserva no encontrada"
+// @Failure		500	{object}	map[string]interface{}						"Error interno del servidor"
+// @Router			/reserves/{id}/transitions [get]
+func (h *ReserveHandler) GetStatusTransitionsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Obtener ID de la reserva ─────────────────────────────
+	reservationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "ID de reserva inválido",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	transitions, err := h.usecase.GetStatusTransitions(ctx, uint(reservationID))
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint64("reservation_id", reservationID).Msg("error interno al obtener transiciones de estado")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudieron obtener las transiciones de estado",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.StatusTransitionsSuccessResponse{
+		Success: true,
+		Data:    mapper.MapToStatusTransitions(*transitions),
+	})
+}
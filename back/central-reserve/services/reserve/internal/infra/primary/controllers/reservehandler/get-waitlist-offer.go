@@ -0,0 +1,39 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Consultar oferta de lista de espera
+// @Description	Endpoint público del enlace enviado por email. Muestra el horario ofrecido, su estado y vencimiento.
+// @Tags			Lista de espera
+// @Produce		json
+// @Param			token	path		string					true	"Token de la oferta"
+// @Success		200		{object}	map[string]interface{}	"Oferta obtenida exitosamente"
+// @Failure		404		{object}	map[string]interface{}	"Oferta no encontrada"
+// @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/waitlist/offers/{token} [get]
+func (h *ReserveHandler) GetWaitlistOfferHandler(c *gin.Context) {
+	offer, err := h.usecase.GetWaitlistOffer(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error al obtener oferta de lista de espera")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo obtener la oferta",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Oferta obtenida exitosamente",
+		"data":    mapper.MapToWaitlistOffer(*offer),
+	})
+}
@@ -0,0 +1,67 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Listar lista de espera
+// @Description	Lista la lista de espera del negocio ordenada por horario y prioridad. Los super admins deben indicar business_id.
+// @Tags			Lista de espera
+// @Produce		json
+// @Security		BearerAuth
+// @Param			business_id	query		int						false	"ID del negocio (solo super admin)"
+// @Param			status		query		string					false	"Estado (waiting, offered, accepted, declined, expired, cancelled)"
+// @Param			start_date	query		string					false	"Desde (RFC3339)"
+// @Param			end_date	query		string					false	"Hasta (RFC3339)"
+// @Success		200			{object}	map[string]interface{}	"Lista de espera obtenida exitosamente"
+// @Failure		400			{object}	map[string]interface{}	"Parámetros inválidos"
+// @Failure		401			{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403			{object}	map[string]interface{}	"Sin acceso al negocio indicado"
+// @Failure		500			{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/waitlist [get]
+func (h *ReserveHandler) GetWaitlistHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Negocio ─────────────────────────────────────────────
+	businessID, err := scopeSingleBusiness(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+	query := domain.WaitlistQuery{BusinessID: businessID}
+
+	// 2. Filtros opcionales ──────────────────────────────────
+	var ok bool
+	if status := c.Query("status"); status != "" {
+		query.Status = &status
+	}
+	if query.StartDate, ok = parseOptionalTime(c, "start_date"); !ok {
+		return
+	}
+	if query.EndDate, ok = parseOptionalTime(c, "end_date"); !ok {
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	entries, err := h.usecase.GetWaitlist(ctx, query)
+	if err != nil {
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("error al obtener lista de espera")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo obtener la lista de espera",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Lista de espera obtenida exitosamente",
+		"data":    mapper.MapToWaitlistEntries(entries),
+	})
+}
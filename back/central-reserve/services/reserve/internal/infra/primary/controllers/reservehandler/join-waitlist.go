@@ -0,0 +1,75 @@
+package reservehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Entrar en lista de espera
+// @Description	Registra a un cliente en la lista de espera de un horario sin mesas disponibles. Cuando una cancelación libera el horario se le envía por email un enlace de aceptación con vencimiento.
+// @Tags			Lista de espera
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			entry	body		request.WaitlistEntry	true	"Datos del cliente y horario deseado"
+// @Success		201		{object}	map[string]interface{}	"Cliente registrado en lista de espera"
+// @Failure		400		{object}	map[string]interface{}	"Solicitud inválida"
+// @Failure		401		{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403		{object}	map[string]interface{}	"Sin acceso al negocio indicado"
+// @Failure		409		{object}	map[string]interface{}	"Hay disponibilidad o el cliente ya está en espera"
+// @Failure		422		{object}	map[string]interface{}	"Fuera del horario de atención o de las reglas de reserva del negocio"
+// @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/waitlist [post]
+func (h *ReserveHandler) JoinWaitlistHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var req request.WaitlistEntry
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error().Err(err).Msg("error al bindear JSON de lista de espera")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_request",
+			"message": "Los datos de la lista de espera no son válidos",
+		})
+		return
+	}
+
+	// 2. Negocio ─────────────────────────────────────────────
+	businessID, err := middleware.RequireBusiness(c, req.BusinessID)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+	req.BusinessID = businessID
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	dni := ""
+	if req.Dni != nil {
+		dni = *req.Dni
+	}
+	entry, err := h.usecase.JoinWaitlist(ctx, mapper.WaitlistToDomain(req), req.Name, req.Email, req.Phone, dni)
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error interno al registrar en lista de espera")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo registrar en la lista de espera",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Cliente registrado en lista de espera",
+		"data":    mapper.MapToWaitlistEntry(*entry),
+	})
+}
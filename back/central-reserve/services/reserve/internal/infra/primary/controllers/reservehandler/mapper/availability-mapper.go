@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+)
+
+// MapToAvailability convierte un domain.AvailabilityDTO a response.Availability
+func MapToAvailability(dto domain.AvailabilityDTO) response.Availability {
+	tables := make([]response.TableAvailability, len(dto.Tables))
+	for i, table := range dto.Tables {
+		tables[i] = response.TableAvailability{
+			TableID:   table.TableID,
+			Number:    table.Number,
+			Capacity:  table.Capacity,
+			RoomID:    table.RoomID,
+			FreeSlots: mapTimeSlots(table.FreeSlots),
+			BusySlots: mapTimeSlots(table.BusySlots),
+		}
+	}
+
+	rooms := make([]response.RoomAvailability, len(dto.Rooms))
+	for i, room := range dto.Rooms {
+		slots := make([]response.RoomSlot, len(room.FreeSlots))
+		for j, slot := range room.FreeSlots {
+			slots[j] = response.RoomSlot{
+				StartAt:           slot.StartAt,
+				EndAt:             slot.EndAt,
+				RemainingCapacity: slot.RemainingCapacity,
+			}
+		}
+		rooms[i] = response.RoomAvailability{
+			RoomID:    room.RoomID,
+			Name:      room.Name,
+			Capacity:  room.Capacity,
+			FreeSlots: slots,
+		}
+	}
+
+	return response.Availability{
+		BusinessID:      dto.BusinessID,
+		StartDate:       dto.StartDate,
+		EndDate:         dto.EndDate,
+		SlotMinutes:     dto.SlotMinutes,
+		DurationMinutes: dto.DurationMinutes,
+		Tables:          tables,
+		Rooms:           rooms,
+	}
+}
+
+func mapTimeSlots(slots []domain.TimeSlot) []response.TimeSlot {
+	result := make([]response.TimeSlot, len(slots))
+	for i, slot := range slots {
+		result[i] = response.TimeSlot{StartAt: slot.StartAt, EndAt: slot.EndAt}
+	}
+	return result
+}
@@ -0,0 +1,16 @@
+package mapper
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+)
+
+// MapToCalendarFeed convierte un domain.CalendarFeedDTO a response.CalendarFeed
+func MapToCalendarFeed(feed domain.CalendarFeedDTO) response.CalendarFeed {
+	return response.CalendarFeed{
+		NegocioID: feed.BusinessID,
+		URL:       feed.URL,
+		Token:     feed.Token,
+		Creado:    feed.CreatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+)
+
+// MapToEmailTemplate convierte un domain.EmailTemplate a response.EmailTemplate
+func MapToEmailTemplate(template domain.EmailTemplate) response.EmailTemplate {
+	return response.EmailTemplate{
+		ID:          template.ID,
+		Codigo:      template.Code,
+		Idioma:      template.Locale,
+		Asunto:      template.Subject,
+		HTML:        template.HTMLBody,
+		Texto:       template.TextBody,
+		Actualizada: template.UpdatedAt,
+	}
+}
+
+// MapToEmailTemplateStatuses convierte el estado de las plantillas a su respuesta
+func MapToEmailTemplateStatuses(statuses []domain.EmailTemplateStatusDTO) []response.EmailTemplateStatus {
+	result := make([]response.EmailTemplateStatus, 0, len(statuses))
+	for _, status := range statuses {
+		item := response.EmailTemplateStatus{
+			Codigo:        status.Code,
+			Idioma:        status.Locale,
+			Personalizada: status.Customized,
+		}
+		if status.Template != nil {
+			template := MapToEmailTemplate(*status.Template)
+			item.Plantilla = &template
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// MapToRenderedEmail convierte un domain.RenderedEmail a response.RenderedEmail
+func MapToRenderedEmail(rendered domain.RenderedEmail) response.RenderedEmail {
+	return response.RenderedEmail{
+		Asunto: rendered.Subject,
+		HTML:   rendered.HTML,
+		Texto:  rendered.Text,
+	}
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+)
+
+// GuestRescheduleToDomain convierte un request.GuestReschedule a domain.GuestRescheduleDTO
+func GuestRescheduleToDomain(r request.GuestReschedule) domain.GuestRescheduleDTO {
+	return domain.GuestRescheduleDTO{
+		StartAt:        r.StartAt,
+		EndAt:          r.EndAt,
+		NumberOfGuests: r.NumberOfGuests,
+	}
+}
+
+// MapToGuestReservation convierte un domain.GuestReservationDTO a response.GuestReservation
+func MapToGuestReservation(dto domain.GuestReservationDTO) response.GuestReservation {
+	reservation := dto.Reservation
+	return response.GuestReservation{
+		ReservaID:        reservation.ReservaID,
+		StartAt:          reservation.StartAt,
+		EndAt:            reservation.EndAt,
+		NumberOfGuests:   reservation.NumberOfGuests,
+		EstadoCodigo:     reservation.EstadoCodigo,
+		EstadoNombre:     reservation.EstadoNombre,
+		ClienteNombre:    reservation.ClienteNombre,
+		MesasAsignadas:   mapAssignedTables(reservation.MesasAsignadas),
+		NegocioNombre:    reservation.NegocioNombre,
+		NegocioDireccion: reservation.NegocioDireccion,
+		PuedeModificar:   dto.CanModify,
+		Token:            dto.Token,
+	}
+}
@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+)
+
+// MapToNotification convierte un domain.Notification a response.Notification
+func MapToNotification(notification domain.Notification) response.Notification {
+	result := response.Notification{
+		ID:           notification.ID,
+		NegocioID:    notification.BusinessID,
+		ReservaID:    notification.ReservationID,
+		Codigo:       notification.Code,
+		Destinatario: notification.Recipient,
+		Asunto:       notification.Subject,
+		Estado:       notification.Status,
+		Intentos:     notification.Attempts,
+		MaxIntentos:  notification.MaxAttempts,
+		UltimoError:  notification.LastError,
+		EnviadoEn:    notification.SentAt,
+		Creado:       notification.CreatedAt,
+	}
+	// El próximo intento solo tiene sentido mientras el email sigue en cola
+	if notification.Status == domain.NotificationPending || notification.Status == domain.NotificationSending {
+		next := notification.NextAttemptAt
+		result.ProximoIntento = &next
+	}
+	for _, attempt := range notification.DeliveryAttempts {
+		result.HistorialEnvios = append(result.HistorialEnvios, response.NotificationAttempt{
+			Numero:     attempt.Number,
+			Exitoso:    attempt.Success,
+			Error:      attempt.Error,
+			DuracionMs: attempt.DurationMs,
+			Fecha:      attempt.AttemptedAt,
+		})
+	}
+	return result
+}
+
+// MapToNotifications convierte una lista de domain.Notification a response.Notification
+func MapToNotifications(notifications []domain.Notification) []response.Notification {
+	result := make([]response.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		result = append(result, MapToNotification(notification))
+	}
+	return result
+}
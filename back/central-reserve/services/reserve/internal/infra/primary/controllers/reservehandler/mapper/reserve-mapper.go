@@ -10,6 +10,9 @@ import (
 func ReserveToDomain(r request.Reservation) domain.Reservation {
 	return domain.Reservation{
 		BusinessID:     r.BusinessID,
+		TableID:        r.TableID,
+		TableIDs:       r.TableIDs,
+		RoomID:         r.RoomID,
 		StartAt:        r.StartAt,
 		EndAt:          r.EndAt,
 		NumberOfGuests: r.NumberOfGuests,
@@ -38,9 +41,12 @@ func MapToReserveDetail(dto domain.ReserveDetailDTO) response.ReserveDetail {
 			}
 			return ""
 		}(),
+		ClienteNoShows:   dto.ClienteNoShows,
+		ClienteReincide:  domain.IsRepeatNoShow(dto.ClienteNoShows),
 		MesaID:           dto.MesaID,
 		MesaNumero:       dto.MesaNumero,
 		MesaCapacidad:    dto.MesaCapacidad,
+		MesasAsignadas:   mapAssignedTables(dto.MesasAsignadas),
 		NegocioID:        dto.NegocioID,
 		NegocioNombre:    dto.NegocioNombre,
 		NegocioCodigo:    dto.NegocioCodigo,
@@ -84,3 +90,29 @@ func MapToReservationStatusList(dtoList []domain.ReservationStatusDTO) []respons
 	}
 	return responseList
 }
+
+func mapAssignedTables(tables []domain.AssignedTableDTO) []response.AssignedTable {
+	result := make([]response.AssignedTable, len(tables))
+	for i, table := range tables {
+		result[i] = response.AssignedTable{
+			ID:        table.ID,
+			Numero:    table.Number,
+			Capacidad: table.Capacity,
+			SalaID:    table.RoomID,
+		}
+	}
+	return result
+}
+
+// MapToStatusTransitions convierte un domain.StatusTransitionsDTO a response.StatusTransitions
+func MapToStatusTransitions(dto domain.StatusTransitionsDTO) response.StatusTransitions {
+	allowed := MapToReservationStatusList(dto.Allowed)
+	if allowed == nil {
+		allowed = []response.ReservationStatus{}
+	}
+	return response.StatusTransitions{
+		ReservaID:  dto.ReservationID,
+		Actual:     MapToReservationStatus(dto.Current),
+		Permitidos: allowed,
+	}
+}
@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+)
+
+// WaitlistToDomain convierte un request.WaitlistEntry a domain.WaitlistEntry
+func WaitlistToDomain(r request.WaitlistEntry) domain.WaitlistEntry {
+	return domain.WaitlistEntry{
+		BusinessID:     r.BusinessID,
+		RoomID:         r.RoomID,
+		StartAt:        r.StartAt,
+		EndAt:          r.EndAt,
+		NumberOfGuests: r.NumberOfGuests,
+		Priority:       r.Priority,
+		Notes:          r.Notes,
+	}
+}
+
+// MapToWaitlistEntry convierte un domain.WaitlistEntryDTO a response.WaitlistEntry
+func MapToWaitlistEntry(dto domain.WaitlistEntryDTO) response.WaitlistEntry {
+	return response.WaitlistEntry{
+		ID:              dto.ID,
+		ClienteID:       dto.ClientID,
+		ClienteNombre:   dto.ClientName,
+		ClienteEmail:    dto.ClientEmail,
+		ClienteTelefono: dto.ClientPhone,
+		SalaID:          dto.RoomID,
+		StartAt:         dto.StartAt,
+		EndAt:           dto.EndAt,
+		NumberOfGuests:  dto.NumberOfGuests,
+		Prioridad:       dto.Priority,
+		Estado:          dto.Status,
+		Notas:           dto.Notes,
+		OfertadaEn:      dto.OfferedAt,
+		OfertaVence:     dto.OfferExpiresAt,
+		ReservaID:       dto.ReservationID,
+		Creada:          dto.CreatedAt,
+	}
+}
+
+// MapToWaitlistEntries convierte un slice de domain.WaitlistEntryDTO a response.WaitlistEntry
+func MapToWaitlistEntries(dtos []domain.WaitlistEntryDTO) []response.WaitlistEntry {
+	result := make([]response.WaitlistEntry, len(dtos))
+	for i, dto := range dtos {
+		result[i] = MapToWaitlistEntry(dto)
+	}
+	return result
+}
+
+// MapToWaitlistOffer convierte un domain.WaitlistOfferDTO a response.WaitlistOffer
+func MapToWaitlistOffer(dto domain.WaitlistOfferDTO) response.WaitlistOffer {
+	return response.WaitlistOffer{
+		ID:             dto.EntryID,
+		Estado:         dto.Status,
+		StartAt:        dto.StartAt,
+		EndAt:          dto.EndAt,
+		NumberOfGuests: dto.NumberOfGuests,
+		Vence:          dto.ExpiresAt,
+		ReservaID:      dto.ReservationID,
+	}
+}
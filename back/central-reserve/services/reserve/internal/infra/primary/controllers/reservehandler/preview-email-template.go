@@ -0,0 +1,87 @@
+package reservehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Vista previa de una plantilla de email
+// @Description	Renderiza la plantilla con datos de ejemplo y la marca del negocio. Si se envían subject, html_body o text_body se previsualizan sin guardarse; si no, se usa la plantilla vigente.
+// @Tags			Plantillas de email
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			code	path		string								true	"Código de la plantilla"
+// @Param			request	body		request.PreviewEmailTemplate		false	"Contenido a previsualizar"
+// @Success		200		{object}	response.RenderedEmailSuccessResponse	"Vista previa generada exitosamente"
+// @Failure		400		{object}	map[string]interface{}				"Solicitud inválida o idioma no soportado"
+// @Failure		401		{object}	map[string]interface{}				"Token de acceso requerido"
+// @Failure		403		{object}	map[string]interface{}				"Sin acceso al negocio indicado"
+// @Failure		404		{object}	map[string]interface{}				"Plantilla desconocida"
+// @Failure		422		{object}	map[string]interface{}				"La plantilla no es válida"
+// @Failure		500		{object}	map[string]interface{}				"Error interno del servidor"
+// @Router			/email-templates/{code}/preview [post]
+func (h *ReserveHandler) PreviewEmailTemplateHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Entrada (el body es opcional) ───────────────────────
+	var req request.PreviewEmailTemplate
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Error().Err(err).Msg("error al bindear JSON de vista previa de plantilla")
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "invalid_request",
+				"message": "Los datos de la plantilla no son válidos",
+			})
+			return
+		}
+	}
+
+	// 2. Negocio (sin body, el super admin lo indica en ?business_id=) ─
+	var businessID uint
+	var err error
+	if req.BusinessID != 0 {
+		businessID, err = middleware.RequireBusiness(c, req.BusinessID)
+	} else {
+		businessID, err = scopeSingleBusiness(c)
+	}
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	rendered, err := h.usecase.PreviewEmailTemplate(ctx, domain.EmailPreviewRequest{
+		BusinessID: businessID,
+		Code:       c.Param("code"),
+		Locale:     req.Locale,
+		Subject:    req.Subject,
+		HTMLBody:   req.HTMLBody,
+		TextBody:   req.TextBody,
+	})
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Str("code", c.Param("code")).Msg("error al previsualizar plantilla de email")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo generar la vista previa",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.RenderedEmailSuccessResponse{
+		Success: true,
+		Data:    mapper.MapToRenderedEmail(*rendered),
+	})
+}
@@ -3,3 +3,9 @@ package request
 type CancelReservation struct {
 	Reason string `json:"reason,omitempty"` // Razón opcional de cancelación
 }
+
+// ChangeReservationStatus solicita la transición de una reserva a otro estado
+type ChangeReservationStatus struct {
+	Status string `json:"status" binding:"required"`          // Código del estado destino (ej: confirmed, seated)
+	Reason string `json:"reason,omitempty" binding:"max=255"` // Motivo opcional del cambio
+}
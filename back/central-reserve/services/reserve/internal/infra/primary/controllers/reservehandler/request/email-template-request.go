@@ -0,0 +1,20 @@
+package request
+
+// SaveEmailTemplate representa la personalización de una plantilla de email.
+// El HTML es el contenido que se inserta dentro del diseño con la marca del negocio.
+type SaveEmailTemplate struct {
+	BusinessID uint   `json:"business_id"` // Solo super admin; el resto usa el negocio del token
+	Subject    string `json:"subject" binding:"required,max=255"`
+	HTMLBody   string `json:"html_body" binding:"required"`
+	TextBody   string `json:"text_body" binding:"required"`
+}
+
+// PreviewEmailTemplate representa la solicitud de vista previa de una plantilla.
+// Los campos omitidos se toman de la plantilla vigente (personalizada o por defecto).
+type PreviewEmailTemplate struct {
+	BusinessID uint    `json:"business_id"` // Solo super admin; el resto usa el negocio del token
+	Locale     string  `json:"locale"`      // Opcional: por defecto el idioma configurado por el negocio
+	Subject    *string `json:"subject,omitempty"`
+	HTMLBody   *string `json:"html_body,omitempty"`
+	TextBody   *string `json:"text_body,omitempty"`
+}
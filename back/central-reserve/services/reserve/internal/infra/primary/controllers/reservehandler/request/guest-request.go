@@ -0,0 +1,15 @@
+package request
+
+import "time"
+
+// GuestReschedule representa la solicitud del cliente para reprogramar su reserva
+type GuestReschedule struct {
+	StartAt        time.Time  `json:"start_at" binding:"required"`
+	EndAt          *time.Time `json:"end_at,omitempty"` // Opcional: por defecto se conserva la duración actual
+	NumberOfGuests *int       `json:"number_of_guests,omitempty"`
+}
+
+// GuestCancel representa la solicitud del cliente para cancelar su reserva
+type GuestCancel struct {
+	Reason string `json:"reason,omitempty" binding:"max=200"` // Razón opcional de cancelación
+}
@@ -10,7 +10,10 @@ type Reservation struct {
 	Email          string    `json:"email" binding:"required"`
 	Phone          string    `json:"phone" binding:"required"`
 	Dni            *string   `json:"dni,omitempty"`
+	TableID        *uint     `json:"table_id,omitempty"`
+	TableIDs       []uint    `json:"table_ids,omitempty"` // Combinación explícita de mesas; si no se envía mesa se auto-asigna
+	RoomID         *uint     `json:"room_id,omitempty"`
 	StartAt        time.Time `json:"start_at" binding:"required"`
-	EndAt          time.Time `json:"end_at" binding:"required"`
+	EndAt          time.Time `json:"end_at"` // Opcional: por defecto inicio + duración configurada por el negocio
 	NumberOfGuests int       `json:"number_of_guests" binding:"required"`
 }
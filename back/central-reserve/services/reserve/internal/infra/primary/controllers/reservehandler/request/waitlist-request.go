@@ -0,0 +1,18 @@
+package request
+
+import "time"
+
+// WaitlistEntry representa la solicitud para entrar en lista de espera
+type WaitlistEntry struct {
+	BusinessID     uint      `json:"business_id"` // Solo super admin; el resto usa el negocio del token
+	Name           string    `json:"name" binding:"required"`
+	Email          string    `json:"email" binding:"required,email"`
+	Phone          string    `json:"phone" binding:"required"`
+	Dni            *string   `json:"dni,omitempty"`
+	RoomID         *uint     `json:"room_id,omitempty"`
+	StartAt        time.Time `json:"start_at" binding:"required"`
+	EndAt          time.Time `json:"end_at"` // Opcional: por defecto inicio + duración configurada por el negocio
+	NumberOfGuests int       `json:"number_of_guests" binding:"required"`
+	Priority       int       `json:"priority"` // Mayor valor = se ofrece primero
+	Notes          string    `json:"notes,omitempty"`
+}
@@ -0,0 +1,58 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Reprogramar reserva (cliente)
+// @Description	Endpoint público del enlace de autogestión. Mueve la reserva a otro horario respetando la disponibilidad, el horario de atención y las reglas de anticipación del negocio. Retorna un nuevo token para el nuevo horario.
+// @Tags			Reservas (cliente)
+// @Accept			json
+// @Produce		json
+// @Param			token		path		string					true	"Token del enlace de la reserva"
+// @Param			reschedule	body		request.GuestReschedule	true	"Nuevo horario"
+// @Success		200			{object}	map[string]interface{}	"Reserva reprogramada exitosamente"
+// @Failure		400			{object}	map[string]interface{}	"Solicitud inválida"
+// @Failure		401			{object}	map[string]interface{}	"Enlace inválido o expirado"
+// @Failure		409			{object}	map[string]interface{}	"Sin disponibilidad o la reserva ya no puede modificarse"
+// @Failure		422			{object}	map[string]interface{}	"Fuera del horario de atención o de las reglas de reserva del negocio"
+// @Failure		500			{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/guest/reservations/{token} [put]
+func (h *ReserveHandler) RescheduleGuestReservationHandler(c *gin.Context) {
+	// 1. Entrada ──────────────────────────────────────────────
+	var req request.GuestReschedule
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_request",
+			"message": "Los datos de la reprogramación no son válidos",
+		})
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	reservation, err := h.usecase.RescheduleGuestReservation(c.Request.Context(), c.Param("token"), mapper.GuestRescheduleToDomain(req))
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Msg("error interno al reprogramar reserva del cliente")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo reprogramar la reserva",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Reserva reprogramada exitosamente",
+		"data":    mapper.MapToGuestReservation(*reservation),
+	})
+}
@@ -0,0 +1,61 @@
+package reservehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Reenvía un email fallido
+// @Description	Vuelve a poner en cola un email que agotó sus intentos, con un nuevo ciclo de reintentos. El historial de intentos anteriores se conserva.
+// @Tags			Notificaciones
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		int									true	"ID de la notificación"
+// @Success		200	{object}	response.NotificationSuccessResponse	"Notificación reencolada exitosamente"
+// @Failure		400	{object}	map[string]interface{}				"ID inválido"
+// @Failure		401	{object}	map[string]interface{}				"Token de acceso requerido"
+// @Failure		404	{object}	map[string]interface{}				"Notificación no encontrada"
+// @Failure		409	{object}	map[string]interface{}				"La notificación no está fallida"
+// @Failure		500	{object}	map[string]interface{}				"Error interno del servidor"
+// @Router			/notifications/{id}/resend [post]
+func (h *ReserveHandler) ResendNotificationHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Entrada ──────────────────────────────────────────────
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "ID de notificación inválido",
+		})
+		return
+	}
+
+	// 2. Caso de uso (business_id = 0 para super admin) ─────
+	businessID, _ := middleware.GetBusinessID(c)
+	notification, err := h.usecase.ResendNotification(ctx, uint(id), businessID)
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("notification_id", uint(id)).Msg("error al reenviar notificación")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo reenviar la notificación",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.NotificationSuccessResponse{
+		Success: true,
+		Data:    mapper.MapToNotification(*notification),
+	})
+}
@@ -0,0 +1,51 @@
+package response
+
+import "time"
+
+// TimeSlot representa un intervalo de tiempo
+type TimeSlot struct {
+	StartAt time.Time `json:"start_at"`
+	EndAt   time.Time `json:"end_at"`
+}
+
+// RoomSlot representa un intervalo libre de una sala con su capacidad restante
+type RoomSlot struct {
+	StartAt           time.Time `json:"start_at"`
+	EndAt             time.Time `json:"end_at"`
+	RemainingCapacity int       `json:"remaining_capacity"`
+}
+
+// TableAvailability representa la disponibilidad de una mesa
+type TableAvailability struct {
+	TableID   uint       `json:"table_id"`
+	Number    int        `json:"number"`
+	Capacity  int        `json:"capacity"`
+	RoomID    *uint      `json:"room_id"`
+	FreeSlots []TimeSlot `json:"free_slots"`
+	BusySlots []TimeSlot `json:"busy_slots"`
+}
+
+// RoomAvailability representa la disponibilidad de una sala
+type RoomAvailability struct {
+	RoomID    uint       `json:"room_id"`
+	Name      string     `json:"name"`
+	Capacity  int        `json:"capacity"`
+	FreeSlots []RoomSlot `json:"free_slots"`
+}
+
+// Availability representa la disponibilidad de un negocio en un rango de fechas
+type Availability struct {
+	BusinessID      uint                `json:"business_id"`
+	StartDate       time.Time           `json:"start_date"`
+	EndDate         time.Time           `json:"end_date"`
+	SlotMinutes     int                 `json:"slot_minutes"`
+	DurationMinutes int                 `json:"duration_minutes"`
+	Tables          []TableAvailability `json:"tables"`
+	Rooms           []RoomAvailability  `json:"rooms"`
+}
+
+// AvailabilitySuccessResponse representa una respuesta exitosa de disponibilidad
+type AvailabilitySuccessResponse struct {
+	Success bool         `json:"success"`
+	Data    Availability `json:"data"`
+}
@@ -0,0 +1,18 @@
+package response
+
+import "time"
+
+// CalendarFeed representa el enlace de suscripción al feed iCal del negocio
+type CalendarFeed struct {
+	NegocioID uint      `json:"negocio_id"`
+	URL       string    `json:"url"`
+	Token     string    `json:"token"` // Solo se muestra al generarlo
+	Creado    time.Time `json:"creado"`
+}
+
+// CalendarFeedSuccessResponse representa una respuesta exitosa con el enlace del feed
+type CalendarFeedSuccessResponse struct {
+	Success bool         `json:"success"`
+	Message string       `json:"message"`
+	Data    CalendarFeed `json:"data"`
+}
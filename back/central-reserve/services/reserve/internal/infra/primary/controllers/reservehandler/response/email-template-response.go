@@ -0,0 +1,47 @@
+package response
+
+import "time"
+
+// EmailTemplate representa una plantilla de email personalizada por el negocio
+type EmailTemplate struct {
+	ID          uint      `json:"id"`
+	Codigo      string    `json:"codigo"`
+	Idioma      string    `json:"idioma"`
+	Asunto      string    `json:"asunto"`
+	HTML        string    `json:"html"`
+	Texto       string    `json:"texto"`
+	Actualizada time.Time `json:"actualizada"`
+}
+
+// EmailTemplateStatus indica si el negocio personalizó una plantilla en un idioma
+type EmailTemplateStatus struct {
+	Codigo        string         `json:"codigo"`
+	Idioma        string         `json:"idioma"`
+	Personalizada bool           `json:"personalizada"`
+	Plantilla     *EmailTemplate `json:"plantilla"`
+}
+
+// RenderedEmail representa la vista previa de un email renderizado
+type RenderedEmail struct {
+	Asunto string `json:"asunto"`
+	HTML   string `json:"html"`
+	Texto  string `json:"texto"`
+}
+
+// EmailTemplateSuccessResponse representa una respuesta exitosa con una plantilla
+type EmailTemplateSuccessResponse struct {
+	Success bool          `json:"success"`
+	Data    EmailTemplate `json:"data"`
+}
+
+// EmailTemplateListSuccessResponse representa una respuesta exitosa con el estado de las plantillas
+type EmailTemplateListSuccessResponse struct {
+	Success bool                  `json:"success"`
+	Data    []EmailTemplateStatus `json:"data"`
+}
+
+// RenderedEmailSuccessResponse representa una respuesta exitosa con la vista previa
+type RenderedEmailSuccessResponse struct {
+	Success bool          `json:"success"`
+	Data    RenderedEmail `json:"data"`
+}
@@ -0,0 +1,19 @@
+package response
+
+import "time"
+
+// GuestReservation representa la vista de una reserva para su cliente (sin datos internos del negocio)
+type GuestReservation struct {
+	ReservaID        uint            `json:"reserva_id"`
+	StartAt          time.Time       `json:"start_at"`
+	EndAt            time.Time       `json:"end_at"`
+	NumberOfGuests   int             `json:"number_of_guests"`
+	EstadoCodigo     string          `json:"estado_codigo"`
+	EstadoNombre     string          `json:"estado_nombre"`
+	ClienteNombre    string          `json:"cliente_nombre"`
+	MesasAsignadas   []AssignedTable `json:"mesas_asignadas"`
+	NegocioNombre    string          `json:"negocio_nombre"`
+	NegocioDireccion string          `json:"negocio_direccion"`
+	PuedeModificar   bool            `json:"puede_modificar"`
+	Token            string          `json:"token,omitempty"` // Nuevo token del enlace si fue reemitido
+}
@@ -0,0 +1,46 @@
+package response
+
+import "time"
+
+// Notification representa un email del outbox
+type Notification struct {
+	ID              uint                  `json:"id"`
+	NegocioID       *uint                 `json:"negocio_id"`
+	ReservaID       *uint                 `json:"reserva_id"`
+	Codigo          string                `json:"codigo"`
+	Destinatario    string                `json:"destinatario"`
+	Asunto          string                `json:"asunto"`
+	Estado          string                `json:"estado"`
+	Intentos        int                   `json:"intentos"`
+	MaxIntentos     int                   `json:"max_intentos"`
+	ProximoIntento  *time.Time            `json:"proximo_intento"`
+	UltimoError     string                `json:"ultimo_error"`
+	EnviadoEn       *time.Time            `json:"enviado_en"`
+	Creado          time.Time             `json:"creado"`
+	HistorialEnvios []NotificationAttempt `json:"historial_envios,omitempty"`
+}
+
+// NotificationAttempt representa un intento de entrega de un email
+type NotificationAttempt struct {
+	Numero     int       `json:"numero"`
+	Exitoso    bool      `json:"exitoso"`
+	Error      string    `json:"error"`
+	DuracionMs int64     `json:"duracion_ms"`
+	Fecha      time.Time `json:"fecha"`
+}
+
+// NotificationSuccessResponse representa una respuesta exitosa con un email del outbox
+type NotificationSuccessResponse struct {
+	Success bool         `json:"success"`
+	Data    Notification `json:"data"`
+}
+
+// NotificationListSuccessResponse representa una página del outbox
+type NotificationListSuccessResponse struct {
+	Success    bool           `json:"success"`
+	Data       []Notification `json:"data"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"page_size"`
+	TotalPages int            `json:"total_pages"`
+}
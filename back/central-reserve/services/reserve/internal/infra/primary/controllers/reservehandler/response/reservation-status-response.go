@@ -12,3 +12,16 @@ type ReservationStatusListSuccessResponse struct {
 	Success bool                `json:"success"`
 	Data    []ReservationStatus `json:"data"`
 }
+
+// StatusTransitions representa el estado actual de una reserva y los estados a los que puede pasar
+type StatusTransitions struct {
+	ReservaID  uint                `json:"reserva_id"`
+	Actual     ReservationStatus   `json:"actual"`
+	Permitidos []ReservationStatus `json:"permitidos"`
+}
+
+// StatusTransitionsSuccessResponse representa una respuesta exitosa con las transiciones permitidas
+type StatusTransitionsSuccessResponse struct {
+	Success bool              `json:"success"`
+	Data    StatusTransitions `json:"data"`
+}
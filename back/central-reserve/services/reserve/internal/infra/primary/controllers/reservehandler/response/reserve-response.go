@@ -24,12 +24,17 @@ type ReserveDetail struct {
 	ClienteEmail    string `json:"cliente_email"`
 	ClienteTelefono string `json:"cliente_telefono"`
 	ClienteDni      string `json:"cliente_dni"`
+	ClienteNoShows  int    `json:"cliente_no_shows"`    // No-shows acumulados del cliente
+	ClienteReincide bool   `json:"cliente_reincidente"` // El cliente alcanzó el umbral de no-shows
 
 	// Mesa
 	MesaID        *uint `json:"mesa_id"`
 	MesaNumero    *int  `json:"mesa_numero"`
 	MesaCapacidad *int  `json:"mesa_capacidad"`
 
+	// Mesas asignadas (más de una si se combinaron)
+	MesasAsignadas []AssignedTable `json:"mesas_asignadas"`
+
 	// Negocio (cambiado de Restaurante)
 	NegocioID        uint   `json:"negocio_id"`
 	NegocioNombre    string `json:"negocio_nombre"`
@@ -42,6 +47,14 @@ type ReserveDetail struct {
 	UsuarioEmail  *string `json:"usuario_email"`
 }
 
+// AssignedTable representa una mesa asignada a la reserva
+type AssignedTable struct {
+	ID        uint  `json:"id"`
+	Numero    int   `json:"numero"`
+	Capacidad int   `json:"capacidad"`
+	SalaID    *uint `json:"sala_id"`
+}
+
 // ReserveSuccessResponse representa una respuesta exitosa con una reserva
 type ReserveSuccessResponse struct {
 	Success bool          `json:"success"`
@@ -0,0 +1,34 @@
+package response
+
+import "time"
+
+// WaitlistEntry representa una entrada de la lista de espera
+type WaitlistEntry struct {
+	ID              uint       `json:"id"`
+	ClienteID       uint       `json:"cliente_id"`
+	ClienteNombre   string     `json:"cliente_nombre"`
+	ClienteEmail    string     `json:"cliente_email"`
+	ClienteTelefono string     `json:"cliente_telefono"`
+	SalaID          *uint      `json:"sala_id"`
+	StartAt         time.Time  `json:"start_at"`
+	EndAt           time.Time  `json:"end_at"`
+	NumberOfGuests  int        `json:"number_of_guests"`
+	Prioridad       int        `json:"prioridad"`
+	Estado          string     `json:"estado"`
+	Notas           string     `json:"notas"`
+	OfertadaEn      *time.Time `json:"ofertada_en"`
+	OfertaVence     *time.Time `json:"oferta_vence"`
+	ReservaID       *uint      `json:"reserva_id"`
+	Creada          time.Time  `json:"creada"`
+}
+
+// WaitlistOffer representa la vista pública de una oferta de lista de espera
+type WaitlistOffer struct {
+	ID             uint       `json:"id"`
+	Estado         string     `json:"estado"`
+	StartAt        time.Time  `json:"start_at"`
+	EndAt          time.Time  `json:"end_at"`
+	NumberOfGuests int        `json:"number_of_guests"`
+	Vence          *time.Time `json:"vence"`
+	ReservaID      *uint      `json:"reserva_id"`
+}
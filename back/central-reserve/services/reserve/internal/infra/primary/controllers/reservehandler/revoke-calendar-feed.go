@@ -0,0 +1,51 @@
+package reservehandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Revoca el feed de calendario
+// @Description	Invalida el enlace de suscripción iCal del negocio. Los super admins deben indicar business_id.
+// @Tags			Calendario
+// @Produce		json
+// @Security		BearerAuth
+// @Param			business_id	query		int						false	"ID del negocio (solo super admin)"
+// @Success		200			{object}	map[string]interface{}	"Feed revocado exitosamente"
+// @Failure		400			{object}	map[string]interface{}	"Solicitud inválida"
+// @Failure		401			{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403			{object}	map[string]interface{}	"Sin acceso al negocio indicado"
+// @Failure		404			{object}	map[string]interface{}	"El negocio no tiene un feed vigente"
+// @Failure		500			{object}	map[string]interface{}	"Error interno del servidor"
+// @Router			/calendar/feed [delete]
+func (h *ReserveHandler) RevokeCalendarFeedHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Negocio ─────────────────────────────────────────────
+	businessID, err := scopeSingleBusiness(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	if err := h.usecase.RevokeCalendarFeed(ctx, businessID); err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("error al revocar feed de calendario")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo revocar el feed de calendario",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Feed de calendario revocado exitosamente",
+	})
+}
@@ -0,0 +1,51 @@
+package reservehandler
+
+import (
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Genera el enlace del feed de calendario
+// @Description	Genera (o rota) el enlace de suscripción iCal con las próximas reservas del negocio, para suscribirse desde Google Calendar, Outlook o Apple Calendar. El enlace anterior deja de funcionar y el token solo se muestra en esta respuesta. Los super admins deben indicar business_id.
+// @Tags			Calendario
+// @Produce		json
+// @Security		BearerAuth
+// @Param			business_id	query		int									false	"ID del negocio (solo super admin)"
+// @Success		200			{object}	response.CalendarFeedSuccessResponse	"Enlace generado exitosamente"
+// @Failure		400			{object}	map[string]interface{}				"Solicitud inválida"
+// @Failure		401			{object}	map[string]interface{}				"Token de acceso requerido"
+// @Failure		403			{object}	map[string]interface{}				"Sin acceso al negocio indicado"
+// @Failure		500			{object}	map[string]interface{}				"Error interno del servidor"
+// @Router			/calendar/feed [post]
+func (h *ReserveHandler) RotateCalendarFeedHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Negocio ─────────────────────────────────────────────
+	businessID, err := scopeSingleBusiness(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	feed, err := h.usecase.RotateCalendarFeed(ctx, businessID, actingUserID(c))
+	if err != nil {
+		h.logger.Error().Err(err).Uint("business_id", businessID).Msg("error al generar feed de calendario")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo generar el enlace del calendario",
+		})
+		return
+	}
+
+	// 3. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.CalendarFeedSuccessResponse{
+		Success: true,
+		Message: "Enlace generado; guárdalo, no se volverá a mostrar",
+		Data:    mapper.MapToCalendarFeed(*feed),
+	})
+}
@@ -8,13 +8,70 @@ import (
 )
 
 func RegisterRoutes(v1Group *gin.RouterGroup, handler IReserveHandler, logger log.ILogger) {
+	read := middleware.RequirePermission(middleware.ResourceReservations, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceReservations, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceReservations, middleware.ActionUpdate)
+	configure := middleware.RequirePermission(middleware.ResourceReservations, middleware.ActionConfigure)
+	readNotifications := middleware.RequirePermission(middleware.ResourceNotifications, middleware.ActionRead)
+	updateNotifications := middleware.RequirePermission(middleware.ResourceNotifications, middleware.ActionUpdate)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourceReservations)
+
 	reserves := v1Group.Group("/reserves")
 	{
-		reserves.GET("", middleware.JWT(), handler.GetReservesHandler)
-		reserves.GET("/:id", middleware.JWT(), handler.GetReserveByIDHandler)
-		reserves.GET("/status", middleware.JWT(), handler.GetReservationStatusesHandler)
-		reserves.PUT("/:id", middleware.JWT(), handler.UpdateReservationHandler)
-		reserves.PATCH("/:id/cancel", middleware.JWT(), handler.CancelReservationHandler)
-		reserves.POST("", middleware.Auto(), handler.CreateReserveHandler)
+		reserves.GET("", middleware.JWT(), feature, read, handler.GetReservesHandler)
+		reserves.GET("/:id", middleware.JWT(), feature, read, handler.GetReserveByIDHandler)
+		reserves.GET("/status", middleware.JWT(), feature, read, handler.GetReservationStatusesHandler)
+		reserves.GET("/availability", middleware.Auto(), feature, read, handler.GetAvailabilityHandler)
+		reserves.PUT("/:id", middleware.JWT(), feature, update, handler.UpdateReservationHandler)
+		reserves.PATCH("/:id/cancel", middleware.JWT(), feature, update, handler.CancelReservationHandler)
+		reserves.PATCH("/:id/status", middleware.JWT(), feature, update, handler.ChangeReservationStatusHandler)
+		reserves.GET("/:id/transitions", middleware.JWT(), feature, read, handler.GetStatusTransitionsHandler)
+		reserves.POST("", middleware.Auto(), feature, create, handler.CreateReserveHandler)
+	}
+
+	waitlist := v1Group.Group("/waitlist")
+	{
+		waitlist.GET("", middleware.Auto(), feature, read, handler.GetWaitlistHandler)
+		waitlist.POST("", middleware.Auto(), feature, create, handler.JoinWaitlistHandler)
+		waitlist.DELETE("/:id", middleware.JWT(), feature, update, handler.CancelWaitlistEntryHandler)
+
+		// Enlaces públicos enviados por email: el token de la oferta es la autorización
+		waitlist.GET("/offers/:token", handler.GetWaitlistOfferHandler)
+		waitlist.POST("/offers/:token/accept", handler.AcceptWaitlistOfferHandler)
+		waitlist.POST("/offers/:token/decline", handler.DeclineWaitlistOfferHandler)
+	}
+
+	// Autogestión del cliente: el token firmado del email de confirmación es la autorización
+	guest := v1Group.Group("/guest/reservations")
+	{
+		guest.GET("/:token", handler.GetGuestReservationHandler)
+		guest.PUT("/:token", handler.RescheduleGuestReservationHandler)
+		guest.POST("/:token/cancel", handler.CancelGuestReservationHandler)
+	}
+
+	templates := v1Group.Group("/email-templates")
+	{
+		templates.GET("", middleware.JWT(), feature, readNotifications, handler.GetEmailTemplatesHandler)
+		templates.PUT("/:code/:locale", middleware.JWT(), feature, updateNotifications, handler.SaveEmailTemplateHandler)
+		templates.DELETE("/:code/:locale", middleware.JWT(), feature, updateNotifications, handler.DeleteEmailTemplateHandler)
+		templates.POST("/:code/preview", middleware.JWT(), feature, readNotifications, handler.PreviewEmailTemplateHandler)
+	}
+
+	notifications := v1Group.Group("/notifications")
+	{
+		notifications.GET("", middleware.JWT(), feature, readNotifications, handler.GetNotificationsHandler)
+		notifications.GET("/:id", middleware.JWT(), feature, readNotifications, handler.GetNotificationByIDHandler)
+		notifications.POST("/:id/resend", middleware.JWT(), feature, updateNotifications, handler.ResendNotificationHandler)
+	}
+
+	calendar := v1Group.Group("/calendar/feed")
+	{
+		calendar.POST("", middleware.JWT(), feature, configure, handler.RotateCalendarFeedHandler)
+		calendar.DELETE("", middleware.JWT(), feature, configure, handler.RevokeCalendarFeedHandler)
+
+		// Suscripción desde clientes de calendario: el token del enlace es la autorización
+		calendar.GET("/:token", handler.GetCalendarFeedHandler)
 	}
 }
@@ -0,0 +1,80 @@
+package reservehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/mapper"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/request"
+	"central_reserve/services/reserve/internal/infra/primary/controllers/reservehandler/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Personaliza una plantilla de email
+// @Description	Crea o reemplaza la plantilla del negocio para un código e idioma. Asunto y texto usan text/template y el HTML usa html/template; el HTML se inserta dentro del diseño con el logo, color y dirección del negocio. Datos disponibles: .Business (Name, LogoURL, PrimaryColor, Address), .GuestName, .Reservation (ID, Date, StartTime, EndTime, NumberOfGuests), .ManageURL, .AcceptURL y .ExpiresAt. La plantilla se valida renderizándola con datos de ejemplo.
+// @Tags			Plantillas de email
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			code	path		string								true	"Código de la plantilla (reservation_confirmation, reservation_cancellation, waitlist_offer, reservation_reminder)"
+// @Param			locale	path		string								true	"Idioma (es, en)"
+// @Param			request	body		request.SaveEmailTemplate			true	"Contenido de la plantilla"
+// @Success		200		{object}	response.EmailTemplateSuccessResponse	"Plantilla guardada exitosamente"
+// @Failure		400		{object}	map[string]interface{}				"Solicitud inválida o idioma no soportado"
+// @Failure		401		{object}	map[string]interface{}				"Token de acceso requerido"
+// @Failure		403		{object}	map[string]interface{}				"Sin acceso al negocio indicado"
+// @Failure		404		{object}	map[string]interface{}				"Plantilla desconocida"
+// @Failure		422		{object}	map[string]interface{}				"La plantilla no es válida"
+// @Failure		500		{object}	map[string]interface{}				"Error interno del servidor"
+// @Router			/email-templates/{code}/{locale} [put]
+func (h *ReserveHandler) SaveEmailTemplateHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Entrada ──────────────────────────────────────────────
+	var req request.SaveEmailTemplate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error().Err(err).Msg("error al bindear JSON de plantilla de email")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_request",
+			"message": "Los datos de la plantilla no son válidos",
+		})
+		return
+	}
+
+	// 2. Negocio ─────────────────────────────────────────────
+	businessID, err := middleware.RequireBusiness(c, req.BusinessID)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	saved, err := h.usecase.SaveEmailTemplate(ctx, domain.EmailTemplate{
+		BusinessID: businessID,
+		Code:       c.Param("code"),
+		Locale:     c.Param("locale"),
+		Subject:    req.Subject,
+		HTMLBody:   req.HTMLBody,
+		TextBody:   req.TextBody,
+	})
+	if err != nil {
+		if respondDomainError(c, err) {
+			return
+		}
+		h.logger.Error().Err(err).Uint("business_id", businessID).Str("code", c.Param("code")).Msg("error al guardar plantilla de email")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "internal_error",
+			"message": "No se pudo guardar la plantilla de email",
+		})
+		return
+	}
+
+	// 4. Salida ──────────────────────────────────────────────
+	c.JSON(http.StatusOK, response.EmailTemplateSuccessResponse{
+		Success: true,
+		Data:    mapper.MapToEmailTemplate(*saved),
+	})
+}
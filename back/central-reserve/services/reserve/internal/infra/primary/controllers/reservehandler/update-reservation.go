@@ -21,6 +21,8 @@ import (
 // @Failure		400		{object}	map[string]interface{}		"Solicitud inválida"
 // @Failure		401		{object}	map[string]interface{}		"Token de acceso requerido"
 // @Failure		404		{object}	map[string]interface{}		"Reserva no encontrada"
+// @Failure		409		{object}	map[string]interface{}		"Mesa o sala ocupada en ese horario o transición de estado no permitida"
+// @Failure		422		{object}	map[string]interface{}		"Fuera del horario de atención o de las reglas de reserva del negocio"
 // @Failure		500		{object}	map[string]interface{}		"Error interno del servidor"
 // @Router			/reserves/{id} [put]
 func (h *ReserveHandler) UpdateReservationHandler(c *gin.Context) {
@@ -59,10 +61,16 @@ func (h *ReserveHandler) UpdateReservationHandler(c *gin.Context) {
 		EndAt:          updateReq.EndAt,
 		NumberOfGuests: updateReq.NumberOfGuests,
 		StatusID:       updateReq.StatusID,
+
+		ChangedByUserID: actingUserID(c),
 	}
 
 	response, err := h.usecase.UpdateReservation(ctx, params)
 	if err != nil {
+		if respondDomainError(c, err) {
+			h.logger.Warn().Err(err).Uint64("reservation_id", reservationID).Msg("actualización de reserva rechazada")
+			return
+		}
 		h.logger.Error().Err(err).Msg("error interno al actualizar reserva")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
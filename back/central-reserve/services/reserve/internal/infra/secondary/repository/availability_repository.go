@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/secondary/repository/mappers"
+	"context"
+	"dbpostgres/app/infra/models"
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetTablesByBusiness obtiene las mesas de un negocio, opcionalmente filtradas por sala
+func (r *Repository) GetTablesByBusiness(ctx context.Context, businessID uint, roomID *uint) ([]domain.Table, error) {
+	var dbTables []models.Table
+
+	query := r.database.Conn(ctx).Model(&models.Table{}).Where("business_id = ?", businessID)
+	if roomID != nil {
+		query = query.Where("room_id = ?", *roomID)
+	}
+
+	if err := query.Order("number ASC").Find(&dbTables).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener mesas del negocio")
+		return nil, err
+	}
+
+	return mappers.ToTableEntitySlice(dbTables), nil
+}
+
+// GetRoomsByBusiness obtiene las salas activas de un negocio, opcionalmente una sola sala
+func (r *Repository) GetRoomsByBusiness(ctx context.Context, businessID uint, roomID *uint) ([]domain.Room, error) {
+	var dbRooms []models.Room
+
+	query := r.database.Conn(ctx).Model(&models.Room{}).Where("business_id = ? AND is_active = ?", businessID, true)
+	if roomID != nil {
+		query = query.Where("id = ?", *roomID)
+	}
+
+	if err := query.Order("name ASC").Find(&dbRooms).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener salas del negocio")
+		return nil, err
+	}
+
+	return mappers.ToRoomEntitySlice(dbRooms), nil
+}
+
+// GetBlockingReservations obtiene las reservas que ocupan mesa o sala dentro del rango indicado
+func (r *Repository) GetBlockingReservations(ctx context.Context, businessID uint, startDate, endDate time.Time) ([]domain.Reservation, error) {
+	var gormReservations []models.Reservation
+
+	db := r.database.Conn(ctx)
+	if err := overlappingReservations(db, businessID, startDate, endDate, 0).
+		Preload("AssignedTables").
+		Order("start_at ASC").
+		Find(&gormReservations).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener reservas para disponibilidad")
+		return nil, err
+	}
+
+	return mappers.ReservationSliceToEntitySlice(gormReservations), nil
+}
+
+// overlappingReservations construye la consulta de reservas activas que se solapan con [startAt, endAt)
+func overlappingReservations(db *gorm.DB, businessID uint, startAt, endAt time.Time, excludeID uint) *gorm.DB {
+	nonBlocking := db.Session(&gorm.Session{NewDB: true}).
+		Model(&models.ReservationStatus{}).
+		Select("id").
+		Where("code IN ?", domain.NonBlockingStatusCodes)
+
+	query := db.Model(&models.Reservation{}).
+		Where("business_id = ?", businessID).
+		Where("start_at < ? AND end_at > ?", endAt, startAt).
+		Where("status_id NOT IN (?)", nonBlocking)
+
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+	return query
+}
+
+// occupyingTables filtra las reservas que ocupan alguna de las mesas, ya sea como mesa
+// principal (table_id) o como parte de una combinación (reservation_table)
+func occupyingTables(query *gorm.DB, tableIDs []uint) *gorm.DB {
+	assigned := query.Session(&gorm.Session{NewDB: true}).
+		Model(&models.ReservationTable{}).
+		Select("reservation_id").
+		Where("table_id IN ?", tableIDs)
+
+	return query.Where("(table_id IN ? OR id IN (?))", tableIDs, assigned)
+}
+
+// normalizeTableIDs retorna la lista de mesas asignadas sin duplicados, con la mesa principal primero
+func normalizeTableIDs(tableID *uint, tableIDs []uint) []uint {
+	result := make([]uint, 0, len(tableIDs)+1)
+	seen := make(map[uint]bool)
+	if tableID != nil {
+		result = append(result, *tableID)
+		seen[*tableID] = true
+	}
+	for _, id := range tableIDs {
+		if !seen[id] {
+			result = append(result, id)
+			seen[id] = true
+		}
+	}
+	return result
+}
+
+// replaceAssignedTables reemplaza las mesas asignadas a una reserva
+func replaceAssignedTables(tx *gorm.DB, reservationID uint, tableIDs []uint) error {
+	if err := tx.Unscoped().Where("reservation_id = ?", reservationID).Delete(&models.ReservationTable{}).Error; err != nil {
+		return err
+	}
+	if len(tableIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]models.ReservationTable, len(tableIDs))
+	for i, tableID := range tableIDs {
+		rows[i] = models.ReservationTable{ReservationID: reservationID, TableID: tableID}
+	}
+	return tx.Create(&rows).Error
+}
+
+// checkAvailability valida dentro de una transacción que las mesas o la sala estén libres.
+// Bloquea las filas de las mesas (o la sala) con SELECT ... FOR UPDATE para serializar
+// reservas concurrentes sobre el mismo recurso.
+func (r *Repository) checkAvailability(tx *gorm.DB, reservation domain.Reservation, excludeID uint) error {
+	tableIDs := normalizeTableIDs(reservation.TableID, reservation.TableIDs)
+	if len(tableIDs) > 0 {
+		// Bloquear en orden de ID para evitar deadlocks entre combinaciones que comparten mesas
+		lockOrder := make([]uint, len(tableIDs))
+		copy(lockOrder, tableIDs)
+		sort.Slice(lockOrder, func(i, j int) bool { return lockOrder[i] < lockOrder[j] })
+
+		var tables []models.Table
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND business_id = ?", lockOrder, reservation.BusinessID).
+			Order("id ASC").
+			Find(&tables).Error
+		if err != nil {
+			return err
+		}
+		if len(tables) != len(lockOrder) {
+			return domain.ErrTableNotFound
+		}
+
+		totalCapacity := 0
+		for _, table := range tables {
+			totalCapacity += table.Capacity
+		}
+		if reservation.NumberOfGuests > totalCapacity {
+			return domain.ErrTableCapacityExceeded
+		}
+
+		var count int64
+		if err := occupyingTables(overlappingReservations(tx, reservation.BusinessID, reservation.StartAt, reservation.EndAt, excludeID), lockOrder).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return domain.ErrReservationConflict
+		}
+		return nil
+	}
+
+	if reservation.RoomID != nil {
+		var room models.Room
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND business_id = ?", *reservation.RoomID, reservation.BusinessID).
+			First(&room).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrRoomNotFound
+			}
+			return err
+		}
+
+		if room.MaxCapacity > 0 && reservation.NumberOfGuests > room.MaxCapacity {
+			return domain.ErrRoomCapacityExceeded
+		}
+
+		// La capacidad se compara con el máximo de personas presentes a la vez, no con la suma de
+		// todas las reservas que tocan el intervalo
+		var rows []struct {
+			StartAt        time.Time
+			EndAt          time.Time
+			NumberOfGuests int
+		}
+		if err := overlappingReservations(tx, reservation.BusinessID, reservation.StartAt, reservation.EndAt, excludeID).
+			Where("room_id = ? AND table_id IS NULL", *reservation.RoomID).
+			Select("start_at, end_at, number_of_guests").
+			Scan(&rows).Error; err != nil {
+			return err
+		}
+		occupancies := make([]domain.Occupancy, len(rows))
+		for i, row := range rows {
+			occupancies[i] = domain.Occupancy{
+				TimeSlot: domain.TimeSlot{StartAt: row.StartAt, EndAt: row.EndAt},
+				Guests:   row.NumberOfGuests,
+			}
+		}
+		booked := domain.PeakOccupancy(domain.TimeSlot{StartAt: reservation.StartAt, EndAt: reservation.EndAt}, occupancies)
+		if booked+reservation.NumberOfGuests > room.Capacity {
+			return domain.ErrRoomCapacityExceeded
+		}
+	}
+
+	return nil
+}
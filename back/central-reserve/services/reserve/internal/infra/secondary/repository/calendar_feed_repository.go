@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveCalendarFeedToken guarda el hash del token del feed de un negocio, reemplazando el anterior
+func (r *Repository) SaveCalendarFeedToken(ctx context.Context, businessID uint, tokenHash string, createdByUserID *uint) error {
+	feed := models.CalendarFeedToken{
+		BusinessID:      businessID,
+		TokenHash:       tokenHash,
+		CreatedByUserID: createdByUserID,
+	}
+	// Un token revocado queda con soft delete: se restaura al rotar para respetar el índice único por negocio
+	err := r.database.Conn(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "business_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"token_hash":         tokenHash,
+			"created_by_user_id": createdByUserID,
+			"last_accessed_at":   nil,
+			"created_at":         time.Now(),
+			"updated_at":         time.Now(),
+			"deleted_at":         nil,
+		}),
+	}).Create(&feed).Error
+	if err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al guardar token del feed de calendario")
+		return err
+	}
+	return nil
+}
+
+// DeleteCalendarFeedToken revoca el feed de un negocio. Retorna false si no tenía uno vigente.
+func (r *Repository) DeleteCalendarFeedToken(ctx context.Context, businessID uint) (bool, error) {
+	result := r.database.Conn(ctx).Where("business_id = ?", businessID).Delete(&models.CalendarFeedToken{})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("business_id", businessID).Msg("Error al revocar feed de calendario")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetBusinessIDByCalendarFeedToken resuelve el negocio de un feed y registra el acceso
+func (r *Repository) GetBusinessIDByCalendarFeedToken(ctx context.Context, tokenHash string) (uint, error) {
+	db := r.database.Conn(ctx)
+
+	var feed models.CalendarFeedToken
+	if err := db.Where("token_hash = ?", tokenHash).First(&feed).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, domain.ErrCalendarFeedNotFound
+		}
+		r.logger.Error().Err(err).Msg("Error al obtener feed de calendario")
+		return 0, err
+	}
+
+	if err := db.Model(&feed).UpdateColumn("last_accessed_at", time.Now()).Error; err != nil {
+		r.logger.Warn().Err(err).Uint("business_id", feed.BusinessID).Msg("No se pudo registrar el acceso al feed de calendario")
+	}
+	return feed.BusinessID, nil
+}
+
+// GetCalendarReservations obtiene las reservas de un negocio que empiezan en el rango, excluyendo los estados indicados
+func (r *Repository) GetCalendarReservations(ctx context.Context, businessID uint, from, to time.Time, excludedStatusCodes []string) ([]domain.ReserveDetailDTO, error) {
+	db := r.database.Conn(ctx)
+	excluded := db.Session(&gorm.Session{NewDB: true}).
+		Model(&models.ReservationStatus{}).
+		Select("id").
+		Where("code IN ?", excludedStatusCodes)
+
+	var reservations []models.Reservation
+	err := db.
+		Preload("Status").Preload("Client").Preload("Table").Preload("Business").Preload("CreatedBy").Preload("AssignedTables.Table").
+		Where("business_id = ? AND start_at >= ? AND start_at < ?", businessID, from, to).
+		Where("status_id NOT IN (?)", excluded).
+		Order("start_at ASC").
+		Find(&reservations).Error
+	if err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener reservas del feed de calendario")
+		return nil, err
+	}
+
+	results := make([]domain.ReserveDetailDTO, 0, len(reservations))
+	for _, reservation := range reservations {
+		results = append(results, reservationToDetailDTO(reservation))
+	}
+	return results, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/secondary/repository/mappers"
+	"context"
+	"dbpostgres/app/infra/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetEmailBranding obtiene los datos de marca, idioma y zona horaria del negocio para sus emails
+func (r *Repository) GetEmailBranding(ctx context.Context, businessID uint) (*domain.EmailBranding, error) {
+	db := r.database.Conn(ctx)
+
+	var business models.Business
+	if err := db.Select("id", "name", "logo_url", "primary_color", "address", "timezone").
+		Where("id = ?", businessID).First(&business).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener marca del negocio")
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(business.Timezone)
+	if err != nil || business.Timezone == "" {
+		location = time.UTC
+	}
+
+	branding := &domain.EmailBranding{
+		BusinessID:   business.Model.ID,
+		Name:         business.Name,
+		LogoURL:      business.LogoURL,
+		PrimaryColor: business.PrimaryColor,
+		Address:      business.Address,
+		Locale:       domain.DefaultEmailLocale,
+		Location:     location,
+	}
+
+	var settings models.BusinessReservationSettings
+	err = db.Select("email_locale").Where("business_id = ?", businessID).First(&settings).Error
+	switch {
+	case err == nil:
+		if settings.EmailLocale != "" {
+			branding.Locale = settings.EmailLocale
+		}
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener idioma de emails del negocio")
+		return nil, err
+	}
+
+	return branding, nil
+}
+
+// GetEmailTemplate obtiene la plantilla personalizada de un negocio. Retorna nil si no existe.
+func (r *Repository) GetEmailTemplate(ctx context.Context, businessID uint, code, locale string) (*domain.EmailTemplate, error) {
+	var template models.EmailTemplate
+	err := r.database.Conn(ctx).
+		Where("business_id = ? AND code = ? AND locale = ?", businessID, code, locale).
+		First(&template).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Uint("business_id", businessID).Str("code", code).Msg("Error al obtener plantilla de email")
+		return nil, err
+	}
+	result := mappers.EmailTemplateToEntity(template)
+	return &result, nil
+}
+
+// GetEmailTemplates obtiene todas las plantillas personalizadas de un negocio
+func (r *Repository) GetEmailTemplates(ctx context.Context, businessID uint) ([]domain.EmailTemplate, error) {
+	var templates []models.EmailTemplate
+	if err := r.database.Conn(ctx).
+		Where("business_id = ?", businessID).
+		Order("code ASC, locale ASC").
+		Find(&templates).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener plantillas de email")
+		return nil, err
+	}
+	return mappers.EmailTemplateSliceToEntitySlice(templates), nil
+}
+
+// SaveEmailTemplate crea o reemplaza la plantilla personalizada de un negocio para un código e idioma
+func (r *Repository) SaveEmailTemplate(ctx context.Context, template domain.EmailTemplate) (*domain.EmailTemplate, error) {
+	model := models.EmailTemplate{
+		BusinessID: template.BusinessID,
+		Code:       template.Code,
+		Locale:     template.Locale,
+		Subject:    template.Subject,
+		HTMLBody:   template.HTMLBody,
+		TextBody:   template.TextBody,
+	}
+	if err := r.database.Conn(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "business_id"}, {Name: "code"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"subject", "html_body", "text_body", "updated_at"}),
+	}).Create(&model).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", template.BusinessID).Str("code", template.Code).Msg("Error al guardar plantilla de email")
+		return nil, err
+	}
+	return r.GetEmailTemplate(ctx, template.BusinessID, template.Code, template.Locale)
+}
+
+// DeleteEmailTemplate elimina la plantilla personalizada para volver a la plantilla por defecto
+func (r *Repository) DeleteEmailTemplate(ctx context.Context, businessID uint, code, locale string) (bool, error) {
+	result := r.database.Conn(ctx).Unscoped().
+		Where("business_id = ? AND code = ? AND locale = ?", businessID, code, locale).
+		Delete(&models.EmailTemplate{})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("business_id", businessID).Str("code", code).Msg("Error al eliminar plantilla de email")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
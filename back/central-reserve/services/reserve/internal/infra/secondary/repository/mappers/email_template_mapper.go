@@ -0,0 +1,29 @@
+package mappers
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"dbpostgres/app/infra/models"
+)
+
+// EmailTemplateToEntity convierte models.EmailTemplate a domain.EmailTemplate
+func EmailTemplateToEntity(template models.EmailTemplate) domain.EmailTemplate {
+	return domain.EmailTemplate{
+		ID:         template.Model.ID,
+		BusinessID: template.BusinessID,
+		Code:       template.Code,
+		Locale:     template.Locale,
+		Subject:    template.Subject,
+		HTMLBody:   template.HTMLBody,
+		TextBody:   template.TextBody,
+		UpdatedAt:  template.Model.UpdatedAt,
+	}
+}
+
+// EmailTemplateSliceToEntitySlice convierte []models.EmailTemplate a []domain.EmailTemplate
+func EmailTemplateSliceToEntitySlice(templates []models.EmailTemplate) []domain.EmailTemplate {
+	result := make([]domain.EmailTemplate, len(templates))
+	for i, template := range templates {
+		result[i] = EmailTemplateToEntity(template)
+	}
+	return result
+}
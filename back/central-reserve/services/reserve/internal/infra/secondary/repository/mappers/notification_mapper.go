@@ -0,0 +1,80 @@
+package mappers
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"dbpostgres/app/infra/models"
+)
+
+// NotificationToModel convierte domain.Notification a models.NotificationOutbox
+func NotificationToModel(notification domain.Notification) models.NotificationOutbox {
+	record := models.NotificationOutbox{
+		BusinessID:    notification.BusinessID,
+		ReservationID: notification.ReservationID,
+		Code:          notification.Code,
+		Recipient:     notification.Recipient,
+		Subject:       notification.Subject,
+		HTMLBody:      notification.HTMLBody,
+		TextBody:      notification.TextBody,
+		Status:        notification.Status,
+		Attempts:      notification.Attempts,
+		MaxAttempts:   notification.MaxAttempts,
+		NextAttemptAt: notification.NextAttemptAt,
+	}
+	for _, attachment := range notification.Attachments {
+		record.Attachments = append(record.Attachments, models.NotificationAttachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+		})
+	}
+	return record
+}
+
+// NotificationToEntity convierte models.NotificationOutbox a domain.Notification
+func NotificationToEntity(notification models.NotificationOutbox) domain.Notification {
+	result := domain.Notification{
+		ID:            notification.Model.ID,
+		BusinessID:    notification.BusinessID,
+		ReservationID: notification.ReservationID,
+		Code:          notification.Code,
+		Recipient:     notification.Recipient,
+		Subject:       notification.Subject,
+		HTMLBody:      notification.HTMLBody,
+		TextBody:      notification.TextBody,
+		Status:        notification.Status,
+		Attempts:      notification.Attempts,
+		MaxAttempts:   notification.MaxAttempts,
+		NextAttemptAt: notification.NextAttemptAt,
+		LastError:     notification.LastError,
+		SentAt:        notification.SentAt,
+		CreatedAt:     notification.Model.CreatedAt,
+		UpdatedAt:     notification.Model.UpdatedAt,
+	}
+	for _, attachment := range notification.Attachments {
+		result.Attachments = append(result.Attachments, domain.NotificationAttachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+		})
+	}
+	for _, attempt := range notification.DeliveryAttempts {
+		result.DeliveryAttempts = append(result.DeliveryAttempts, domain.NotificationAttempt{
+			ID:          attempt.Model.ID,
+			Number:      attempt.Number,
+			Success:     attempt.Success,
+			Error:       attempt.Error,
+			DurationMs:  attempt.DurationMs,
+			AttemptedAt: attempt.Model.CreatedAt,
+		})
+	}
+	return result
+}
+
+// NotificationSliceToEntitySlice convierte []models.NotificationOutbox a []domain.Notification
+func NotificationSliceToEntitySlice(notifications []models.NotificationOutbox) []domain.Notification {
+	result := make([]domain.Notification, len(notifications))
+	for i, notification := range notifications {
+		result[i] = NotificationToEntity(notification)
+	}
+	return result
+}
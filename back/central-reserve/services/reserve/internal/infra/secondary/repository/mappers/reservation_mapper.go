@@ -11,7 +11,9 @@ func ReservationToEntity(reservation models.Reservation) domain.Reservation {
 	return domain.Reservation{
 		ID:              reservation.Model.ID,
 		BusinessID:      reservation.BusinessID,
+		RoomID:          reservation.RoomID,
 		TableID:         reservation.TableID,
+		TableIDs:        AssignedTableIDs(reservation),
 		ClientID:        reservation.ClientID,
 		CreatedByUserID: reservation.CreatedByUserID,
 		StartAt:         reservation.StartAt,
@@ -23,10 +25,49 @@ func ReservationToEntity(reservation models.Reservation) domain.Reservation {
 	}
 }
 
+// AssignedTableIDs obtiene los IDs de las mesas asignadas a una reserva.
+// Las reservas anteriores a la combinación de mesas solo tienen table_id.
+func AssignedTableIDs(reservation models.Reservation) []uint {
+	if len(reservation.AssignedTables) > 0 {
+		ids := make([]uint, len(reservation.AssignedTables))
+		for i, assigned := range reservation.AssignedTables {
+			ids[i] = assigned.TableID
+		}
+		return ids
+	}
+	if reservation.TableID != nil {
+		return []uint{*reservation.TableID}
+	}
+	return nil
+}
+
+// AssignedTablesToDTO convierte las mesas asignadas precargadas a DTOs
+func AssignedTablesToDTO(reservation models.Reservation) []domain.AssignedTableDTO {
+	result := make([]domain.AssignedTableDTO, 0, len(reservation.AssignedTables))
+	for _, assigned := range reservation.AssignedTables {
+		result = append(result, domain.AssignedTableDTO{
+			ID:       assigned.TableID,
+			Number:   assigned.Table.Number,
+			Capacity: assigned.Table.Capacity,
+			RoomID:   assigned.Table.RoomID,
+		})
+	}
+	if len(result) == 0 && reservation.Table.Model.ID != 0 {
+		result = append(result, domain.AssignedTableDTO{
+			ID:       reservation.Table.Model.ID,
+			Number:   reservation.Table.Number,
+			Capacity: reservation.Table.Capacity,
+			RoomID:   reservation.Table.RoomID,
+		})
+	}
+	return result
+}
+
 // EntityToReservation convierte entities.Reservation a models.Reservation
 func EntityToReservation(reservation domain.Reservation) models.Reservation {
 	return models.Reservation{
 		BusinessID:      reservation.BusinessID,
+		RoomID:          reservation.RoomID,
 		TableID:         reservation.TableID,
 		ClientID:        reservation.ClientID,
 		CreatedByUserID: reservation.CreatedByUserID,
@@ -53,6 +94,7 @@ func ReservationStatusHistoryToEntity(history models.ReservationStatusHistory) d
 		ReservationID:   history.ReservationID,
 		StatusID:        history.StatusID,
 		ChangedByUserID: history.ChangedByUserID,
+		Reason:          history.Reason,
 		CreatedAt:       history.Model.CreatedAt,
 		UpdatedAt:       history.Model.UpdatedAt,
 	}
@@ -64,6 +106,7 @@ func EntityToReservationStatusHistory(history domain.ReservationStatusHistory) m
 		ReservationID:   history.ReservationID,
 		StatusID:        history.StatusID,
 		ChangedByUserID: history.ChangedByUserID,
+		Reason:          history.Reason,
 	}
 }
 
@@ -135,3 +178,48 @@ func ToClientEntitySlice(clients []models.Client) []domain.Client {
 	}
 	return entities
 }
+
+// ToTableEntity convierte models.Table a entities.Table
+func ToTableEntity(table models.Table) domain.Table {
+	return domain.Table{
+		ID:         table.Model.ID,
+		BusinessID: table.BusinessID,
+		Number:     table.Number,
+		Capacity:   table.Capacity,
+		RoomID:     table.RoomID,
+		CreatedAt:  table.Model.CreatedAt,
+		UpdatedAt:  table.Model.UpdatedAt,
+	}
+}
+
+// ToTableEntitySlice convierte []models.Table a []entities.Table
+func ToTableEntitySlice(tables []models.Table) []domain.Table {
+	result := make([]domain.Table, len(tables))
+	for i, table := range tables {
+		result[i] = ToTableEntity(table)
+	}
+	return result
+}
+
+// ToRoomEntity convierte models.Room a entities.Room
+func ToRoomEntity(room models.Room) domain.Room {
+	return domain.Room{
+		ID:          room.Model.ID,
+		BusinessID:  room.BusinessID,
+		Name:        room.Name,
+		Code:        room.Code,
+		Capacity:    room.Capacity,
+		MinCapacity: room.MinCapacity,
+		MaxCapacity: room.MaxCapacity,
+		IsActive:    room.IsActive,
+	}
+}
+
+// ToRoomEntitySlice convierte []models.Room a []entities.Room
+func ToRoomEntitySlice(rooms []models.Room) []domain.Room {
+	result := make([]domain.Room, len(rooms))
+	for i, room := range rooms {
+		result[i] = ToRoomEntity(room)
+	}
+	return result
+}
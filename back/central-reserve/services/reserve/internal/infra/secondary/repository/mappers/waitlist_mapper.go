@@ -0,0 +1,57 @@
+package mappers
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"dbpostgres/app/infra/models"
+)
+
+// WaitlistEntryToModel convierte domain.WaitlistEntry a models.WaitlistEntry
+func WaitlistEntryToModel(entry domain.WaitlistEntry) models.WaitlistEntry {
+	return models.WaitlistEntry{
+		BusinessID:     entry.BusinessID,
+		ClientID:       entry.ClientID,
+		RoomID:         entry.RoomID,
+		StartAt:        entry.StartAt,
+		EndAt:          entry.EndAt,
+		NumberOfGuests: entry.NumberOfGuests,
+		Priority:       entry.Priority,
+		Status:         entry.Status,
+		Notes:          entry.Notes,
+	}
+}
+
+// WaitlistEntryToDTO convierte models.WaitlistEntry (con Client precargado) a domain.WaitlistEntryDTO
+func WaitlistEntryToDTO(model models.WaitlistEntry) domain.WaitlistEntryDTO {
+	return domain.WaitlistEntryDTO{
+		WaitlistEntry: domain.WaitlistEntry{
+			ID:             model.ID,
+			BusinessID:     model.BusinessID,
+			ClientID:       model.ClientID,
+			RoomID:         model.RoomID,
+			StartAt:        model.StartAt,
+			EndAt:          model.EndAt,
+			NumberOfGuests: model.NumberOfGuests,
+			Priority:       model.Priority,
+			Status:         model.Status,
+			Notes:          model.Notes,
+			OfferedAt:      model.OfferedAt,
+			OfferExpiresAt: model.OfferExpiresAt,
+			ReservationID:  model.ReservationID,
+			CreatedAt:      model.CreatedAt,
+			UpdatedAt:      model.UpdatedAt,
+		},
+		ClientName:  model.Client.Name,
+		ClientEmail: model.Client.Email,
+		ClientPhone: model.Client.Phone,
+		ClientDni:   model.Client.Dni,
+	}
+}
+
+// WaitlistEntriesToDTO convierte un slice de models.WaitlistEntry a DTOs
+func WaitlistEntriesToDTO(entries []models.WaitlistEntry) []domain.WaitlistEntryDTO {
+	result := make([]domain.WaitlistEntryDTO, len(entries))
+	for i, entry := range entries {
+		result[i] = WaitlistEntryToDTO(entry)
+	}
+	return result
+}
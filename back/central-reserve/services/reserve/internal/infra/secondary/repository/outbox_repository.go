@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/secondary/repository/mappers"
+	"central_reserve/shared/db"
+	"context"
+	"dbpostgres/app/infra/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EnqueueNotification guarda un email en el outbox para que el worker lo envíe
+func (r *Repository) EnqueueNotification(ctx context.Context, notification domain.Notification) (uint, error) {
+	record := mappers.NotificationToModel(notification)
+	if err := r.database.Conn(ctx).Create(&record).Error; err != nil {
+		r.logger.Error().Err(err).Str("code", notification.Code).Str("recipient", notification.Recipient).Msg("Error al encolar notificación")
+		return 0, err
+	}
+	return record.Model.ID, nil
+}
+
+// ClaimDueNotifications toma los emails cuyo intento venció y los marca como "sending" hasta now + lease.
+// SKIP LOCKED permite que varias instancias del worker tomen lotes distintos sin bloquearse; un email
+// en "sending" cuyo plazo venció (p. ej. el proceso se reinició a mitad del envío) vuelve a tomarse.
+func (r *Repository) ClaimDueNotifications(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]domain.Notification, error) {
+	var claimed []models.NotificationOutbox
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ? AND next_attempt_at <= ?", []string{domain.NotificationPending, domain.NotificationSending}, now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&claimed).Error; err != nil {
+			return err
+		}
+		if len(claimed) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(claimed))
+		for i, notification := range claimed {
+			ids[i] = notification.Model.ID
+		}
+		if err := tx.Model(&models.NotificationOutbox{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":          domain.NotificationSending,
+				"next_attempt_at": now.Add(lease),
+			}).Error; err != nil {
+			return err
+		}
+
+		// Los adjuntos se cargan aparte: la consulta con SKIP LOCKED solo bloquea las filas del outbox
+		return tx.Preload("Attachments").Where("id IN ?", ids).Order("next_attempt_at ASC").Find(&claimed).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Error al tomar notificaciones pendientes")
+		return nil, err
+	}
+	return mappers.NotificationSliceToEntitySlice(claimed), nil
+}
+
+// RecordNotificationDelivery registra un intento de entrega y actualiza el estado del email
+func (r *Repository) RecordNotificationDelivery(ctx context.Context, delivery domain.NotificationDelivery) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		attempt := models.NotificationAttempt{
+			OutboxID:   delivery.NotificationID,
+			Number:     delivery.Attempt.Number,
+			Success:    delivery.Attempt.Success,
+			Error:      delivery.Attempt.Error,
+			DurationMs: delivery.Attempt.DurationMs,
+		}
+		if err := tx.Create(&attempt).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.NotificationOutbox{}).
+			Where("id = ?", delivery.NotificationID).
+			Updates(map[string]interface{}{
+				"status":          delivery.Status,
+				"attempts":        delivery.Attempt.Number,
+				"last_error":      delivery.Attempt.Error,
+				"next_attempt_at": delivery.NextAttemptAt,
+				"sent_at":         delivery.SentAt,
+			}).Error
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("notification_id", delivery.NotificationID).Msg("Error al registrar intento de notificación")
+		return err
+	}
+	return nil
+}
+
+// GetNotifications obtiene una página del outbox, de la más reciente a la más antigua
+func (r *Repository) GetNotifications(ctx context.Context, query domain.NotificationQuery) ([]domain.Notification, int64, error) {
+	tx := r.database.Conn(ctx).Model(&models.NotificationOutbox{}).Scopes(db.ByBusiness(query.BusinessID))
+	if query.Status != nil {
+		tx = tx.Where("status = ?", *query.Status)
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		r.logger.Error().Err(err).Msg("Error al contar notificaciones")
+		return nil, 0, err
+	}
+
+	var notifications []models.NotificationOutbox
+	if err := tx.Order("created_at DESC").
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		Find(&notifications).Error; err != nil {
+		r.logger.Error().Err(err).Msg("Error al obtener notificaciones")
+		return nil, 0, err
+	}
+	return mappers.NotificationSliceToEntitySlice(notifications), total, nil
+}
+
+// GetNotificationByID obtiene un email del outbox con sus intentos. businessID = 0 no filtra por negocio.
+func (r *Repository) GetNotificationByID(ctx context.Context, id uint, businessID uint) (*domain.Notification, error) {
+	db := r.database.Conn(ctx).
+		Preload("DeliveryAttempts", func(db *gorm.DB) *gorm.DB { return db.Order("number ASC") }).
+		Where("id = ?", id)
+	if businessID != 0 {
+		db = db.Where("business_id = ?", businessID)
+	}
+
+	var notification models.NotificationOutbox
+	if err := db.First(&notification).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotificationNotFound
+		}
+		r.logger.Error().Err(err).Uint("notification_id", id).Msg("Error al obtener notificación")
+		return nil, err
+	}
+	result := mappers.NotificationToEntity(notification)
+	return &result, nil
+}
+
+// RequeueNotification vuelve a poner en cola un email fallido con extraAttempts intentos más.
+// Retorna false si el email no existe o no está fallido.
+func (r *Repository) RequeueNotification(ctx context.Context, id uint, businessID uint, now time.Time, extraAttempts int) (bool, error) {
+	db := r.database.Conn(ctx).Model(&models.NotificationOutbox{}).
+		Where("id = ? AND status = ?", id, domain.NotificationFailed)
+	if businessID != 0 {
+		db = db.Where("business_id = ?", businessID)
+	}
+
+	result := db.Updates(map[string]interface{}{
+		"status":          domain.NotificationPending,
+		"max_attempts":    gorm.Expr("attempts + ?", extraAttempts),
+		"next_attempt_at": now,
+	})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("notification_id", id).Msg("Error al reencolar notificación")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
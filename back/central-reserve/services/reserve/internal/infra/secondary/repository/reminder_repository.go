@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// reminderHours es la anticipación del recordatorio del negocio, con el valor por defecto si no tiene reglas
+var reminderHours = fmt.Sprintf("COALESCE(settings.reminder_hours_before, %d)", domain.DefaultReminderHoursBefore)
+
+// GetReservationsDueForReminder obtiene las reservas activas que entraron en la ventana de recordatorio
+// de su negocio y aún no lo recibieron
+func (r *Repository) GetReservationsDueForReminder(ctx context.Context, now time.Time, limit int) ([]domain.ReserveDetailDTO, error) {
+	db := r.database.Conn(ctx)
+
+	var reservations []models.Reservation
+	err := db.
+		Preload("Status").Preload("Client").Preload("Table").Preload("Business").Preload("CreatedBy").Preload("AssignedTables.Table").
+		Joins("LEFT JOIN business_reservation_settings AS settings ON settings.business_id = reservation.business_id AND settings.deleted_at IS NULL").
+		Where("reservation.reminder_sent_at IS NULL").
+		Where("reservation.status_id IN (?)", activeStatusIDs(db)).
+		Where("reservation.start_at > ?", now).
+		Where(reminderHours+" > 0").
+		Where("reservation.start_at - "+reminderHours+" * INTERVAL '1 hour' <= ?", now).
+		// Las reservas hechas dentro de la ventana ya recibieron la confirmación con los mismos datos
+		Where("reservation.created_at < reservation.start_at - " + reminderHours + " * INTERVAL '1 hour'").
+		Order("reservation.start_at ASC").
+		Limit(limit).
+		Find(&reservations).Error
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Error al obtener reservas pendientes de recordatorio")
+		return nil, err
+	}
+
+	results := make([]domain.ReserveDetailDTO, 0, len(reservations))
+	for _, reservation := range reservations {
+		results = append(results, reservationToDetailDTO(reservation))
+	}
+	return results, nil
+}
+
+// ClaimReservationReminder marca el recordatorio de una reserva como enviado solo si nadie lo hizo
+// antes, para que dos instancias del servicio no lo envíen dos veces
+func (r *Repository) ClaimReservationReminder(ctx context.Context, id uint, sentAt time.Time) (bool, error) {
+	result := r.database.Conn(ctx).Model(&models.Reservation{}).
+		Where("id = ? AND reminder_sent_at IS NULL", id).
+		UpdateColumn("reminder_sent_at", sentAt)
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("reservation_id", id).Msg("Error al marcar recordatorio de reserva")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ReleaseReservationReminder deja la reserva pendiente de recordatorio otra vez (si no se pudo encolar)
+func (r *Repository) ReleaseReservationReminder(ctx context.Context, id uint) error {
+	if err := r.database.Conn(ctx).Model(&models.Reservation{}).
+		Where("id = ?", id).
+		UpdateColumn("reminder_sent_at", nil).Error; err != nil {
+		r.logger.Error().Err(err).Uint("reservation_id", id).Msg("Error al liberar recordatorio de reserva")
+		return err
+	}
+	return nil
+}
+
+// GetReservationsPastNoShowGrace obtiene las reservas activas cuyo inicio más la tolerancia de no-show
+// del negocio ya pasó. Los negocios sin tolerancia configurada no marcan no-shows automáticos.
+func (r *Repository) GetReservationsPastNoShowGrace(ctx context.Context, now time.Time, limit int) ([]uint, error) {
+	db := r.database.Conn(ctx)
+
+	var ids []uint
+	err := db.Model(&models.Reservation{}).
+		Joins("JOIN business_reservation_settings AS settings ON settings.business_id = reservation.business_id AND settings.deleted_at IS NULL").
+		Where("settings.no_show_grace_minutes > 0").
+		Where("reservation.status_id IN (?)", activeStatusIDs(db)).
+		Where("reservation.start_at + settings.no_show_grace_minutes * INTERVAL '1 minute' <= ?", now).
+		Order("reservation.start_at ASC").
+		Limit(limit).
+		Pluck("reservation.id", &ids).Error
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Error al obtener reservas vencidas sin llegada del cliente")
+		return nil, err
+	}
+	return ids, nil
+}
+
+// activeStatusIDs es la subconsulta de los estados en los que la reserva sigue esperando al cliente
+func activeStatusIDs(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).
+		Model(&models.ReservationStatus{}).
+		Select("id").
+		Where("code IN ?", domain.ReminderStatuses)
+}
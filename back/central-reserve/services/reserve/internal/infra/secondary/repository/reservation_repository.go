@@ -7,8 +7,12 @@ import (
 	"central_reserve/shared/log"
 	"context"
 	"dbpostgres/app/infra/models"
+	"errors"
 	"fmt"
 	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -26,9 +30,33 @@ func New(db db.IDatabase, logger log.ILogger) domain.IReservationRepository {
 // CreateReserve crea una nueva reserva
 func (r *Repository) CreateReserve(ctx context.Context, reserve domain.Reservation) (uint, error) {
 	// Mapear de entities.Reservation a models.Reservation
+	reserve.TableIDs = normalizeTableIDs(reserve.TableID, reserve.TableIDs)
+	if len(reserve.TableIDs) > 0 {
+		reserve.TableID = &reserve.TableIDs[0]
+	}
 	gormReservation := mappers.EntityToReservation(reserve)
 
-	if err := r.database.Conn(ctx).Create(&gormReservation).Error; err != nil {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := r.checkAvailability(tx, reserve, 0); err != nil {
+			return err
+		}
+		if err := tx.Create(&gormReservation).Error; err != nil {
+			return err
+		}
+		if err := replaceAssignedTables(tx, gormReservation.Model.ID, reserve.TableIDs); err != nil {
+			return err
+		}
+
+		// Estado inicial en el historial, atribuido a quien crea la reserva
+		history := models.ReservationStatusHistory{
+			ReservationID:   gormReservation.Model.ID,
+			TableID:         gormReservation.TableID,
+			StatusID:        gormReservation.StatusID,
+			ChangedByUserID: gormReservation.CreatedByUserID,
+		}
+		return tx.Create(&history).Error
+	})
+	if err != nil {
 		r.logger.Error().Err(err).Msg("Error al crear reserva")
 		return 0, err
 	}
@@ -52,7 +80,7 @@ func (r *Repository) GetLatestReservationByClient(ctx context.Context, clientID
 func (r *Repository) GetReserves(ctx context.Context, statusID *uint, clientID *uint, tableID *uint, startDate *time.Time, endDate *time.Time) ([]domain.ReserveDetailDTO, error) {
 	var gormReservations []models.Reservation
 
-	query := r.database.Conn(ctx).Preload("Status").Preload("Client").Preload("Table").Preload("Business").Preload("CreatedBy")
+	query := r.database.Conn(ctx).Preload("Status").Preload("Client").Preload("Table").Preload("Business").Preload("CreatedBy").Preload("AssignedTables.Table")
 
 	// Aplicar filtros
 	if statusID != nil {
@@ -84,54 +112,61 @@ func (r *Repository) GetReserves(ctx context.Context, statusID *uint, clientID *
 	// Mapear a DTOs
 	var results []domain.ReserveDetailDTO
 	for _, reservation := range gormReservations {
-		dto := domain.ReserveDetailDTO{
-			ReservaID:          reservation.Model.ID,
-			StartAt:            reservation.StartAt,
-			EndAt:              reservation.EndAt,
-			NumberOfGuests:     reservation.NumberOfGuests,
-			ReservaCreada:      reservation.Model.CreatedAt,
-			ReservaActualizada: reservation.Model.UpdatedAt,
-			EstadoID:           reservation.StatusID,
-		}
+		results = append(results, reservationToDetailDTO(reservation))
+	}
 
-		// Manejar relaciones de forma segura
-		if reservation.Status.Model.ID != 0 {
-			dto.EstadoCodigo = reservation.Status.Code
-			dto.EstadoNombre = reservation.Status.Name
-		}
+	r.logger.Info().Int("total_results", len(results)).Msg("Reservas mapeadas exitosamente")
+	return results, nil
+}
 
-		if reservation.Client.Model.ID != 0 {
-			dto.ClienteID = reservation.Client.Model.ID
-			dto.ClienteNombre = reservation.Client.Name
-			dto.ClienteEmail = reservation.Client.Email
-			dto.ClienteTelefono = reservation.Client.Phone
-			dto.ClienteDni = reservation.Client.Dni
-		}
+// reservationToDetailDTO mapea una reserva con sus relaciones precargadas, tolerando relaciones vacías
+func reservationToDetailDTO(reservation models.Reservation) domain.ReserveDetailDTO {
+	dto := domain.ReserveDetailDTO{
+		ReservaID:          reservation.Model.ID,
+		StartAt:            reservation.StartAt,
+		EndAt:              reservation.EndAt,
+		NumberOfGuests:     reservation.NumberOfGuests,
+		ReservaCreada:      reservation.Model.CreatedAt,
+		ReservaActualizada: reservation.Model.UpdatedAt,
+		EstadoID:           reservation.StatusID,
+	}
 
-		if reservation.Table.Model.ID != 0 {
-			dto.MesaID = &reservation.Table.Model.ID
-			dto.MesaNumero = &reservation.Table.Number
-			dto.MesaCapacidad = &reservation.Table.Capacity
-		}
+	// Manejar relaciones de forma segura
+	if reservation.Status.Model.ID != 0 {
+		dto.EstadoCodigo = reservation.Status.Code
+		dto.EstadoNombre = reservation.Status.Name
+	}
 
-		if reservation.Business.Model.ID != 0 {
-			dto.NegocioID = reservation.Business.Model.ID
-			dto.NegocioNombre = reservation.Business.Name
-			dto.NegocioCodigo = reservation.Business.Code
-			dto.NegocioDireccion = reservation.Business.Address
-		}
+	if reservation.Client.Model.ID != 0 {
+		dto.ClienteID = reservation.Client.Model.ID
+		dto.ClienteNombre = reservation.Client.Name
+		dto.ClienteEmail = reservation.Client.Email
+		dto.ClienteTelefono = reservation.Client.Phone
+		dto.ClienteDni = reservation.Client.Dni
+		dto.ClienteNoShows = reservation.Client.NoShowCount
+	}
 
-		if reservation.CreatedBy.Model.ID != 0 {
-			dto.UsuarioID = &reservation.CreatedBy.Model.ID
-			dto.UsuarioNombre = &reservation.CreatedBy.Name
-			dto.UsuarioEmail = &reservation.CreatedBy.Email
-		}
+	if reservation.Table.Model.ID != 0 {
+		dto.MesaID = &reservation.Table.Model.ID
+		dto.MesaNumero = &reservation.Table.Number
+		dto.MesaCapacidad = &reservation.Table.Capacity
+	}
+	dto.MesasAsignadas = mappers.AssignedTablesToDTO(reservation)
 
-		results = append(results, dto)
+	if reservation.Business.Model.ID != 0 {
+		dto.NegocioID = reservation.Business.Model.ID
+		dto.NegocioNombre = reservation.Business.Name
+		dto.NegocioCodigo = reservation.Business.Code
+		dto.NegocioDireccion = reservation.Business.Address
 	}
 
-	r.logger.Info().Int("total_results", len(results)).Msg("Reservas mapeadas exitosamente")
-	return results, nil
+	if reservation.CreatedBy.Model.ID != 0 {
+		dto.UsuarioID = &reservation.CreatedBy.Model.ID
+		dto.UsuarioNombre = &reservation.CreatedBy.Name
+		dto.UsuarioEmail = &reservation.CreatedBy.Email
+	}
+
+	return dto
 }
 
 // GetReservesCount obtiene el número total de reservas (para debugging)
@@ -151,7 +186,7 @@ func (r *Repository) GetReserveByID(ctx context.Context, id uint) (*domain.Reser
 	var gormReservation models.Reservation
 
 	err := r.database.Conn(ctx).
-		Preload("Status").Preload("Client").Preload("Table").Preload("Business").Preload("CreatedBy").
+		Preload("Status").Preload("Client").Preload("Table").Preload("Business").Preload("CreatedBy").Preload("AssignedTables.Table").
 		Where("id = ?", id).
 		First(&gormReservation).Error
 
@@ -176,9 +211,11 @@ func (r *Repository) GetReserveByID(ctx context.Context, id uint) (*domain.Reser
 		ClienteEmail:       gormReservation.Client.Email,
 		ClienteTelefono:    gormReservation.Client.Phone,
 		ClienteDni:         gormReservation.Client.Dni,
+		ClienteNoShows:     gormReservation.Client.NoShowCount,
 		MesaID:             &gormReservation.Table.Model.ID,
 		MesaNumero:         &gormReservation.Table.Number,
 		MesaCapacidad:      &gormReservation.Table.Capacity,
+		MesasAsignadas:     mappers.AssignedTablesToDTO(gormReservation),
 		NegocioID:          gormReservation.Business.Model.ID,
 		NegocioNombre:      gormReservation.Business.Name,
 		NegocioCodigo:      gormReservation.Business.Code,
@@ -191,35 +228,6 @@ func (r *Repository) GetReserveByID(ctx context.Context, id uint) (*domain.Reser
 	return &result, nil
 }
 
-// CancelReservation cancela una reserva
-func (r *Repository) CancelReservation(ctx context.Context, id uint, reason string) (string, error) {
-	// Verificar que la reserva existe
-	var existingReservation models.Reservation
-	if err := r.database.Conn(ctx).Where("id = ?", id).First(&existingReservation).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al verificar reserva existente")
-		return "", err
-	}
-
-	// Actualizar el estado de la reserva a cancelado (asumiendo que el ID del estado cancelado es 3)
-	if err := r.database.Conn(ctx).Model(&models.Reservation{}).Where("id = ?", id).Update("status_id", 3).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al cancelar reserva")
-		return "", err
-	}
-
-	// Crear registro en el historial
-	history := models.ReservationStatusHistory{
-		ReservationID: id,
-		StatusID:      3, // Estado cancelado
-	}
-
-	if err := r.database.Conn(ctx).Create(&history).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al crear historial de cancelación")
-		// No retornamos error aquí porque la reserva ya fue cancelada
-	}
-
-	return fmt.Sprintf("Reserva cancelada con ID: %d", id), nil
-}
-
 // GetReservationStatuses obtiene todos los estados de reserva
 func (r *Repository) GetReservationStatuses(ctx context.Context) ([]domain.ReservationStatus, error) {
 	var gormStatuses []models.ReservationStatus
@@ -239,6 +247,8 @@ func (r *Repository) UpdateReservation(ctx context.Context, params domain.Update
 	}
 	if params.StartAt != nil {
 		updates["start_at"] = *params.StartAt
+		// Al reprogramar, el recordatorio se envía de nuevo con el nuevo horario
+		updates["reminder_sent_at"] = nil
 	}
 	if params.EndAt != nil {
 		updates["end_at"] = *params.EndAt
@@ -246,27 +256,76 @@ func (r *Repository) UpdateReservation(ctx context.Context, params domain.Update
 	if params.NumberOfGuests != nil {
 		updates["number_of_guests"] = *params.NumberOfGuests
 	}
-	if params.StatusID != nil {
-		updates["status_id"] = *params.StatusID
-	}
 
-	if len(updates) == 0 {
+	if len(updates) == 0 && params.StatusID == nil {
 		return "No hay campos para actualizar", nil
 	}
 
-	if err := r.database.Conn(ctx).Model(&models.Reservation{}).Where("id = ?", params.ID).Updates(updates).Error; err != nil {
-		r.logger.Error().Uint("id", params.ID).Err(err).Msg("Error al actualizar reserva")
-		return "", err
-	}
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.Reservation
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", params.ID).First(&current).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrReservationNotFound
+			}
+			return err
+		}
 
-	if params.StatusID != nil {
-		history := models.ReservationStatusHistory{
-			ReservationID: params.ID,
-			StatusID:      *params.StatusID,
+		if err := tx.Model(&models.ReservationTable{}).Where("reservation_id = ?", params.ID).Find(&current.AssignedTables).Error; err != nil {
+			return err
+		}
+
+		// Validar disponibilidad con el estado resultante de la reserva
+		merged := mappers.ReservationToEntity(current)
+		if params.TableID != nil {
+			// Asignar una mesa explícitamente reemplaza cualquier combinación previa
+			merged.TableID = params.TableID
+			merged.TableIDs = []uint{*params.TableID}
 		}
-		if err := r.database.Conn(ctx).Create(&history).Error; err != nil {
-			r.logger.Error().Uint("id", params.ID).Err(err).Msg("Error al crear historial de estado")
+		if params.StartAt != nil {
+			merged.StartAt = *params.StartAt
 		}
+		if params.EndAt != nil {
+			merged.EndAt = *params.EndAt
+		}
+		if params.NumberOfGuests != nil {
+			merged.NumberOfGuests = *params.NumberOfGuests
+		}
+
+		if !merged.EndAt.After(merged.StartAt) {
+			return domain.ErrInvalidTimeRange
+		}
+
+		if params.TableID != nil || params.StartAt != nil || params.EndAt != nil || params.NumberOfGuests != nil {
+			if err := r.checkAvailability(tx, merged, params.ID); err != nil {
+				return err
+			}
+		}
+
+		if len(updates) > 0 {
+			if err := tx.Model(&models.Reservation{}).Where("id = ?", params.ID).Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+
+		if params.TableID != nil {
+			if err := replaceAssignedTables(tx, params.ID, merged.TableIDs); err != nil {
+				return err
+			}
+		}
+
+		// El cambio de estado pasa por la máquina de estados (reenviar el estado actual no es un cambio)
+		if params.StatusID != nil && *params.StatusID != current.StatusID {
+			target, err := findStatus(tx, "id = ?", *params.StatusID)
+			if err != nil {
+				return err
+			}
+			return applyStatusChange(tx, current, *target, params.ChangedByUserID, "")
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error().Uint("id", params.ID).Err(err).Msg("Error al actualizar reserva")
+		return "", err
 	}
 
 	return fmt.Sprintf("Reserva actualizada con ID: %d", params.ID), nil
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"context"
+	"dbpostgres/app/infra/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GetBusinessSchedule obtiene la zona horaria, reglas de reserva, horario semanal y las excepciones
+// de un negocio que afectan al rango [startDate, endDate]. Si el negocio no configuró reglas se usan los valores por defecto.
+func (r *Repository) GetBusinessSchedule(ctx context.Context, businessID uint, startDate, endDate time.Time) (*domain.BusinessSchedule, error) {
+	db := r.database.Conn(ctx)
+
+	var business models.Business
+	if err := db.Select("id", "timezone").Where("id = ?", businessID).First(&business).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener zona horaria del negocio")
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(business.Timezone)
+	if err != nil || business.Timezone == "" {
+		r.logger.Warn().Str("timezone", business.Timezone).Uint("business_id", businessID).Msg("Zona horaria inválida, se usa UTC")
+		location = time.UTC
+	}
+
+	schedule := &domain.BusinessSchedule{
+		BusinessID:             businessID,
+		Location:               location,
+		SlotMinutes:            domain.DefaultSlotMinutes,
+		DefaultDurationMinutes: domain.DefaultReservationMinutes,
+	}
+
+	var settings models.BusinessReservationSettings
+	err = db.Where("business_id = ?", businessID).First(&settings).Error
+	switch {
+	case err == nil:
+		if settings.SlotMinutes > 0 {
+			schedule.SlotMinutes = settings.SlotMinutes
+		}
+		if settings.DefaultDurationMinutes > 0 {
+			schedule.DefaultDurationMinutes = settings.DefaultDurationMinutes
+		}
+		schedule.MinLeadTimeMinutes = settings.MinLeadTimeMinutes
+		schedule.MaxAdvanceDays = settings.MaxAdvanceDays
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener reglas de reserva del negocio")
+		return nil, err
+	}
+
+	var hours []models.BusinessOpeningHour
+	if err := db.Where("business_id = ?", businessID).Order("day_of_week ASC, open_time ASC").Find(&hours).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener horario de atención del negocio")
+		return nil, err
+	}
+	for _, hour := range hours {
+		schedule.OpeningHours = append(schedule.OpeningHours, domain.OpeningHour{
+			DayOfWeek: hour.DayOfWeek,
+			OpenTime:  hour.OpenTime,
+			CloseTime: hour.CloseTime,
+		})
+	}
+
+	// Un día de margen a cada lado: las franjas nocturnas cruzan la medianoche local
+	from := startDate.In(location).AddDate(0, 0, -1).Format("2006-01-02")
+	to := endDate.In(location).AddDate(0, 0, 1).Format("2006-01-02")
+
+	var blackouts []models.BusinessBlackoutDate
+	if err := db.Where("business_id = ? AND date BETWEEN ? AND ?", businessID, from, to).Order("date ASC").Find(&blackouts).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener excepciones de horario del negocio")
+		return nil, err
+	}
+	for _, blackout := range blackouts {
+		schedule.BlackoutDates = append(schedule.BlackoutDates, domain.BlackoutDate{
+			Date:      time.Date(blackout.Date.Year(), blackout.Date.Month(), blackout.Date.Day(), 0, 0, 0, 0, time.UTC),
+			IsClosed:  blackout.IsClosed,
+			OpenTime:  blackout.OpenTime,
+			CloseTime: blackout.CloseTime,
+			Reason:    blackout.Reason,
+		})
+	}
+
+	return schedule, nil
+}
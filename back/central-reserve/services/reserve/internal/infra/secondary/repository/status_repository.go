@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/secondary/repository/mappers"
+	"context"
+	"dbpostgres/app/infra/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetReservationStatusByCode obtiene un estado de reserva por su código
+func (r *Repository) GetReservationStatusByCode(ctx context.Context, code string) (*domain.ReservationStatus, error) {
+	status, err := findStatus(r.database.Conn(ctx), "code = ?", code)
+	if err != nil {
+		r.logger.Error().Err(err).Str("code", code).Msg("Error al obtener estado de reserva por código")
+		return nil, err
+	}
+	result := mappers.ReservationStatusToEntity(*status)
+	return &result, nil
+}
+
+// GetReservationStatusByID obtiene un estado de reserva por su ID
+func (r *Repository) GetReservationStatusByID(ctx context.Context, id uint) (*domain.ReservationStatus, error) {
+	status, err := findStatus(r.database.Conn(ctx), "id = ?", id)
+	if err != nil {
+		r.logger.Error().Err(err).Uint("status_id", id).Msg("Error al obtener estado de reserva por ID")
+		return nil, err
+	}
+	result := mappers.ReservationStatusToEntity(*status)
+	return &result, nil
+}
+
+// ChangeReservationStatus aplica un cambio de estado validado por la máquina de estados.
+// La reserva se bloquea durante la transacción para que dos cambios concurrentes no
+// partan del mismo estado de origen.
+func (r *Repository) ChangeReservationStatus(ctx context.Context, change domain.StatusChange) error {
+	err := r.database.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.Reservation
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", change.ReservationID).First(&current).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrReservationNotFound
+			}
+			return err
+		}
+
+		target, err := findStatus(tx, "code = ?", change.StatusCode)
+		if err != nil {
+			return err
+		}
+		return applyStatusChange(tx, current, *target, change.ChangedByUserID, change.Reason)
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Uint("reservation_id", change.ReservationID).Str("status", change.StatusCode).Msg("Error al cambiar estado de reserva")
+		return err
+	}
+	return nil
+}
+
+// applyStatusChange valida la transición desde el estado actual de la reserva (ya bloqueada
+// en tx), actualiza el estado y registra el cambio en el historial
+func applyStatusChange(tx *gorm.DB, current models.Reservation, target models.ReservationStatus, changedByUserID *uint, reason string) error {
+	from, err := findStatus(tx, "id = ?", current.StatusID)
+	if err != nil {
+		return err
+	}
+	if !domain.CanTransition(from.Code, target.Code) {
+		return domain.ErrInvalidStatusTransition
+	}
+
+	if err := tx.Model(&models.Reservation{}).Where("id = ?", current.Model.ID).Update("status_id", target.Model.ID).Error; err != nil {
+		return err
+	}
+
+	// Cada no-show suma al historial del cliente para que el negocio identifique a los reincidentes
+	if target.Code == domain.StatusNoShow {
+		if err := tx.Model(&models.Client{}).Where("id = ?", current.ClientID).UpdateColumns(map[string]interface{}{
+			"no_show_count":   gorm.Expr("no_show_count + 1"),
+			"last_no_show_at": time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	history := models.ReservationStatusHistory{
+		ReservationID:   current.Model.ID,
+		TableID:         current.TableID,
+		StatusID:        target.Model.ID,
+		ChangedByUserID: changedByUserID,
+		Reason:          reason,
+	}
+	return tx.Create(&history).Error
+}
+
+// findStatus busca un estado de reserva y traduce la ausencia a un error de dominio
+func findStatus(tx *gorm.DB, query string, arg interface{}) (*models.ReservationStatus, error) {
+	var status models.ReservationStatus
+	if err := tx.Where(query, arg).First(&status).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrReservationStatusNotFound
+		}
+		return nil, err
+	}
+	return &status, nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"central_reserve/services/reserve/internal/domain"
+	"central_reserve/services/reserve/internal/infra/secondary/repository/mappers"
+	"context"
+	"dbpostgres/app/infra/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// waitlistPriorityOrder ordena la lista de espera: mayor prioridad primero y, a igual prioridad, por orden de llegada
+const waitlistPriorityOrder = "priority DESC, created_at ASC, id ASC"
+
+// CreateWaitlistEntry registra un cliente en lista de espera
+func (r *Repository) CreateWaitlistEntry(ctx context.Context, entry domain.WaitlistEntry) (uint, error) {
+	model := mappers.WaitlistEntryToModel(entry)
+	if err := r.database.Conn(ctx).Create(&model).Error; err != nil {
+		r.logger.Error().Err(err).Uint("client_id", entry.ClientID).Msg("Error al crear entrada de lista de espera")
+		return 0, err
+	}
+	return model.ID, nil
+}
+
+// GetWaitlistEntries lista la lista de espera de un negocio en orden de prioridad
+func (r *Repository) GetWaitlistEntries(ctx context.Context, query domain.WaitlistQuery) ([]domain.WaitlistEntryDTO, error) {
+	db := r.database.Conn(ctx).Preload("Client").Where("business_id = ?", query.BusinessID)
+	if query.Status != nil {
+		db = db.Where("status = ?", *query.Status)
+	}
+	if query.StartDate != nil {
+		db = db.Where("start_at >= ?", *query.StartDate)
+	}
+	if query.EndDate != nil {
+		db = db.Where("start_at <= ?", *query.EndDate)
+	}
+
+	var entries []models.WaitlistEntry
+	if err := db.Order("start_at ASC, " + waitlistPriorityOrder).Find(&entries).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", query.BusinessID).Msg("Error al obtener lista de espera")
+		return nil, err
+	}
+	return mappers.WaitlistEntriesToDTO(entries), nil
+}
+
+// GetWaitlistEntryByID obtiene una entrada de lista de espera
+func (r *Repository) GetWaitlistEntryByID(ctx context.Context, id uint) (*domain.WaitlistEntryDTO, error) {
+	var entry models.WaitlistEntry
+	if err := r.database.Conn(ctx).Preload("Client").Where("id = ?", id).First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWaitlistEntryNotFound
+		}
+		r.logger.Error().Err(err).Uint("id", id).Msg("Error al obtener entrada de lista de espera")
+		return nil, err
+	}
+	dto := mappers.WaitlistEntryToDTO(entry)
+	return &dto, nil
+}
+
+// GetWaitlistEntryByOfferToken obtiene la entrada asociada al hash del token de oferta
+func (r *Repository) GetWaitlistEntryByOfferToken(ctx context.Context, tokenHash string) (*domain.WaitlistEntryDTO, error) {
+	var entry models.WaitlistEntry
+	if err := r.database.Conn(ctx).Preload("Client").Where("offer_token_hash = ?", tokenHash).First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWaitlistOfferNotFound
+		}
+		r.logger.Error().Err(err).Msg("Error al obtener oferta de lista de espera")
+		return nil, err
+	}
+	dto := mappers.WaitlistEntryToDTO(entry)
+	return &dto, nil
+}
+
+// HasActiveWaitlistEntry indica si el cliente ya espera (o tiene una oferta) para un horario que se solapa
+func (r *Repository) HasActiveWaitlistEntry(ctx context.Context, clientID uint, startAt, endAt time.Time) (bool, error) {
+	var count int64
+	if err := r.database.Conn(ctx).
+		Model(&models.WaitlistEntry{}).
+		Where("client_id = ? AND status IN ?", clientID, []string{domain.WaitlistStatusWaiting, domain.WaitlistStatusOffered}).
+		Where("start_at < ? AND end_at > ?", endAt, startAt).
+		Count(&count).Error; err != nil {
+		r.logger.Error().Err(err).Uint("client_id", clientID).Msg("Error al verificar lista de espera del cliente")
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetWaitlistCandidates obtiene las entradas en espera cuyo horario se solapa con el liberado, en orden de prioridad
+func (r *Repository) GetWaitlistCandidates(ctx context.Context, businessID uint, startAt, endAt, now time.Time) ([]domain.WaitlistEntryDTO, error) {
+	var entries []models.WaitlistEntry
+	if err := r.database.Conn(ctx).
+		Preload("Client").
+		Where("business_id = ? AND status = ?", businessID, domain.WaitlistStatusWaiting).
+		Where("start_at < ? AND end_at > ? AND start_at > ?", endAt, startAt, now).
+		Order(waitlistPriorityOrder).
+		Find(&entries).Error; err != nil {
+		r.logger.Error().Err(err).Uint("business_id", businessID).Msg("Error al obtener candidatos de lista de espera")
+		return nil, err
+	}
+	return mappers.WaitlistEntriesToDTO(entries), nil
+}
+
+// GetExpiredWaitlistOffers obtiene las ofertas enviadas cuyo enlace ya venció
+func (r *Repository) GetExpiredWaitlistOffers(ctx context.Context, now time.Time) ([]domain.WaitlistEntryDTO, error) {
+	var entries []models.WaitlistEntry
+	if err := r.database.Conn(ctx).
+		Preload("Client").
+		Where("status = ? AND offer_expires_at <= ?", domain.WaitlistStatusOffered, now).
+		Order("offer_expires_at ASC").
+		Find(&entries).Error; err != nil {
+		r.logger.Error().Err(err).Msg("Error al obtener ofertas de lista de espera vencidas")
+		return nil, err
+	}
+	return mappers.WaitlistEntriesToDTO(entries), nil
+}
+
+// MarkWaitlistOffered registra la oferta solo si la entrada sigue en espera; retorna false si otro proceso la tomó
+func (r *Repository) MarkWaitlistOffered(ctx context.Context, id uint, tokenHash string, offeredAt, expiresAt time.Time) (bool, error) {
+	result := r.database.Conn(ctx).
+		Model(&models.WaitlistEntry{}).
+		Where("id = ? AND status = ?", id, domain.WaitlistStatusWaiting).
+		Updates(map[string]interface{}{
+			"status":           domain.WaitlistStatusOffered,
+			"offer_token_hash": tokenHash,
+			"offered_at":       offeredAt,
+			"offer_expires_at": expiresAt,
+		})
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("id", id).Msg("Error al registrar oferta de lista de espera")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UpdateWaitlistStatus cambia el estado solo si la entrada está en fromStatus; retorna false si no aplicó.
+// Al volver a "waiting" se invalida el token de la oferta anterior.
+func (r *Repository) UpdateWaitlistStatus(ctx context.Context, id uint, fromStatus, toStatus string, reservationID *uint) (bool, error) {
+	updates := map[string]interface{}{"status": toStatus}
+	if reservationID != nil {
+		updates["reservation_id"] = *reservationID
+	}
+	if toStatus == domain.WaitlistStatusWaiting {
+		updates["offer_token_hash"] = nil
+		updates["offered_at"] = nil
+		updates["offer_expires_at"] = nil
+	}
+
+	result := r.database.Conn(ctx).
+		Model(&models.WaitlistEntry{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Updates(updates)
+	if result.Error != nil {
+		r.logger.Error().Err(result.Error).Uint("id", id).Str("to_status", toStatus).Msg("Error al actualizar estado de lista de espera")
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
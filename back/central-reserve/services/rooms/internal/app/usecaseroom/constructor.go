@@ -8,12 +8,11 @@ import (
 
 type IUseCaseRoom interface {
 	CreateRoom(ctx context.Context, room domain.Room) (string, error)
-	GetRooms(ctx context.Context) ([]domain.Room, error)
-	GetRoomsByBusinessID(ctx context.Context, businessID uint) ([]domain.Room, error)
-	GetRoomByID(ctx context.Context, id uint) (*domain.Room, error)
+	GetRooms(ctx context.Context, businessID uint) ([]domain.Room, error)
+	GetRoomByID(ctx context.Context, businessID, id uint) (*domain.Room, error)
 	GetRoomByCodeAndBusiness(ctx context.Context, code string, businessID uint) (*domain.Room, error)
-	UpdateRoom(ctx context.Context, id uint, room domain.Room) (string, error)
-	DeleteRoom(ctx context.Context, id uint) (string, error)
+	UpdateRoom(ctx context.Context, businessID, id uint, room domain.Room) (string, error)
+	DeleteRoom(ctx context.Context, businessID, id uint) (string, error)
 }
 
 type RoomUseCase struct {
@@ -2,6 +2,7 @@ package usecaseroom
 
 import (
 	"central_reserve/services/rooms/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
@@ -46,5 +47,15 @@ func (uc *RoomUseCase) CreateRoom(ctx context.Context, room domain.Room) (string
 		return "", fmt.Errorf("error al crear sala: %w", err)
 	}
 
+	if created, err := uc.repository.GetRoomByCodeAndBusiness(ctx, room.Code, room.BusinessID); err == nil && created != nil {
+		audit.Log(ctx, audit.Entry{
+			BusinessID: created.BusinessID,
+			EntityType: "room",
+			EntityID:   created.ID,
+			Action:     audit.ActionCreate,
+			After:      created,
+		})
+	}
+
 	return result, nil
 }
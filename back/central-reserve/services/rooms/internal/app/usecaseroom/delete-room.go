@@ -1,31 +1,36 @@
 package usecaseroom
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
 
 // DeleteRoom elimina una sala
-func (uc *RoomUseCase) DeleteRoom(ctx context.Context, id uint) (string, error) {
+func (uc *RoomUseCase) DeleteRoom(ctx context.Context, businessID, id uint) (string, error) {
 	if id == 0 {
 		return "", fmt.Errorf("el ID de la sala es requerido")
 	}
 
-	// Verificar que la sala existe
-	existingRoom, err := uc.repository.GetRoomByID(ctx, id)
+	// Verificar que la sala existe en el negocio
+	existingRoom, err := uc.repository.GetRoomByID(ctx, businessID, id)
 	if err != nil {
 		return "", fmt.Errorf("error al verificar existencia de la sala: %w", err)
 	}
 
-	if existingRoom == nil {
-		return "", fmt.Errorf("la sala con ID %d no existe", id)
-	}
-
 	// Eliminar la sala
-	result, err := uc.repository.DeleteRoom(ctx, id)
+	result, err := uc.repository.DeleteRoom(ctx, businessID, id)
 	if err != nil {
 		return "", fmt.Errorf("error al eliminar sala: %w", err)
 	}
 
+	audit.Log(ctx, audit.Entry{
+		BusinessID: existingRoom.BusinessID,
+		EntityType: "room",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     existingRoom,
+	})
+
 	return result, nil
 }
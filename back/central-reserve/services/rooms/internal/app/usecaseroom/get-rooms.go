@@ -6,35 +6,22 @@ import (
 	"fmt"
 )
 
-// GetRooms obtiene todas las salas
-func (uc *RoomUseCase) GetRooms(ctx context.Context) ([]domain.Room, error) {
-	rooms, err := uc.repository.GetRooms(ctx)
+// GetRooms obtiene las salas del negocio
+func (uc *RoomUseCase) GetRooms(ctx context.Context, businessID uint) ([]domain.Room, error) {
+	rooms, err := uc.repository.GetRooms(ctx, businessID)
 	if err != nil {
 		return nil, fmt.Errorf("error al obtener salas: %w", err)
 	}
 	return rooms, nil
 }
 
-// GetRoomsByBusinessID obtiene todas las salas de un negocio específico
-func (uc *RoomUseCase) GetRoomsByBusinessID(ctx context.Context, businessID uint) ([]domain.Room, error) {
-	if businessID == 0 {
-		return nil, fmt.Errorf("el ID del negocio es requerido")
-	}
-
-	rooms, err := uc.repository.GetRoomsByBusinessID(ctx, businessID)
-	if err != nil {
-		return nil, fmt.Errorf("error al obtener salas del negocio: %w", err)
-	}
-	return rooms, nil
-}
-
-// GetRoomByID obtiene una sala por su ID
-func (uc *RoomUseCase) GetRoomByID(ctx context.Context, id uint) (*domain.Room, error) {
+// GetRoomByID obtiene una sala por su ID dentro del negocio
+func (uc *RoomUseCase) GetRoomByID(ctx context.Context, businessID, id uint) (*domain.Room, error) {
 	if id == 0 {
 		return nil, fmt.Errorf("el ID de la sala es requerido")
 	}
 
-	room, err := uc.repository.GetRoomByID(ctx, id)
+	room, err := uc.repository.GetRoomByID(ctx, businessID, id)
 	if err != nil {
 		return nil, fmt.Errorf("error al obtener sala por ID: %w", err)
 	}
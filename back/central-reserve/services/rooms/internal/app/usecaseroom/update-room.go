@@ -2,13 +2,14 @@ package usecaseroom
 
 import (
 	"central_reserve/services/rooms/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 	"strings"
 )
 
 // UpdateRoom actualiza una sala existente
-func (uc *RoomUseCase) UpdateRoom(ctx context.Context, id uint, room domain.Room) (string, error) {
+func (uc *RoomUseCase) UpdateRoom(ctx context.Context, businessID, id uint, room domain.Room) (string, error) {
 	if id == 0 {
 		return "", fmt.Errorf("el ID de la sala es requerido")
 	}
@@ -22,10 +23,6 @@ func (uc *RoomUseCase) UpdateRoom(ctx context.Context, id uint, room domain.Room
 		return "", fmt.Errorf("el código de la sala es requerido")
 	}
 
-	if room.BusinessID == 0 {
-		return "", fmt.Errorf("el ID del negocio es requerido")
-	}
-
 	if room.Capacity <= 0 {
 		return "", fmt.Errorf("la capacidad debe ser mayor a 0")
 	}
@@ -38,15 +35,14 @@ func (uc *RoomUseCase) UpdateRoom(ctx context.Context, id uint, room domain.Room
 		return "", fmt.Errorf("la capacidad máxima debe ser mayor o igual a la capacidad mínima")
 	}
 
-	// Verificar que la sala existe
-	existingRoom, err := uc.repository.GetRoomByID(ctx, id)
+	// Verificar que la sala existe en el negocio
+	existingRoom, err := uc.repository.GetRoomByID(ctx, businessID, id)
 	if err != nil {
 		return "", fmt.Errorf("error al verificar existencia de la sala: %w", err)
 	}
 
-	if existingRoom == nil {
-		return "", fmt.Errorf("la sala con ID %d no existe", id)
-	}
+	// Una sala no puede cambiar de negocio
+	room.BusinessID = existingRoom.BusinessID
 
 	// Verificar si ya existe otra sala con el mismo código en el mismo negocio
 	roomWithSameCode, err := uc.repository.GetRoomByCodeAndBusiness(ctx, room.Code, room.BusinessID)
@@ -55,10 +51,23 @@ func (uc *RoomUseCase) UpdateRoom(ctx context.Context, id uint, room domain.Room
 	}
 
 	// Actualizar la sala
-	result, err := uc.repository.UpdateRoom(ctx, id, room)
+	result, err := uc.repository.UpdateRoom(ctx, businessID, id, room)
 	if err != nil {
 		return "", fmt.Errorf("error al actualizar sala: %w", err)
 	}
 
+	updatedRoom, err := uc.repository.GetRoomByID(ctx, businessID, id)
+	if err != nil {
+		return "", fmt.Errorf("error al obtener sala actualizada: %w", err)
+	}
+	audit.Log(ctx, audit.Entry{
+		BusinessID: existingRoom.BusinessID,
+		EntityType: "room",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     existingRoom,
+		After:      updatedRoom,
+	})
+
 	return result, nil
 }
@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+var (
+	ErrRoomNotFound = errors.New("sala no encontrada")
+)
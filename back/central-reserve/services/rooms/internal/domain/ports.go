@@ -2,13 +2,13 @@ package domain
 
 import "context"
 
-// IRoomRepository define las operaciones para salas
+// IRoomRepository define las operaciones para salas. Las consultas se acotan al negocio indicado;
+// db.AllBusinesses solo lo usa el super admin que pide explícitamente todos los negocios.
 type IRoomRepository interface {
 	CreateRoom(ctx context.Context, room Room) (string, error)
-	GetRooms(ctx context.Context) ([]Room, error)
-	GetRoomsByBusinessID(ctx context.Context, businessID uint) ([]Room, error)
-	GetRoomByID(ctx context.Context, id uint) (*Room, error)
+	GetRooms(ctx context.Context, businessID uint) ([]Room, error)
+	GetRoomByID(ctx context.Context, businessID, id uint) (*Room, error)
 	GetRoomByCodeAndBusiness(ctx context.Context, code string, businessID uint) (*Room, error)
-	UpdateRoom(ctx context.Context, id uint, room Room) (string, error)
-	DeleteRoom(ctx context.Context, id uint) (string, error)
+	UpdateRoom(ctx context.Context, businessID, id uint, room Room) (string, error)
+	DeleteRoom(ctx context.Context, businessID, id uint) (string, error)
 }
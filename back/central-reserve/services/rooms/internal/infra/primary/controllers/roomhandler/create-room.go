@@ -1,6 +1,7 @@
 package roomhandler
 
 import (
+	"central_reserve/services/auth/middleware"
 	"central_reserve/services/rooms/internal/infra/primary/controllers/roomhandler/mapper"
 	"central_reserve/services/rooms/internal/infra/primary/controllers/roomhandler/request"
 	"net/http"
@@ -19,6 +20,7 @@ import (
 // @Success		201		{object}	map[string]interface{}	"Sala creada exitosamente"
 // @Failure		400		{object}	map[string]interface{}	"Solicitud inválida"
 // @Failure		401		{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403		{object}	map[string]interface{}	"Sin acceso al negocio indicado"
 // @Failure		409		{object}	map[string]interface{}	"Sala ya existe para este negocio"
 // @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
 // @Router			/rooms [post]
@@ -37,8 +39,15 @@ func (h *RoomHandler) CreateRoomHandler(c *gin.Context) {
 		return
 	}
 
+	businessID, err := middleware.RequireBusiness(c, req.BusinessID)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
 	// 2. DTO → Dominio ───────────────────────────────────────
 	room := mapper.RoomToDomain(req)
+	room.BusinessID = businessID
 
 	// 3. Caso de uso ─────────────────────────────────────────
 	response, err := h.usecase.CreateRoom(ctx, room)
@@ -1,9 +1,11 @@
 package roomhandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/rooms/internal/domain"
+	"errors"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -37,16 +39,23 @@ func (h *RoomHandler) DeleteRoomHandler(c *gin.Context) {
 		return
 	}
 
+	// Negocio
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
 	// Caso de uso
-	response, err := h.usecase.DeleteRoom(ctx, uint(id))
+	response, err := h.usecase.DeleteRoom(ctx, businessID, uint(id))
 	if err != nil {
-		// Manejar error de sala no encontrada
-		if strings.Contains(err.Error(), "no existe") {
+		// La sala no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrRoomNotFound) {
 			h.logger.Warn().Err(err).Uint("id", uint(id)).Msg("sala no encontrada")
 			c.JSON(http.StatusNotFound, gin.H{
 				"success": false,
 				"error":   "room_not_found",
-				"message": err.Error(),
+				"message": "Sala no encontrada",
 			})
 			return
 		}
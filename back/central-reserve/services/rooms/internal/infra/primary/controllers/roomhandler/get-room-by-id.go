@@ -1,6 +1,9 @@
 package roomhandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/rooms/internal/domain"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -36,9 +39,16 @@ func (h *RoomHandler) GetRoomByIDHandler(c *gin.Context) {
 		return
 	}
 
-	// Caso de uso
-	room, err := h.usecase.GetRoomByID(ctx, uint(id))
+	// Negocio
+	businessID, err := middleware.ResolveBusinessScope(c)
 	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// Caso de uso
+	room, err := h.usecase.GetRoomByID(ctx, businessID, uint(id))
+	if err != nil && !errors.Is(err, domain.ErrRoomNotFound) {
 		h.logger.Error().Err(err).Uint("id", uint(id)).Msg("error al obtener sala por ID")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -49,6 +59,7 @@ func (h *RoomHandler) GetRoomByIDHandler(c *gin.Context) {
 	}
 
 	if room == nil {
+		// La sala no existe o pertenece a otro negocio
 		c.JSON(http.StatusNotFound, gin.H{
 			"success": false,
 			"error":   "room_not_found",
@@ -1,27 +1,38 @@
 package roomhandler
 
 import (
+	"central_reserve/services/auth/middleware"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
 
-// @Summary		Obtiene todas las salas
-// @Description	Este endpoint permite obtener todas las salas del sistema.
+// @Summary		Obtiene las salas del negocio
+// @Description	Este endpoint permite obtener las salas del negocio del token. El super admin debe indicar el negocio con business_id, o business_id=all para todos.
 // @Tags			Salas
 // @Accept			json
 // @Produce		json
 // @Security		BearerAuth
+// @Param			business_id	query		string						false	"ID del negocio o all para todos (solo super admin, obligatorio para él)"
 // @Success		200	{object}	map[string]interface{}	"Lista de salas"
+// @Failure		400	{object}	map[string]interface{}	"Filtro de negocio inválido"
 // @Failure		401	{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403	{object}	map[string]interface{}	"Sin acceso al negocio indicado"
 // @Failure		500	{object}	map[string]interface{}	"Error interno del servidor"
 // @Router			/rooms [get]
 func (h *RoomHandler) GetRoomsHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 
+	// Negocio
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
 	// Caso de uso
-	rooms, err := h.usecase.GetRooms(ctx)
+	rooms, err := h.usecase.GetRooms(ctx, businessID)
 	if err != nil {
 		h.logger.Error().Err(err).Msg("error al obtener salas")
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -50,6 +61,7 @@ func (h *RoomHandler) GetRoomsHandler(c *gin.Context) {
 // @Success		200			{object}	map[string]interface{}	"Lista de salas del negocio"
 // @Failure		400			{object}	map[string]interface{}	"ID de negocio inválido"
 // @Failure		401			{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403			{object}	map[string]interface{}	"Sin acceso al negocio indicado"
 // @Failure		500			{object}	map[string]interface{}	"Error interno del servidor"
 // @Router			/business-rooms/{business_id} [get]
 func (h *RoomHandler) GetRoomsByBusinessHandler(c *gin.Context) {
@@ -68,10 +80,17 @@ func (h *RoomHandler) GetRoomsByBusinessHandler(c *gin.Context) {
 		return
 	}
 
+	// Un usuario de negocio solo puede consultar su propio negocio
+	scopedBusinessID, err := middleware.RequireBusiness(c, uint(businessID))
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
 	// Caso de uso
-	rooms, err := h.usecase.GetRoomsByBusinessID(ctx, uint(businessID))
+	rooms, err := h.usecase.GetRooms(ctx, scopedBusinessID)
 	if err != nil {
-		h.logger.Error().Err(err).Uint("businessID", uint(businessID)).Msg("error al obtener salas por negocio")
+		h.logger.Error().Err(err).Uint("businessID", scopedBusinessID).Msg("error al obtener salas por negocio")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"error":   "internal_error",
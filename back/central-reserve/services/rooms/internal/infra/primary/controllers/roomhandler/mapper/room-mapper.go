@@ -22,7 +22,6 @@ func RoomToDomain(r request.Room) domain.Room {
 // UpdateRoomToDomain convierte un request.UpdateRoom a entities.Room
 func UpdateRoomToDomain(r request.UpdateRoom) domain.Room {
 	return domain.Room{
-		BusinessID:  r.BusinessID,
 		Name:        r.Name,
 		Code:        r.Code,
 		Description: r.Description,
@@ -1,7 +1,8 @@
 package request
 
 type Room struct {
-	BusinessID  uint   `json:"business_id" binding:"required"`
+	// Solo lo indica el super admin; para el resto se usa el negocio del business token
+	BusinessID  uint   `json:"business_id"`
 	Name        string `json:"name" binding:"required"`
 	Code        string `json:"code" binding:"required"`
 	Description string `json:"description"`
@@ -1,7 +1,6 @@
 package request
 
 type UpdateRoom struct {
-	BusinessID  uint   `json:"business_id" binding:"required"`
 	Name        string `json:"name" binding:"required"`
 	Code        string `json:"code" binding:"required"`
 	Description string `json:"description"`
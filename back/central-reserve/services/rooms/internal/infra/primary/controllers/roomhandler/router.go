@@ -11,16 +11,24 @@ import (
 func RegisterRoutes(router *gin.RouterGroup, handler IRoomHandler, logger log.ILogger) {
 	rooms := router.Group("/rooms")
 
+	read := middleware.RequirePermission(middleware.ResourceRooms, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceRooms, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceRooms, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceRooms, middleware.ActionDelete)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourceRooms)
+
 	{
-		rooms.POST("", middleware.JWT(), handler.CreateRoomHandler)
-		rooms.GET("", middleware.JWT(), handler.GetRoomsHandler)
-		rooms.GET("/:id", middleware.JWT(), handler.GetRoomByIDHandler)
-		rooms.PUT("/:id", middleware.JWT(), handler.UpdateRoomHandler)
-		rooms.DELETE("/:id", middleware.JWT(), handler.DeleteRoomHandler)
+		rooms.POST("", middleware.JWT(), feature, create, handler.CreateRoomHandler)
+		rooms.GET("", middleware.JWT(), feature, read, handler.GetRoomsHandler)
+		rooms.GET("/:id", middleware.JWT(), feature, read, handler.GetRoomByIDHandler)
+		rooms.PUT("/:id", middleware.JWT(), feature, update, handler.UpdateRoomHandler)
+		rooms.DELETE("/:id", middleware.JWT(), feature, remove, handler.DeleteRoomHandler)
 	}
 
 	businessRooms := router.Group("/business-rooms")
 	{
-		businessRooms.GET("/:business_id", middleware.JWT(), handler.GetRoomsByBusinessHandler)
+		businessRooms.GET("/:business_id", middleware.JWT(), feature, read, handler.GetRoomsByBusinessHandler)
 	}
 }
@@ -1,8 +1,11 @@
 package roomhandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/rooms/internal/domain"
 	"central_reserve/services/rooms/internal/infra/primary/controllers/roomhandler/mapper"
 	"central_reserve/services/rooms/internal/infra/primary/controllers/roomhandler/request"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -41,6 +44,13 @@ func (h *RoomHandler) UpdateRoomHandler(c *gin.Context) {
 		return
 	}
 
+	// Negocio
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
 	// 1. Entrada ──────────────────────────────────────────────
 	var req request.UpdateRoom
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -57,15 +67,15 @@ func (h *RoomHandler) UpdateRoomHandler(c *gin.Context) {
 	room := mapper.UpdateRoomToDomain(req)
 
 	// 3. Caso de uso ─────────────────────────────────────────
-	response, err := h.usecase.UpdateRoom(ctx, uint(id), room)
+	response, err := h.usecase.UpdateRoom(ctx, businessID, uint(id), room)
 	if err != nil {
-		// Manejar error de sala no encontrada
-		if strings.Contains(err.Error(), "no existe") {
+		// La sala no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrRoomNotFound) {
 			h.logger.Warn().Err(err).Uint("id", uint(id)).Msg("sala no encontrada")
 			c.JSON(http.StatusNotFound, gin.H{
 				"success": false,
 				"error":   "room_not_found",
-				"message": err.Error(),
+				"message": "Sala no encontrada",
 			})
 			return
 		}
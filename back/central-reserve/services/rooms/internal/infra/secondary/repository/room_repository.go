@@ -5,7 +5,10 @@ import (
 	"central_reserve/shared/db"
 	"central_reserve/shared/log"
 	"context"
+	"errors"
 	"fmt"
+
+	"gorm.io/gorm"
 )
 
 type Repository struct {
@@ -29,31 +32,24 @@ func (r *Repository) CreateRoom(ctx context.Context, room domain.Room) (string,
 	return fmt.Sprintf("Sala creada con ID: %d", room.ID), nil
 }
 
-// GetRooms obtiene todas las salas
-func (r *Repository) GetRooms(ctx context.Context) ([]domain.Room, error) {
+// GetRooms obtiene las salas del negocio
+func (r *Repository) GetRooms(ctx context.Context, businessID uint) ([]domain.Room, error) {
 	var rooms []domain.Room
-	if err := r.database.Conn(ctx).Table("room").Find(&rooms).Error; err != nil {
-		r.logger.Error().Msg("Error al obtener salas")
+	if err := r.database.Conn(ctx).Table("room").Scopes(db.ByBusiness(businessID)).Find(&rooms).Error; err != nil {
+		r.logger.Error().Uint("businessID", businessID).Msg("Error al obtener salas")
 		return nil, err
 	}
 	return rooms, nil
 }
 
-// GetRoomsByBusinessID obtiene todas las salas de un negocio específico
-func (r *Repository) GetRoomsByBusinessID(ctx context.Context, businessID uint) ([]domain.Room, error) {
-	var rooms []domain.Room
-	if err := r.database.Conn(ctx).Table("room").Where("business_id = ?", businessID).Find(&rooms).Error; err != nil {
-		r.logger.Error().Uint("businessID", businessID).Msg("Error al obtener salas por negocio")
-		return nil, err
-	}
-	return rooms, nil
-}
-
-// GetRoomByID obtiene una sala por su ID
-func (r *Repository) GetRoomByID(ctx context.Context, id uint) (*domain.Room, error) {
+// GetRoomByID obtiene una sala por su ID dentro del negocio
+func (r *Repository) GetRoomByID(ctx context.Context, businessID, id uint) (*domain.Room, error) {
 	var room domain.Room
-	if err := r.database.Conn(ctx).Table("room").Where("id = ?", id).First(&room).Error; err != nil {
-		r.logger.Error().Uint("id", id).Msg("Error al obtener sala por ID")
+	if err := r.database.Conn(ctx).Table("room").Scopes(db.ByBusiness(businessID)).Where("id = ?", id).First(&room).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrRoomNotFound
+		}
+		r.logger.Error().Uint("id", id).Uint("businessID", businessID).Msg("Error al obtener sala por ID")
 		return nil, err
 	}
 	return &room, nil
@@ -69,20 +65,28 @@ func (r *Repository) GetRoomByCodeAndBusiness(ctx context.Context, code string,
 	return &room, nil
 }
 
-// UpdateRoom actualiza una sala existente
-func (r *Repository) UpdateRoom(ctx context.Context, id uint, room domain.Room) (string, error) {
-	if err := r.database.Conn(ctx).Table("room").Where("id = ?", id).Updates(&room).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al actualizar sala")
-		return "", err
+// UpdateRoom actualiza una sala existente del negocio
+func (r *Repository) UpdateRoom(ctx context.Context, businessID, id uint, room domain.Room) (string, error) {
+	result := r.database.Conn(ctx).Table("room").Scopes(db.ByBusiness(businessID)).Where("id = ?", id).Updates(&room)
+	if result.Error != nil {
+		r.logger.Error().Uint("id", id).Err(result.Error).Msg("Error al actualizar sala")
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", domain.ErrRoomNotFound
 	}
 	return fmt.Sprintf("Sala actualizada con ID: %d", id), nil
 }
 
-// DeleteRoom elimina una sala
-func (r *Repository) DeleteRoom(ctx context.Context, id uint) (string, error) {
-	if err := r.database.Conn(ctx).Table("room").Where("id = ?", id).Delete(&domain.Room{}).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al eliminar sala")
-		return "", err
+// DeleteRoom elimina una sala del negocio
+func (r *Repository) DeleteRoom(ctx context.Context, businessID, id uint) (string, error) {
+	result := r.database.Conn(ctx).Table("room").Scopes(db.ByBusiness(businessID)).Where("id = ?", id).Delete(&domain.Room{})
+	if result.Error != nil {
+		r.logger.Error().Uint("id", id).Err(result.Error).Msg("Error al eliminar sala")
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", domain.ErrRoomNotFound
 	}
 	return fmt.Sprintf("Sala eliminada con ID: %d", id), nil
 }
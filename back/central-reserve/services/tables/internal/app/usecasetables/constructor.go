@@ -8,10 +8,10 @@ import (
 
 type IUseCaseTable interface {
 	CreateTable(ctx context.Context, table domain.Table) (string, error)
-	GetTables(ctx context.Context) ([]domain.Table, error)
-	GetTableByID(ctx context.Context, id uint) (*domain.Table, error)
-	UpdateTable(ctx context.Context, id uint, table domain.Table) (string, error)
-	DeleteTable(ctx context.Context, id uint) (string, error)
+	GetTables(ctx context.Context, businessID uint) ([]domain.Table, error)
+	GetTableByID(ctx context.Context, businessID, id uint) (*domain.Table, error)
+	UpdateTable(ctx context.Context, businessID, id uint, table domain.Table) (string, error)
+	DeleteTable(ctx context.Context, businessID, id uint) (string, error)
 }
 
 type TableUseCase struct {
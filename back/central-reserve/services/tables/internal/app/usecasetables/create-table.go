@@ -2,6 +2,7 @@ package usecasetables
 
 import (
 	"central_reserve/services/tables/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
@@ -9,6 +10,10 @@ import (
 // CreateTable crea una nueva mesa
 func (u *TableUseCase) CreateTable(ctx context.Context, table domain.Table) (string, error) {
 	// Validar que la mesa tenga los campos requeridos
+	if table.BusinessID == 0 {
+		return "", fmt.Errorf("el ID del negocio es requerido")
+	}
+
 	if table.Number == 0 {
 		return "", fmt.Errorf("el número de mesa es requerido")
 	}
@@ -18,10 +23,18 @@ func (u *TableUseCase) CreateTable(ctx context.Context, table domain.Table) (str
 	}
 
 	// Crear la mesa
-	result, err := u.repository.CreateTable(ctx, table)
+	created, err := u.repository.CreateTable(ctx, table)
 	if err != nil {
 		return "", fmt.Errorf("error al crear mesa: %w", err)
 	}
 
-	return result, nil
+	audit.Log(ctx, audit.Entry{
+		BusinessID: created.BusinessID,
+		EntityType: "table",
+		EntityID:   created.ID,
+		Action:     audit.ActionCreate,
+		After:      created,
+	})
+
+	return fmt.Sprintf("Mesa creada con ID: %d", created.ID), nil
 }
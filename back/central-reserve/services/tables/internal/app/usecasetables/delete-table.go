@@ -1,13 +1,27 @@
 package usecasetables
 
 import (
+	"central_reserve/shared/audit"
 	"context"
 )
 
-func (u *TableUseCase) DeleteTable(ctx context.Context, id uint) (string, error) {
-	response, err := u.repository.DeleteTable(ctx, id)
+func (u *TableUseCase) DeleteTable(ctx context.Context, businessID, id uint) (string, error) {
+	before, err := u.repository.GetTableByID(ctx, businessID, id)
 	if err != nil {
 		return "", err
 	}
+
+	response, err := u.repository.DeleteTable(ctx, businessID, id)
+	if err != nil {
+		return "", err
+	}
+
+	audit.Log(ctx, audit.Entry{
+		BusinessID: before.BusinessID,
+		EntityType: "table",
+		EntityID:   id,
+		Action:     audit.ActionDelete,
+		Before:     before,
+	})
 	return response, nil
 }
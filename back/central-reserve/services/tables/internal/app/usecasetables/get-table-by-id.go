@@ -6,9 +6,9 @@ import (
 	"fmt"
 )
 
-// GetTableByID obtiene una mesa por su ID
-func (u *TableUseCase) GetTableByID(ctx context.Context, id uint) (*domain.Table, error) {
-	table, err := u.repository.GetTableByID(ctx, id)
+// GetTableByID obtiene una mesa por su ID dentro del negocio
+func (u *TableUseCase) GetTableByID(ctx context.Context, businessID, id uint) (*domain.Table, error) {
+	table, err := u.repository.GetTableByID(ctx, businessID, id)
 	if err != nil {
 		return nil, fmt.Errorf("error al obtener mesa: %w", err)
 	}
@@ -5,9 +5,9 @@ import (
 	"context"
 )
 
-// GetTables obtiene todas las mesas
-func (u *TableUseCase) GetTables(ctx context.Context) ([]domain.Table, error) {
-	tables, err := u.repository.GetTables(ctx)
+// GetTables obtiene las mesas del negocio
+func (u *TableUseCase) GetTables(ctx context.Context, businessID uint) ([]domain.Table, error) {
+	tables, err := u.repository.GetTables(ctx, businessID)
 	if err != nil {
 		return nil, err
 	}
@@ -2,27 +2,40 @@ package usecasetables
 
 import (
 	"central_reserve/services/tables/internal/domain"
+	"central_reserve/shared/audit"
 	"context"
 	"fmt"
 )
 
 // UpdateTable actualiza una mesa existente
-func (u *TableUseCase) UpdateTable(ctx context.Context, id uint, table domain.Table) (string, error) {
-	// Verificar que la mesa existe
-	existingTable, err := u.repository.GetTableByID(ctx, id)
+func (u *TableUseCase) UpdateTable(ctx context.Context, businessID, id uint, table domain.Table) (string, error) {
+	// Verificar que la mesa existe en el negocio
+	before, err := u.repository.GetTableByID(ctx, businessID, id)
 	if err != nil {
-		return "", fmt.Errorf("mesa no encontrada: %w", err)
+		return "", fmt.Errorf("error al verificar mesa: %w", err)
 	}
 
-	if existingTable == nil {
-		return "", fmt.Errorf("mesa no encontrada")
-	}
+	// Una mesa no puede cambiar de negocio: con valor cero Updates no modifica business_id
+	table.BusinessID = 0
 
 	// Actualizar la mesa
-	result, err := u.repository.UpdateTable(ctx, id, table)
+	result, err := u.repository.UpdateTable(ctx, businessID, id, table)
 	if err != nil {
 		return "", fmt.Errorf("error al actualizar mesa: %w", err)
 	}
 
+	after, err := u.repository.GetTableByID(ctx, businessID, id)
+	if err != nil {
+		return "", fmt.Errorf("error al obtener mesa actualizada: %w", err)
+	}
+	audit.Log(ctx, audit.Entry{
+		BusinessID: before.BusinessID,
+		EntityType: "table",
+		EntityID:   id,
+		Action:     audit.ActionUpdate,
+		Before:     before,
+		After:      after,
+	})
+
 	return result, nil
 }
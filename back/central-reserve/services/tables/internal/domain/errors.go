@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+var (
+	ErrTableNotFound = errors.New("mesa no encontrada")
+)
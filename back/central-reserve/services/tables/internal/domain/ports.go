@@ -2,11 +2,12 @@ package domain
 
 import "context"
 
-// ITableRepository define las operaciones para mesas
+// ITableRepository define las operaciones para mesas. Todas se acotan al negocio indicado;
+// db.AllBusinesses solo lo usa el super admin que pide explícitamente todos los negocios.
 type ITableRepository interface {
-	CreateTable(ctx context.Context, table Table) (string, error)
-	GetTables(ctx context.Context) ([]Table, error)
-	GetTableByID(ctx context.Context, id uint) (*Table, error)
-	UpdateTable(ctx context.Context, id uint, table Table) (string, error)
-	DeleteTable(ctx context.Context, id uint) (string, error)
+	CreateTable(ctx context.Context, table Table) (*Table, error)
+	GetTables(ctx context.Context, businessID uint) ([]Table, error)
+	GetTableByID(ctx context.Context, businessID, id uint) (*Table, error)
+	UpdateTable(ctx context.Context, businessID, id uint, table Table) (string, error)
+	DeleteTable(ctx context.Context, businessID, id uint) (string, error)
 }
@@ -1,6 +1,7 @@
 package tablehandler
 
 import (
+	"central_reserve/services/auth/middleware"
 	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/mapper"
 	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/request"
 	"net/http"
@@ -19,6 +20,7 @@ import (
 // @Success		201		{object}	map[string]interface{}	"Mesa creada exitosamente"
 // @Failure		400		{object}	map[string]interface{}	"Solicitud inválida"
 // @Failure		401		{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403		{object}	map[string]interface{}	"Sin acceso al negocio indicado"
 // @Failure		409		{object}	map[string]interface{}	"Mesa ya existe para este restaurante"
 // @Failure		500		{object}	map[string]interface{}	"Error interno del servidor"
 // @Router			/tables [post]
@@ -34,11 +36,18 @@ func (h *TableHandler) CreateTableHandler(c *gin.Context) {
 		return
 	}
 
+	businessID, err := middleware.RequireBusiness(c, req.BusinessID)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
 	// 2. DTO → Dominio ───────────────────────────────────────
 	table := mapper.TableToDomain(req)
+	table.BusinessID = businessID
 
 	// 3. Caso de uso ─────────────────────────────────────────
-	_, err := h.usecase.CreateTable(ctx, table)
+	_, err = h.usecase.CreateTable(ctx, table)
 	if err != nil {
 		// Manejar error de mesa duplicada
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
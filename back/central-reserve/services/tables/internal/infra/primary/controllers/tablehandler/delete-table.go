@@ -1,7 +1,10 @@
 package tablehandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/tables/internal/domain"
 	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/mapper"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -34,19 +37,26 @@ func (h *TableHandler) DeleteTableHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Caso de uso ─────────────────────────────────────────
-	response, err := h.usecase.DeleteTable(ctx, uint(tableID))
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("error interno al eliminar mesa")
-		errorResponse := mapper.BuildErrorResponse("internal_error", "No se pudo eliminar la mesa")
-		c.JSON(http.StatusInternalServerError, errorResponse)
+		respondBusinessScopeError(c, err)
 		return
 	}
 
-	// 3. Verificar si la mesa existía ───────────────────────
-	if response == "" {
-		errorResponse := mapper.BuildErrorResponse("not_found", "Mesa no encontrada")
-		c.JSON(http.StatusNotFound, errorResponse)
+	// 3. Caso de uso ─────────────────────────────────────────
+	_, err = h.usecase.DeleteTable(ctx, businessID, uint(tableID))
+	if err != nil {
+		// La mesa no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrTableNotFound) {
+			errorResponse := mapper.BuildErrorResponse("not_found", "Mesa no encontrada")
+			c.JSON(http.StatusNotFound, errorResponse)
+			return
+		}
+
+		h.logger.Error().Err(err).Msg("error interno al eliminar mesa")
+		errorResponse := mapper.BuildErrorResponse("internal_error", "No se pudo eliminar la mesa")
+		c.JSON(http.StatusInternalServerError, errorResponse)
 		return
 	}
 
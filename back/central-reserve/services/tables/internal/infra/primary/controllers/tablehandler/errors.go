@@ -0,0 +1,13 @@
+package tablehandler
+
+import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/mapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondBusinessScopeError responde el error al acotar la request al negocio del token
+func respondBusinessScopeError(c *gin.Context, err error) {
+	c.JSON(middleware.BusinessScopeStatus(err), mapper.BuildErrorResponse("invalid_business_scope", err.Error()))
+}
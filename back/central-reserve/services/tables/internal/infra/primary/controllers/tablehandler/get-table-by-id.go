@@ -1,7 +1,10 @@
 package tablehandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/tables/internal/domain"
 	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/mapper"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -34,11 +37,18 @@ func (h *TableHandler) GetTableByIDHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Caso de uso ─────────────────────────────────────────
-	table, err := h.usecase.GetTableByID(ctx, uint(tableID))
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
 	if err != nil {
-		// Si es error de "record not found", retornar 404
-		if err.Error() == "record not found" {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Caso de uso ─────────────────────────────────────────
+	table, err := h.usecase.GetTableByID(ctx, businessID, uint(tableID))
+	if err != nil {
+		// La mesa no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrTableNotFound) {
 			errorResponse := mapper.BuildErrorResponse("not_found", "Mesa no encontrada")
 			c.JSON(http.StatusNotFound, errorResponse)
 			return
@@ -50,7 +60,7 @@ func (h *TableHandler) GetTableByIDHandler(c *gin.Context) {
 		return
 	}
 
-	// 3. Salida ──────────────────────────────────────────────
+	// 4. Salida ──────────────────────────────────────────────
 	response := mapper.BuildGetTablePtrResponse(table, "Mesa obtenida exitosamente")
 	c.JSON(http.StatusOK, response)
 }
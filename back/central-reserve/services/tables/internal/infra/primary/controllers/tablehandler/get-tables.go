@@ -1,27 +1,38 @@
 package tablehandler
 
 import (
+	"central_reserve/services/auth/middleware"
 	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/mapper"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
-// @Summary		Obtiene todas las mesas
-// @Description	Este endpoint permite obtener la lista de todas las mesas registradas.
+// @Summary		Obtiene las mesas del negocio
+// @Description	Este endpoint permite obtener las mesas del negocio del token. El super admin debe indicar el negocio con business_id, o business_id=all para todos.
 // @Tags			Mesas
 // @Accept			json
 // @Produce		json
 // @Security		BearerAuth
+// @Param			business_id	query		string						false	"ID del negocio o all para todos (solo super admin, obligatorio para él)"
 // @Success		200	{object}	map[string]interface{}	"Lista de mesas obtenida exitosamente"
+// @Failure		400	{object}	map[string]interface{}	"Filtro de negocio inválido"
 // @Failure		401	{object}	map[string]interface{}	"Token de acceso requerido"
+// @Failure		403	{object}	map[string]interface{}	"Sin acceso al negocio indicado"
 // @Failure		500	{object}	map[string]interface{}	"Error interno del servidor"
 // @Router			/tables [get]
 func (h *TableHandler) GetTablesHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	// 1. Caso de uso ─────────────────────────────────────────
-	tables, err := h.usecase.GetTables(ctx)
+	// 1. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 2. Caso de uso ─────────────────────────────────────────
+	tables, err := h.usecase.GetTables(ctx, businessID)
 	if err != nil {
 		h.logger.Error().Err(err).Msg("error interno al obtener mesas")
 		errorResponse := mapper.BuildErrorResponse("internal_error", "No se pudieron obtener las mesas")
@@ -29,7 +40,7 @@ func (h *TableHandler) GetTablesHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Salida ──────────────────────────────────────────────
+	// 3. Salida ──────────────────────────────────────────────
 	response := mapper.BuildGetTablesResponse(tables, "Mesas obtenidas exitosamente")
 	c.JSON(http.StatusOK, response)
 }
@@ -9,9 +9,6 @@ import (
 func UpdateTableToDomain(u request.UpdateTable) domain.Table {
 	table := domain.Table{}
 
-	if u.BusinessID != nil {
-		table.BusinessID = *u.BusinessID
-	}
 	if u.Number != nil {
 		table.Number = *u.Number
 	}
@@ -1,7 +1,8 @@
 package request
 
 type Table struct {
-	BusinessID uint `json:"business_id" binding:"required"`
+	// Solo lo indica el super admin; para el resto se usa el negocio del business token
+	BusinessID uint `json:"business_id"`
 	Number     int  `json:"number" binding:"required"`
 	Capacity   int  `json:"capacity" binding:"required"`
 }
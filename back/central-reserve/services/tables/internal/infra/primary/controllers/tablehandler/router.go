@@ -11,11 +11,19 @@ import (
 func RegisterRoutes(v1Group *gin.RouterGroup, handler ITableHandler, logger log.ILogger) {
 	tables := v1Group.Group("/tables")
 
+	read := middleware.RequirePermission(middleware.ResourceTables, middleware.ActionRead)
+	create := middleware.RequirePermission(middleware.ResourceTables, middleware.ActionCreate)
+	update := middleware.RequirePermission(middleware.ResourceTables, middleware.ActionUpdate)
+	remove := middleware.RequirePermission(middleware.ResourceTables, middleware.ActionDelete)
+
+	// El negocio debe tener el módulo habilitado
+	feature := middleware.RequireFeature(middleware.ResourceTables)
+
 	{
-		tables.GET("", middleware.JWT(), handler.GetTablesHandler)
-		tables.GET("/:id", middleware.JWT(), handler.GetTableByIDHandler)
-		tables.POST("", middleware.JWT(), handler.CreateTableHandler)
-		tables.PUT("/:id", middleware.JWT(), handler.UpdateTableHandler)
-		tables.DELETE("/:id", middleware.JWT(), handler.DeleteTableHandler)
+		tables.GET("", middleware.JWT(), feature, read, handler.GetTablesHandler)
+		tables.GET("/:id", middleware.JWT(), feature, read, handler.GetTableByIDHandler)
+		tables.POST("", middleware.JWT(), feature, create, handler.CreateTableHandler)
+		tables.PUT("/:id", middleware.JWT(), feature, update, handler.UpdateTableHandler)
+		tables.DELETE("/:id", middleware.JWT(), feature, remove, handler.DeleteTableHandler)
 	}
 }
@@ -1,8 +1,11 @@
 package tablehandler
 
 import (
+	"central_reserve/services/auth/middleware"
+	"central_reserve/services/tables/internal/domain"
 	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/mapper"
 	"central_reserve/services/tables/internal/infra/primary/controllers/tablehandler/request"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -36,7 +39,14 @@ func (h *TableHandler) UpdateTableHandler(c *gin.Context) {
 		return
 	}
 
-	// 2. Entrada ──────────────────────────────────────────────
+	// 2. Negocio ───────────────────────────────────────────
+	businessID, err := middleware.ResolveBusinessScope(c)
+	if err != nil {
+		respondBusinessScopeError(c, err)
+		return
+	}
+
+	// 3. Entrada ──────────────────────────────────────────────
 	var req request.UpdateTable
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error().Err(err).Msg("error al bindear JSON de actualización de mesa")
@@ -45,25 +55,25 @@ func (h *TableHandler) UpdateTableHandler(c *gin.Context) {
 		return
 	}
 
-	// 3. DTO → Dominio ───────────────────────────────────────
+	// 4. DTO → Dominio ───────────────────────────────────────
 	updateData := mapper.UpdateTableToDomain(req)
 
-	// 4. Caso de uso ─────────────────────────────────────────
-	response, err := h.usecase.UpdateTable(ctx, uint(tableID), updateData)
+	// 5. Caso de uso ─────────────────────────────────────────
+	_, err = h.usecase.UpdateTable(ctx, businessID, uint(tableID), updateData)
 	if err != nil {
+		// La mesa no existe o pertenece a otro negocio
+		if errors.Is(err, domain.ErrTableNotFound) {
+			errorResponse := mapper.BuildErrorResponse("not_found", "Mesa no encontrada")
+			c.JSON(http.StatusNotFound, errorResponse)
+			return
+		}
+
 		h.logger.Error().Err(err).Msg("error interno al actualizar mesa")
 		errorResponse := mapper.BuildErrorResponse("internal_error", "No se pudo actualizar la mesa")
 		c.JSON(http.StatusInternalServerError, errorResponse)
 		return
 	}
 
-	// 5. Verificar si la mesa existía ───────────────────────
-	if response == "" {
-		errorResponse := mapper.BuildErrorResponse("not_found", "Mesa no encontrada")
-		c.JSON(http.StatusNotFound, errorResponse)
-		return
-	}
-
 	// 6. Salida ──────────────────────────────────────────────
 	responseDTO := mapper.BuildUpdateTableStringResponse("Mesa actualizada exitosamente")
 	c.JSON(http.StatusOK, responseDTO)
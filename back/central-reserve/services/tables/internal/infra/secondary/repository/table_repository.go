@@ -7,7 +7,10 @@ import (
 	"central_reserve/shared/log"
 	"context"
 	"dbpostgres/app/infra/models"
+	"errors"
 	"fmt"
+
+	"gorm.io/gorm"
 )
 
 type Repository struct {
@@ -23,22 +26,23 @@ func New(db db.IDatabase, logger log.ILogger) domain.ITableRepository {
 }
 
 // CreateTable crea una nueva mesa
-func (r *Repository) CreateTable(ctx context.Context, table domain.Table) (string, error) {
+func (r *Repository) CreateTable(ctx context.Context, table domain.Table) (*domain.Table, error) {
 	tableModel := mappers.CreateTableModel(table)
 
 	if err := r.database.Conn(ctx).Model(&models.Table{}).Create(&tableModel).Error; err != nil {
 		r.logger.Error().Err(err).Msg("Error al crear mesa")
-		return "", err
+		return nil, err
 	}
 
-	return fmt.Sprintf("Mesa creada con ID: %d", tableModel.ID), nil
+	created := mappers.ToTableEntity(tableModel)
+	return &created, nil
 }
 
-// GetTables obtiene todas las mesas
-func (r *Repository) GetTables(ctx context.Context) ([]domain.Table, error) {
+// GetTables obtiene las mesas del negocio
+func (r *Repository) GetTables(ctx context.Context, businessID uint) ([]domain.Table, error) {
 	var dbTables []models.Table
-	if err := r.database.Conn(ctx).Model(&models.Table{}).Find(&dbTables).Error; err != nil {
-		r.logger.Error().Msg("Error al obtener mesas")
+	if err := r.database.Conn(ctx).Model(&models.Table{}).Scopes(db.ByBusiness(businessID)).Find(&dbTables).Error; err != nil {
+		r.logger.Error().Uint("business_id", businessID).Msg("Error al obtener mesas")
 		return nil, err
 	}
 
@@ -46,11 +50,14 @@ func (r *Repository) GetTables(ctx context.Context) ([]domain.Table, error) {
 	return tables, nil
 }
 
-// GetTableByID obtiene una mesa por su ID
-func (r *Repository) GetTableByID(ctx context.Context, id uint) (*domain.Table, error) {
+// GetTableByID obtiene una mesa por su ID dentro del negocio
+func (r *Repository) GetTableByID(ctx context.Context, businessID, id uint) (*domain.Table, error) {
 	var dbTable models.Table
-	if err := r.database.Conn(ctx).Model(&models.Table{}).Where("id = ?", id).First(&dbTable).Error; err != nil {
-		r.logger.Error().Uint("id", id).Msg("Error al obtener mesa por ID")
+	if err := r.database.Conn(ctx).Model(&models.Table{}).Scopes(db.ByBusiness(businessID)).Where("id = ?", id).First(&dbTable).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTableNotFound
+		}
+		r.logger.Error().Uint("id", id).Uint("business_id", businessID).Msg("Error al obtener mesa por ID")
 		return nil, err
 	}
 
@@ -58,23 +65,31 @@ func (r *Repository) GetTableByID(ctx context.Context, id uint) (*domain.Table,
 	return &table, nil
 }
 
-// UpdateTable actualiza una mesa existente
-func (r *Repository) UpdateTable(ctx context.Context, id uint, table domain.Table) (string, error) {
+// UpdateTable actualiza una mesa existente del negocio
+func (r *Repository) UpdateTable(ctx context.Context, businessID, id uint, table domain.Table) (string, error) {
 	tableModel := mappers.CreateTableModel(table)
 
-	if err := r.database.Conn(ctx).Model(&models.Table{}).Where("id = ?", id).Updates(&tableModel).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al actualizar mesa")
-		return "", err
+	result := r.database.Conn(ctx).Model(&models.Table{}).Scopes(db.ByBusiness(businessID)).Where("id = ?", id).Updates(&tableModel)
+	if result.Error != nil {
+		r.logger.Error().Uint("id", id).Err(result.Error).Msg("Error al actualizar mesa")
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", domain.ErrTableNotFound
 	}
 
 	return fmt.Sprintf("Mesa actualizada con ID: %d", id), nil
 }
 
-// DeleteTable elimina una mesa
-func (r *Repository) DeleteTable(ctx context.Context, id uint) (string, error) {
-	if err := r.database.Conn(ctx).Model(&models.Table{}).Where("id = ?", id).Delete(&models.Table{}).Error; err != nil {
-		r.logger.Error().Uint("id", id).Err(err).Msg("Error al eliminar mesa")
-		return "", err
+// DeleteTable elimina una mesa del negocio
+func (r *Repository) DeleteTable(ctx context.Context, businessID, id uint) (string, error) {
+	result := r.database.Conn(ctx).Scopes(db.ByBusiness(businessID)).Where("id = ?", id).Delete(&models.Table{})
+	if result.Error != nil {
+		r.logger.Error().Uint("id", id).Err(result.Error).Msg("Error al eliminar mesa")
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", domain.ErrTableNotFound
 	}
 
 	return fmt.Sprintf("Mesa eliminada con ID: %d", id), nil
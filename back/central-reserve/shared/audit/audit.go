@@ -0,0 +1,118 @@
+// Package audit registra las mutaciones administrativas (quién cambió qué, en qué negocio y cómo)
+// en un log de solo inserción. El middleware de autenticación deja el actor en el contexto de la
+// petición y los casos de uso llaman a Log después de cada cambio; el servicio de auditoría
+// registra el Writer que persiste los registros.
+package audit
+
+import (
+	"central_reserve/shared/log"
+	"context"
+	"time"
+)
+
+// Acciones registradas
+const (
+	ActionCreate     = "create"
+	ActionUpdate     = "update"
+	ActionDelete     = "delete"
+	ActionActivate   = "activate"
+	ActionDeactivate = "deactivate"
+	ActionAssign     = "assign"
+	ActionRevoke     = "revoke"
+	ActionImport     = "import"
+)
+
+// Actor es quien ejecuta la petición
+type Actor struct {
+	UserID     uint
+	APIKeyID   uint // 0 si la petición se autenticó con JWT
+	BusinessID uint // 0 para el super admin
+	IPAddress  string
+}
+
+// Entry describe una mutación desde el caso de uso. Before y After son el estado de la entidad
+// antes y después del cambio (nil en altas y bajas respectivamente).
+type Entry struct {
+	BusinessID uint // Negocio afectado; 0 toma el negocio del actor
+	EntityType string
+	EntityID   uint
+	Action     string
+	Before     any
+	After      any
+}
+
+// Record es el registro persistido
+type Record struct {
+	CreatedAt  time.Time
+	BusinessID uint
+	UserID     uint
+	APIKeyID   uint
+	IPAddress  string
+	EntityType string
+	EntityID   uint
+	Action     string
+	Changes    Changes
+}
+
+// Writer persiste los registros de auditoría
+type Writer interface {
+	Append(ctx context.Context, record Record) error
+}
+
+var (
+	defaultWriter Writer
+	defaultLogger log.ILogger
+)
+
+// Configure registra el writer usado por Log
+func Configure(writer Writer, logger log.ILogger) {
+	defaultWriter = writer
+	defaultLogger = logger
+}
+
+type actorKey struct{}
+
+// WithActor agrega el actor al contexto
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromCtx obtiene el actor del contexto
+func ActorFromCtx(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
+
+// Log registra la mutación con el actor del contexto. La auditoría no debe tumbar una operación
+// que ya se aplicó, por lo que un fallo al persistir solo se informa en el log.
+func Log(ctx context.Context, entry Entry) {
+	if defaultWriter == nil {
+		return
+	}
+
+	actor, _ := ActorFromCtx(ctx)
+	businessID := entry.BusinessID
+	if businessID == 0 {
+		businessID = actor.BusinessID
+	}
+
+	record := Record{
+		CreatedAt:  time.Now(),
+		BusinessID: businessID,
+		UserID:     actor.UserID,
+		APIKeyID:   actor.APIKeyID,
+		IPAddress:  actor.IPAddress,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Action:     entry.Action,
+		Changes:    Diff(entry.Before, entry.After),
+	}
+
+	if err := defaultWriter.Append(ctx, record); err != nil && defaultLogger != nil {
+		defaultLogger.Error(ctx).Err(err).
+			Str("entity_type", entry.EntityType).
+			Uint("entity_id", entry.EntityID).
+			Str("action", entry.Action).
+			Msg("Error al registrar auditoría")
+	}
+}
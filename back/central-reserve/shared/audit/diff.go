@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Change es el valor de un campo antes y después de la mutación
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Changes son los campos modificados, por nombre
+type Changes map[string]Change
+
+// Redacted reemplaza los valores de campos sensibles
+const Redacted = "[redactado]"
+
+// ignoredFields son marcas de tiempo que cambian en cada mutación y no aportan al diff
+var ignoredFields = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"deleted_at": true,
+}
+
+// sensitiveMarkers identifican campos cuyo valor nunca se guarda (contraseñas, hashes, secretos)
+var sensitiveMarkers = []string{"password", "secret", "hash", "token", "api_key"}
+
+// Diff compara dos estados de una entidad campo a campo. Ambos se serializan a JSON, por lo que
+// sirven tanto structs como mapas; nil representa una entidad inexistente. Los nombres de campo
+// se normalizan a snake_case (BusinessID -> business_id).
+func Diff(before, after any) Changes {
+	beforeFields := toFields(before)
+	afterFields := toFields(after)
+
+	changes := Changes{}
+	for name, value := range beforeFields {
+		if ignoredFields[name] {
+			continue
+		}
+		newValue, exists := afterFields[name]
+		if exists && reflect.DeepEqual(value, newValue) {
+			continue
+		}
+		changes[name] = redact(name, Change{Before: value, After: newValue})
+	}
+	for name, value := range afterFields {
+		if ignoredFields[name] {
+			continue
+		}
+		if _, exists := beforeFields[name]; exists {
+			continue
+		}
+		changes[name] = redact(name, Change{After: value})
+	}
+	return changes
+}
+
+func toFields(state any) map[string]any {
+	if state == nil {
+		return nil
+	}
+	if v := reflect.ValueOf(state); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil
+	}
+
+	fields := make(map[string]any, len(decoded))
+	for name, value := range decoded {
+		fields[snakeCase(name)] = value
+	}
+	return fields
+}
+
+// snakeCase convierte nombres de campo Go a snake_case respetando siglas: BusinessID -> business_id,
+// APIKeyID -> api_key_id. Los nombres que ya vienen en snake_case no cambian.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+					b.WriteRune('_')
+				}
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func redact(name string, change Change) Change {
+	for _, marker := range sensitiveMarkers {
+		if strings.Contains(name, marker) {
+			if change.Before != nil {
+				change.Before = Redacted
+			}
+			if change.After != nil {
+				change.After = Redacted
+			}
+			return change
+		}
+	}
+	return change
+}
@@ -0,0 +1,18 @@
+package db
+
+import "gorm.io/gorm"
+
+// AllBusinesses es el negocio con que el super admin pide explícitamente las filas de todos los
+// negocios (?business_id=all). Ningún otro valor deja de filtrar.
+const AllBusinesses = ^uint(0)
+
+// ByBusiness restringe la consulta a las filas del negocio indicado. Solo AllBusinesses no filtra;
+// un businessID 0 no coincide con ninguna fila.
+func ByBusiness(businessID uint) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if businessID == AllBusinesses {
+			return tx
+		}
+		return tx.Where("business_id = ?", businessID)
+	}
+}
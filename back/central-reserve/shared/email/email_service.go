@@ -13,6 +13,7 @@ import (
 // Interfaz genérica de envío de correo
 type IEmailService interface {
 	SendHTML(ctx context.Context, to, subject, html string) error
+	Send(ctx context.Context, msg Message) error
 }
 
 type EmailService struct {
@@ -28,10 +29,17 @@ func New(config env.IConfig, logger log.ILogger) IEmailService {
 }
 
 func (e *EmailService) SendHTML(ctx context.Context, to, subject, html string) error {
-	return e.sendEmail(ctx, to, subject, html)
+	return e.sendEmail(ctx, Message{To: to, Subject: subject, HTML: html})
 }
 
-func (e *EmailService) sendEmail(ctx context.Context, to, subject, body string) error {
+// Send envía un mensaje con su alternativa en texto plano
+func (e *EmailService) Send(ctx context.Context, msg Message) error {
+	return e.sendEmail(ctx, msg)
+}
+
+func (e *EmailService) sendEmail(ctx context.Context, msg Message) error {
+	to, subject := msg.To, msg.Subject
+
 	// Configuración SMTP
 	smtpHost := e.config.Get("SMTP_HOST")
 	smtpPort := e.config.Get("SMTP_PORT")
@@ -50,13 +58,16 @@ func (e *EmailService) sendEmail(ctx context.Context, to, subject, body string)
 	addr := smtpHost + ":" + smtpPort
 
 	// Crear el mensaje
-	message := e.buildMessage(fromEmail, to, subject, body)
+	message, err := e.buildMessage(fromEmail, msg)
+	if err != nil {
+		e.logger.Error().Err(err).Str("to", to).Msg("Error construyendo email")
+		return err
+	}
 
 	// Configurar autenticación
 	auth := smtp.PlainAuth("", smtpUser, smtpPass, smtpHost)
 
 	// Enviar según el método de seguridad
-	var err error
 	if useTLS {
 		err = e.sendWithTLS(addr, auth, fromEmail, []string{to}, message)
 	} else if useSTARTTLS {
@@ -88,24 +99,6 @@ func (e *EmailService) sendEmail(ctx context.Context, to, subject, body string)
 	return nil
 }
 
-func (e *EmailService) buildMessage(from, to, subject, body string) []byte {
-	headers := make(map[string]string)
-	headers["From"] = from
-	headers["To"] = to
-	headers["Subject"] = subject
-	headers["MIME-Version"] = "1.0"
-	headers["Content-Type"] = "text/html; charset=UTF-8"
-	headers["Content-Transfer-Encoding"] = "quoted-printable"
-
-	message := ""
-	for k, v := range headers {
-		message += fmt.Sprintf("%s: %s\r\n", k, v)
-	}
-	message += "\r\n" + body
-
-	return []byte(message)
-}
-
 func (e *EmailService) sendWithTLS(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
 	// Crear configuración TLS
 	tlsConfig := &tls.Config{
@@ -0,0 +1,164 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+)
+
+// Message representa un correo con cuerpo HTML y, opcionalmente, su alternativa en texto plano y adjuntos
+type Message struct {
+	To          string
+	Subject     string
+	HTML        string
+	Text        string // Si está vacío se envía solo la parte HTML
+	Attachments []Attachment
+}
+
+// Attachment representa un archivo adjunto
+type Attachment struct {
+	Filename    string
+	ContentType string // Ej: "text/calendar; charset=UTF-8; method=REQUEST"
+	Content     []byte
+}
+
+// base64LineLength es el largo máximo de línea de una parte codificada en base64 (RFC 2045)
+const base64LineLength = 76
+
+// buildMessage arma el mensaje MIME. Con texto plano el cuerpo es multipart/alternative para
+// que los clientes de correo sin HTML muestren la versión en texto; con adjuntos el cuerpo
+// se envuelve en un multipart/mixed junto a los archivos.
+func (e *EmailService) buildMessage(from string, msg Message) ([]byte, error) {
+	body, header, err := buildBody(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Attachments) > 0 {
+		if body, header, err = wrapWithAttachments(body, header, msg.Attachments); err != nil {
+			return nil, err
+		}
+	}
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", from)
+	fmt.Fprintf(&message, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", msg.Subject))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&message, "Content-Type: %s\r\n", header.Get("Content-Type"))
+	if encoding := header.Get("Content-Transfer-Encoding"); encoding != "" {
+		fmt.Fprintf(&message, "Content-Transfer-Encoding: %s\r\n", encoding)
+	}
+	message.WriteString("\r\n")
+	message.Write(body)
+
+	return message.Bytes(), nil
+}
+
+// buildBody arma el cuerpo del mensaje (HTML o HTML + texto) y las cabeceras que lo describen
+func buildBody(msg Message) ([]byte, textproto.MIMEHeader, error) {
+	var body bytes.Buffer
+
+	if msg.Text == "" {
+		if err := writeQuotedPrintable(&body, msg.HTML); err != nil {
+			return nil, nil, err
+		}
+		return body.Bytes(), textproto.MIMEHeader{
+			"Content-Type":              {"text/html; charset=UTF-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		}, nil
+	}
+
+	writer := multipart.NewWriter(&body)
+
+	// El orden importa: la última parte es la preferida
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", msg.Text},
+		{"text/html; charset=UTF-8", msg.HTML},
+	} {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error creando parte del mensaje: %w", err)
+		}
+		if err := writeQuotedPrintable(partWriter, part.content); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, nil, fmt.Errorf("error cerrando mensaje multipart: %w", err)
+	}
+
+	return body.Bytes(), textproto.MIMEHeader{
+		"Content-Type": {"multipart/alternative; boundary=" + writer.Boundary()},
+	}, nil
+}
+
+// wrapWithAttachments envuelve el cuerpo en un multipart/mixed y agrega los adjuntos en base64
+func wrapWithAttachments(body []byte, header textproto.MIMEHeader, attachments []Attachment) ([]byte, textproto.MIMEHeader, error) {
+	var mixed bytes.Buffer
+	writer := multipart.NewWriter(&mixed)
+
+	bodyWriter, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creando parte del mensaje: %w", err)
+	}
+	if _, err := bodyWriter.Write(body); err != nil {
+		return nil, nil, fmt.Errorf("error escribiendo cuerpo del mensaje: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {contentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error creando adjunto %s: %w", attachment.Filename, err)
+		}
+		if err := writeBase64(partWriter, attachment.Content); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, nil, fmt.Errorf("error cerrando mensaje multipart: %w", err)
+	}
+
+	return mixed.Bytes(), textproto.MIMEHeader{
+		"Content-Type": {"multipart/mixed; boundary=" + writer.Boundary()},
+	}, nil
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return fmt.Errorf("error codificando contenido del mensaje: %w", err)
+	}
+	return qp.Close()
+}
+
+// writeBase64 codifica el contenido en base64 con líneas de base64LineLength caracteres
+func writeBase64(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 0 {
+		n := base64LineLength
+		if len(encoded) < n {
+			n = len(encoded)
+		}
+		if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+			return fmt.Errorf("error codificando adjunto: %w", err)
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
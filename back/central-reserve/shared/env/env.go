@@ -164,6 +164,12 @@ type Config struct {
 	SMTPUseSTARTTLS string `env:"SMTP_USE_STARTTLS"`
 	SMTPUseTLS      string `env:"SMTP_USE_TLS"`
 	UrlBaseDomainS3 string `env:"URL_BASE_DOMAIN_S3,required"`
+
+	// Enlaces públicos enviados por email (frontend)
+	URLBaseFrontend string `env:"URL_BASE_FRONTEND"`
+
+	// Lista de espera
+	WaitlistOfferMinutes string `env:"WAITLIST_OFFER_MINUTES"`
 }
 
 func splitTag(tag string) []string {
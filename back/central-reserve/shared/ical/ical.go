@@ -0,0 +1,167 @@
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType es el tipo MIME de un calendario iCalendar
+const ContentType = "text/calendar; charset=UTF-8"
+
+// Métodos de un calendario (RFC 5546)
+const (
+	MethodPublish = "PUBLISH" // Feed de suscripción
+	MethodRequest = "REQUEST" // Invitación a un evento
+	MethodCancel  = "CANCEL"  // Cancelación de un evento ya enviado
+)
+
+// Estados de un evento
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// maxLineOctets es el largo máximo de una línea de contenido sin el CRLF (RFC 5545 §3.1)
+const maxLineOctets = 75
+
+const timeLayout = "20060102T150405Z"
+
+// Calendar representa un objeto VCALENDAR
+type Calendar struct {
+	ProdID   string
+	Method   string
+	Name     string // X-WR-CALNAME: nombre que muestran los clientes al suscribirse
+	Timezone string // X-WR-TIMEZONE: zona horaria sugerida para mostrar el feed
+	Events   []Event
+}
+
+// Event representa un VEVENT. Las fechas se escriben en UTC.
+type Event struct {
+	UID         string
+	Sequence    int // Debe crecer con cada cambio para que los clientes reemplacen la versión anterior
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Status      string
+	Organizer   *Person
+	Attendees   []Person
+}
+
+// Person es el organizador o un asistente de un evento
+type Person struct {
+	Name  string
+	Email string
+}
+
+// Bytes serializa el calendario según RFC 5545: líneas CRLF plegadas a 75 octetos y texto escapado
+func (c Calendar) Bytes() []byte {
+	var buf bytes.Buffer
+	w := &writer{buf: &buf}
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + c.ProdID)
+	w.line("CALSCALE:GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD:" + c.Method)
+	}
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if c.Timezone != "" {
+		w.line("X-WR-TIMEZONE:" + c.Timezone)
+	}
+	for _, event := range c.Events {
+		event.write(w)
+	}
+	w.line("END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func (e Event) write(w *writer) {
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + e.UID)
+	w.line(fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+	w.line("DTSTAMP:" + formatTime(e.Stamp))
+	w.line("DTSTART:" + formatTime(e.Start))
+	w.line("DTEND:" + formatTime(e.End))
+	w.line("SUMMARY:" + escapeText(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION:" + escapeText(e.Description))
+	}
+	if e.Location != "" {
+		w.line("LOCATION:" + escapeText(e.Location))
+	}
+	if e.URL != "" {
+		w.line("URL:" + e.URL)
+	}
+	if e.Status != "" {
+		w.line("STATUS:" + e.Status)
+	}
+	if e.Organizer != nil && e.Organizer.Email != "" {
+		w.line("ORGANIZER" + commonName(e.Organizer.Name) + ":mailto:" + e.Organizer.Email)
+	}
+	for _, attendee := range e.Attendees {
+		if attendee.Email == "" {
+			continue
+		}
+		w.line("ATTENDEE" + commonName(attendee.Name) + ";ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED:mailto:" + attendee.Email)
+	}
+	w.line("END:VEVENT")
+}
+
+type writer struct {
+	buf *bytes.Buffer
+}
+
+// line escribe una línea de contenido plegándola sin partir caracteres UTF-8
+func (w *writer) line(content string) {
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		// Las líneas de continuación empiezan con un espacio que cuenta en el largo
+		limit = maxLineOctets - 1
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// escapeText escapa un valor TEXT (RFC 5545 §3.3.11)
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(value)
+}
+
+// commonName arma el parámetro CN; las comillas no están permitidas dentro de un valor entrecomillado
+func commonName(name string) string {
+	name = strings.TrimSpace(strings.NewReplacer(`"`, "", "\r", "", "\n", " ").Replace(name))
+	if name == "" {
+		return ""
+	}
+	return `;CN="` + name + `"`
+}
@@ -0,0 +1,65 @@
+package jwt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// GuestReservationClaims - Claims del enlace de autogestión que recibe el cliente de una reserva
+type GuestReservationClaims struct {
+	ReservationID uint   `json:"reservation_id"`
+	BusinessID    uint   `json:"business_id"`
+	ClientID      uint   `json:"client_id"`
+	Scope         string `json:"scope"` // "guest_reservation"
+	jwt.RegisteredClaims
+}
+
+// GenerateGuestReservationToken genera un token para que el cliente consulte, reprograme o
+// cancele su reserva sin autenticarse. El token deja de ser válido en expiresAt.
+func (j *JWTService) GenerateGuestReservationToken(reservationID, businessID, clientID uint, expiresAt time.Time) (string, error) {
+	claims := GuestReservationClaims{
+		ReservationID: reservationID,
+		BusinessID:    businessID,
+		ClientID:      clientID,
+		Scope:         "guest_reservation",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			Subject:   fmt.Sprintf("guest_reservation_%d", reservationID),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(j.secretKey))
+	if err != nil {
+		return "", fmt.Errorf("error generando token de reserva para cliente: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// ValidateGuestReservationToken valida un token de autogestión de reserva
+func (j *JWTService) ValidateGuestReservationToken(tokenString string) (*GuestReservationClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &GuestReservationClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("método de firma inesperado: %v", token.Header["alg"])
+		}
+		return []byte(j.secretKey), nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("token inválido: %w", err)
+	}
+
+	if claims, ok := token.Claims.(*GuestReservationClaims); ok && token.Valid {
+		if claims.Scope != "guest_reservation" {
+			return nil, fmt.Errorf("scope inválido para token de reserva de cliente")
+		}
+		return claims, nil
+	}
+
+	return nil, fmt.Errorf("token de reserva de cliente inválido")
+}
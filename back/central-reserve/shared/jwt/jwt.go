@@ -7,21 +7,32 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// AccessTokenTTL es la vigencia de los tokens principal y de business. Es corta porque se renuevan
+// con el refresh token de la sesión.
+const AccessTokenTTL = 15 * time.Minute
+
 // IJWTService define operaciones de JWT sin depender de otros módulos
 type IJWTService interface {
-	GenerateToken(userID uint) (string, error)
+	GenerateToken(userID, sessionID uint) (string, error)
 	ValidateToken(tokenString string) (*JWTClaims, error)
-	RefreshToken(tokenString string) (string, error)
 
 	// Tokens para business
-	GenerateBusinessToken(userID, businessID, businessTypeID, roleID uint) (string, error)
+	GenerateBusinessToken(userID, businessID, businessTypeID, roleID, sessionID uint) (string, error)
 	ValidateBusinessToken(tokenString string) (*BusinessTokenClaims, error)
 
+	// Token intermedio del login con segundo factor
+	GenerateTwoFactorToken(userID uint, tokenType string) (string, error)
+	ValidateTwoFactorToken(tokenString string) (uint, string, error)
+
 	// Tokens para votación pública
 	GeneratePublicVotingToken(votingID, votingGroupID, hpID uint, durationHours int) (string, error)
 	GenerateVotingAuthToken(residentID, propertyUnitID, votingID, votingGroupID, hpID uint) (string, error)
 	ValidatePublicVotingToken(tokenString string) (*PublicVotingClaims, error)
 	ValidateVotingAuthToken(tokenString string) (*VotingAuthClaims, error)
+
+	// Tokens de autogestión de reservas para clientes
+	GenerateGuestReservationToken(reservationID, businessID, clientID uint, expiresAt time.Time) (string, error)
+	ValidateGuestReservationToken(tokenString string) (*GuestReservationClaims, error)
 }
 
 // JWTService implementación concreta
@@ -32,6 +43,7 @@ type JWTService struct {
 // Claims representa los claims internos del token
 type Claims struct {
 	UserID    uint   `json:"user_id"`
+	SessionID uint   `json:"sid"`
 	TokenType string `json:"token_type"` // "main" o "business"
 	jwt.RegisteredClaims
 }
@@ -39,6 +51,7 @@ type Claims struct {
 // JWTClaims es la estructura pública que exponemos a consumidores
 type JWTClaims struct {
 	UserID    uint
+	SessionID uint
 	TokenType string
 }
 
@@ -48,6 +61,7 @@ type BusinessTokenClaims struct {
 	BusinessID     uint
 	BusinessTypeID uint
 	RoleID         uint
+	SessionID      uint
 	TokenType      string
 }
 
@@ -57,6 +71,7 @@ type BusinessClaims struct {
 	BusinessID     uint   `json:"business_id"`
 	BusinessTypeID uint   `json:"business_type_id"`
 	RoleID         uint   `json:"role_id"`
+	SessionID      uint   `json:"sid"`
 	TokenType      string `json:"token_type"`
 	jwt.RegisteredClaims
 }
@@ -68,13 +83,14 @@ func New(secretKey string) IJWTService {
 	}
 }
 
-// GenerateToken genera un nuevo token JWT
-func (j *JWTService) GenerateToken(userID uint) (string, error) {
+// GenerateToken genera un token principal de la sesión
+func (j *JWTService) GenerateToken(userID, sessionID uint) (string, error) {
 	claims := Claims{
 		UserID:    userID,
+		SessionID: sessionID,
 		TokenType: "main",
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 			Issuer:    "central-reserve-api",
@@ -108,6 +124,7 @@ func (j *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
 	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
 		return &JWTClaims{
 			UserID:    claims.UserID,
+			SessionID: claims.SessionID,
 			TokenType: claims.TokenType,
 		}, nil
 	}
@@ -115,26 +132,17 @@ func (j *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
 	return nil, fmt.Errorf("token inválido")
 }
 
-// RefreshToken refresca un token JWT
-func (j *JWTService) RefreshToken(tokenString string) (string, error) {
-	claims, err := j.ValidateToken(tokenString)
-	if err != nil {
-		return "", err
-	}
-
-	return j.GenerateToken(claims.UserID)
-}
-
-// GenerateBusinessToken genera un token JWT para un business específico
-func (j *JWTService) GenerateBusinessToken(userID, businessID, businessTypeID, roleID uint) (string, error) {
+// GenerateBusinessToken genera un token JWT para un business específico dentro de la sesión
+func (j *JWTService) GenerateBusinessToken(userID, businessID, businessTypeID, roleID, sessionID uint) (string, error) {
 	claims := BusinessClaims{
 		UserID:         userID,
 		BusinessID:     businessID,
 		BusinessTypeID: businessTypeID,
 		RoleID:         roleID,
+		SessionID:      sessionID,
 		TokenType:      "business",
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 			Issuer:    "central-reserve-api",
@@ -171,6 +179,7 @@ func (j *JWTService) ValidateBusinessToken(tokenString string) (*BusinessTokenCl
 			BusinessID:     claims.BusinessID,
 			BusinessTypeID: claims.BusinessTypeID,
 			RoleID:         claims.RoleID,
+			SessionID:      claims.SessionID,
 			TokenType:      claims.TokenType,
 		}, nil
 	}
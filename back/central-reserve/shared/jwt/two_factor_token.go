@@ -0,0 +1,72 @@
+package jwt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Tipos de token intermedio del login con segundo factor
+const (
+	TwoFactorTokenChallenge  = "2fa"        // Falta el código TOTP o de recuperación
+	TwoFactorTokenEnrollment = "2fa_enroll" // El rol exige 2FA y el usuario aún no lo configuró
+)
+
+// TwoFactorTokenTTL es la vigencia del token intermedio entre la contraseña y el segundo factor
+const TwoFactorTokenTTL = 5 * time.Minute
+
+// twoFactorAudience separa estos tokens de los principales: no sirven para ningún endpoint protegido
+const twoFactorAudience = "two-factor"
+
+// TwoFactorClaims - Claims del token intermedio que se emite tras validar la contraseña
+type TwoFactorClaims struct {
+	UserID    uint   `json:"user_id"`
+	TokenType string `json:"token_type"`
+	jwt.RegisteredClaims
+}
+
+// GenerateTwoFactorToken genera el token intermedio del login con segundo factor
+func (j *JWTService) GenerateTwoFactorToken(userID uint, tokenType string) (string, error) {
+	claims := TwoFactorClaims{
+		UserID:    userID,
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(TwoFactorTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			Issuer:    "central-reserve-api",
+			Subject:   fmt.Sprintf("%d", userID),
+			Audience:  jwt.ClaimStrings{twoFactorAudience},
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(j.secretKey))
+	if err != nil {
+		return "", fmt.Errorf("error al firmar token de segundo factor: %w", err)
+	}
+	return tokenString, nil
+}
+
+// ValidateTwoFactorToken valida el token intermedio y retorna el usuario y el tipo de token
+func (j *JWTService) ValidateTwoFactorToken(tokenString string) (uint, string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &TwoFactorClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("método de firma inesperado: %v", token.Header["alg"])
+		}
+		return []byte(j.secretKey), nil
+	}, jwt.WithAudience(twoFactorAudience))
+	if err != nil {
+		return 0, "", fmt.Errorf("error al parsear token de segundo factor: %w", err)
+	}
+
+	claims, ok := token.Claims.(*TwoFactorClaims)
+	if !ok || !token.Valid || claims.UserID == 0 {
+		return 0, "", fmt.Errorf("token de segundo factor inválido")
+	}
+	if claims.TokenType != TwoFactorTokenChallenge && claims.TokenType != TwoFactorTokenEnrollment {
+		return 0, "", fmt.Errorf("token type inválido: %s", claims.TokenType)
+	}
+	return claims.UserID, claims.TokenType, nil
+}
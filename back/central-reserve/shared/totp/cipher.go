@@ -0,0 +1,58 @@
+package totp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Cipher cifra los secretos TOTP antes de guardarlos (AES-256-GCM), para que una copia de la base
+// de datos no baste para generar códigos
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher deriva la clave AES de la clave configurada
+func NewCipher(key string) (*Cipher, error) {
+	if key == "" {
+		return nil, errors.New("clave de cifrado TOTP vacía")
+	}
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal cifra el secreto; el resultado incluye el nonce y está en base64
+func (c *Cipher) Seal(secret string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("error al cifrar secreto TOTP: %w", err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open descifra un secreto cifrado con Seal
+func (c *Cipher) Open(sealed string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(raw) < c.aead.NonceSize() {
+		return "", errors.New("secreto TOTP cifrado inválido")
+	}
+	nonce, ciphertext := raw[:c.aead.NonceSize()], raw[c.aead.NonceSize():]
+	secret, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.New("no se pudo descifrar el secreto TOTP")
+	}
+	return string(secret), nil
+}
@@ -0,0 +1,106 @@
+// Package totp implementa contraseñas de un solo uso basadas en tiempo (RFC 6238) compatibles con
+// Google Authenticator, Authy y similares: HMAC-SHA1, 6 dígitos y pasos de 30 segundos.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits es el largo de los códigos
+	Digits = 6
+	// Period es la duración de cada paso
+	Period = 30 * time.Second
+	// Skew son los pasos anteriores y posteriores que se aceptan por desfase de reloj
+	Skew = 1
+	// SecretBytes es el largo del secreto (160 bits, el recomendado para HMAC-SHA1)
+	SecretBytes = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret genera un secreto aleatorio codificado en base32, como lo esperan las apps
+func GenerateSecret() (string, error) {
+	raw := make([]byte, SecretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("error al generar secreto TOTP: %w", err)
+	}
+	return encoding.EncodeToString(raw), nil
+}
+
+// Step retorna el paso de tiempo que corresponde a t
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// CodeAt calcula el código de un paso (RFC 4226 §5.3)
+func CodeAt(secret string, step int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	modulo := uint32(1)
+	for i := 0; i < Digits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, value%modulo), nil
+}
+
+// Validate comprueba el código contra los pasos cercanos a t y retorna el paso que coincidió, para
+// que el llamador rechace códigos ya usados
+func Validate(secret, code string, t time.Time) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := Step(t)
+	for delta := int64(-Skew); delta <= Skew; delta++ {
+		expected, err := CodeAt(secret, current+delta)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return current + delta, true
+		}
+	}
+	return 0, false
+}
+
+// ProvisioningURI arma el URI otpauth:// que las apps leen desde un código QR
+func ProvisioningURI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(Digits))
+	params.Set("period", fmt.Sprint(int(Period/time.Second)))
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("secreto TOTP inválido: %w", err)
+	}
+	return key, nil
+}
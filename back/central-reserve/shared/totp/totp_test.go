@@ -0,0 +1,97 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret es la clave de los vectores de prueba SHA1 del RFC 6238 ("12345678901234567890")
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeAt(t *testing.T) {
+	// Vectores del apéndice B del RFC 6238, recortados a 6 dígitos
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		t.Run(time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			got, err := CodeAt(rfcSecret, Step(time.Unix(tt.unix, 0)))
+			if err != nil {
+				t.Fatalf("CodeAt() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CodeAt() = %s, se esperaba %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	issuedAt := time.Unix(1234567890, 0)
+	step := Step(issuedAt)
+	code, err := CodeAt(rfcSecret, step)
+	if err != nil {
+		t.Fatalf("CodeAt() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		at       time.Time
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "mismo paso", code: code, at: issuedAt, wantOK: true, wantStep: step},
+		{name: "reloj del servidor un paso adelantado", code: code, at: issuedAt.Add(Period), wantOK: true, wantStep: step},
+		{name: "reloj del servidor un paso atrasado", code: code, at: issuedAt.Add(-Period), wantOK: true, wantStep: step},
+		{name: "dos pasos de desfase", code: code, at: issuedAt.Add(2 * Period), wantOK: false},
+		{name: "dos pasos de desfase hacia atrás", code: code, at: issuedAt.Add(-2 * Period), wantOK: false},
+		{name: "con espacios", code: code[:3] + " " + code[3:], at: issuedAt, wantOK: true, wantStep: step},
+		{name: "código incorrecto", code: "000000", at: issuedAt, wantOK: false},
+		{name: "largo incorrecto", code: code[:5], at: issuedAt, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Validate(rfcSecret, tt.code, tt.at)
+			if ok != tt.wantOK {
+				t.Fatalf("Validate() ok = %v, se esperaba %v", ok, tt.wantOK)
+			}
+			if ok && got != tt.wantStep {
+				t.Errorf("Validate() paso = %d, se esperaba %d", got, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	cipher, err := NewCipher("clave-de-prueba")
+	if err != nil {
+		t.Fatalf("NewCipher() error = %v", err)
+	}
+	sealed, err := cipher.Seal(rfcSecret)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	opened, err := cipher.Open(sealed)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if opened != rfcSecret {
+		t.Errorf("Open() = %s, se esperaba %s", opened, rfcSecret)
+	}
+
+	other, _ := NewCipher("otra-clave")
+	if _, err := other.Open(sealed); err == nil {
+		t.Error("Open() con otra clave debería fallar")
+	}
+}
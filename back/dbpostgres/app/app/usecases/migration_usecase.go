@@ -5,8 +5,20 @@ import (
 	"dbpostgres/pkg/log"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// reservationStatuses son los estados canónicos de la máquina de estados de reservas.
+// Los servicios los resuelven por código, por lo que deben existir siempre.
+var reservationStatuses = []models.ReservationStatus{
+	{Code: "pending", Name: "Pendiente"},
+	{Code: "confirmed", Name: "Confirmada"},
+	{Code: "seated", Name: "En mesa"},
+	{Code: "completed", Name: "Completada"},
+	{Code: "cancelled", Name: "Cancelada"},
+	{Code: "no_show", Name: "No se presentó"},
+}
+
 // MigrationUseCase maneja la lógica de migración de esquema
 type MigrationUseCase struct {
 	db     *gorm.DB
@@ -35,6 +47,9 @@ func (uc *MigrationUseCase) MigrateDB() error {
 		&models.BusinessType{},
 		&models.Scope{},
 		&models.Business{},
+		&models.BusinessReservationSettings{},
+		&models.BusinessOpeningHour{},
+		&models.BusinessBlackoutDate{},
 		&models.Role{},
 		&models.Permission{},
 		&models.User{},
@@ -44,8 +59,27 @@ func (uc *MigrationUseCase) MigrateDB() error {
 		&models.ReservationStatus{},
 		&models.Reservation{},
 		&models.ReservationStatusHistory{},
+		&models.ReservationTable{},
+		&models.WaitlistEntry{},
+		&models.EmailTemplate{},
+		&models.NotificationOutbox{},
+		&models.NotificationAttempt{},
+		&models.NotificationAttachment{},
+		&models.CalendarFeedToken{},
 		&models.Room{},
 		&models.APIKey{},
+		&models.APIKeyRateWindow{},
+		&models.UserSession{},
+		&models.RefreshToken{},
+		&models.UserAccountToken{},
+		&models.UserTwoFactor{},
+		&models.UserRecoveryCode{},
+		&models.LoginAttempt{},
+		&models.AuditLog{},
+		&models.SSOProvider{},
+		&models.SSORoleMapping{},
+		&models.UserIdentity{},
+		&models.SSOLoginState{},
 		&models.Resource{},
 		&models.BusinessResourceConfigured{},
 		&models.Action{},
@@ -67,6 +101,11 @@ func (uc *MigrationUseCase) MigrateDB() error {
 		&models.Voting{},
 		&models.VotingOption{},
 		&models.Vote{},
+		&models.VoteSelection{},
+		&models.SecretBallot{},
+		&models.SecretBallotSelection{},
+		&models.VotingQuorumSnapshot{},
+		&models.VotingResultSnapshot{},
 		&models.Proxy{},
 		&models.AttendanceRecord{},
 		&models.AttendanceList{},
@@ -74,6 +113,26 @@ func (uc *MigrationUseCase) MigrateDB() error {
 		return err
 	}
 
+	if err := uc.seedReservationStatuses(); err != nil {
+		return err
+	}
+
+	if err := uc.protectAuditLog(); err != nil {
+		return err
+	}
+
+	if err := uc.backfillVotingStatus(); err != nil {
+		return err
+	}
+
+	if err := uc.anonymizeSecretVotes(); err != nil {
+		return err
+	}
+
+	if err := uc.confirmSSOIdentities(); err != nil {
+		return err
+	}
+
 	uc.logger.Info().Msg("✅ Migración de esquema completada exitosamente")
 	return nil
 }
@@ -99,3 +158,126 @@ func (uc *MigrationUseCase) createHorizontalPropertySchema() error {
 	uc.logger.Info().Msg("✅ Esquema horizontal_property creado correctamente")
 	return nil
 }
+
+// seedReservationStatuses crea los estados de reserva canónicos que aún no existan
+func (uc *MigrationUseCase) seedReservationStatuses() error {
+	for _, status := range reservationStatuses {
+		status := status
+		if err := uc.db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "code"}},
+			DoNothing: true,
+		}).Create(&status).Error; err != nil {
+			uc.logger.Error().Err(err).Str("code", status.Code).Msg("Error creando estado de reserva")
+			return err
+		}
+	}
+
+	uc.logger.Info().Int("statuses_count", len(reservationStatuses)).Msg("✅ Estados de reserva verificados")
+	return nil
+}
+
+// protectAuditLog impide modificar o borrar registros de audit_log: la tabla es solo inserción
+func (uc *MigrationUseCase) protectAuditLog() error {
+	sql := `
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_log es solo inserción';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only
+	BEFORE UPDATE OR DELETE ON audit_log
+	FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();`
+	if err := uc.db.Exec(sql).Error; err != nil {
+		uc.logger.Error().Err(err).Msg("Error protegiendo la tabla audit_log")
+		return err
+	}
+
+	uc.logger.Info().Msg("✅ Tabla audit_log protegida como solo inserción")
+	return nil
+}
+
+// backfillVotingStatus marca como cerradas las votaciones desactivadas antes de que existiera el
+// estado: la columna se crea con 'open' para todas
+func (uc *MigrationUseCase) backfillVotingStatus() error {
+	result := uc.db.Exec(`UPDATE horizontal_property.votings SET status = 'closed', closed_at = COALESCE(closed_at, updated_at)
+		WHERE status = 'open' AND is_active = false`)
+	if result.Error != nil {
+		uc.logger.Error().Err(result.Error).Msg("Error actualizando el estado de las votaciones")
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		uc.logger.Info().Int64("votings", result.RowsAffected).Msg("✅ Votaciones desactivadas marcadas como cerradas")
+	}
+	return nil
+}
+
+// anonymizeSecretVotes pasa a papeletas anónimas los votos de votaciones secretas emitidos antes
+// de que existieran: el voto queda solo como participación de la unidad
+func (uc *MigrationUseCase) anonymizeSecretVotes() error {
+	result := uc.db.Exec(`WITH moved AS MATERIALIZED (
+			SELECT v.id AS vote_id, v.voting_id, v.voting_option_id,
+				replace(gen_random_uuid()::text, '-', '') AS ballot_id,
+				COALESCE(pu.participation_coefficient, 0) AS coefficient
+			FROM horizontal_property.votes v
+			JOIN horizontal_property.votings vt ON vt.id = v.voting_id
+			LEFT JOIN horizontal_property.property_units pu ON pu.id = v.property_unit_id
+			WHERE vt.is_secret AND v.voting_option_id IS NOT NULL
+		), ballots AS (
+			INSERT INTO horizontal_property.secret_ballots (id, voting_id, coefficient)
+			SELECT ballot_id, voting_id, coefficient FROM moved
+		), selections AS (
+			INSERT INTO horizontal_property.secret_ballot_selections (ballot_id, voting_option_id, rank)
+			SELECT m.ballot_id, COALESCE(s.voting_option_id, m.voting_option_id), COALESCE(s.rank, 1)
+			FROM moved m
+			LEFT JOIN horizontal_property.vote_selections s ON s.vote_id = m.vote_id AND s.deleted_at IS NULL
+		), cleared AS (
+			DELETE FROM horizontal_property.vote_selections WHERE vote_id IN (SELECT vote_id FROM moved)
+		)
+		UPDATE horizontal_property.votes SET voting_option_id = NULL
+		WHERE id IN (SELECT vote_id FROM moved)`)
+	if result.Error != nil {
+		uc.logger.Error().Err(result.Error).Msg("Error anonimizando votos de votaciones secretas")
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		uc.logger.Info().Int64("votes", result.RowsAffected).Msg("✅ Votos de votaciones secretas pasados a papeletas anónimas")
+	}
+	return nil
+}
+
+// confirmSSOIdentities confirma las identidades SSO que se podrían vincular solas: las de usuarios
+// que solo son staff del negocio del proveedor y no son super admin. Las demás (incluidas las que
+// se vincularon por email antes de exigir confirmación) quedan pendientes hasta que el dueño de la
+// cuenta confirme el enlace que recibe en su próximo login por SSO.
+func (uc *MigrationUseCase) confirmSSOIdentities() error {
+	result := uc.db.Exec(`UPDATE user_identity ui SET confirmed_at = ui.created_at
+		FROM sso_provider p
+		WHERE p.id = ui.provider_id AND ui.confirmed_at IS NULL AND ui.deleted_at IS NULL
+			AND EXISTS (
+				SELECT 1 FROM business_staff bs
+				WHERE bs.user_id = ui.user_id AND bs.business_id = p.business_id AND bs.deleted_at IS NULL
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM business_staff bs
+				WHERE bs.user_id = ui.user_id AND bs.business_id <> p.business_id AND bs.deleted_at IS NULL
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM user_roles ur
+				JOIN role r ON r.id = ur.role_id
+				JOIN scope s ON s.id = r.scope_id
+				WHERE ur.user_id = ui.user_id AND s.code = 'platform'
+			)`)
+	if result.Error != nil {
+		uc.logger.Error().Err(result.Error).Msg("Error confirmando identidades SSO")
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		uc.logger.Info().Int64("identities", result.RowsAffected).Msg("✅ Identidades SSO de staff del negocio confirmadas")
+	}
+	return nil
+}
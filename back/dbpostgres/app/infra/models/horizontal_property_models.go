@@ -292,16 +292,17 @@ func (PropertyStaff) TableName() string {
 // ───────────────────────────────────────────
 type VotingGroup struct {
 	gorm.Model
-	BusinessID       uint      `gorm:"not null;index"`                  // Propiedad horizontal
-	Name             string    `gorm:"size:150;not null"`               // Nombre del grupo (ej. "Asamblea Ordinaria 2024")
-	Description      string    `gorm:"size:1000"`                       // Descripción detallada
-	VotingStartDate  time.Time `gorm:"not null"`                        // Fecha de inicio de votaciones
-	VotingEndDate    time.Time `gorm:"not null"`                        // Fecha de cierre de votaciones
-	IsActive         bool      `gorm:"default:true"`                    // Si está activo
-	RequiresQuorum   bool      `gorm:"default:true"`                    // Si requiere quórum
-	QuorumPercentage *float64  `gorm:"type:decimal(5,2);default:50.00"` // Porcentaje de quórum requerido
-	CreatedByUserID  *uint     `gorm:"index"`                           // Usuario que creó el grupo
-	Notes            string    `gorm:"size:2000"`                       // Notas adicionales
+	BusinessID               uint      `gorm:"not null;index"`                  // Propiedad horizontal
+	Name                     string    `gorm:"size:150;not null"`               // Nombre del grupo (ej. "Asamblea Ordinaria 2024")
+	Description              string    `gorm:"size:1000"`                       // Descripción detallada
+	VotingStartDate          time.Time `gorm:"not null"`                        // Fecha de inicio de votaciones
+	VotingEndDate            time.Time `gorm:"not null"`                        // Fecha de cierre de votaciones
+	IsActive                 bool      `gorm:"default:true"`                    // Si está activo
+	RequiresQuorum           bool      `gorm:"default:true"`                    // Si requiere quórum
+	QuorumPercentage         *float64  `gorm:"type:decimal(5,2);default:50.00"` // Porcentaje de quórum requerido
+	AllowVotingWithoutQuorum bool      `gorm:"default:false"`                   // Con quórum requerido, permite activar y votar sin alcanzarlo (solo advierte)
+	CreatedByUserID          *uint     `gorm:"index"`                           // Usuario que creó el grupo
+	Notes                    string    `gorm:"size:2000"`                       // Notas adicionales
 
 	// Relaciones
 	Business        Business         `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
@@ -325,14 +326,29 @@ type Voting struct {
 	VotingGroupID   uint   `gorm:"not null;index"`                    // Grupo de votaciones al que pertenece
 	Title           string `gorm:"size:200;not null"`                 // Título de la votación
 	Description     string `gorm:"size:2000;not null"`                // Descripción completa de lo que se vota
-	VotingType      string `gorm:"size:20;not null;default:'simple'"` // simple, majority, unanimity, etc.
+	VotingType      string `gorm:"size:20;not null;default:'simple'"` // simple, majority, unanimity, single_choice, multiple_choice, ranked_choice, election
 	IsSecret        bool   `gorm:"default:false"`                     // Si es votación secreta
 	AllowAbstention bool   `gorm:"default:true"`                      // Si permite abstención
-	IsActive        bool   `gorm:"default:true"`                      // Si está activa
+	IsActive        bool   `gorm:"default:true"`                      // Si está abierta (status = open)
 	DisplayOrder    int    `gorm:"default:1"`                         // Orden de visualización
 
+	// Ciclo de vida
+	Status            string     `gorm:"size:12;not null;default:'open';index"` // draft, open, closed, certified
+	ScheduledOpenAt   *time.Time `gorm:"index"`                                 // Apertura programada
+	ScheduledCloseAt  *time.Time `gorm:"index"`                                 // Cierre programado
+	OpenedAt          *time.Time // Momento en que se abrió
+	ClosedAt          *time.Time // Momento en que se cerró
+	CertifiedAt       *time.Time // Momento en que se certificaron los resultados
+	CertifiedByUserID *uint      `gorm:"index"` // Usuario que certificó
+
 	// Configuración de mayorías
-	RequiredPercentage *float64 `gorm:"type:decimal(5,2);default:50.00"` // Porcentaje requerido para aprobar
+	RequiredPercentage *float64 `gorm:"type:decimal(5,2);default:50.00"`    // Porcentaje requerido para aprobar
+	MajorityBase       string   `gorm:"size:10;not null;default:'present'"` // Base del porcentaje: present (coeficientes presentes) o total
+
+	// Configuración de la papeleta
+	MinSelections int `gorm:"not null;default:1"` // Mínimo de opciones a marcar (multiple_choice)
+	MaxSelections int `gorm:"not null;default:0"` // Máximo de opciones a marcar u ordenar (multiple_choice, ranked_choice); 0 sin límite
+	Seats         int `gorm:"not null;default:1"` // Cargos a elegir (election)
 
 	// Relaciones
 	VotingGroup   VotingGroup    `gorm:"foreignKey:VotingGroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
@@ -345,6 +361,58 @@ func (Voting) TableName() string {
 	return "horizontal_property.votings"
 }
 
+// ───────────────────────────────────────────
+//
+//	VOTING QUORUM SNAPSHOTS – Quórum registrado al abrir y cerrar cada votación
+//
+// ───────────────────────────────────────────
+type VotingQuorumSnapshot struct {
+	gorm.Model
+	VotingID           uint      `gorm:"not null;index"`                         // Votación
+	Event              string    `gorm:"size:10;not null"`                       // open, close
+	PresentUnits       int       `gorm:"not null;default:0"`                     // Unidades presentes (propietarios y apoderados válidos)
+	PresentCoefficient float64   `gorm:"type:decimal(10,6);not null;default:0"`  // Coeficiente presente
+	TotalUnits         int       `gorm:"not null;default:0"`                     // Unidades activas de la copropiedad
+	TotalCoefficient   float64   `gorm:"type:decimal(10,6);not null;default:0"`  // Coeficiente total
+	PresentPercentage  float64   `gorm:"type:decimal(6,3);not null;default:0"`   // Porcentaje presente sobre el total
+	WeightedBy         string    `gorm:"size:12;not null;default:'coefficient'"` // coefficient o units (sin coeficientes cargados)
+	QuorumPercentage   float64   `gorm:"type:decimal(5,2);not null;default:0"`   // Quórum exigido en ese momento
+	QuorumMet          bool      `gorm:"default:false"`                          // Si se alcanzaba el quórum
+	TakenAt            time.Time `gorm:"not null"`                               // Momento del registro
+
+	// Relaciones
+	Voting Voting `gorm:"foreignKey:VotingID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de tabla con esquema para VotingQuorumSnapshot
+func (VotingQuorumSnapshot) TableName() string {
+	return "horizontal_property.voting_quorum_snapshots"
+}
+
+// ───────────────────────────────────────────
+//
+//	VOTING RESULT SNAPSHOTS – Resultados definitivos registrados al cerrar cada votación
+//
+// ───────────────────────────────────────────
+type VotingResultSnapshot struct {
+	gorm.Model
+	VotingID           uint      `gorm:"not null;uniqueIndex"`                  // Votación (un registro por votación)
+	Outcome            string    `gorm:"size:10;not null"`                      // approved, rejected
+	ApprovalPercentage float64   `gorm:"type:decimal(6,3);not null;default:0"`  // Porcentaje de la base que obtuvo la opción que decide
+	VotedUnits         int       `gorm:"not null;default:0"`                    // Unidades que votaron
+	VotedCoefficient   float64   `gorm:"type:decimal(10,6);not null;default:0"` // Coeficiente de las unidades que votaron
+	Tally              string    `gorm:"type:jsonb;not null"`                   // Resultados por opción y decisión completos
+	ClosedAt           time.Time `gorm:"not null"`                              // Momento del cierre
+
+	// Relaciones
+	Voting Voting `gorm:"foreignKey:VotingID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de tabla con esquema para VotingResultSnapshot
+func (VotingResultSnapshot) TableName() string {
+	return "horizontal_property.voting_result_snapshots"
+}
+
 // ───────────────────────────────────────────
 //
 //	VOTING OPTIONS – Opciones de votación
@@ -378,16 +446,17 @@ type Vote struct {
 	gorm.Model
 	VotingID       uint      `gorm:"not null;index;uniqueIndex:idx_voting_property_unit_vote,priority:1"` // Votación
 	PropertyUnitID uint      `gorm:"not null;index;uniqueIndex:idx_voting_property_unit_vote,priority:2"` // Unidad que vota
-	VotingOptionID uint      `gorm:"not null;index"`                                                      // Opción seleccionada
+	VotingOptionID *uint     `gorm:"index"`                                                               // Opción seleccionada (primera preferencia si la papeleta lleva varias); nil en votaciones secretas
 	VotedAt        time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`                                  // Fecha y hora del voto
 	IPAddress      string    `gorm:"size:45"`                                                             // IP desde donde se votó (para auditoría)
 	UserAgent      string    `gorm:"size:500"`                                                            // User agent (para auditoría)
 	Notes          string    `gorm:"size:500"`                                                            // Notas adicionales
 
 	// Relaciones
-	Voting       Voting       `gorm:"foreignKey:VotingID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	PropertyUnit PropertyUnit `gorm:"foreignKey:PropertyUnitID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	VotingOption VotingOption `gorm:"foreignKey:VotingOptionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Voting       Voting          `gorm:"foreignKey:VotingID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	PropertyUnit PropertyUnit    `gorm:"foreignKey:PropertyUnitID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	VotingOption VotingOption    `gorm:"foreignKey:VotingOptionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Selections   []VoteSelection `gorm:"foreignKey:VoteID"`
 }
 
 // TableName especifica el nombre de tabla con esquema para Vote
@@ -395,6 +464,65 @@ func (Vote) TableName() string {
 	return "horizontal_property.votes"
 }
 
+// ───────────────────────────────────────────
+//
+//	VOTE SELECTIONS – Opciones marcadas en cada papeleta, en orden de preferencia
+//
+// ───────────────────────────────────────────
+type VoteSelection struct {
+	gorm.Model
+	VoteID         uint `gorm:"not null;index;uniqueIndex:idx_vote_selection_option,priority:1"` // Voto al que pertenece
+	VotingOptionID uint `gorm:"not null;index;uniqueIndex:idx_vote_selection_option,priority:2"` // Opción marcada
+	Rank           int  `gorm:"not null;default:1"`                                              // Preferencia (1 = primera)
+
+	// Relaciones
+	Vote         Vote         `gorm:"foreignKey:VoteID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	VotingOption VotingOption `gorm:"foreignKey:VotingOptionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de tabla con esquema para VoteSelection
+func (VoteSelection) TableName() string {
+	return "horizontal_property.vote_selections"
+}
+
+// ───────────────────────────────────────────
+//
+//	SECRET BALLOTS – Papeletas anónimas de las votaciones secretas. El voto de la unidad queda
+//	en votes sin opción (solo participación) y la papeleta no guarda la unidad, fechas ni un ID
+//	secuencial que permita relacionarlas.
+//
+// ───────────────────────────────────────────
+type SecretBallot struct {
+	ID          string  `gorm:"size:32;primaryKey"`                    // Identificador aleatorio
+	VotingID    uint    `gorm:"not null;index"`                        // Votación
+	Coefficient float64 `gorm:"type:decimal(10,6);not null;default:0"` // Coeficiente de la unidad al votar (peso de la papeleta)
+
+	// Relaciones
+	Voting     Voting                  `gorm:"foreignKey:VotingID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Selections []SecretBallotSelection `gorm:"foreignKey:BallotID"`
+}
+
+// TableName especifica el nombre de tabla con esquema para SecretBallot
+func (SecretBallot) TableName() string {
+	return "horizontal_property.secret_ballots"
+}
+
+// SecretBallotSelection - Opción marcada en una papeleta anónima, en orden de preferencia
+type SecretBallotSelection struct {
+	BallotID       string `gorm:"size:32;primaryKey"`             // Papeleta
+	VotingOptionID uint   `gorm:"primaryKey;autoIncrement:false"` // Opción marcada
+	Rank           int    `gorm:"not null;default:1"`             // Preferencia (1 = primera)
+
+	// Relaciones
+	Ballot       SecretBallot `gorm:"foreignKey:BallotID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	VotingOption VotingOption `gorm:"foreignKey:VotingOptionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de tabla con esquema para SecretBallotSelection
+func (SecretBallotSelection) TableName() string {
+	return "horizontal_property.secret_ballot_selections"
+}
+
 // ───────────────────────────────────────────
 //
 //	ATTENDANCE LISTS – Listas de asistencia para grupos de votación
@@ -134,6 +134,57 @@ type Resource struct {
 	Permissions                 []Permission                 `gorm:"foreignKey:ResourceID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
 
+// ───────────────────────────────────────────
+//
+//	BUSINESS RESERVATION SETTINGS – reglas de slots para reservas
+//
+// ───────────────────────────────────────────
+type BusinessReservationSettings struct {
+	gorm.Model
+	BusinessID             uint   `gorm:"not null;uniqueIndex"`
+	SlotMinutes            int    `gorm:"not null;default:30"`           // Granularidad de los horarios de reserva
+	DefaultDurationMinutes int    `gorm:"not null;default:120"`          // Duración si la reserva no indica hora de fin
+	MinLeadTimeMinutes     int    `gorm:"not null;default:0"`            // Anticipación mínima para reservar
+	MaxAdvanceDays         int    `gorm:"not null;default:0"`            // Máximo de días hacia adelante (0 = sin límite)
+	EmailLocale            string `gorm:"size:10;not null;default:'es'"` // Idioma de los emails a clientes (es, en)
+	ReminderHoursBefore    int    `gorm:"not null;default:24"`           // Recordatorio por email antes de la reserva (0 = sin recordatorio)
+	NoShowGraceMinutes     int    `gorm:"not null;default:0"`            // Tolerancia tras el inicio para marcar no-show automático (0 = desactivado)
+
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	BUSINESS OPENING HOURS – horario semanal de atención
+//
+// ───────────────────────────────────────────
+type BusinessOpeningHour struct {
+	gorm.Model
+	BusinessID uint   `gorm:"not null;index;uniqueIndex:idx_business_opening_hour,priority:1"`
+	DayOfWeek  int    `gorm:"not null;uniqueIndex:idx_business_opening_hour,priority:2"`        // 0=domingo ... 6=sábado
+	OpenTime   string `gorm:"size:5;not null;uniqueIndex:idx_business_opening_hour,priority:3"` // HH:MM hora local del negocio
+	CloseTime  string `gorm:"size:5;not null"`                                                  // HH:MM; si es <= OpenTime cierra al día siguiente
+
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	BUSINESS BLACKOUT DATES – festivos y excepciones de horario
+//
+// ───────────────────────────────────────────
+type BusinessBlackoutDate struct {
+	gorm.Model
+	BusinessID uint      `gorm:"not null;index;uniqueIndex:idx_business_blackout_date,priority:1"`
+	Date       time.Time `gorm:"type:date;not null;uniqueIndex:idx_business_blackout_date,priority:2"`
+	IsClosed   bool      `gorm:"default:true"` // Cerrado todo el día
+	OpenTime   *string   `gorm:"size:5"`       // Horario especial del día (si no está cerrado)
+	CloseTime  *string   `gorm:"size:5"`
+	Reason     string    `gorm:"size:255"`
+
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
 // ───────────────────────────────────────────
 //
 //	ROOMS – salas dentro de un negocio
@@ -170,6 +221,8 @@ type Role struct {
 	Level       int    `gorm:"not null;default:1"` // Nivel jerárquico (1=super, 2=admin, 3=manager, 4=staff)
 	IsSystem    bool   `gorm:"default:false"`      // Si es rol del sistema (no se puede eliminar)
 
+	RequireTwoFactor bool `gorm:"default:false"` // Los usuarios con este rol deben usar 2FA para iniciar sesión
+
 	// Scope del rol
 	ScopeID uint  `gorm:"not null;index"`
 	Scope   Scope `gorm:"foreignKey:ScopeID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
@@ -220,6 +273,13 @@ type User struct {
 	IsActive    bool   `gorm:"default:true"`
 	LastLoginAt *time.Time
 
+	EmailVerifiedAt *time.Time // nil hasta que el usuario confirma su email
+
+	// Protección contra fuerza bruta del login
+	FailedLoginCount  int        `gorm:"not null;default:0"` // Intentos fallidos consecutivos
+	LastFailedLoginAt *time.Time // Último intento fallido; base del retraso progresivo
+	LockedUntil       *time.Time // Bloqueo temporal tras demasiados intentos fallidos
+
 	// Relación con negocios (un usuario puede estar en múltiples negocios)
 	Businesses []Business `gorm:"many2many:user_businesses;"`
 
@@ -260,6 +320,10 @@ type Client struct {
 	Phone      string  `gorm:"size:20"`
 	Dni        *string `gorm:"size:30;uniqueIndex:idx_business_client_dni,priority:2"`
 
+	// Historial de asistencia: permite identificar clientes que reinciden en no presentarse
+	NoShowCount  int `gorm:"not null;default:0"`
+	LastNoShowAt *time.Time
+
 	Reservations []Reservation
 	Business     Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -295,10 +359,11 @@ type Reservation struct {
 	// Opcional: quién registró la reserva (empleado o sistema)
 	CreatedByUserID *uint `gorm:"index"`
 
-	StartAt        time.Time `gorm:"not null;index"`
-	EndAt          time.Time `gorm:"not null"`
-	NumberOfGuests int       `gorm:"not null"`
-	StatusID       uint      `gorm:"not null;index"`
+	StartAt        time.Time  `gorm:"not null;index"`
+	EndAt          time.Time  `gorm:"not null"`
+	NumberOfGuests int        `gorm:"not null"`
+	StatusID       uint       `gorm:"not null;index"`
+	ReminderSentAt *time.Time // Recordatorio ya encolado; se limpia al reprogramar
 
 	Business  Business          `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Room      *Room             `gorm:"foreignKey:RoomID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"` // Relación opcional
@@ -306,6 +371,143 @@ type Reservation struct {
 	Client    Client            `gorm:"foreignKey:ClientID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	CreatedBy User              `gorm:"foreignKey:CreatedByUserID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 	Status    ReservationStatus `gorm:"foreignKey:StatusID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+
+	// Mesas asignadas (una o varias mesas combinadas para grupos grandes)
+	AssignedTables []ReservationTable `gorm:"foreignKey:ReservationID"`
+}
+
+// ───────────────────────────────────────────
+//
+//	RESERVATION TABLES – mesas asignadas a una reserva (combinación de mesas)
+//
+// ───────────────────────────────────────────
+type ReservationTable struct {
+	gorm.Model
+	ReservationID uint `gorm:"not null;index;uniqueIndex:idx_reservation_table,priority:1"`
+	TableID       uint `gorm:"not null;index;uniqueIndex:idx_reservation_table,priority:2"`
+
+	Reservation Reservation `gorm:"foreignKey:ReservationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Table       Table       `gorm:"foreignKey:TableID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	WAITLIST ENTRIES – lista de espera para horarios sin disponibilidad
+//
+// ───────────────────────────────────────────
+type WaitlistEntry struct {
+	gorm.Model
+	BusinessID     uint      `gorm:"not null;index"`
+	ClientID       uint      `gorm:"not null;index"`
+	RoomID         *uint     `gorm:"index"` // Sala preferida (opcional)
+	StartAt        time.Time `gorm:"not null;index"`
+	EndAt          time.Time `gorm:"not null"`
+	NumberOfGuests int       `gorm:"not null"`
+	Priority       int       `gorm:"not null;default:0"`                       // Mayor valor = se ofrece primero
+	Status         string    `gorm:"size:20;not null;default:'waiting';index"` // waiting, offered, accepted, declined, expired, cancelled
+	Notes          string    `gorm:"size:255"`
+
+	// Oferta vigente: se guarda solo el hash del token enviado por email
+	OfferTokenHash *string `gorm:"size:64;uniqueIndex"`
+	OfferedAt      *time.Time
+	OfferExpiresAt *time.Time `gorm:"index"`
+	ReservationID  *uint      `gorm:"index"` // Reserva creada al aceptar la oferta
+
+	Business    Business     `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Client      Client       `gorm:"foreignKey:ClientID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Room        *Room        `gorm:"foreignKey:RoomID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Reservation *Reservation `gorm:"foreignKey:ReservationID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+// ───────────────────────────────────────────
+//
+//	EMAIL TEMPLATES – plantillas de email personalizadas por negocio
+//
+// ───────────────────────────────────────────
+type EmailTemplate struct {
+	gorm.Model
+	BusinessID uint   `gorm:"not null;uniqueIndex:idx_email_template_business_code_locale"`
+	Code       string `gorm:"size:50;not null;uniqueIndex:idx_email_template_business_code_locale"` // Ej: reservation_confirmation
+	Locale     string `gorm:"size:10;not null;uniqueIndex:idx_email_template_business_code_locale"` // Ej: es, en
+	Subject    string `gorm:"size:255;not null"`
+	HTMLBody   string `gorm:"type:text;not null"` // Contenido HTML (se inserta dentro del diseño con la marca del negocio)
+	TextBody   string `gorm:"type:text;not null"` // Alternativa en texto plano
+
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	NOTIFICATION OUTBOX – emails pendientes de envío con reintentos
+//
+// ───────────────────────────────────────────
+type NotificationOutbox struct {
+	gorm.Model
+	BusinessID    *uint     `gorm:"index"`
+	ReservationID *uint     `gorm:"index"`
+	Code          string    `gorm:"size:50;not null"` // Plantilla que originó el email
+	Recipient     string    `gorm:"size:255;not null"`
+	Subject       string    `gorm:"size:255;not null"`
+	HTMLBody      string    `gorm:"type:text;not null"`
+	TextBody      string    `gorm:"type:text"`
+	Status        string    `gorm:"size:20;not null;default:'pending';index"` // pending, sending, sent, failed
+	Attempts      int       `gorm:"not null;default:0"`
+	MaxAttempts   int       `gorm:"not null"`
+	NextAttemptAt time.Time `gorm:"not null;index"` // En estado sending, vencimiento del envío en curso
+	LastError     string    `gorm:"type:text"`
+	SentAt        *time.Time
+
+	Business         *Business                `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Reservation      *Reservation             `gorm:"foreignKey:ReservationID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	DeliveryAttempts []NotificationAttempt    `gorm:"foreignKey:OutboxID"`
+	Attachments      []NotificationAttachment `gorm:"foreignKey:OutboxID"`
+}
+
+// ───────────────────────────────────────────
+//
+//	NOTIFICATION ATTACHMENT – adjuntos de un email del outbox (ej: invitación .ics)
+//
+// ───────────────────────────────────────────
+type NotificationAttachment struct {
+	gorm.Model
+	OutboxID    uint   `gorm:"not null;index"`
+	Filename    string `gorm:"size:255;not null"`
+	ContentType string `gorm:"size:100;not null"`
+	Content     []byte `gorm:"type:bytea;not null"`
+
+	Outbox NotificationOutbox `gorm:"foreignKey:OutboxID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	NOTIFICATION ATTEMPT – intentos de entrega de un email del outbox
+//
+// ───────────────────────────────────────────
+type NotificationAttempt struct {
+	gorm.Model
+	OutboxID   uint   `gorm:"not null;index"`
+	Number     int    `gorm:"not null"`
+	Success    bool   `gorm:"not null"`
+	Error      string `gorm:"type:text"`
+	DurationMs int64  `gorm:"not null;default:0"`
+
+	Outbox NotificationOutbox `gorm:"foreignKey:OutboxID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	CALENDAR FEED TOKEN – acceso al feed iCal de reservas de un negocio
+//
+// ───────────────────────────────────────────
+type CalendarFeedToken struct {
+	gorm.Model
+	BusinessID      uint   `gorm:"not null;uniqueIndex"`         // Un feed vigente por negocio; rotar reemplaza el token
+	TokenHash       string `gorm:"size:64;not null;uniqueIndex"` // Solo se guarda el hash del token
+	CreatedByUserID *uint
+	LastAccessedAt  *time.Time
+
+	Business  Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedBy *User    `gorm:"foreignKey:CreatedByUserID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 }
 
 // ───────────────────────────────────────────
@@ -326,10 +528,11 @@ type ReservationStatus struct {
 // ───────────────────────────────────────────
 type ReservationStatusHistory struct {
 	gorm.Model
-	ReservationID   uint  `gorm:"not null;index"`
-	TableID         *uint `gorm:"index"` // Ahora opcional (nullable)
-	StatusID        uint  `gorm:"not null;index"`
-	ChangedByUserID *uint `gorm:"index"` // Quién hizo el cambio (puede ser null si fue automático)
+	ReservationID   uint   `gorm:"not null;index"`
+	TableID         *uint  `gorm:"index"` // Ahora opcional (nullable)
+	StatusID        uint   `gorm:"not null;index"`
+	ChangedByUserID *uint  `gorm:"index"`    // Quién hizo el cambio (puede ser null si fue automático)
+	Reason          string `gorm:"size:255"` // Motivo del cambio (ej: razón de cancelación)
 
 	Reservation Reservation       `gorm:"foreignKey:ReservationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Status      ReservationStatus `gorm:"foreignKey:StatusID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
@@ -371,6 +574,198 @@ type StaffType struct {
 	// Futuro: RestaurantStaff, HotelStaff, etc.
 }
 
+// ───────────────────────────────────────────
+//
+//	USER SESSION – sesión de login; los access tokens la referencian (sid)
+//
+// ───────────────────────────────────────────
+type UserSession struct {
+	gorm.Model
+	UserID        uint      `gorm:"not null;index"`
+	UserAgent     string    `gorm:"size:500"`
+	IPAddress     string    `gorm:"size:64"`
+	LastUsedAt    time.Time `gorm:"not null"` // Último refresh
+	ExpiresAt     time.Time `gorm:"not null"` // Vencimiento del refresh token vigente
+	RevokedAt     *time.Time
+	RevokedReason string `gorm:"size:50"` // logout, admin, reuse_detected...
+
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	REFRESH TOKEN – token opaco de una sesión; se rota en cada uso
+//
+// ───────────────────────────────────────────
+type RefreshToken struct {
+	gorm.Model
+	SessionID uint       `gorm:"not null;index"`
+	TokenHash string     `gorm:"size:64;not null;uniqueIndex"` // Solo se guarda el hash del token
+	ExpiresAt time.Time  `gorm:"not null"`
+	UsedAt    *time.Time // Se marca al rotarlo; volver a usarlo revoca la sesión
+
+	Session UserSession `gorm:"foreignKey:SessionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	USER ACCOUNT TOKEN – tokens de un solo uso para restablecer contraseña y verificar email
+//
+// ───────────────────────────────────────────
+type UserAccountToken struct {
+	gorm.Model
+	UserID     uint       `gorm:"not null;index"`
+	Purpose    string     `gorm:"size:32;not null;index"`       // password_reset, email_verification, sso_link
+	TokenHash  string     `gorm:"size:64;not null;uniqueIndex"` // Solo se guarda el hash del token
+	Email      string     `gorm:"size:255;not null"`            // Email al que se envió el enlace
+	IdentityID *uint      `gorm:"index"`                        // Identidad SSO que confirma un token sso_link
+	ExpiresAt  time.Time  `gorm:"not null"`
+	UsedAt     *time.Time // Se marca al usarlo o al emitir otro del mismo tipo
+
+	User     User         `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Identity UserIdentity `gorm:"foreignKey:IdentityID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	USER TWO FACTOR – segundo factor TOTP (RFC 6238) del usuario
+//
+// ───────────────────────────────────────────
+type UserTwoFactor struct {
+	gorm.Model
+	UserID       uint       `gorm:"not null;uniqueIndex"`
+	Secret       string     `gorm:"size:255;not null"` // Secreto TOTP cifrado con AES-GCM
+	EnabledAt    *time.Time // nil mientras el usuario no confirma el primer código
+	LastUsedStep int64      `gorm:"not null;default:0"` // Último paso aceptado; impide reutilizar un código
+
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	USER RECOVERY CODE – códigos de un solo uso para entrar sin la app TOTP
+//
+// ───────────────────────────────────────────
+type UserRecoveryCode struct {
+	gorm.Model
+	UserID   uint   `gorm:"not null;index"`
+	CodeHash string `gorm:"size:64;not null"` // Solo se guarda el hash del código
+	UsedAt   *time.Time
+
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	LOGIN ATTEMPT – auditoría de intentos de login (solo inserción)
+//
+// ───────────────────────────────────────────
+type LoginAttempt struct {
+	ID        uint      `gorm:"primarykey"`
+	CreatedAt time.Time `gorm:"not null;index"`
+	UserID    *uint     `gorm:"index"` // nil si el email no corresponde a ningún usuario
+	Email     string    `gorm:"size:255;not null;index"`
+	IPAddress string    `gorm:"size:64;index"`
+	UserAgent string    `gorm:"size:500"`
+	Success   bool      `gorm:"not null"`
+	Reason    string    `gorm:"size:50"` // invalid_password, locked, throttled...
+}
+
+// ───────────────────────────────────────────
+//
+//	AUDIT LOG – mutaciones administrativas (solo inserción)
+//
+// ───────────────────────────────────────────
+type AuditLog struct {
+	ID         uint      `gorm:"primarykey"`
+	CreatedAt  time.Time `gorm:"not null;index"`
+	BusinessID *uint     `gorm:"index"` // nil para cambios globales (tipos de negocio, recursos...)
+	UserID     *uint     `gorm:"index"` // Usuario que hizo el cambio
+	APIKeyID   *uint     `gorm:"index"` // API Key usada, si la petición no vino con JWT
+	IPAddress  string    `gorm:"size:64"`
+	EntityType string    `gorm:"size:50;not null;index:idx_audit_log_entity"` // role, table, resident, vote...
+	EntityID   string    `gorm:"size:64;index:idx_audit_log_entity"`
+	Action     string    `gorm:"size:30;not null;index"` // create, update, delete...
+	Changes    string    `gorm:"type:jsonb"`             // {"campo": {"before": x, "after": y}}
+}
+
+// ───────────────────────────────────────────
+//
+//	SSO PROVIDER – proveedor OpenID Connect del negocio para el login del staff
+//
+// ───────────────────────────────────────────
+type SSOProvider struct {
+	gorm.Model
+	BusinessID   uint   `gorm:"not null;uniqueIndex"` // Un proveedor por negocio
+	IssuerURL    string `gorm:"size:500;not null"`    // Emisor OIDC; se usa para el discovery
+	ClientID     string `gorm:"size:255;not null"`
+	ClientSecret string `gorm:"size:1000"`                                        // Cifrado con AES-GCM; vacío para clientes públicos (solo PKCE)
+	RedirectURL  string `gorm:"size:500;not null"`                                // Página del frontend que recibe el código
+	Scopes       string `gorm:"size:255;not null;default:'openid email profile'"` // Separados por espacio
+	GroupsClaim  string `gorm:"size:100;not null;default:'groups'"`               // Claim del ID token con los grupos del usuario
+
+	// Alta automática de usuarios que no existen en el sistema
+	AllowProvisioning bool  `gorm:"not null;default:false"`
+	DefaultRoleID     *uint `gorm:"index"` // Rol si ningún grupo tiene mapeo; nil rechaza el acceso
+	IsActive          bool  `gorm:"not null;default:true"`
+
+	Business     Business         `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	DefaultRole  *Role            `gorm:"foreignKey:DefaultRoleID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	RoleMappings []SSORoleMapping `gorm:"foreignKey:ProviderID"`
+}
+
+// ───────────────────────────────────────────
+//
+//	SSO ROLE MAPPING – grupo del proveedor → rol en el negocio
+//
+// ───────────────────────────────────────────
+type SSORoleMapping struct {
+	ID         uint   `gorm:"primarykey"`
+	ProviderID uint   `gorm:"not null;uniqueIndex:idx_sso_role_mapping_group,priority:1"`
+	GroupName  string `gorm:"size:255;not null;uniqueIndex:idx_sso_role_mapping_group,priority:2"`
+	RoleID     uint   `gorm:"not null;index"`
+	Priority   int    `gorm:"not null;default:0"` // Si el usuario está en varios grupos gana el de menor prioridad
+
+	Provider SSOProvider `gorm:"foreignKey:ProviderID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Role     Role        `gorm:"foreignKey:RoleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	USER IDENTITY – cuenta del usuario en un proveedor OIDC (issuer + subject)
+//
+// ───────────────────────────────────────────
+type UserIdentity struct {
+	gorm.Model
+	UserID      uint   `gorm:"not null;index"`
+	ProviderID  uint   `gorm:"not null;index"`
+	Issuer      string `gorm:"size:500;not null;uniqueIndex:idx_user_identity_subject,priority:1"`
+	Subject     string `gorm:"size:255;not null;uniqueIndex:idx_user_identity_subject,priority:2"`
+	Email       string `gorm:"size:255"` // Email informado por el proveedor en el último login
+	LastLoginAt *time.Time
+	ConfirmedAt *time.Time // nil mientras el dueño de la cuenta no confirme el vínculo por email
+
+	User     User        `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Provider SSOProvider `gorm:"foreignKey:ProviderID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// ───────────────────────────────────────────
+//
+//	SSO LOGIN STATE – login OIDC en curso (state, nonce y verificador PKCE)
+//
+// ───────────────────────────────────────────
+type SSOLoginState struct {
+	ID           uint      `gorm:"primarykey"`
+	CreatedAt    time.Time `gorm:"not null"`
+	StateHash    string    `gorm:"size:64;not null;uniqueIndex"` // SHA-256 del parámetro state
+	ProviderID   uint      `gorm:"not null;index"`
+	Nonce        string    `gorm:"size:100;not null"`
+	CodeVerifier string    `gorm:"size:100;not null"`
+	ExpiresAt    time.Time `gorm:"not null;index"`
+
+	Provider SSOProvider `gorm:"foreignKey:ProviderID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
 // ───────────────────────────────────────────
 //
 //	API KEYS - Claves de API para integraciones
@@ -380,8 +775,9 @@ type APIKey struct {
 	gorm.Model
 	UserID      uint   `gorm:"not null;index"`    // Usuario para el cual se genera la API Key
 	BusinessID  uint   `gorm:"not null;index"`    // Business asociado
-	CreatedByID uint   `gorm:"not null;index"`    // Usuario que creó la API Key (super admin)
+	CreatedByID uint   `gorm:"not null;index"`    // Usuario que creó la API Key
 	Name        string `gorm:"size:255;not null"` // Nombre de referencia (ej. "API para sitio web")
+	KeyPrefix   string `gorm:"size:32;index"`     // Parte pública de la API Key para buscarla sin conocer el secreto
 	KeyHash     string `gorm:"size:255;not null"` // Hash de la API Key (bcrypt)
 	Description string `gorm:"size:500"`          // Descripción opcional
 
@@ -399,3 +795,17 @@ type APIKey struct {
 	Business  Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	CreatedBy User     `gorm:"foreignKey:CreatedByID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 }
+
+// ───────────────────────────────────────────
+//
+//	API KEY RATE WINDOWS – requests de una API Key en cada ventana de su límite
+//
+// ───────────────────────────────────────────
+type APIKeyRateWindow struct {
+	gorm.Model
+	APIKeyID    uint      `gorm:"not null;uniqueIndex:idx_api_key_rate_window,priority:1"`
+	WindowStart time.Time `gorm:"not null;index;uniqueIndex:idx_api_key_rate_window,priority:2"` // Inicio de la ventana (hora truncada)
+	Requests    int       `gorm:"not null"`                                                      // Requests aceptadas en la ventana
+
+	APIKey APIKey `gorm:"foreignKey:APIKeyID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}